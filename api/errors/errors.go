@@ -91,6 +91,63 @@ var ErrQueryError = errors.New("query error")
 // ErrGetPidInfo signals that an error occurred while getting peer ID info
 var ErrGetPidInfo = errors.New("error getting peer id info")
 
+// ErrGetConsensusRoundsTimeline signals that an error occurred while getting the consensus rounds timeline
+var ErrGetConsensusRoundsTimeline = errors.New("error getting consensus rounds timeline")
+
+// ErrGetSlashingEvidences signals that an error occurred while getting the slashing evidences
+var ErrGetSlashingEvidences = errors.New("error getting slashing evidences")
+
+// ErrGetGasProfile signals that an error occurred while getting the gas profile
+var ErrGetGasProfile = errors.New("error getting gas profile")
+
+// ErrGetShufflingPreview signals that an error occurred while getting the next epoch shuffling preview
+var ErrGetShufflingPreview = errors.New("error getting the next epoch shuffling preview")
+
+// ErrGetValidatorHistory signals that an error occurred while getting the history of a validator
+var ErrGetValidatorHistory = errors.New("error getting the validator history")
+
+// ErrGetGovernanceProposals signals that an error occurred while getting the governance proposals
+var ErrGetGovernanceProposals = errors.New("error getting the governance proposals")
+
+// ErrGetGovernanceVotingPower signals that an error occurred while computing the governance voting power
+var ErrGetGovernanceVotingPower = errors.New("error getting the governance voting power")
+
+// ErrValidationEmptyProposalReference signals that an empty governance proposal reference was provided
+var ErrValidationEmptyProposalReference = errors.New("proposal reference is empty")
+
+// ErrGetUserDelegations signals that an error occurred while getting the delegations of an address
+var ErrGetUserDelegations = errors.New("error getting the delegations of the address")
+
+// ErrGetDelegationProvider signals that an error occurred while getting the delegation provider details
+var ErrGetDelegationProvider = errors.New("error getting the delegation provider")
+
+// ErrValidationEmptyDelegationContract signals that an empty delegation contract address was provided
+var ErrValidationEmptyDelegationContract = errors.New("delegation contract address is empty")
+
+// ErrGetStakingQueue signals that an error occurred while getting the staking queue
+var ErrGetStakingQueue = errors.New("error getting the staking queue")
+
+// ErrGetStakingNode signals that an error occurred while getting the staking details of a node
+var ErrGetStakingNode = errors.New("error getting the staking node")
+
+// ErrValidationEmptyBLSKey signals that an empty BLS key was provided
+var ErrValidationEmptyBLSKey = errors.New("BLS key is empty")
+
+// ErrEconomicsDryRun signals that an error occurred while running the economics dry-run
+var ErrEconomicsDryRun = errors.New("error running the economics dry-run")
+
+// ErrGetRewardsPreview signals that an error occurred while computing the rewards preview
+var ErrGetRewardsPreview = errors.New("error getting the rewards preview")
+
+// ErrGetESDTHolders signals that an error occurred while getting the holders of an ESDT
+var ErrGetESDTHolders = errors.New("error getting the esdt holders")
+
+// ErrGetCollectionNFTs signals that an error occurred while getting the NFTs of a collection
+var ErrGetCollectionNFTs = errors.New("error getting the collection nfts")
+
+// ErrGetEvents signals that an error occurred while getting the events
+var ErrGetEvents = errors.New("error getting the events")
+
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
 
@@ -109,6 +166,9 @@ var ErrGetProof = errors.New("getting proof failed")
 // ErrVerifyProof signals an error happening when trying to verify a Merkle proof
 var ErrVerifyProof = errors.New("verifying proof failed")
 
+// ErrGetTransactionInclusionProof signals an error happening when trying to compute a transaction inclusion proof
+var ErrGetTransactionInclusionProof = errors.New("getting transaction inclusion proof failed")
+
 // ErrNilHttpServer signals that a nil http server has been provided
 var ErrNilHttpServer = errors.New("nil http server")
 
@@ -123,3 +183,15 @@ var ErrFacadeWrongTypeAssertion = errors.New("facade - wrong type assertion")
 
 // ErrGetGenesisNodes signals that an error happened when trying to feth genesis nodes config
 var ErrGetGenesisNodes = errors.New("getting genesis nodes failed")
+
+// ErrGetAntifloodQuotaInfo signals that an error occurred while getting the antiflood quota info
+var ErrGetAntifloodQuotaInfo = errors.New("error getting antiflood quota info")
+
+// ErrGetBlacklistedPeers signals that an error occurred while getting the blacklisted peers
+var ErrGetBlacklistedPeers = errors.New("error getting blacklisted peers")
+
+// ErrBlacklistPeer signals that an error occurred while blacklisting a peer
+var ErrBlacklistPeer = errors.New("error blacklisting peer")
+
+// ErrPardonPeer signals that an error occurred while pardoning a peer
+var ErrPardonPeer = errors.New("error pardoning peer")
@@ -42,6 +42,12 @@ func checkArgs(args ArgsNewWebServer) error {
 	if check.IfNil(args.Facade) {
 		return errHandler("nil facade")
 	}
+	if check.IfNil(args.MetricsRegistry) {
+		return errHandler("nil metrics registry")
+	}
+	if check.IfNil(args.Tracer) {
+		return errHandler("nil tracer")
+	}
 
 	return nil
 }
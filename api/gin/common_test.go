@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/facade/initial"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/require"
 )
 
@@ -23,6 +25,14 @@ func TestCommon_checkArgs(t *testing.T) {
 
 	args.Facade = initial.NewInitialNodeFacade("api interface", false)
 	err = checkArgs(args)
+	require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
+
+	args.MetricsRegistry = &testscommon.MetricsRegistryStub{}
+	err = checkArgs(args)
+	require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
+
+	args.Tracer = tracing.NewDisabledTracer()
+	err = checkArgs(args)
 	require.NoError(t, err)
 }
 
@@ -13,6 +13,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/gin-contrib/cors"
@@ -27,6 +29,8 @@ type ArgsNewWebServer struct {
 	Facade          shared.FacadeHandler
 	ApiConfig       config.ApiRoutesConfig
 	AntiFloodConfig config.WebServerAntifloodConfig
+	MetricsRegistry common.MetricsRegistry
+	Tracer          tracing.Tracer
 }
 
 type webServer struct {
@@ -34,6 +38,8 @@ type webServer struct {
 	facade          shared.FacadeHandler
 	apiConfig       config.ApiRoutesConfig
 	antiFloodConfig config.WebServerAntifloodConfig
+	metricsRegistry common.MetricsRegistry
+	tracer          tracing.Tracer
 	httpServer      shared.HttpServerCloser
 	groups          map[string]shared.GroupHandler
 	cancelFunc      func()
@@ -50,6 +56,8 @@ func NewGinWebServerHandler(args ArgsNewWebServer) (*webServer, error) {
 		facade:          args.Facade,
 		antiFloodConfig: args.AntiFloodConfig,
 		apiConfig:       args.ApiConfig,
+		metricsRegistry: args.MetricsRegistry,
+		tracer:          args.Tracer,
 	}
 
 	return gws, nil
@@ -223,6 +231,17 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 
 func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, error) {
 	middlewares := make([]shared.MiddlewareProcessor, 0)
+	requestTracingMiddleware, err := middleware.NewRequestTracingMiddleware(ws.tracer)
+	if err != nil {
+		return nil, err
+	}
+	middlewares = append(middlewares, requestTracingMiddleware)
+
+	responseMetricsMiddleware, err := middleware.NewResponseMetricsMiddleware(ws.metricsRegistry)
+	if err != nil {
+		return nil, err
+	}
+	middlewares = append(middlewares, responseMetricsMiddleware)
 
 	if ws.apiConfig.Logging.LoggingEnabled {
 		responseLoggerMiddleware := middleware.NewResponseLoggerMiddleware(time.Duration(ws.apiConfig.Logging.ThresholdInMicroSeconds) * time.Microsecond)
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -112,6 +113,16 @@ type keyValuePairsResponse struct {
 	Code  string
 }
 
+type userDelegationsResponseData struct {
+	Delegations []*common.UserDelegation `json:"delegations"`
+}
+
+type userDelegationsResponse struct {
+	Data  userDelegationsResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string
+}
+
 type esdtRolesResponseData struct {
 	Roles map[string][]string `json:"roles"`
 }
@@ -960,6 +971,68 @@ func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 	assert.True(t, strings.Contains(response.Error, newErr.Error()))
 }
 
+func TestGetUserDelegations_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetUserDelegationsCalled: func(_ string) ([]*common.UserDelegation, error) {
+			return nil, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/delegations", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := userDelegationsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetUserDelegations.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetUserDelegations_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedDelegations := []*common.UserDelegation{
+		{
+			DelegationScAddress: "contract",
+			ActiveStake:         "1000",
+			UnStakedFunds: []*common.DelegationUnStakedFund{
+				{Value: "10", RemainingEpochs: 2, UnBondEpoch: 12},
+			},
+			ClaimableRewards:      "5",
+			TotalCumulatedRewards: "7",
+		},
+	}
+	facade := mock.FacadeStub{
+		GetUserDelegationsCalled: func(address string) ([]*common.UserDelegation, error) {
+			assert.Equal(t, "address", address)
+			return expectedDelegations, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/address/delegations", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := userDelegationsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedDelegations, response.Data.Delegations)
+}
+
 func getAddressRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -976,6 +1049,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: true},
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
+					{Name: "/:address/delegations", Open: true},
 				},
 			},
 		},
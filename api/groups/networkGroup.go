@@ -2,7 +2,9 @@ package groups
 
 import (
 	"fmt"
+	"math"
 	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/shared/logging"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/eventsIndex"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/gin-gonic/gin"
 )
@@ -27,10 +30,24 @@ const (
 	getSFTsPath            = "/esdt/semi-fungible-tokens"
 	getNFTsPath            = "/esdt/non-fungible-tokens"
 	getESDTSupplyPath      = "/esdt/supply/:token"
+	getESDTHoldersPath     = "/esdt/:token/holders"
+	getCollectionNFTsPath  = "/esdt/:token/nfts"
 	directStakedInfoPath   = "/direct-staked-info"
 	delegatedInfoPath      = "/delegated-info"
 	ratingsPath            = "/ratings"
 	genesisNodesConfigPath = "/genesis-nodes"
+	proposalsPath          = "/governance/proposals"
+	proposalPath           = "/governance/proposals/:reference"
+	votingPowerPath        = "/governance/voting-power/:address"
+	delegationProviderPath = "/delegation/:contract"
+	economicsDryRunPath    = "/economics/dry-run"
+	stakingQueuePath       = "/staking/queue"
+	stakingNodePath        = "/staking/node/:blsKey"
+	rewardsPreviewPath     = "/rewards/preview"
+	eventsPath             = "/events"
+
+	defaultPageSize = 100
+	maxPageSize     = 1000
 )
 
 // networkFacadeHandler defines the methods to be implemented by a facade for handling network requests
@@ -40,8 +57,19 @@ type networkFacadeHandler interface {
 	GetDelegatorsList() ([]*api.Delegator, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
-	GetTokenSupply(token string) (*api.ESDTSupply, error)
+	GetTokenSupply(token string) (*common.ESDTSupply, error)
+	GetESDTHolders(token string, from uint32, size uint32) (*common.ESDTHolders, error)
+	GetCollectionNFTs(collection string, from uint32, size uint32) (*common.CollectionNFTs, error)
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
+	GetGovernanceProposals() ([]*common.GovernanceProposal, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposal, error)
+	GetGovernanceVotingPower(address string) (*common.GovernanceVotingPower, error)
+	GetDelegationProvider(contract string) (*common.DelegationProvider, error)
+	GetStakingQueue(from uint32, size uint32) (*common.StakingQueue, error)
+	GetStakingNode(blsKey string) (*common.StakingNode, error)
+	DryRunEconomics(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error)
+	GetRewardsPreview() (*common.RewardsPreview, error)
+	GetEvents(query common.EventsQuery) (*common.Events, error)
 	IsInterfaceNil() bool
 }
 
@@ -124,6 +152,16 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.getESDTTokenSupply,
 		},
+		{
+			Path:    getESDTHoldersPath,
+			Method:  http.MethodGet,
+			Handler: ng.getESDTHolders,
+		},
+		{
+			Path:    getCollectionNFTsPath,
+			Method:  http.MethodGet,
+			Handler: ng.getCollectionNFTs,
+		},
 		{
 			Path:    ratingsPath,
 			Method:  http.MethodGet,
@@ -134,6 +172,51 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.getGenesisNodesConfig,
 		},
+		{
+			Path:    proposalsPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceProposals,
+		},
+		{
+			Path:    proposalPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceProposal,
+		},
+		{
+			Path:    votingPowerPath,
+			Method:  http.MethodGet,
+			Handler: ng.getGovernanceVotingPower,
+		},
+		{
+			Path:    delegationProviderPath,
+			Method:  http.MethodGet,
+			Handler: ng.getDelegationProvider,
+		},
+		{
+			Path:    economicsDryRunPath,
+			Method:  http.MethodGet,
+			Handler: ng.dryRunEconomics,
+		},
+		{
+			Path:    stakingQueuePath,
+			Method:  http.MethodGet,
+			Handler: ng.getStakingQueue,
+		},
+		{
+			Path:    stakingNodePath,
+			Method:  http.MethodGet,
+			Handler: ng.getStakingNode,
+		},
+		{
+			Path:    rewardsPreviewPath,
+			Method:  http.MethodGet,
+			Handler: ng.getRewardsPreview,
+		},
+		{
+			Path:    eventsPath,
+			Method:  http.MethodGet,
+			Handler: ng.getEvents,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -415,6 +498,369 @@ func (ng *networkGroup) getGenesisNodesConfig(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"nodes": nc}, "", shared.ReturnCodeSuccess)
 }
 
+// getESDTHolders returns a page of the holders of the provided token from the current shard
+func (ng *networkGroup) getESDTHolders(c *gin.Context) {
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyToken.Error()),
+		)
+		return
+	}
+
+	from, size, err := getQueryParamsPage(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	holders, err := ng.getFacade().GetESDTHolders(token, from, size)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetESDTHolders.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"holders": holders}, "", shared.ReturnCodeSuccess)
+}
+
+// getCollectionNFTs returns a page of the NFTs of the provided collection held in the current shard
+func (ng *networkGroup) getCollectionNFTs(c *gin.Context) {
+	collection := c.Param("token")
+	if collection == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyToken.Error()),
+		)
+		return
+	}
+
+	from, size, err := getQueryParamsPage(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	nfts, err := ng.getFacade().GetCollectionNFTs(collection, from, size)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetCollectionNFTs.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"nfts": nfts}, "", shared.ReturnCodeSuccess)
+}
+
+// getEvents returns a page of the events from the current shard filtered by emitter address, identifier, first topic
+// and blocks range
+func (ng *networkGroup) getEvents(c *gin.Context) {
+	query, err := getQueryParamsEvents(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	events, err := ng.getFacade().GetEvents(query)
+	logging.LogAPIActionDurationIfNeeded(start, "GetEvents")
+	if eventsIndex.IsQueryError(err) {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetEvents.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"events": events}, "", shared.ReturnCodeSuccess)
+}
+
+func getQueryParamsEvents(c *gin.Context) (common.EventsQuery, error) {
+	from, size, err := getQueryParamsPage(c)
+	if err != nil {
+		return common.EventsQuery{}, err
+	}
+
+	urlQuery := c.Request.URL.Query()
+	query := common.EventsQuery{
+		Address:    urlQuery.Get("address"),
+		Identifier: urlQuery.Get("identifier"),
+		Topic0:     urlQuery.Get("topic0"),
+		// the end of the blocks range is capped to the last indexed block
+		ToBlock:          math.MaxUint64,
+		DefaultFromBlock: true,
+		From:             from,
+		Size:             size,
+	}
+	if urlQuery.Get("fromBlock") != "" {
+		query.DefaultFromBlock = false
+		query.FromBlock, err = getQueryParamUint64(c, "fromBlock")
+		if err != nil {
+			return common.EventsQuery{}, errors.ErrInvalidQueryParameter
+		}
+	}
+	if urlQuery.Get("toBlock") != "" {
+		query.ToBlock, err = getQueryParamUint64(c, "toBlock")
+		if err != nil || query.ToBlock < query.FromBlock {
+			return common.EventsQuery{}, errors.ErrInvalidQueryParameter
+		}
+	}
+
+	return query, nil
+}
+
+func getQueryParamsPage(c *gin.Context) (uint32, uint32, error) {
+	from := uint32(0)
+	size := uint32(defaultPageSize)
+
+	var err error
+	if c.Request.URL.Query().Get("from") != "" {
+		from, err = getQueryParamUint32(c, "from")
+		if err != nil {
+			return 0, 0, errors.ErrInvalidQueryParameter
+		}
+	}
+	if c.Request.URL.Query().Get("size") != "" {
+		size, err = getQueryParamUint32(c, "size")
+		if err != nil || size == 0 || size > maxPageSize {
+			return 0, 0, errors.ErrInvalidQueryParameter
+		}
+	}
+
+	return from, size, nil
+}
+
+// getGovernanceProposals returns all the governance proposals together with their status and tallies
+func (ng *networkGroup) getGovernanceProposals(c *gin.Context) {
+	start := time.Now()
+	proposals, err := ng.getFacade().GetGovernanceProposals()
+	logging.LogAPIActionDurationIfNeeded(start, "GetGovernanceProposals")
+
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetGovernanceProposals.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"proposals": proposals}, "", shared.ReturnCodeSuccess)
+}
+
+// getGovernanceProposal returns the governance proposal with the provided reference together with its votes
+func (ng *networkGroup) getGovernanceProposal(c *gin.Context) {
+	reference := c.Param("reference")
+	if reference == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyProposalReference.Error()),
+		)
+		return
+	}
+
+	proposal, err := ng.getFacade().GetGovernanceProposal(reference)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetGovernanceProposals.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"proposal": proposal}, "", shared.ReturnCodeSuccess)
+}
+
+// getGovernanceVotingPower returns the governance voting power of the provided address
+func (ng *networkGroup) getGovernanceVotingPower(c *gin.Context) {
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyAddress.Error()),
+		)
+		return
+	}
+
+	votingPower, err := ng.getFacade().GetGovernanceVotingPower(address)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetGovernanceVotingPower.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"votingPower": votingPower}, "", shared.ReturnCodeSuccess)
+}
+
+// getDelegationProvider returns the configuration, the nodes states and the staked values of a delegation contract
+func (ng *networkGroup) getDelegationProvider(c *gin.Context) {
+	contract := c.Param("contract")
+	if contract == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyDelegationContract.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	provider, err := ng.getFacade().GetDelegationProvider(contract)
+	logging.LogAPIActionDurationIfNeeded(start, "GetDelegationProvider")
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetDelegationProvider.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"provider": provider}, "", shared.ReturnCodeSuccess)
+}
+
+// dryRunEconomics returns the fees, refunds and gas used by the transactions from the provided block nonces range
+// under both the current and the alternative economics and gas schedule configurations
+func (ng *networkGroup) dryRunEconomics(c *gin.Context) {
+	fromNonce, err := getQueryParamUint64(c, "fromNonce")
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+	toNonce, err := getQueryParamUint64(c, "toNonce")
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	result, err := ng.getFacade().DryRunEconomics(fromNonce, toNonce)
+	logging.LogAPIActionDurationIfNeeded(start, "DryRunEconomics")
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrEconomicsDryRun.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"dryRun": result}, "", shared.ReturnCodeSuccess)
+}
+
+// getStakingQueue returns a page of the nodes from the staking queue together with their positions and owners
+func (ng *networkGroup) getStakingQueue(c *gin.Context) {
+	from, size, err := getQueryParamsPage(c)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	start := time.Now()
+	queue, err := ng.getFacade().GetStakingQueue(from, size)
+	logging.LogAPIActionDurationIfNeeded(start, "GetStakingQueue")
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetStakingQueue.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"queue": queue}, "", shared.ReturnCodeSuccess)
+}
+
+// getStakingNode returns the staking lifecycle view of the node with the provided BLS key
+func (ng *networkGroup) getStakingNode(c *gin.Context) {
+	blsKey := c.Param("blsKey")
+	if blsKey == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyBLSKey.Error()),
+		)
+		return
+	}
+
+	node, err := ng.getFacade().GetStakingNode(blsKey)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetStakingNode.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"node": node}, "", shared.ReturnCodeSuccess)
+}
+
+// getRewardsPreview returns the rewards per node, per owner and per delegation contract estimated as if the current
+// epoch ended at the current block
+func (ng *networkGroup) getRewardsPreview(c *gin.Context) {
+	start := time.Now()
+	preview, err := ng.getFacade().GetRewardsPreview()
+	logging.LogAPIActionDurationIfNeeded(start, "GetRewardsPreview")
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusInternalServerError,
+			nil,
+			fmt.Sprintf("%s: %s", errors.ErrGetRewardsPreview.Error(), err.Error()),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"preview": preview}, "", shared.ReturnCodeSuccess)
+}
+
+func getQueryParamUint64(c *gin.Context, name string) (uint64, error) {
+	return strconv.ParseUint(c.Request.URL.Query().Get(name), 10, 64)
+}
+
 func (ng *networkGroup) getFacade() networkFacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
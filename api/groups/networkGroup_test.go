@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
@@ -19,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/eventsIndex"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -62,6 +64,94 @@ type genesisNodesConfigData struct {
 	Nodes groups.GenesisNodesConfig `json:"nodes"`
 }
 
+type governanceProposalsResponse struct {
+	Data struct {
+		Proposals []*common.GovernanceProposal `json:"proposals"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceProposalResponse struct {
+	Data struct {
+		Proposal *common.GovernanceProposal `json:"proposal"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type governanceVotingPowerResponse struct {
+	Data struct {
+		VotingPower *common.GovernanceVotingPower `json:"votingPower"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type esdtHoldersResponse struct {
+	Data struct {
+		Holders *common.ESDTHolders `json:"holders"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type collectionNFTsResponse struct {
+	Data struct {
+		NFTs *common.CollectionNFTs `json:"nfts"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type delegationProviderResponse struct {
+	Data struct {
+		Provider *common.DelegationProvider `json:"provider"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type economicsDryRunResponse struct {
+	Data struct {
+		DryRun *common.EconomicsDryRunResult `json:"dryRun"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type stakingQueueResponse struct {
+	Data struct {
+		Queue *common.StakingQueue `json:"queue"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type stakingNodeResponse struct {
+	Data struct {
+		Node *common.StakingNode `json:"node"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type rewardsPreviewResponse struct {
+	Data struct {
+		Preview *common.RewardsPreview `json:"preview"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type eventsResponse struct {
+	Data struct {
+		Events *common.Events `json:"events"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 type ratingsConfigResponse struct {
 	Data struct {
 		Config map[string]interface{} `json:"config"`
@@ -555,7 +645,7 @@ func TestGetESDTTotalSupply_InternalError(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetTokenSupplyCalled: func(token string) (*api.ESDTSupply, error) {
+		GetTokenSupplyCalled: func(token string) (*common.ESDTSupply, error) {
 			return nil, expectedErr
 		},
 	}
@@ -643,15 +733,16 @@ func TestGetESDTTotalSupply(t *testing.T) {
 	t.Parallel()
 
 	type supplyResponse struct {
-		Data *api.ESDTSupply `json:"data"`
+		Data *common.ESDTSupply `json:"data"`
 	}
 
 	facade := mock.FacadeStub{
-		GetTokenSupplyCalled: func(token string) (*api.ESDTSupply, error) {
-			return &api.ESDTSupply{
-				Supply: "1000",
-				Burned: "500",
-				Minted: "1500",
+		GetTokenSupplyCalled: func(token string) (*common.ESDTSupply, error) {
+			return &common.ESDTSupply{
+				Supply:  "1000",
+				Burned:  "500",
+				Minted:  "1500",
+				Partial: true,
 			}, nil
 		},
 	}
@@ -672,10 +763,11 @@ func TestGetESDTTotalSupply(t *testing.T) {
 	err = json.Unmarshal(respBytes, respSupply)
 	require.Nil(t, err)
 
-	require.Equal(t, &supplyResponse{Data: &api.ESDTSupply{
-		Supply: "1000",
-		Burned: "500",
-		Minted: "1500",
+	require.Equal(t, &supplyResponse{Data: &common.ESDTSupply{
+		Supply:  "1000",
+		Burned:  "500",
+		Minted:  "1500",
+		Partial: true,
 	}}, respSupply)
 }
 
@@ -747,6 +839,870 @@ func TestGetGenesisNodes(t *testing.T) {
 	})
 }
 
+func TestGetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetGovernanceProposalsCalled: func() ([]*common.GovernanceProposal, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposals", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGovernanceProposals.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proposals := []*common.GovernanceProposal{
+			{Reference: "reference", Status: "active", Yes: "7", No: "2", Veto: "0"},
+		}
+		facade := mock.FacadeStub{
+			GetGovernanceProposalsCalled: func() ([]*common.GovernanceProposal, error) {
+				return proposals, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposals", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, proposals, response.Data.Proposals)
+	})
+}
+
+func TestGetGovernanceProposal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetGovernanceProposalCalled: func(reference string) (*common.GovernanceProposal, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposals/reference", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proposal := &common.GovernanceProposal{
+			Reference: "reference",
+			Votes: []*common.GovernanceVote{
+				{Voter: "voter", Items: []*common.GovernanceVoteItem{{Value: "Yes", Power: "3", Balance: "9"}}},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetGovernanceProposalCalled: func(reference string) (*common.GovernanceProposal, error) {
+				assert.Equal(t, "reference", reference)
+				return proposal, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/proposals/reference", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceProposalResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, proposal, response.Data.Proposal)
+	})
+}
+
+func TestGetGovernanceVotingPower(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetGovernanceVotingPowerCalled: func(address string) (*common.GovernanceVotingPower, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/voting-power/address", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceVotingPowerResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGovernanceVotingPower.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		votingPower := &common.GovernanceVotingPower{
+			Address:              "address",
+			StakedValue:          "100",
+			DelegatedValue:       "21",
+			ValidatorVotingPower: "10",
+			VotingPower:          "11",
+		}
+		facade := mock.FacadeStub{
+			GetGovernanceVotingPowerCalled: func(address string) (*common.GovernanceVotingPower, error) {
+				assert.Equal(t, "address", address)
+				return votingPower, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/governance/voting-power/address", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := governanceVotingPowerResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, votingPower, response.Data.VotingPower)
+	})
+}
+
+func TestGetDelegationProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetDelegationProviderCalled: func(contract string) (*common.DelegationProvider, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/delegation/contract", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := delegationProviderResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetDelegationProvider.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		provider := &common.DelegationProvider{
+			DelegationScAddress: "contract",
+			OwnerAddress:        "owner",
+			ServiceFee:          "1000",
+			NumUsers:            3,
+			TotalActiveStake:    "2500",
+			TopUp:               "0",
+			Nodes: []*common.DelegationProviderNode{
+				{BLSKey: "abcd", State: "staked"},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetDelegationProviderCalled: func(contract string) (*common.DelegationProvider, error) {
+				assert.Equal(t, "contract", contract)
+				return provider, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/delegation/contract", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := delegationProviderResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, provider, response.Data.Provider)
+	})
+}
+
+func TestGetStakingQueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid page size should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetStakingQueueCalled: func(from uint32, size uint32) (*common.StakingQueue, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/staking/queue?size=0", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := stakingQueueResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetStakingQueueCalled: func(from uint32, size uint32) (*common.StakingQueue, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/staking/queue", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := stakingQueueResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStakingQueue.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		queue := &common.StakingQueue{
+			Size: 1,
+			Nodes: []*common.StakingQueueEntry{
+				{Position: 1, BLSKey: "abcd", OwnerAddress: "owner", RewardAddress: "reward", RegisterNonce: 37},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetStakingQueueCalled: func(from uint32, size uint32) (*common.StakingQueue, error) {
+				assert.Equal(t, uint32(10), from)
+				assert.Equal(t, uint32(20), size)
+				return queue, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/staking/queue?from=10&size=20", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := stakingQueueResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, queue, response.Data.Queue)
+	})
+}
+
+func TestGetStakingNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetStakingNodeCalled: func(blsKey string) (*common.StakingNode, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/staking/node/abcd", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := stakingNodeResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStakingNode.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		node := &common.StakingNode{
+			BLSKey:         "abcd",
+			OwnerAddress:   "owner",
+			RewardAddress:  "reward",
+			Status:         "queued",
+			QueuePosition:  4,
+			QueueSize:      10,
+			TotalStaked:    "2600",
+			TopUp:          "100",
+			NumActiveNodes: 1,
+		}
+		facade := mock.FacadeStub{
+			GetStakingNodeCalled: func(blsKey string) (*common.StakingNode, error) {
+				assert.Equal(t, "abcd", blsKey)
+				return node, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/staking/node/abcd", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := stakingNodeResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, node, response.Data.Node)
+	})
+}
+
+func TestGetRewardsPreview(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetRewardsPreviewCalled: func() (*common.RewardsPreview, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/rewards/preview", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := rewardsPreviewResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetRewardsPreview.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		preview := &common.RewardsPreview{
+			Epoch:             37,
+			TotalToDistribute: "1000",
+			Nodes: []*common.RewardsPreviewNode{
+				{BLSKey: "abcd", OwnerAddress: "owner", RewardAddress: "reward", TotalReward: "100"},
+			},
+			Owners: []*common.RewardsPreviewAccount{
+				{Address: "owner", NumEligibleNodes: 1, TotalStaked: "2500", TotalReward: "100", EstimatedAPR: 0.1},
+			},
+			DelegationContracts: []*common.RewardsPreviewAccount{},
+		}
+		facade := mock.FacadeStub{
+			GetRewardsPreviewCalled: func() (*common.RewardsPreview, error) {
+				return preview, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/rewards/preview", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := rewardsPreviewResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, preview, response.Data.Preview)
+	})
+}
+
+func TestGetEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid query parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetEventsCalled: func(query common.EventsQuery) (*common.Events, error) {
+				assert.Fail(t, "should have not called the facade")
+				return nil, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		for _, query := range []string{"?fromBlock=a", "?toBlock=-1", "?fromBlock=5&toBlock=4", "?size=0", "?from=a"} {
+			req, _ := http.NewRequest("GET", "/network/events"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := eventsResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetEventsCalled: func(query common.EventsQuery) (*common.Events, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/events", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := eventsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetEvents.Error()))
+	})
+	t.Run("query error from the facade should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		for _, expectedErr := range []error{eventsIndex.ErrInvalidBlocksRange, eventsIndex.ErrBlocksRangeTooLarge} {
+			facade := mock.FacadeStub{
+				GetEventsCalled: func(query common.EventsQuery) (*common.Events, error) {
+					return nil, expectedErr
+				},
+			}
+
+			networkGroup, err := groups.NewNetworkGroup(&facade)
+			require.NoError(t, err)
+
+			ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+			req, _ := http.NewRequest("GET", "/network/events?fromBlock=0&address=erd1", nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := eventsResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+			assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+		}
+	})
+	t.Run("omitted blocks range should use the default one", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetEventsCalled: func(query common.EventsQuery) (*common.Events, error) {
+				assert.Equal(t, common.EventsQuery{
+					Address:          "erd1",
+					ToBlock:          math.MaxUint64,
+					DefaultFromBlock: true,
+					Size:             100,
+				}, query)
+				return &common.Events{}, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/events?address=erd1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		events := &common.Events{
+			FromBlock: 10,
+			ToBlock:   20,
+			HasMore:   true,
+			Events: []*common.Event{
+				{BlockNonce: 12, TxHash: "abcd", Address: "erd1", Identifier: "transfer", Topics: [][]byte{[]byte("topic")}},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetEventsCalled: func(query common.EventsQuery) (*common.Events, error) {
+				assert.Equal(t, common.EventsQuery{
+					Address:    "erd1",
+					Identifier: "transfer",
+					Topic0:     "abcd",
+					FromBlock:  10,
+					ToBlock:    math.MaxUint64,
+					From:       3,
+					Size:       1,
+				}, query)
+				return events, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/events?address=erd1&identifier=transfer&topic0=abcd&fromBlock=10&from=3&size=1", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := eventsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, events, response.Data.Events)
+	})
+}
+
+func TestDryRunEconomics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonces should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			DryRunEconomicsCalled: func(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error) {
+				assert.Fail(t, "should have not called the facade")
+				return nil, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		for _, query := range []string{"", "?fromNonce=1", "?toNonce=2", "?fromNonce=a&toNonce=2", "?fromNonce=1&toNonce=-2"} {
+			req, _ := http.NewRequest("GET", "/network/economics/dry-run"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := economicsDryRunResponse{}
+			loadResponse(resp.Body, &response)
+
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+		}
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			DryRunEconomicsCalled: func(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/economics/dry-run?fromNonce=1&toNonce=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := economicsDryRunResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrEconomicsDryRun.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		result := &common.EconomicsDryRunResult{
+			FromNonce:       10,
+			ToNonce:         12,
+			NumTransactions: 1,
+			Current:         &common.EconomicsDryRunTotals{GasUsed: 50000, Fees: "50000000000000"},
+			Alternative:     &common.EconomicsDryRunTotals{GasUsed: 60000, Fees: "60000000000000"},
+			Transactions: []*common.EconomicsDryRunTransaction{
+				{
+					Hash:        "aabb",
+					BlockNonce:  11,
+					Current:     &common.EconomicsDryRunOutcome{GasUsed: 50000, Fee: "50000000000000", Refund: "0"},
+					Alternative: &common.EconomicsDryRunOutcome{GasUsed: 60000, Fee: "60000000000000", Refund: "0"},
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			DryRunEconomicsCalled: func(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error) {
+				assert.Equal(t, uint64(10), fromNonce)
+				assert.Equal(t, uint64(12), toNonce)
+				return result, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/economics/dry-run?fromNonce=10&toNonce=12", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := economicsDryRunResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, result, response.Data.DryRun)
+	})
+}
+
+func TestGetESDTHolders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid page size should error", func(t *testing.T) {
+		t.Parallel()
+
+		networkGroup, err := groups.NewNetworkGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/TKN-1q2w3e/holders?size=1001", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := esdtHoldersResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetESDTHoldersCalled: func(token string, from uint32, size uint32) (*common.ESDTHolders, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/TKN-1q2w3e/holders", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := esdtHoldersResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetESDTHolders.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		holders := &common.ESDTHolders{
+			Token:      "TKN-1q2w3e",
+			NumHolders: 12,
+			Holders:    []*common.ESDTHolder{{Address: "alice", Balance: "100"}},
+		}
+		facade := mock.FacadeStub{
+			GetESDTHoldersCalled: func(token string, from uint32, size uint32) (*common.ESDTHolders, error) {
+				assert.Equal(t, "TKN-1q2w3e", token)
+				assert.Equal(t, uint32(10), from)
+				assert.Equal(t, uint32(5), size)
+				return holders, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/TKN-1q2w3e/holders?from=10&size=5", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := esdtHoldersResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, holders, response.Data.Holders)
+	})
+}
+
+func TestGetCollectionNFTs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetCollectionNFTsCalled: func(collection string, from uint32, size uint32) (*common.CollectionNFTs, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/NFT-1q2w3e/nfts", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := collectionNFTsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetCollectionNFTs.Error()))
+	})
+	t.Run("should work with the default page", func(t *testing.T) {
+		t.Parallel()
+
+		nfts := &common.CollectionNFTs{
+			Collection: "NFT-1q2w3e",
+			NumNFTs:    1,
+			NFTs: []*common.CollectionNFT{
+				{
+					Identifier: "NFT-1q2w3e-01",
+					Nonce:      1,
+					Owners:     []*common.ESDTHolder{{Address: "alice", Balance: "1"}},
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetCollectionNFTsCalled: func(collection string, from uint32, size uint32) (*common.CollectionNFTs, error) {
+				assert.Equal(t, "NFT-1q2w3e", collection)
+				assert.Equal(t, uint32(0), from)
+				assert.Equal(t, uint32(100), size)
+				return nfts, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/NFT-1q2w3e/nfts", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := collectionNFTsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, nfts, response.Data.NFTs)
+	})
+}
+
 func getNetworkRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -761,8 +1717,19 @@ func getNetworkRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/direct-staked-info", Open: true},
 					{Name: "/delegated-info", Open: true},
 					{Name: "/esdt/supply/:token", Open: true},
+					{Name: "/esdt/:token/holders", Open: true},
+					{Name: "/esdt/:token/nfts", Open: true},
 					{Name: "/genesis-nodes", Open: true},
 					{Name: "/ratings", Open: true},
+					{Name: "/governance/proposals", Open: true},
+					{Name: "/governance/proposals/:reference", Open: true},
+					{Name: "/governance/voting-power/:address", Open: true},
+					{Name: "/delegation/:contract", Open: true},
+					{Name: "/economics/dry-run", Open: true},
+					{Name: "/staking/queue", Open: true},
+					{Name: "/staking/node/:blsKey", Open: true},
+					{Name: "/rewards/preview", Open: true},
+					{Name: "/events", Open: true},
 				},
 			},
 		},
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
@@ -16,13 +17,20 @@ import (
 )
 
 const (
-	pidQueryParam       = "pid"
-	debugPath           = "/debug"
-	heartbeatStatusPath = "/heartbeatstatus"
-	metricsPath         = "/metrics"
-	p2pStatusPath       = "/p2pstatus"
-	peerInfoPath        = "/peerinfo"
-	statusPath          = "/status"
+	pidQueryParam         = "pid"
+	debugPath             = "/debug"
+	heartbeatStatusPath   = "/heartbeatstatus"
+	metricsPath           = "/metrics"
+	p2pStatusPath         = "/p2pstatus"
+	peerInfoPath          = "/peerinfo"
+	statusPath            = "/status"
+	consensusRoundsPath   = "/consensus/rounds"
+	slashingEvidencesPath = "/slashing/evidences"
+	gasProfilePath        = "/gas-profile"
+	antifloodQuotasPath   = "/antiflood/quotas"
+	blacklistPath         = "/antiflood/blacklist"
+	blacklistAddPath      = "/antiflood/blacklist/add"
+	blacklistPardonPath   = "/antiflood/blacklist/pardon"
 )
 
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
@@ -31,6 +39,13 @@ type nodeFacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRoundsTimeline() ([]*common.ConsensusRoundTimeline, error)
+	GetSlashingEvidences() ([]*common.SlashingEvidence, error)
+	GetGasProfile() (*common.GasProfile, error)
+	GetAntifloodQuotaInfo() (*common.AntifloodQuotaInfo, error)
+	GetBlacklistedPeers() ([]common.BlacklistedPeerInfo, error)
+	BlacklistPeerManually(pid string, reason string, durationInSeconds uint32) error
+	PardonPeer(pid string) error
 	IsInterfaceNil() bool
 }
 
@@ -40,6 +55,18 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// BlacklistPeerRequest represents the structure on which user input for manually blacklisting a peer will validate against
+type BlacklistPeerRequest struct {
+	Pid               string `json:"pid"`
+	Reason            string `json:"reason"`
+	DurationInSeconds uint32 `json:"durationInSeconds"`
+}
+
+// PardonPeerRequest represents the structure on which user input for pardoning a peer will validate against
+type PardonPeerRequest struct {
+	Pid string `json:"pid"`
+}
+
 type nodeGroup struct {
 	*baseGroup
 	facade    nodeFacadeHandler
@@ -88,6 +115,41 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.peerInfo,
 		},
+		{
+			Path:    consensusRoundsPath,
+			Method:  http.MethodGet,
+			Handler: ng.consensusRounds,
+		},
+		{
+			Path:    slashingEvidencesPath,
+			Method:  http.MethodGet,
+			Handler: ng.slashingEvidences,
+		},
+		{
+			Path:    gasProfilePath,
+			Method:  http.MethodGet,
+			Handler: ng.gasProfile,
+		},
+		{
+			Path:    antifloodQuotasPath,
+			Method:  http.MethodGet,
+			Handler: ng.antifloodQuotas,
+		},
+		{
+			Path:    blacklistPath,
+			Method:  http.MethodGet,
+			Handler: ng.blacklistedPeers,
+		},
+		{
+			Path:    blacklistAddPath,
+			Method:  http.MethodPost,
+			Handler: ng.blacklistPeer,
+		},
+		{
+			Path:    blacklistPardonPath,
+			Method:  http.MethodPost,
+			Handler: ng.pardonPeer,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -241,6 +303,209 @@ func (ng *nodeGroup) peerInfo(c *gin.Context) {
 	)
 }
 
+// consensusRounds returns the timeline of the consensus events of the last rounds
+func (ng *nodeGroup) consensusRounds(c *gin.Context) {
+	rounds, err := ng.getFacade().GetConsensusRoundsTimeline()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetConsensusRoundsTimeline.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"rounds": rounds},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// slashingEvidences returns the evidences of the validators that signed conflicting consensus messages
+func (ng *nodeGroup) slashingEvidences(c *gin.Context) {
+	evidences, err := ng.getFacade().GetSlashingEvidences()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetSlashingEvidences.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"evidences": evidences},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// gasProfile returns the gas consumption aggregated per contract function and per built-in function
+func (ng *nodeGroup) gasProfile(c *gin.Context) {
+	profile, err := ng.getFacade().GetGasProfile()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetGasProfile.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"profile": profile},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// antifloodQuotas returns the current per-peer and per-topic quota usage of the antiflood components
+func (ng *nodeGroup) antifloodQuotas(c *gin.Context) {
+	quotaInfo, err := ng.getFacade().GetAntifloodQuotaInfo()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetAntifloodQuotaInfo.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"quotas": quotaInfo},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// blacklistedPeers returns the blacklisted peers along with the reason and the expiry time of each decision
+func (ng *nodeGroup) blacklistedPeers(c *gin.Context) {
+	peers, err := ng.getFacade().GetBlacklistedPeers()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetBlacklistedPeers.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"peers": peers},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// blacklistPeer will manually blacklist the peer provided in the request
+func (ng *nodeGroup) blacklistPeer(c *gin.Context) {
+	request := BlacklistPeerRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	err = ng.getFacade().BlacklistPeerManually(request.Pid, request.Reason, request.DurationInSeconds)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrBlacklistPeer.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"status": "blacklisted"},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// pardonPeer will remove from the black list the peer provided in the request
+func (ng *nodeGroup) pardonPeer(c *gin.Context) {
+	request := PardonPeerRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	err = ng.getFacade().PardonPeer(request.Pid)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrPardonPeer.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"status": "pardoned"},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // prometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 	metrics, err := ng.getFacade().StatusMetrics().StatusMetricsWithoutP2PPrometheusString()
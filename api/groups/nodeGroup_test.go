@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
@@ -387,6 +388,487 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	assert.NotNil(t, responseInfo["info"])
 }
 
+func TestConsensusRounds_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetConsensusRoundsTimelineCalled: func() ([]*common.ConsensusRoundTimeline, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetConsensusRoundsTimeline.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestConsensusRounds_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	blockReceivedMs := int64(150)
+	facade := mock.FacadeStub{
+		GetConsensusRoundsTimelineCalled: func() ([]*common.ConsensusRoundTimeline, error) {
+			return []*common.ConsensusRoundTimeline{
+				{
+					Round:           37,
+					SelfRole:        "validator",
+					BlockReceivedMs: &blockReceivedMs,
+					Outcome:         "committed",
+				},
+			}, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	rounds, ok := responseData["rounds"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(rounds))
+
+	round, ok := rounds[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, float64(37), round["round"])
+	assert.Equal(t, float64(blockReceivedMs), round["blockReceivedMs"])
+	assert.Equal(t, "committed", round["outcome"])
+}
+
+func TestSlashingEvidences_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetSlashingEvidencesCalled: func() ([]*common.SlashingEvidence, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/slashing/evidences", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetSlashingEvidences.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestSlashingEvidences_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetSlashingEvidencesCalled: func() ([]*common.SlashingEvidence, error) {
+			return []*common.SlashingEvidence{
+				{
+					Type:   "doubleSigning",
+					PubKey: "aabb",
+					Round:  37,
+					TxData: "reportSlashingEvidence@aa",
+				},
+			}, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/slashing/evidences", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	evidences, ok := responseData["evidences"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(evidences))
+
+	evidence, ok := evidences[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "doubleSigning", evidence["type"])
+	assert.Equal(t, "aabb", evidence["pubKey"])
+	assert.Equal(t, float64(37), evidence["round"])
+	assert.Equal(t, "reportSlashingEvidence@aa", evidence["txData"])
+}
+
+func TestGasProfile_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetGasProfileCalled: func() (*common.GasProfile, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/gas-profile", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetGasProfile.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGasProfile_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetGasProfileCalled: func() (*common.GasProfile, error) {
+			return &common.GasProfile{
+				WindowInSeconds: 3600,
+				Contracts: []*common.GasProfileEntry{
+					{
+						Contract:       "erd1contract",
+						Function:       "swap",
+						NumCalls:       4,
+						NumFailed:      1,
+						FailureRate:    0.25,
+						GasUsed:        4000,
+						AverageGasUsed: 1000,
+					},
+				},
+				BuiltInFunctions: []*common.GasProfileEntry{
+					{
+						Function:       "ESDTTransfer",
+						NumCalls:       2,
+						GasUsed:        100,
+						AverageGasUsed: 50,
+					},
+				},
+			}, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/gas-profile", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	profile, ok := responseData["profile"].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, float64(3600), profile["windowInSeconds"])
+
+	contracts, ok := profile["contracts"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(contracts))
+	contract, ok := contracts[0].(map[string]interface{})
+	require.True(t, ok)
+	assert.Equal(t, "erd1contract", contract["contract"])
+	assert.Equal(t, "swap", contract["function"])
+	assert.Equal(t, 0.25, contract["failureRate"])
+	assert.Equal(t, float64(1000), contract["averageGasUsed"])
+
+	builtInFunctions, ok := profile["builtInFunctions"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(builtInFunctions))
+	builtInFunction, ok := builtInFunctions[0].(map[string]interface{})
+	require.True(t, ok)
+	_, hasContract := builtInFunction["contract"]
+	assert.False(t, hasContract)
+	assert.Equal(t, "ESDTTransfer", builtInFunction["function"])
+	assert.Equal(t, float64(100), builtInFunction["gasUsed"])
+}
+
+func TestAntifloodQuotas_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetAntifloodQuotaInfoCalled: func() (*common.AntifloodQuotaInfo, error) {
+			return &common.AntifloodQuotaInfo{
+				FloodPreventers: []common.FloodPreventerQuotaInfo{
+					{
+						Name:                  "fast_reacting",
+						MaxNumMessagesPerPeer: 140,
+						Peers: []common.PeerQuotaInfo{
+							{Pid: "pid", NumReceivedMessages: 150},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/antiflood/quotas", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "", response.Error)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	quotas, ok := responseData["quotas"].(map[string]interface{})
+	require.True(t, ok)
+	floodPreventers, ok := quotas["floodPreventers"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(floodPreventers))
+	floodPreventer := floodPreventers[0].(map[string]interface{})
+	assert.Equal(t, "fast_reacting", floodPreventer["name"])
+}
+
+func TestBlacklistedPeers_FacadeErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetBlacklistedPeersCalled: func() ([]common.BlacklistedPeerInfo, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/antiflood/blacklist", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetBlacklistedPeers.Error()))
+}
+
+func TestBlacklistedPeers_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetBlacklistedPeersCalled: func() ([]common.BlacklistedPeerInfo, error) {
+			return []common.BlacklistedPeerInfo{
+				{Pid: "pid", Reason: "spam", IsManual: true, BlacklistedAt: 100, ExpiresAt: 200},
+			}, nil
+		},
+	}
+
+	nodeGroup, err := groups.NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/antiflood/blacklist", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	responseData, ok := response.Data.(map[string]interface{})
+	require.True(t, ok)
+	peers, ok := responseData["peers"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(peers))
+	peer := peers[0].(map[string]interface{})
+	assert.Equal(t, "spam", peer["reason"])
+	assert.Equal(t, float64(200), peer["expiresAt"])
+}
+
+func TestBlacklistPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/antiflood/blacklist/add", bytes.NewBuffer([]byte("invalid")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			BlacklistPeerManuallyCalled: func(pid string, reason string, durationInSeconds uint32) error {
+				return expectedErr
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		body := []byte(`{"pid":"pid","reason":"spam","durationInSeconds":60}`)
+		req, _ := http.NewRequest("POST", "/node/antiflood/blacklist/add", bytes.NewBuffer(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBlacklistPeer.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		blacklistCalled := false
+		facade := mock.FacadeStub{
+			BlacklistPeerManuallyCalled: func(pid string, reason string, durationInSeconds uint32) error {
+				blacklistCalled = true
+				assert.Equal(t, "pid", pid)
+				assert.Equal(t, "spam", reason)
+				assert.Equal(t, uint32(60), durationInSeconds)
+
+				return nil
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		body := []byte(`{"pid":"pid","reason":"spam","durationInSeconds":60}`)
+		req, _ := http.NewRequest("POST", "/node/antiflood/blacklist/add", bytes.NewBuffer(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.True(t, blacklistCalled)
+	})
+}
+
+func TestPardonPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			PardonPeerCalled: func(pid string) error {
+				return expectedErr
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/antiflood/blacklist/pardon", bytes.NewBuffer([]byte(`{"pid":"pid"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrPardonPeer.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pardonedPid := ""
+		facade := mock.FacadeStub{
+			PardonPeerCalled: func(pid string) error {
+				pardonedPid = pid
+				return nil
+			},
+		}
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/antiflood/blacklist/pardon", bytes.NewBuffer([]byte(`{"pid":"pid"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "pid", pardonedPid)
+	})
+}
+
 func TestPrometheusMetrics_ShouldReturnErrorIfFacadeReturnsError(t *testing.T) {
 	expectedErr := errors.New("i am an error")
 
@@ -466,6 +948,13 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/p2pstatus", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
+					{Name: "/consensus/rounds", Open: true},
+					{Name: "/slashing/evidences", Open: true},
+					{Name: "/gas-profile", Open: true},
+					{Name: "/antiflood/quotas", Open: true},
+					{Name: "/antiflood/blacklist", Open: true},
+					{Name: "/antiflood/blacklist/add", Open: true},
+					{Name: "/antiflood/blacklist/pardon", Open: true},
 				},
 			},
 		},
@@ -8,6 +8,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
@@ -20,10 +21,18 @@ const (
 	getProofEndpoint                = "/proof/root-hash/:roothash/address/:address"
 	getProofDataTrieEndpoint        = "/proof/root-hash/:roothash/address/:address/key/:key"
 	verifyProofEndpoint             = "/proof/verify"
+	getMultiProofEndpoint           = "/proof/multi"
+	verifyMultiProofEndpoint        = "/proof/verify-multi"
+	getProofESDTBalanceEndpoint     = "/proof/root-hash/:roothash/address/:address/esdt/:tokenIdentifier"
+	verifyESDTBalanceProofEndpoint  = "/proof/verify-esdt"
 	getProofCurrentRootHashPath     = "/address/:address"
 	getProofPath                    = "/root-hash/:roothash/address/:address"
 	getProofDataTriePath            = "/root-hash/:roothash/address/:address/key/:key"
 	verifyProofPath                 = "/verify"
+	getMultiProofPath               = "/multi"
+	verifyMultiProofPath            = "/verify-multi"
+	getProofESDTBalancePath         = "/root-hash/:roothash/address/:address/esdt/:tokenIdentifier"
+	verifyESDTBalanceProofPath      = "/verify-esdt"
 )
 
 // proofFacadeHandler defines the methods to be implemented by a facade for proof requests
@@ -32,6 +41,10 @@ type proofFacadeHandler interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProof(rootHash string, addresses []string, proof [][]byte) (bool, error)
+	GetProofESDTBalance(rootHash string, address string, tokenIdentifier string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyESDTBalanceProof(rootHash string, address string, tokenIdentifier string, mainProof [][]byte, dataTrieProof [][]byte) (*esdt.ESDigitalToken, bool, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -98,6 +111,50 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 				},
 			},
 		},
+		{
+			Path:    getMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.getMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getProofESDTBalancePath,
+			Method:  http.MethodGet,
+			Handler: pg.getProofESDTBalance,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofESDTBalanceEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyESDTBalanceProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyESDTBalanceProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyESDTBalanceProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	pg.endpoints = endpoints
 
@@ -111,6 +168,28 @@ type VerifyProofRequest struct {
 	Proof    []string `json:"proof"`
 }
 
+// MultiProofRequest represents the parameters needed to compute a Merkle multi-proof
+type MultiProofRequest struct {
+	RootHash  string   `json:"roothash"`
+	Addresses []string `json:"addresses"`
+}
+
+// VerifyMultiProofRequest represents the parameters needed to verify a Merkle multi-proof
+type VerifyMultiProofRequest struct {
+	RootHash  string   `json:"roothash"`
+	Addresses []string `json:"addresses"`
+	Proof     []string `json:"proof"`
+}
+
+// VerifyESDTBalanceProofRequest represents the parameters needed to verify the Merkle proofs of an ESDT balance
+type VerifyESDTBalanceProofRequest struct {
+	RootHash        string   `json:"roothash"`
+	Address         string   `json:"address"`
+	TokenIdentifier string   `json:"tokenIdentifier"`
+	MainProof       []string `json:"mainProof"`
+	DataTrieProof   []string `json:"dataTrieProof"`
+}
+
 // getProof will receive a rootHash and an address from the client, and it will return the Merkle proof
 func (pg *proofGroup) getProof(c *gin.Context) {
 	rootHash := c.Param("roothash")
@@ -353,6 +432,244 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 	)
 }
 
+// getMultiProof will receive a rootHash and a list of addresses from the client, and it will return a single
+// Merkle multi-proof for all the addresses
+func (pg *proofGroup) getMultiProof(c *gin.Context) {
+	var multiProofParams = &MultiProofRequest{}
+	err := c.ShouldBindJSON(&multiProofParams)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+	if multiProofParams.RootHash == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyRootHash.Error()),
+		)
+		return
+	}
+	if len(multiProofParams.Addresses) == 0 {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyAddress.Error()),
+		)
+		return
+	}
+
+	response, err := pg.getFacade().GetMultiProof(multiProofParams.RootHash, multiProofParams.Addresses)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	values := make(map[string]string)
+	for i, address := range multiProofParams.Addresses {
+		if i < len(response.Values) {
+			values[address] = hex.EncodeToString(response.Values[i])
+		}
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{
+				"proof":    bytesToHex(response.Proof),
+				"values":   values,
+				"rootHash": response.RootHash,
+			},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// verifyMultiProof will receive a rootHash, a list of addresses and a Merkle multi-proof from the client,
+// and it will verify the multi-proof
+func (pg *proofGroup) verifyMultiProof(c *gin.Context) {
+	var verifyMultiProofParams = &VerifyMultiProofRequest{}
+	err := c.ShouldBindJSON(&verifyMultiProofParams)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	proof, err := hexToBytes(verifyMultiProofParams.Proof)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	proofOk, err := pg.getFacade().VerifyMultiProof(verifyMultiProofParams.RootHash, verifyMultiProofParams.Addresses, proof)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrVerifyProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"ok": proofOk},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getProofESDTBalance will receive a rootHash, an address and a token identifier from the client, and it will
+// return the Merkle proofs for the address and for the ESDT balance stored in the address' dataTrie
+func (pg *proofGroup) getProofESDTBalance(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyRootHash.Error()),
+		)
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyAddress.Error()),
+		)
+		return
+	}
+
+	tokenIdentifier := c.Param("tokenIdentifier")
+	if tokenIdentifier == "" {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyToken.Error()),
+		)
+		return
+	}
+
+	mainTrieResponse, dataTrieResponse, err := pg.getFacade().GetProofESDTBalance(rootHash, address, tokenIdentifier)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	proofs := make(map[string]interface{})
+	proofs["mainProof"] = bytesToHex(mainTrieResponse.Proof)
+	proofs["dataTrieProof"] = bytesToHex(dataTrieResponse.Proof)
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{
+				"proofs":           proofs,
+				"value":            hex.EncodeToString(dataTrieResponse.Value),
+				"dataTrieRootHash": dataTrieResponse.RootHash,
+			},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// verifyESDTBalanceProof will receive a rootHash, an address, a token identifier and the Merkle proofs
+// returned by the ESDT balance proof endpoint, and it will verify them end to end
+func (pg *proofGroup) verifyESDTBalanceProof(c *gin.Context) {
+	var verifyParams = &VerifyESDTBalanceProofRequest{}
+	err := c.ShouldBindJSON(&verifyParams)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	mainProof, err := hexToBytes(verifyParams.MainProof)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	dataTrieProof, err := hexToBytes(verifyParams.DataTrieProof)
+	if err != nil {
+		shared.RespondWithValidationError(
+			c, fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+		)
+		return
+	}
+
+	esdtToken, proofOk, err := pg.getFacade().VerifyESDTBalanceProof(
+		verifyParams.RootHash,
+		verifyParams.Address,
+		verifyParams.TokenIdentifier,
+		mainProof,
+		dataTrieProof,
+	)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrVerifyProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	balance := "0"
+	if proofOk && esdtToken != nil && esdtToken.Value != nil {
+		balance = esdtToken.Value.String()
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{
+				"ok":      proofOk,
+				"balance": balance,
+			},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func hexToBytes(hexValues []string) ([][]byte, error) {
+	bytesValues := make([][]byte, 0, len(hexValues))
+	for _, hexValue := range hexValues {
+		bytesValue, err := hex.DecodeString(hexValue)
+		if err != nil {
+			return nil, err
+		}
+
+		bytesValues = append(bytesValues, bytesValue)
+	}
+
+	return bytesValues, nil
+}
+
 func (pg *proofGroup) getFacade() proofFacadeHandler {
 	pg.mutFacade.RLock()
 	defer pg.mutFacade.RUnlock()
@@ -6,11 +6,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
@@ -379,6 +381,274 @@ func TestVerifyProof(t *testing.T) {
 	assert.True(t, isValid)
 }
 
+func TestGetMultiProof_BadRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/proof/multi", bytes.NewBuffer([]byte("invalid bytes")))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestGetMultiProof_EmptyAddressesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	multiProofBytes, _ := json.Marshal(groups.MultiProofRequest{RootHash: "rootHash"})
+	req, _ := http.NewRequest("POST", "/proof/multi", bytes.NewBuffer(multiProofBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationEmptyAddress.Error()))
+}
+
+func TestGetMultiProof_GetMultiProofErr(t *testing.T) {
+	t.Parallel()
+
+	getMultiProofErr := fmt.Errorf("GetMultiProof error")
+	facade := &mock.FacadeStub{
+		GetMultiProofCalled: func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+			return nil, getMultiProofErr
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	multiProofBytes, _ := json.Marshal(groups.MultiProofRequest{RootHash: "rootHash", Addresses: []string{"addr"}})
+	req, _ := http.NewRequest("POST", "/proof/multi", bytes.NewBuffer(multiProofBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+}
+
+func TestGetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	rootHash := "rootHash"
+	addresses := []string{"addr1", "addr2"}
+	facade := &mock.FacadeStub{
+		GetMultiProofCalled: func(rH string, addrs []string) (*common.GetMultiProofResponse, error) {
+			assert.Equal(t, rootHash, rH)
+			assert.Equal(t, addresses, addrs)
+
+			return &common.GetMultiProofResponse{
+				Proof:    [][]byte{[]byte("valid"), []byte("proof")},
+				Values:   [][]byte{[]byte("value1"), []byte("value2")},
+				RootHash: rootHash,
+			}, nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	multiProofBytes, _ := json.Marshal(groups.MultiProofRequest{RootHash: rootHash, Addresses: addresses})
+	req, _ := http.NewRequest("POST", "/proof/multi", bytes.NewBuffer(multiProofBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	assert.True(t, ok)
+
+	proofs, ok := responseMap["proof"].([]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, hex.EncodeToString([]byte("valid")), proofs[0].(string))
+	assert.Equal(t, hex.EncodeToString([]byte("proof")), proofs[1].(string))
+
+	values, ok := responseMap["values"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, hex.EncodeToString([]byte("value1")), values["addr1"])
+	assert.Equal(t, hex.EncodeToString([]byte("value2")), values["addr2"])
+}
+
+func TestVerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	validProof := []string{hex.EncodeToString([]byte("valid")), hex.EncodeToString([]byte("proof"))}
+	verifyParams := groups.VerifyMultiProofRequest{
+		RootHash:  "rootHash",
+		Addresses: []string{"addr1", "addr2"},
+		Proof:     validProof,
+	}
+	verifyBytes, _ := json.Marshal(verifyParams)
+
+	facade := &mock.FacadeStub{
+		VerifyMultiProofCalled: func(rootHash string, addresses []string, proof [][]byte) (bool, error) {
+			assert.Equal(t, verifyParams.RootHash, rootHash)
+			assert.Equal(t, verifyParams.Addresses, addresses)
+			for i := range proof {
+				assert.Equal(t, validProof[i], hex.EncodeToString(proof[i]))
+			}
+
+			return true, nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/proof/verify-multi", bytes.NewBuffer(verifyBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	assert.True(t, ok)
+	assert.True(t, responseMap["ok"].(bool))
+}
+
+func TestGetProofESDTBalance(t *testing.T) {
+	t.Parallel()
+
+	mainTrieProof := [][]byte{[]byte("main"), []byte("proof")}
+	dataTrieProof := [][]byte{[]byte("data"), []byte("proof")}
+	facade := &mock.FacadeStub{
+		GetProofESDTBalanceCalled: func(rootHash string, address string, tokenIdentifier string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+			assert.Equal(t, "roothash", rootHash)
+			assert.Equal(t, "addr", address)
+			assert.Equal(t, "TKN-010101", tokenIdentifier)
+
+			return &common.GetProofResponse{Proof: mainTrieProof},
+				&common.GetProofResponse{Proof: dataTrieProof, Value: []byte("value"), RootHash: "dataTrieRootHash"},
+				nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/proof/root-hash/roothash/address/addr/esdt/TKN-010101", nil)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, hex.EncodeToString([]byte("value")), responseMap["value"])
+	assert.Equal(t, "dataTrieRootHash", responseMap["dataTrieRootHash"])
+
+	proofs, ok := responseMap["proofs"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{hex.EncodeToString([]byte("data")), hex.EncodeToString([]byte("proof"))}, proofs["dataTrieProof"])
+}
+
+func TestVerifyESDTBalanceProof_CanNotDecodeProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	proofGroup, err := groups.NewProofGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	verifyBytes, _ := json.Marshal(groups.VerifyESDTBalanceProofRequest{MainProof: []string{"invalid hex"}})
+	req, _ := http.NewRequest("POST", "/proof/verify-esdt", bytes.NewBuffer(verifyBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestVerifyESDTBalanceProof(t *testing.T) {
+	t.Parallel()
+
+	verifyParams := groups.VerifyESDTBalanceProofRequest{
+		RootHash:        "rootHash",
+		Address:         "addr",
+		TokenIdentifier: "TKN-010101",
+		MainProof:       []string{hex.EncodeToString([]byte("main"))},
+		DataTrieProof:   []string{hex.EncodeToString([]byte("data"))},
+	}
+	verifyBytes, _ := json.Marshal(verifyParams)
+
+	facade := &mock.FacadeStub{
+		VerifyESDTBalanceProofCalled: func(rootHash string, address string, tokenIdentifier string, mainProof [][]byte, dataTrieProof [][]byte) (*esdt.ESDigitalToken, bool, error) {
+			assert.Equal(t, verifyParams.RootHash, rootHash)
+			assert.Equal(t, verifyParams.Address, address)
+			assert.Equal(t, verifyParams.TokenIdentifier, tokenIdentifier)
+			assert.Equal(t, [][]byte{[]byte("main")}, mainProof)
+			assert.Equal(t, [][]byte{[]byte("data")}, dataTrieProof)
+
+			return &esdt.ESDigitalToken{Value: big.NewInt(37)}, true, nil
+		},
+	}
+
+	proofGroup, err := groups.NewProofGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/proof/verify-esdt", bytes.NewBuffer(verifyBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, ok := response.Data.(map[string]interface{})
+	assert.True(t, ok)
+	assert.True(t, responseMap["ok"].(bool))
+	assert.Equal(t, "37", responseMap["balance"])
+}
+
 func getProofRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -388,6 +658,10 @@ func getProofRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/root-hash/:roothash/address/:address/key/:key", Open: true},
 					{Name: "/address/:address", Open: true},
 					{Name: "/verify", Open: true},
+					{Name: "/multi", Open: true},
+					{Name: "/verify-multi", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/esdt/:tokenIdentifier", Open: true},
+					{Name: "/verify-esdt", Open: true},
 				},
 			},
 		},
@@ -24,12 +24,14 @@ const (
 	simulateTransactionEndpoint      = "/transaction/simulate"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
+	getInclusionProofEndpoint        = "/transaction/:hash/inclusion-proof"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
 	getTransactionsPool              = "/pool"
+	getInclusionProofPath            = "/:txhash/inclusion-proof"
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
@@ -45,6 +47,7 @@ type transactionFacadeHandler interface {
 	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPool() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionInclusionProof(hash string) (*common.TransactionInclusionProof, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
@@ -123,6 +126,17 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    getInclusionProofPath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionInclusionProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getInclusionProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	tg.endpoints = endpoints
 
@@ -473,6 +487,56 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 	)
 }
 
+// getTransactionInclusionProof returns the data needed to prove that a transaction was included in a block
+// notarized by the metachain
+func (tg *transactionGroup) getTransactionInclusionProof(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	proof, err := tg.getFacade().GetTransactionInclusionProof(txhash)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionInclusionProof.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{
+				"txHash":         hex.EncodeToString(proof.TxHash),
+				"txIndex":        proof.TxIndex,
+				"miniBlock":      hex.EncodeToString(proof.MiniBlock),
+				"miniBlockHash":  hex.EncodeToString(proof.MiniBlockHash),
+				"headerShardID":  proof.HeaderShardID,
+				"header":         hex.EncodeToString(proof.Header),
+				"headerHash":     hex.EncodeToString(proof.HeaderHash),
+				"metaBlock":      hex.EncodeToString(proof.MetaBlock),
+				"metaBlockHash":  hex.EncodeToString(proof.MetaBlockHash),
+				"metaBlockNonce": proof.MetaBlockNonce,
+			},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // computeTransactionGasLimit returns how many gas units a transaction wil consume
 func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	var gtx SendTxRequest
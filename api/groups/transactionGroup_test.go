@@ -891,6 +891,73 @@ func TestGetTransactionsPoolShouldWork(t *testing.T) {
 	assert.Equal(t, *expectedTxPool, txsPoolResp.Data.TxPool)
 }
 
+func TestGetTransactionInclusionProofShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetTransactionInclusionProofCalled: func(hash string) (*common.TransactionInclusionProof, error) {
+			return nil, expectedErr
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/aabb/inclusion-proof", nil)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	proofResp := generalResponse{}
+	loadResponse(resp.Body, &proofResp)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(proofResp.Error, expectedErr.Error()))
+}
+
+func TestGetTransactionInclusionProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	providedHash := ""
+	facade := mock.FacadeStub{
+		GetTransactionInclusionProofCalled: func(hash string) (*common.TransactionInclusionProof, error) {
+			providedHash = hash
+			return &common.TransactionInclusionProof{
+				TxHash:         []byte("tx"),
+				TxIndex:        2,
+				MiniBlockHash:  []byte("mb"),
+				HeaderShardID:  1,
+				MetaBlockNonce: 37,
+			}, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/aabb/inclusion-proof", nil)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	proofResp := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &proofResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "aabb", providedHash)
+	assert.Empty(t, proofResp.Error)
+	proofData := proofResp.Data.(map[string]interface{})
+	assert.Equal(t, hex.EncodeToString([]byte("tx")), proofData["txHash"])
+	assert.Equal(t, hex.EncodeToString([]byte("mb")), proofData["miniBlockHash"])
+	assert.Equal(t, float64(2), proofData["txIndex"])
+	assert.Equal(t, float64(37), proofData["metaBlockNonce"])
+}
+
 func getTransactionRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -902,6 +969,7 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/pool", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
+					{Name: "/:txhash/inclusion-proof", Open: true},
 					{Name: "/simulate", Open: true},
 				},
 			},
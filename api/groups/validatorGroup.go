@@ -3,20 +3,28 @@ package groups
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/gin-gonic/gin"
 )
 
-const statisticsPath = "/statistics"
+const (
+	statisticsPath       = "/statistics"
+	shufflingPreviewPath = "/shuffling/preview"
+	historyPath          = "/:pubkey/history"
+)
 
 // validatorFacadeHandler defines the methods to be implemented by a facade for validator requests
 type validatorFacadeHandler interface {
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetNextEpochShufflingPreview() (*common.NextEpochShufflingPreview, error)
+	GetValidatorHistory(pubKey string, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error)
 	IsInterfaceNil() bool
 }
 
@@ -43,6 +51,16 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.statistics,
 		},
+		{
+			Path:    shufflingPreviewPath,
+			Method:  http.MethodGet,
+			Handler: ng.shufflingPreview,
+		},
+		{
+			Path:    historyPath,
+			Method:  http.MethodGet,
+			Handler: ng.history,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -74,6 +92,76 @@ func (vg *validatorGroup) statistics(c *gin.Context) {
 	)
 }
 
+// shufflingPreview will return the nodes that will change their position in the next epoch
+func (vg *validatorGroup) shufflingPreview(c *gin.Context) {
+	preview, err := vg.getFacade().GetNextEpochShufflingPreview()
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetShufflingPreview.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"preview": preview},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// history will return the rating and signing statistics recorded for a validator in the requested epochs range
+func (vg *validatorGroup) history(c *gin.Context) {
+	pubKey := c.Param("pubkey")
+	fromEpoch, errFrom := getQueryParamUint32(c, "fromEpoch")
+	toEpoch, errTo := getQueryParamUint32(c, "toEpoch")
+	if errFrom != nil || errTo != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrInvalidQueryParameter.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	validatorHistory, err := vg.getFacade().GetValidatorHistory(pubKey, fromEpoch, toEpoch)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetValidatorHistory.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"history": validatorHistory},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func getQueryParamUint32(c *gin.Context, name string) (uint32, error) {
+	value, err := strconv.ParseUint(c.Request.URL.Query().Get(name), 10, 32)
+	return uint32(value), err
+}
+
 func (vg *validatorGroup) getFacade() validatorFacadeHandler {
 	vg.mutFacade.RLock()
 	defer vg.mutFacade.RUnlock()
@@ -5,12 +5,14 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/stretchr/testify/assert"
@@ -106,12 +108,203 @@ func TestValidatorStatistics_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, validatorStatistics.Result, mapToReturn)
 }
 
+type shufflingPreviewResponseData struct {
+	Preview *common.NextEpochShufflingPreview `json:"preview"`
+}
+
+type shufflingPreviewResponse struct {
+	Data  shufflingPreviewResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
+func TestValidatorShufflingPreview_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetNextEpochShufflingPreviewCalled: func() (*common.NextEpochShufflingPreview, error) {
+			return nil, expectedErr
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/validator/shuffling/preview", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetShufflingPreview.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestValidatorShufflingPreview_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	node := &common.ShufflingPreviewNode{
+		PubKey:         "aabb",
+		CurrentShardID: 0,
+		CurrentList:    "eligible",
+		NextShardID:    1,
+		NextList:       "waiting",
+		Transition:     "shuffledOut",
+	}
+	preview := &common.NextEpochShufflingPreview{
+		Epoch:             6,
+		Randomness:        "ccdd",
+		RandomnessNonce:   10,
+		IsRandomnessFinal: true,
+		Nodes:             []*common.ShufflingPreviewNode{node},
+		OwnNodes:          []*common.ShufflingPreviewNode{node},
+	}
+	facade := mock.FacadeStub{
+		GetNextEpochShufflingPreviewCalled: func() (*common.NextEpochShufflingPreview, error) {
+			return preview, nil
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/validator/shuffling/preview", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shufflingPreviewResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, preview, response.Data.Preview)
+}
+
+type validatorHistoryResponseData struct {
+	History []*common.ValidatorEpochHistory `json:"history"`
+}
+
+type validatorHistoryResponse struct {
+	Data  validatorHistoryResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
+func TestValidatorHistory_InvalidQueryParameters(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetValidatorHistoryCalled: func(_ string, _ uint32, _ uint32) ([]*common.ValidatorEpochHistory, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+	for _, query := range []string{"", "?fromEpoch=2", "?fromEpoch=a&toEpoch=3", "?fromEpoch=2&toEpoch=-3"} {
+		req, _ := http.NewRequest("GET", "/validator/aabb/history"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidQueryParameter.Error()))
+	}
+}
+
+func TestValidatorHistory_ErrorWhenFacadeFails(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetValidatorHistoryCalled: func(_ string, _ uint32, _ uint32) ([]*common.ValidatorEpochHistory, error) {
+			return nil, expectedErr
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/validator/aabb/history?fromEpoch=2&toEpoch=3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetValidatorHistory.Error()))
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestValidatorHistory_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	history := []*common.ValidatorEpochHistory{
+		{
+			Epoch:               2,
+			List:                "eligible",
+			Rating:              50,
+			TempRating:          51,
+			NumLeaderSuccess:    3,
+			NumValidatorSuccess: 40,
+			NumValidatorFailure: 1,
+		},
+		{
+			Epoch:     3,
+			List:      "jailed",
+			Rating:    9,
+			JailEvent: "jailed",
+		},
+	}
+	facade := mock.FacadeStub{
+		GetValidatorHistoryCalled: func(pubKey string, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error) {
+			assert.Equal(t, "aabb", pubKey)
+			assert.Equal(t, uint32(2), fromEpoch)
+			assert.Equal(t, uint32(3), toEpoch)
+
+			return history, nil
+		},
+	}
+
+	validatorGroup, err := groups.NewValidatorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(validatorGroup, "validator", getValidatorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/validator/aabb/history?fromEpoch=2&toEpoch=3", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := validatorHistoryResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, history, response.Data.History)
+}
+
 func getValidatorRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
 			"validator": {
 				Routes: []config.RouteConfig{
 					{Name: "/statistics", Open: true},
+					{Name: "/shuffling/preview", Open: true},
+					{Name: "/:pubkey/history", Open: true},
 				},
 			},
 		},
@@ -7,3 +7,9 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrNilMetricsRegistry signals that a nil metrics registry was provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry")
+
+// ErrNilTracer signals that a nil tracer was provided
+var ErrNilTracer = errors.New("nil tracer")
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+type requestTracingMiddleware struct {
+	tracer tracing.Tracer
+}
+
+// NewRequestTracingMiddleware returns a new instance of requestTracingMiddleware
+func NewRequestTracingMiddleware(tracer tracing.Tracer) (*requestTracingMiddleware, error) {
+	if check.IfNil(tracer) {
+		return nil, ErrNilTracer
+	}
+
+	return &requestTracingMiddleware{
+		tracer: tracer,
+	}, nil
+}
+
+// MiddlewareHandlerFunc starts a span around each request. The span is stored in the request context so the
+// handlers can start child spans
+func (rtm *requestTracingMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !rtm.tracer.IsEnabled() {
+			c.Next()
+			return
+		}
+
+		route := c.FullPath()
+		if len(route) == 0 {
+			route = unknownRoute
+		}
+
+		ctx, span := rtm.tracer.StartServerSpan(
+			c.Request.Context(),
+			c.Request.Method+" "+route,
+			tracing.String(tracing.AttributeHTTPMethod, c.Request.Method),
+			tracing.String(tracing.AttributeHTTPRoute, route),
+		)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(tracing.Int(tracing.AttributeHTTPStatusCode, status))
+		if status >= http.StatusInternalServerError {
+			span.SetError(fmt.Errorf("%s", http.StatusText(status)))
+		}
+		span.End()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rtm *requestTracingMiddleware) IsInterfaceNil() bool {
+	return rtm == nil
+}
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startNodeServerRequestTracing(rtm *requestTracingMiddleware, handler func(c *gin.Context)) *gin.Engine {
+	ws := gin.New()
+	ws.Use(rtm.MiddlewareHandlerFunc())
+
+	ginAddressRoutes := ws.Group("/address")
+	ginAddressRoutes.Handle(http.MethodGet, "/:address/balance", handler)
+
+	return ws
+}
+
+func TestNewRequestTracingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil tracer should error", func(t *testing.T) {
+		t.Parallel()
+
+		rtm, err := NewRequestTracingMiddleware(nil)
+		assert.Equal(t, ErrNilTracer, err)
+		assert.True(t, check.IfNil(rtm))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rtm, err := NewRequestTracingMiddleware(tracing.NewDisabledTracer())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(rtm))
+	})
+}
+
+func TestRequestTracingMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	workingDir := t.TempDir()
+	tracer, err := tracing.CreateTracer(config.TracingConfig{
+		Enabled:                     true,
+		Exporter:                    tracing.FileExporter,
+		FilePath:                    "spans.json",
+		ServiceName:                 "node",
+		QueueSize:                   10,
+		BatchSize:                   10,
+		FlushIntervalInMilliseconds: 1000,
+	}, workingDir)
+	require.Nil(t, err)
+	rtm, err := NewRequestTracingMiddleware(tracer)
+	require.Nil(t, err)
+
+	handlerCalled := false
+	ws := startNodeServerRequestTracing(rtm, func(c *gin.Context) {
+		handlerCalled = true
+		_, span := tracer.StartSpan(c.Request.Context(), "handler")
+		span.End()
+		c.JSON(http.StatusInternalServerError, "failed")
+	})
+	req, _ := http.NewRequest(http.MethodGet, "/address/erd1test/balance", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Nil(t, tracer.Close())
+	assert.True(t, handlerCalled)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+
+	buff, err := ioutil.ReadFile(filepath.Join(workingDir, "spans.json"))
+	require.Nil(t, err)
+	exported := string(buff)
+	assert.Contains(t, exported, `"name":"handler"`)
+	assert.Contains(t, exported, `"name":"GET /address/:address/balance"`)
+	assert.Contains(t, exported, `{"key":"http.status_code","value":{"intValue":"500"}}`)
+	assert.Contains(t, exported, `"status":{"code":2,"message":"Internal Server Error"}`)
+}
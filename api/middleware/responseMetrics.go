@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
+	"github.com/gin-gonic/gin"
+)
+
+const unknownRoute = "unknown"
+
+type responseMetricsMiddleware struct {
+	metricsRegistry common.MetricsRegistry
+}
+
+// NewResponseMetricsMiddleware returns a new instance of responseMetricsMiddleware
+func NewResponseMetricsMiddleware(metricsRegistry common.MetricsRegistry) (*responseMetricsMiddleware, error) {
+	if check.IfNil(metricsRegistry) {
+		return nil, ErrNilMetricsRegistry
+	}
+
+	return &responseMetricsMiddleware{
+		metricsRegistry: metricsRegistry,
+	}, nil
+}
+
+// MiddlewareHandlerFunc records the duration of each request, labeled by method and route
+func (rmm *responseMetricsMiddleware) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		t := time.Now()
+
+		c.Next()
+
+		// the route template is used instead of the request path in order to keep the labels cardinality bounded
+		route := c.FullPath()
+		if len(route) == 0 {
+			route = unknownRoute
+		}
+
+		rmm.metricsRegistry.ObserveDurationSince(prometheus.RestRequestDuration, t, c.Request.Method, route)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rmm *responseMetricsMiddleware) IsInterfaceNil() bool {
+	return rmm == nil
+}
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type observedRequest struct {
+	name        string
+	labelValues []string
+}
+
+func startNodeServerResponseMetrics(rmm *responseMetricsMiddleware) *gin.Engine {
+	ws := gin.New()
+	ws.Use(rmm.MiddlewareHandlerFunc())
+
+	ginAddressRoutes := ws.Group("/address")
+	ginAddressRoutes.Handle(http.MethodGet, "/:address/balance", func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	})
+
+	return ws
+}
+
+func createResponseMetricsMiddleware(observed *[]observedRequest) *responseMetricsMiddleware {
+	rmm, _ := NewResponseMetricsMiddleware(&testscommon.MetricsRegistryStub{
+		ObserveDurationSinceCalled: func(name string, _ time.Time, labelValues ...string) {
+			*observed = append(*observed, observedRequest{name: name, labelValues: labelValues})
+		},
+	})
+
+	return rmm
+}
+
+func TestNewResponseMetricsMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metrics registry should error", func(t *testing.T) {
+		t.Parallel()
+
+		rmm, err := NewResponseMetricsMiddleware(nil)
+		assert.Equal(t, ErrNilMetricsRegistry, err)
+		assert.True(t, check.IfNil(rmm))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rmm, err := NewResponseMetricsMiddleware(&testscommon.MetricsRegistryStub{})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(rmm))
+	})
+}
+
+func TestResponseMetricsMiddleware_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("known route should use the route template", func(t *testing.T) {
+		t.Parallel()
+
+		observed := make([]observedRequest, 0)
+		rmm := createResponseMetricsMiddleware(&observed)
+
+		ws := startNodeServerResponseMetrics(rmm)
+		req, _ := http.NewRequest(http.MethodGet, "/address/erd1test/balance", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, []observedRequest{
+			{name: prometheus.RestRequestDuration, labelValues: []string{http.MethodGet, "/address/:address/balance"}},
+		}, observed)
+	})
+	t.Run("unknown route should use the unknown label", func(t *testing.T) {
+		t.Parallel()
+
+		observed := make([]observedRequest, 0)
+		rmm := createResponseMetricsMiddleware(&observed)
+
+		ws := startNodeServerResponseMetrics(rmm)
+		req, _ := http.NewRequest(http.MethodPost, "/not/existing", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, []observedRequest{
+			{name: prometheus.RestRequestDuration, labelValues: []string{http.MethodPost, unknownRoute}},
+		}, observed)
+	})
+}
@@ -35,11 +35,20 @@ type FacadeStub struct {
 	ExecuteSCQueryHandler                   func(query *process.SCQuery) (*vm.VMOutputApi, error)
 	StatusMetricsHandler                    func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler              func() (map[string]*state.ValidatorApiResponse, error)
+	GetNextEpochShufflingPreviewCalled      func() (*common.NextEpochShufflingPreview, error)
+	GetValidatorHistoryCalled               func(pubKey string, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error)
 	ComputeTransactionGasLimitHandler       func(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	NodeConfigCalled                        func() map[string]interface{}
 	GetQueryHandlerCalled                   func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                    func(address string, key string) (string, error)
 	GetPeerInfoCalled                       func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRoundsTimelineCalled        func() ([]*common.ConsensusRoundTimeline, error)
+	GetSlashingEvidencesCalled              func() ([]*common.SlashingEvidence, error)
+	GetGasProfileCalled                     func() (*common.GasProfile, error)
+	GetAntifloodQuotaInfoCalled             func() (*common.AntifloodQuotaInfo, error)
+	GetBlacklistedPeersCalled               func() ([]common.BlacklistedPeerInfo, error)
+	BlacklistPeerManuallyCalled             func(pid string, reason string, durationInSeconds uint32) error
+	PardonPeerCalled                        func(pid string) error
 	GetThrottlerForEndpointCalled           func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                       func(address string) (string, error)
 	GetKeyValuePairsCalled                  func(address string) (map[string]string, error)
@@ -68,13 +77,57 @@ type FacadeStub struct {
 	GetProofCurrentRootHashCalled           func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                  func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                       func(string, string, [][]byte) (bool, error)
-	GetTokenSupplyCalled                    func(token string) (*api.ESDTSupply, error)
+	GetMultiProofCalled                     func(string, []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProofCalled                  func(string, []string, [][]byte) (bool, error)
+	GetProofESDTBalanceCalled               func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyESDTBalanceProofCalled            func(string, string, string, [][]byte, [][]byte) (*esdt.ESDigitalToken, bool, error)
+	GetTokenSupplyCalled                    func(token string) (*common.ESDTSupply, error)
+	GetESDTHoldersCalled                    func(token string, from uint32, size uint32) (*common.ESDTHolders, error)
+	GetCollectionNFTsCalled                 func(collection string, from uint32, size uint32) (*common.CollectionNFTs, error)
+	GetEventsCalled                         func(query common.EventsQuery) (*common.Events, error)
 	GetGenesisNodesPubKeysCalled            func() (map[uint32][]string, map[uint32][]string, error)
+	GetGovernanceProposalsCalled            func() ([]*common.GovernanceProposal, error)
+	GetGovernanceProposalCalled             func(reference string) (*common.GovernanceProposal, error)
+	GetGovernanceVotingPowerCalled          func(address string) (*common.GovernanceVotingPower, error)
+	GetUserDelegationsCalled                func(address string) ([]*common.UserDelegation, error)
+	GetDelegationProviderCalled             func(contract string) (*common.DelegationProvider, error)
+	GetStakingQueueCalled                   func(from uint32, size uint32) (*common.StakingQueue, error)
+	GetStakingNodeCalled                    func(blsKey string) (*common.StakingNode, error)
+	DryRunEconomicsCalled                   func(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error)
+	GetRewardsPreviewCalled                 func() (*common.RewardsPreview, error)
 	GetTransactionsPoolCalled               func() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionInclusionProofCalled      func(hash string) (*common.TransactionInclusionProof, error)
+}
+
+// GetESDTHolders -
+func (f *FacadeStub) GetESDTHolders(token string, from uint32, size uint32) (*common.ESDTHolders, error) {
+	if f.GetESDTHoldersCalled != nil {
+		return f.GetESDTHoldersCalled(token, from, size)
+	}
+
+	return nil, nil
+}
+
+// GetCollectionNFTs -
+func (f *FacadeStub) GetCollectionNFTs(collection string, from uint32, size uint32) (*common.CollectionNFTs, error) {
+	if f.GetCollectionNFTsCalled != nil {
+		return f.GetCollectionNFTsCalled(collection, from, size)
+	}
+
+	return nil, nil
+}
+
+// GetEvents -
+func (f *FacadeStub) GetEvents(query common.EventsQuery) (*common.Events, error) {
+	if f.GetEventsCalled != nil {
+		return f.GetEventsCalled(query)
+	}
+
+	return nil, nil
 }
 
 // GetTokenSupply -
-func (f *FacadeStub) GetTokenSupply(token string) (*api.ESDTSupply, error) {
+func (f *FacadeStub) GetTokenSupply(token string) (*common.ESDTSupply, error) {
 	if f.GetTokenSupplyCalled != nil {
 		return f.GetTokenSupplyCalled(token)
 	}
@@ -118,6 +171,42 @@ func (f *FacadeStub) VerifyProof(rootHash string, address string, proof [][]byte
 	return false, nil
 }
 
+// GetMultiProof -
+func (f *FacadeStub) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	if f.GetMultiProofCalled != nil {
+		return f.GetMultiProofCalled(rootHash, addresses)
+	}
+
+	return nil, nil
+}
+
+// VerifyMultiProof -
+func (f *FacadeStub) VerifyMultiProof(rootHash string, addresses []string, proof [][]byte) (bool, error) {
+	if f.VerifyMultiProofCalled != nil {
+		return f.VerifyMultiProofCalled(rootHash, addresses, proof)
+	}
+
+	return false, nil
+}
+
+// GetProofESDTBalance -
+func (f *FacadeStub) GetProofESDTBalance(rootHash string, address string, tokenIdentifier string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+	if f.GetProofESDTBalanceCalled != nil {
+		return f.GetProofESDTBalanceCalled(rootHash, address, tokenIdentifier)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyESDTBalanceProof -
+func (f *FacadeStub) VerifyESDTBalanceProof(rootHash string, address string, tokenIdentifier string, mainProof [][]byte, dataTrieProof [][]byte) (*esdt.ESDigitalToken, bool, error) {
+	if f.VerifyESDTBalanceProofCalled != nil {
+		return f.VerifyESDTBalanceProofCalled(rootHash, address, tokenIdentifier, mainProof, dataTrieProof)
+	}
+
+	return nil, false, nil
+}
+
 // GetUsername -
 func (f *FacadeStub) GetUsername(address string) (string, error) {
 	if f.GetUsernameCalled != nil {
@@ -287,6 +376,24 @@ func (f *FacadeStub) ValidatorStatisticsApi() (map[string]*state.ValidatorApiRes
 	return f.ValidatorStatisticsHandler()
 }
 
+// GetNextEpochShufflingPreview -
+func (f *FacadeStub) GetNextEpochShufflingPreview() (*common.NextEpochShufflingPreview, error) {
+	if f.GetNextEpochShufflingPreviewCalled != nil {
+		return f.GetNextEpochShufflingPreviewCalled()
+	}
+
+	return &common.NextEpochShufflingPreview{}, nil
+}
+
+// GetValidatorHistory -
+func (f *FacadeStub) GetValidatorHistory(pubKey string, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error) {
+	if f.GetValidatorHistoryCalled != nil {
+		return f.GetValidatorHistoryCalled(pubKey, fromEpoch, toEpoch)
+	}
+
+	return make([]*common.ValidatorEpochHistory, 0), nil
+}
+
 // ExecuteSCQuery is a mock implementation.
 func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
 	return f.ExecuteSCQueryHandler(query)
@@ -342,6 +449,69 @@ func (f *FacadeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
+// GetConsensusRoundsTimeline -
+func (f *FacadeStub) GetConsensusRoundsTimeline() ([]*common.ConsensusRoundTimeline, error) {
+	if f.GetConsensusRoundsTimelineCalled != nil {
+		return f.GetConsensusRoundsTimelineCalled()
+	}
+
+	return make([]*common.ConsensusRoundTimeline, 0), nil
+}
+
+// GetSlashingEvidences -
+func (f *FacadeStub) GetSlashingEvidences() ([]*common.SlashingEvidence, error) {
+	if f.GetSlashingEvidencesCalled != nil {
+		return f.GetSlashingEvidencesCalled()
+	}
+
+	return make([]*common.SlashingEvidence, 0), nil
+}
+
+// GetGasProfile -
+func (f *FacadeStub) GetGasProfile() (*common.GasProfile, error) {
+	if f.GetGasProfileCalled != nil {
+		return f.GetGasProfileCalled()
+	}
+
+	return &common.GasProfile{}, nil
+}
+
+// GetAntifloodQuotaInfo -
+func (f *FacadeStub) GetAntifloodQuotaInfo() (*common.AntifloodQuotaInfo, error) {
+	if f.GetAntifloodQuotaInfoCalled != nil {
+		return f.GetAntifloodQuotaInfoCalled()
+	}
+
+	return &common.AntifloodQuotaInfo{}, nil
+}
+
+// GetBlacklistedPeers -
+func (f *FacadeStub) GetBlacklistedPeers() ([]common.BlacklistedPeerInfo, error) {
+	if f.GetBlacklistedPeersCalled != nil {
+		return f.GetBlacklistedPeersCalled()
+	}
+
+	return make([]common.BlacklistedPeerInfo, 0), nil
+}
+
+// BlacklistPeerManually -
+func (f *FacadeStub) BlacklistPeerManually(pid string, reason string, durationInSeconds uint32) error {
+	if f.BlacklistPeerManuallyCalled != nil {
+		return f.BlacklistPeerManuallyCalled(pid, reason, durationInSeconds)
+	}
+
+	return nil
+}
+
+// PardonPeer -
+func (f *FacadeStub) PardonPeer(pid string) error {
+	if f.PardonPeerCalled != nil {
+		return f.PardonPeerCalled(pid)
+	}
+
+	return nil
+}
+
 // GetBlockByNonce -
 func (f *FacadeStub) GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error) {
 	return f.GetBlockByNonceCalled(nonce, withTxs)
@@ -432,6 +602,78 @@ func (f *FacadeStub) GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][
 	return nil, nil, nil
 }
 
+// GetGovernanceProposals -
+func (f *FacadeStub) GetGovernanceProposals() ([]*common.GovernanceProposal, error) {
+	if f.GetGovernanceProposalsCalled != nil {
+		return f.GetGovernanceProposalsCalled()
+	}
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (f *FacadeStub) GetGovernanceProposal(reference string) (*common.GovernanceProposal, error) {
+	if f.GetGovernanceProposalCalled != nil {
+		return f.GetGovernanceProposalCalled(reference)
+	}
+	return nil, nil
+}
+
+// GetGovernanceVotingPower -
+func (f *FacadeStub) GetGovernanceVotingPower(address string) (*common.GovernanceVotingPower, error) {
+	if f.GetGovernanceVotingPowerCalled != nil {
+		return f.GetGovernanceVotingPowerCalled(address)
+	}
+	return nil, nil
+}
+
+// GetUserDelegations -
+func (f *FacadeStub) GetUserDelegations(address string) ([]*common.UserDelegation, error) {
+	if f.GetUserDelegationsCalled != nil {
+		return f.GetUserDelegationsCalled(address)
+	}
+	return nil, nil
+}
+
+// GetDelegationProvider -
+func (f *FacadeStub) GetDelegationProvider(contract string) (*common.DelegationProvider, error) {
+	if f.GetDelegationProviderCalled != nil {
+		return f.GetDelegationProviderCalled(contract)
+	}
+	return nil, nil
+}
+
+// GetStakingQueue -
+func (f *FacadeStub) GetStakingQueue(from uint32, size uint32) (*common.StakingQueue, error) {
+	if f.GetStakingQueueCalled != nil {
+		return f.GetStakingQueueCalled(from, size)
+	}
+	return nil, nil
+}
+
+// GetStakingNode -
+func (f *FacadeStub) GetStakingNode(blsKey string) (*common.StakingNode, error) {
+	if f.GetStakingNodeCalled != nil {
+		return f.GetStakingNodeCalled(blsKey)
+	}
+	return nil, nil
+}
+
+// DryRunEconomics -
+func (f *FacadeStub) DryRunEconomics(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error) {
+	if f.DryRunEconomicsCalled != nil {
+		return f.DryRunEconomicsCalled(fromNonce, toNonce)
+	}
+	return nil, nil
+}
+
+// GetRewardsPreview -
+func (f *FacadeStub) GetRewardsPreview() (*common.RewardsPreview, error) {
+	if f.GetRewardsPreviewCalled != nil {
+		return f.GetRewardsPreviewCalled()
+	}
+	return nil, nil
+}
+
 // GetTransactionsPool -
 func (f *FacadeStub) GetTransactionsPool() (*common.TransactionsPoolAPIResponse, error) {
 	if f.GetTransactionsPoolCalled != nil {
@@ -441,6 +683,15 @@ func (f *FacadeStub) GetTransactionsPool() (*common.TransactionsPoolAPIResponse,
 	return nil, nil
 }
 
+// GetTransactionInclusionProof -
+func (f *FacadeStub) GetTransactionInclusionProof(hash string) (*common.TransactionInclusionProof, error) {
+	if f.GetTransactionInclusionProofCalled != nil {
+		return f.GetTransactionInclusionProofCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // Trigger -
 func (f *FacadeStub) Trigger(_ uint32, _ bool) error {
 	return nil
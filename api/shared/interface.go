@@ -87,15 +87,29 @@ type FacadeHandler interface {
 	GetDirectStakedList() ([]*api.DirectStakedValue, error)
 	GetDelegatorsList() ([]*api.Delegator, error)
 	StatusMetrics() external.StatusMetricsHandler
-	GetTokenSupply(token string) (*api.ESDTSupply, error)
+	GetTokenSupply(token string) (*common.ESDTSupply, error)
+	GetESDTHolders(token string, from uint32, size uint32) (*common.ESDTHolders, error)
+	GetCollectionNFTs(collection string, from uint32, size uint32) (*common.CollectionNFTs, error)
+	GetEvents(query common.EventsQuery) (*common.Events, error)
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRoundsTimeline() ([]*common.ConsensusRoundTimeline, error)
+	GetSlashingEvidences() ([]*common.SlashingEvidence, error)
+	GetGasProfile() (*common.GasProfile, error)
+	GetAntifloodQuotaInfo() (*common.AntifloodQuotaInfo, error)
+	GetBlacklistedPeers() ([]common.BlacklistedPeerInfo, error)
+	BlacklistPeerManually(pid string, reason string, durationInSeconds uint32) error
+	PardonPeer(pid string) error
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProof(rootHash string, addresses []string, proof [][]byte) (bool, error)
+	GetProofESDTBalance(rootHash string, address string, tokenIdentifier string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyESDTBalanceProof(rootHash string, address string, tokenIdentifier string, mainProof [][]byte, dataTrieProof [][]byte) (*esdt.ESDigitalToken, bool, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
@@ -107,12 +121,24 @@ type FacadeHandler interface {
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	GetNextEpochShufflingPreview() (*common.NextEpochShufflingPreview, error)
+	GetValidatorHistory(pubKey string, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error)
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	RestApiInterface() string
 	RestAPIServerDebugMode() bool
 	PprofEnabled() bool
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
+	GetGovernanceProposals() ([]*common.GovernanceProposal, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposal, error)
+	GetGovernanceVotingPower(address string) (*common.GovernanceVotingPower, error)
+	GetUserDelegations(address string) ([]*common.UserDelegation, error)
+	GetDelegationProvider(contract string) (*common.DelegationProvider, error)
+	GetStakingQueue(from uint32, size uint32) (*common.StakingQueue, error)
+	GetStakingNode(blsKey string) (*common.StakingNode, error)
+	DryRunEconomics(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error)
+	GetRewardsPreview() (*common.RewardsPreview, error)
 	GetTransactionsPool() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionInclusionProof(hash string) (*common.TransactionInclusionProof, error)
 	IsInterfaceNil() bool
 }
@@ -8,9 +8,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/persister"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
@@ -20,6 +22,7 @@ var log = logger.GetOrCreate("main")
 type statusHandlersInfo struct {
 	AppStatusHandler  core.AppStatusHandler
 	StatusMetrics     external.StatusMetricsHandler
+	Registry          common.MetricsRegistry
 	PersistentHandler *persister.PersistentStatusHandler
 }
 
@@ -48,7 +51,8 @@ func (shuf *statusHandlerUtilsFactory) Create(
 		return nil, fmt.Errorf("%s: nil uint64 byte slice converter", baseErrMessage)
 	}
 
-	statusMetrics := statusHandler.NewStatusMetrics()
+	registry := prometheus.NewRegistry()
+	statusMetrics := statusHandler.NewStatusMetricsWithRegistry(registry)
 	appStatusHandlers = append(appStatusHandlers, statusMetrics)
 
 	persistentHandler, err := persister.NewPersistentStatusHandler(marshalizer, uint64ByteSliceConverter)
@@ -70,6 +74,7 @@ func (shuf *statusHandlerUtilsFactory) Create(
 	statusHandlersInfoObject := new(statusHandlersInfo)
 	statusHandlersInfoObject.AppStatusHandler = handler
 	statusHandlersInfoObject.StatusMetrics = statusMetrics
+	statusHandlersInfoObject.Registry = registry
 	statusHandlersInfoObject.PersistentHandler = persistentHandler
 
 	return statusHandlersInfoObject, nil
@@ -95,6 +100,11 @@ func (shi *statusHandlersInfo) Metrics() external.StatusMetricsHandler {
 	return shi.StatusMetrics
 }
 
+// MetricsRegistry returns the registry of the histograms and counters
+func (shi *statusHandlersInfo) MetricsRegistry() common.MetricsRegistry {
+	return shi.Registry
+}
+
 // IsInterfaceNil returns true if the interface is nil
 func (shi *statusHandlersInfo) IsInterfaceNil() bool {
 	return shi == nil
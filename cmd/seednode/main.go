@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/cmd/seednode/api"
 	"github.com/ElrondNetwork/elrond-go/common"
+	commonDisabled "github.com/ElrondNetwork/elrond-go/common/disabled"
 	"github.com/ElrondNetwork/elrond-go/common/logging"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/epochStart/bootstrap/disabled"
@@ -249,6 +250,7 @@ func createNode(p2pConfig config.P2PConfig, marshalizer marshal.Marshalizer) (p2
 		PreferredPeersHolder: disabled.NewPreferredPeersHolder(),
 		NodeOperationMode:    p2p.NormalOperation,
 		PeersRatingHandler:   disabled.NewDisabledPeersRatingHandler(),
+		MetricsRegistry:      commonDisabled.NewMetricsRegistry(),
 	}
 
 	return libp2p.NewNetworkMessenger(arg)
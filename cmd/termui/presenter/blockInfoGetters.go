@@ -29,6 +29,11 @@ func (psh *PresenterStatusHandler) GetConsensusRoundState() string {
 	return psh.getFromCacheAsString(common.MetricConsensusRoundState)
 }
 
+// GetConsensusLastRoundTimeline will return the summary of the consensus events of the last ended round
+func (psh *PresenterStatusHandler) GetConsensusLastRoundTimeline() string {
+	return psh.getFromCacheAsString(common.MetricConsensusLastRoundTimeline)
+}
+
 // GetCurrentBlockHash will return current block hash
 func (psh *PresenterStatusHandler) GetCurrentBlockHash() string {
 	return psh.getFromCacheAsString(common.MetricCurrentBlockHash)
@@ -92,6 +92,17 @@ func TestPresenterStatusHandler_GetConsensusStateShouldReturnErrorMessageInvalid
 	assert.Equal(t, metricNotAvailable, result)
 }
 
+func TestPresenterStatusHandler_GetConsensusLastRoundTimeline(t *testing.T) {
+	t.Parallel()
+
+	lastRoundTimeline := "round 10 (validator): block +100ms, committed +1200ms, committed"
+	presenterStatusHandler := NewPresenterStatusHandler()
+	presenterStatusHandler.SetStringValue(common.MetricConsensusLastRoundTimeline, lastRoundTimeline)
+	result := presenterStatusHandler.GetConsensusLastRoundTimeline()
+
+	assert.Equal(t, lastRoundTimeline, result)
+}
+
 func TestPresenterStatusHandler_GetConsensusRoundStateState(t *testing.T) {
 	t.Parallel()
 
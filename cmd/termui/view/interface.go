@@ -30,6 +30,7 @@ type Presenter interface {
 	GetCrossCheckBlockHeight() string
 	GetConsensusState() string
 	GetConsensusRoundState() string
+	GetConsensusLastRoundTimeline() string
 	GetCpuLoadPercent() uint64
 	GetMemLoadPercent() uint64
 	GetTotalMem() uint64
@@ -266,8 +266,8 @@ func computeRedundancyStr(redundancyLevel int64, redundancyIsMainActive string)
 }
 
 func (wr *WidgetsRender) prepareBlockInfo() {
-	// 7 rows and one column
-	numRows := 8
+	// 9 rows and one column
+	numRows := 9
 	rows := make([][]string, numRows)
 
 	currentBlockHeight := wr.presenter.GetNonce()
@@ -312,6 +312,9 @@ func (wr *WidgetsRender) prepareBlockInfo() {
 	currentRoundTimestamp := wr.presenter.GetCurrentRoundTimestamp()
 	rows[7] = []string{fmt.Sprintf("Current round timestamp: %d", currentRoundTimestamp)}
 
+	lastRoundTimeline := wr.presenter.GetConsensusLastRoundTimeline()
+	rows[8] = []string{fmt.Sprintf("Last consensus round: %s", lastRoundTimeline)}
+
 	wr.blockInfo.Title = "Block info"
 	wr.blockInfo.RowSeparator = false
 	wr.blockInfo.Rows = rows
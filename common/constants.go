@@ -189,6 +189,12 @@ const MetricNumMiniBlocks = "erd_num_mini_blocks"
 // MetricConsensusRoundState is the metric for consensus round state for a block
 const MetricConsensusRoundState = "erd_consensus_round_state"
 
+// MetricConsensusLastRoundTimeline is the metric that holds the summary of the consensus events of the last ended round
+const MetricConsensusLastRoundTimeline = "erd_consensus_last_round_timeline"
+
+// MetricNumSlashingEvidences is the metric that holds the number of slashing evidences collected by the node
+const MetricNumSlashingEvidences = "erd_num_slashing_evidences"
+
 // MetricCrossCheckBlockHeight is the metric that store cross block height
 const MetricCrossCheckBlockHeight = "erd_cross_check_block_height"
 
@@ -605,6 +611,8 @@ const (
 	NetStatisticsOrder
 	// OldDatabaseCleanOrder defines the order in which oldDatabaseCleaner component is notified of a start of epoch event
 	OldDatabaseCleanOrder
+	// ValidatorHistoryOrder defines the order in which the validator history is notified of a start of epoch event
+	ValidatorHistoryOrder
 )
 
 // NodeState specifies what type of state a node could have
@@ -647,6 +655,15 @@ const MetricP2PNumConnectedPeersClassification = "erd_p2p_num_connected_peers_cl
 // to process VM queries
 const MetricAreVMQueriesReady = "erd_are_vm_queries_ready"
 
+// MetricDiskTotalSpace is the metric for monitoring the total space of the volume containing the working directory
+const MetricDiskTotalSpace = "erd_disk_total_space"
+
+// MetricDiskFreeSpace is the metric for monitoring the free space of the volume containing the working directory
+const MetricDiskFreeSpace = "erd_disk_free_space"
+
+// MetricDiskSpaceStatus is the metric that holds the disk space status as computed by the disk space watchdog
+const MetricDiskSpaceStatus = "erd_disk_space_status"
+
 // HighestRoundFromBootStorage is the key for the highest round that is saved in storage
 const HighestRoundFromBootStorage = "highestRoundFromBootStorage"
 
@@ -665,6 +682,9 @@ const ShuffledOut = "shuffledOut"
 // WrongConfiguration signals that the node has a malformed configuration and cannot continue processing
 const WrongConfiguration = "wrongConfiguration"
 
+// DiskSpaceExhausted signals that the node stopped processing because the free disk space is below the stop threshold
+const DiskSpaceExhausted = "diskSpaceExhausted"
+
 // ImportComplete signals that a node restart will be done because the import did complete
 const ImportComplete = "importComplete"
 
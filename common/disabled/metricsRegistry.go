@@ -0,0 +1,34 @@
+package disabled
+
+import "time"
+
+// metricsRegistry is the disabled implementation of the registry holding the histograms and the counters of the node
+type metricsRegistry struct {
+}
+
+// NewMetricsRegistry creates a new instance of type metricsRegistry
+func NewMetricsRegistry() *metricsRegistry {
+	return &metricsRegistry{}
+}
+
+// ObserveDuration does nothing
+func (mr *metricsRegistry) ObserveDuration(_ string, _ time.Duration, _ ...string) {}
+
+// ObserveDurationSince does nothing
+func (mr *metricsRegistry) ObserveDurationSince(_ string, _ time.Time, _ ...string) {}
+
+// IncrementCounter does nothing
+func (mr *metricsRegistry) IncrementCounter(_ string, _ ...string) {}
+
+// AddToCounter does nothing
+func (mr *metricsRegistry) AddToCounter(_ string, _ uint64, _ ...string) {}
+
+// PrometheusString returns an empty string
+func (mr *metricsRegistry) PrometheusString(_ uint32) string {
+	return ""
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mr *metricsRegistry) IsInterfaceNil() bool {
+	return mr == nil
+}
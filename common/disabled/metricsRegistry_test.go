@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsRegistry_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked: %v", r))
+		}
+	}()
+
+	mr := NewMetricsRegistry()
+	assert.False(t, check.IfNil(mr))
+	mr.ObserveDuration("", time.Second)
+	mr.ObserveDurationSince("", time.Now())
+	mr.IncrementCounter("")
+	mr.AddToCounter("", 1)
+	assert.Equal(t, "", mr.PrometheusString(0))
+}
@@ -7,9 +7,464 @@ type GetProofResponse struct {
 	RootHash string
 }
 
+// GetMultiProofResponse is a struct that stores the response of a GetMultiProof API request. The Proof field holds
+// the deduplicated trie nodes needed to prove all the requested keys, while the Values field holds the values of the
+// requested keys, in the same order as the keys were provided
+type GetMultiProofResponse struct {
+	Proof    [][]byte
+	Values   [][]byte
+	RootHash string
+}
+
+// TransactionInclusionProof holds all the data needed to prove that a transaction was included in a block and that
+// the block was notarized by the metachain: the transaction hash is found in the miniblock at the given position, the
+// miniblock hash is found in the miniblock headers of the block and the block hash is found in the shard info of the
+// notarizing metablock. For transactions executed on the metachain, the block is the metablock itself and the
+// notarizing metablock fields are empty
+type TransactionInclusionProof struct {
+	TxHash         []byte
+	TxIndex        uint32
+	MiniBlock      []byte
+	MiniBlockHash  []byte
+	HeaderShardID  uint32
+	Header         []byte
+	HeaderHash     []byte
+	MetaBlock      []byte
+	MetaBlockHash  []byte
+	MetaBlockNonce uint64
+}
+
 // TransactionsPoolAPIResponse is a struct that holds the data to be returned when getting the transaction pool from an API call
 type TransactionsPoolAPIResponse struct {
 	RegularTransactions  []string `json:"regularTransactions"`
 	SmartContractResults []string `json:"smartContractResults"`
 	Rewards              []string `json:"rewards"`
 }
+
+// ConsensusRoundTimeline holds the moments of the consensus events of a round, as seen by the node. The moments are
+// expressed in milliseconds elapsed since the round start and are missing if the event did not happen
+type ConsensusRoundTimeline struct {
+	Round                int64                       `json:"round"`
+	RoundStartTimestamp  int64                       `json:"roundStartTimestamp"`
+	Leader               string                      `json:"leader"`
+	SelfRole             string                      `json:"selfRole"`
+	ConsensusGroupSize   int                         `json:"consensusGroupSize"`
+	BlockHash            string                      `json:"blockHash,omitempty"`
+	BlockProposedMs      *int64                      `json:"blockProposedMs,omitempty"`
+	BlockReceivedMs      *int64                      `json:"blockReceivedMs,omitempty"`
+	BlockProcessedMs     *int64                      `json:"blockProcessedMs,omitempty"`
+	SelfSignatureSentMs  *int64                      `json:"selfSignatureSentMs,omitempty"`
+	Signatures           []ConsensusSignatureArrival `json:"signatures"`
+	MedianSignatureMs    *int64                      `json:"medianSignatureMs,omitempty"`
+	SignaturesThreshold  int                         `json:"signaturesThreshold,omitempty"`
+	ThresholdReachedMs   *int64                      `json:"thresholdReachedMs,omitempty"`
+	FinalInfoBroadcastMs *int64                      `json:"finalInfoBroadcastMs,omitempty"`
+	FinalInfoReceivedMs  *int64                      `json:"finalInfoReceivedMs,omitempty"`
+	BlockCommittedMs     *int64                      `json:"blockCommittedMs,omitempty"`
+	Outcome              string                      `json:"outcome"`
+}
+
+// ConsensusSignatureArrival holds the moment when the signature of a consensus group member was received, expressed
+// in milliseconds elapsed since the round start
+type ConsensusSignatureArrival struct {
+	PubKey     string `json:"pubKey"`
+	ReceivedMs int64  `json:"receivedMs"`
+}
+
+// BlacklistedPeerInfo holds the details of a blacklisting decision. The timestamps are expressed in unix seconds
+type BlacklistedPeerInfo struct {
+	Pid           string `json:"pid"`
+	Reason        string `json:"reason"`
+	IsManual      bool   `json:"isManual"`
+	BlacklistedAt int64  `json:"blacklistedAt"`
+	ExpiresAt     int64  `json:"expiresAt"`
+}
+
+// PeerQuotaInfo holds the quota used by a peer in the current interval of a flood preventer
+type PeerQuotaInfo struct {
+	Pid                   string `json:"pid"`
+	NumReceivedMessages   uint32 `json:"numReceivedMessages"`
+	SizeReceivedMessages  uint64 `json:"sizeReceivedMessages"`
+	NumProcessedMessages  uint32 `json:"numProcessedMessages"`
+	SizeProcessedMessages uint64 `json:"sizeProcessedMessages"`
+}
+
+// FloodPreventerQuotaInfo holds the limits and the per-peer quota usage of a flood preventer
+type FloodPreventerQuotaInfo struct {
+	Name                  string          `json:"name"`
+	MaxNumMessagesPerPeer uint32          `json:"maxNumMessagesPerPeer"`
+	MaxTotalSizePerPeer   uint64          `json:"maxTotalSizePerPeer"`
+	Peers                 []PeerQuotaInfo `json:"peers"`
+}
+
+// TopicQuotaInfo holds the number of messages received from each peer on a topic in the current interval
+type TopicQuotaInfo struct {
+	Topic              string            `json:"topic"`
+	MaxMessagesPerPeer uint32            `json:"maxMessagesPerPeer"`
+	NumMessagesPerPeer map[string]uint32 `json:"numMessagesPerPeer"`
+}
+
+// AntifloodQuotaInfo holds the quota usage measured by the antiflood components
+type AntifloodQuotaInfo struct {
+	FloodPreventers []FloodPreventerQuotaInfo `json:"floodPreventers"`
+	Topics          []TopicQuotaInfo          `json:"topics"`
+}
+
+// SlashingEvidence holds the proof that a validator signed two conflicting consensus messages in the same round. The
+// binary fields are hex encoded
+type SlashingEvidence struct {
+	Type       string                   `json:"type"`
+	PubKey     string                   `json:"pubKey"`
+	ShardID    uint32                   `json:"shardID"`
+	Round      int64                    `json:"round"`
+	DetectedAt int64                    `json:"detectedAt"`
+	Proofs     []*SlashingEvidenceProof `json:"proofs"`
+	TxData     string                   `json:"txData"`
+}
+
+// SlashingEvidenceProof holds a consensus message together with the p2p envelope that authenticates it: the payload
+// is signed with the p2p key of the originator and the consensus message binds the validator key to that originator
+type SlashingEvidenceProof struct {
+	HeaderHash       string `json:"headerHash"`
+	ConsensusMessage string `json:"consensusMessage"`
+	Payload          string `json:"payload"`
+	From             string `json:"from"`
+	SeqNo            string `json:"seqNo"`
+	Topic            string `json:"topic"`
+	Signature        string `json:"signature"`
+	Key              string `json:"key"`
+}
+
+// ShufflingPreviewNode holds the current and the previewed position of a node in the nodes lists
+type ShufflingPreviewNode struct {
+	PubKey         string `json:"pubKey"`
+	CurrentShardID uint32 `json:"currentShardID"`
+	CurrentList    string `json:"currentList"`
+	NextShardID    uint32 `json:"nextShardID"`
+	NextList       string `json:"nextList"`
+	Transition     string `json:"transition"`
+}
+
+// NextEpochShufflingPreview holds the nodes whose position will change in the next epoch together with the
+// positions of the node's own keys. The randomness is final only once the last block of the epoch was committed
+type NextEpochShufflingPreview struct {
+	Epoch             uint32                  `json:"epoch"`
+	Randomness        string                  `json:"randomness"`
+	RandomnessNonce   uint64                  `json:"randomnessNonce"`
+	IsRandomnessFinal bool                    `json:"isRandomnessFinal"`
+	Nodes             []*ShufflingPreviewNode `json:"nodes"`
+	OwnNodes          []*ShufflingPreviewNode `json:"ownNodes"`
+}
+
+// ValidatorEpochHistory holds the rating and the signing statistics of a validator at the end of an epoch. The jail
+// event is set only in the epochs in which the validator was jailed or un-jailed
+type ValidatorEpochHistory struct {
+	Epoch                         uint32  `json:"epoch"`
+	ShardID                       uint32  `json:"shardID"`
+	List                          string  `json:"list"`
+	Rating                        float32 `json:"rating"`
+	TempRating                    float32 `json:"tempRating"`
+	NumLeaderSuccess              uint32  `json:"numLeaderSuccess"`
+	NumLeaderFailure              uint32  `json:"numLeaderFailure"`
+	NumValidatorSuccess           uint32  `json:"numValidatorSuccess"`
+	NumValidatorFailure           uint32  `json:"numValidatorFailure"`
+	NumValidatorIgnoredSignatures uint32  `json:"numValidatorIgnoredSignatures"`
+	JailEvent                     string  `json:"jailEvent,omitempty"`
+}
+
+// GovernanceProposal holds the voting period, the status and the tallies of a governance proposal. The votes are
+// filled only when a single proposal is requested and are no longer available once the proposal was closed
+type GovernanceProposal struct {
+	Reference      string            `json:"reference"`
+	CommitHash     string            `json:"commitHash"`
+	Issuer         string            `json:"issuer"`
+	StartVoteNonce uint64            `json:"startVoteNonce"`
+	EndVoteNonce   uint64            `json:"endVoteNonce"`
+	Status         string            `json:"status"`
+	Yes            string            `json:"yes"`
+	No             string            `json:"no"`
+	Veto           string            `json:"veto"`
+	Passed         bool              `json:"passed"`
+	NumVoters      int               `json:"numVoters"`
+	Votes          []*GovernanceVote `json:"votes,omitempty"`
+}
+
+// GovernanceVote holds all the votes cast by an address on a governance proposal
+type GovernanceVote struct {
+	Voter       string                `json:"voter"`
+	UsedPower   string                `json:"usedPower"`
+	UsedBalance string                `json:"usedBalance"`
+	TotalYes    string                `json:"totalYes"`
+	TotalNo     string                `json:"totalNo"`
+	TotalVeto   string                `json:"totalVeto"`
+	Items       []*GovernanceVoteItem `json:"items"`
+}
+
+// GovernanceVoteItem holds a single vote cast on a governance proposal
+type GovernanceVoteItem struct {
+	Value       string `json:"value"`
+	Power       string `json:"power"`
+	Balance     string `json:"balance"`
+	DelegatedTo string `json:"delegatedTo,omitempty"`
+}
+
+// GovernanceVotingPower holds the governance voting power of an address. The voting power is used when voting with
+// the whole stake while the validator voting power is used when a validator delegates its vote
+type GovernanceVotingPower struct {
+	Address              string `json:"address"`
+	StakedValue          string `json:"stakedValue"`
+	DelegatedValue       string `json:"delegatedValue"`
+	ValidatorVotingPower string `json:"validatorVotingPower"`
+	VotingPower          string `json:"votingPower"`
+}
+
+// DelegationUnStakedFund holds an un-delegated amount together with the number of epochs left until it can be
+// withdrawn
+type DelegationUnStakedFund struct {
+	Value           string `json:"value"`
+	RemainingEpochs uint32 `json:"remainingEpochs"`
+	UnBondEpoch     uint32 `json:"unBondEpoch"`
+}
+
+// UserDelegation holds the funds and the rewards of a user in a delegation contract
+type UserDelegation struct {
+	DelegationScAddress   string                    `json:"delegationScAddress"`
+	ActiveStake           string                    `json:"activeStake"`
+	UnStakedFunds         []*DelegationUnStakedFund `json:"unStakedFunds"`
+	ClaimableRewards      string                    `json:"claimableRewards"`
+	TotalCumulatedRewards string                    `json:"totalCumulatedRewards"`
+}
+
+// DelegationProviderNode holds the BLS key of a node managed by a delegation contract and its state in the contract
+type DelegationProviderNode struct {
+	BLSKey string `json:"blsKey"`
+	State  string `json:"state"`
+}
+
+// DelegationProvider holds the configuration, the nodes and the staked values of a delegation contract
+type DelegationProvider struct {
+	DelegationScAddress         string                    `json:"delegationScAddress"`
+	OwnerAddress                string                    `json:"ownerAddress"`
+	ServiceFee                  string                    `json:"serviceFee"`
+	MaxDelegationCap            string                    `json:"maxDelegationCap"`
+	InitialOwnerFunds           string                    `json:"initialOwnerFunds"`
+	AutomaticActivation         bool                      `json:"automaticActivation"`
+	WithDelegationCap           bool                      `json:"withDelegationCap"`
+	ChangeableServiceFee        bool                      `json:"changeableServiceFee"`
+	CheckCapOnReDelegateRewards bool                      `json:"checkCapOnReDelegateRewards"`
+	CreatedNonce                uint64                    `json:"createdNonce"`
+	UnBondPeriodInEpochs        uint32                    `json:"unBondPeriodInEpochs"`
+	Name                        string                    `json:"name,omitempty"`
+	Website                     string                    `json:"website,omitempty"`
+	Identifier                  string                    `json:"identifier,omitempty"`
+	NumUsers                    uint64                    `json:"numUsers"`
+	TotalActiveStake            string                    `json:"totalActiveStake"`
+	TotalUnStaked               string                    `json:"totalUnStaked"`
+	TotalStaked                 string                    `json:"totalStaked"`
+	TopUp                       string                    `json:"topUp"`
+	Nodes                       []*DelegationProviderNode `json:"nodes"`
+}
+
+// StakingQueueEntry holds a node from the staking queue together with its position
+type StakingQueueEntry struct {
+	Position      uint32 `json:"position"`
+	BLSKey        string `json:"blsKey"`
+	OwnerAddress  string `json:"ownerAddress,omitempty"`
+	RewardAddress string `json:"rewardAddress"`
+	RegisterNonce uint64 `json:"registerNonce"`
+}
+
+// StakingQueue holds the nodes waiting in the staking queue, in the order they will be staked
+type StakingQueue struct {
+	Size  uint32               `json:"size"`
+	Nodes []*StakingQueueEntry `json:"nodes"`
+}
+
+// StakingNode holds the staking lifecycle view of a node. The remaining unbond period is expressed in metachain
+// blocks and is set only for the unbonding nodes. The total staked and the top up values belong to the owner and are
+// shared between all its active nodes
+type StakingNode struct {
+	BLSKey                string `json:"blsKey"`
+	OwnerAddress          string `json:"ownerAddress,omitempty"`
+	RewardAddress         string `json:"rewardAddress"`
+	Status                string `json:"status"`
+	Jailed                bool   `json:"jailed"`
+	QueuePosition         uint32 `json:"queuePosition,omitempty"`
+	QueueSize             uint32 `json:"queueSize"`
+	UnBonding             bool   `json:"unBonding"`
+	RemainingUnBondPeriod uint64 `json:"remainingUnBondPeriod"`
+	TotalStaked           string `json:"totalStaked"`
+	TopUp                 string `json:"topUp"`
+	NumActiveNodes        uint64 `json:"numActiveNodes"`
+}
+
+// ESDTSupply holds the supply of an ESDT from the current shard. A partial supply was not fully indexed, as it was
+// recorded by an older node version or a balance wiped from one of its holders was not known, so it might be
+// overestimated
+type ESDTSupply struct {
+	Supply  string `json:"supply"`
+	Burned  string `json:"burned"`
+	Minted  string `json:"minted"`
+	Partial bool   `json:"partial"`
+}
+
+// ESDTHolder holds the balance of an ESDT holder
+type ESDTHolder struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+}
+
+// ESDTHolders holds a page of the holders of an ESDT from the current shard
+type ESDTHolders struct {
+	Token      string        `json:"token"`
+	NumHolders uint32        `json:"numHolders"`
+	Holders    []*ESDTHolder `json:"holders"`
+}
+
+// CollectionNFT holds the owners of a single NFT from a collection
+type CollectionNFT struct {
+	Identifier string        `json:"identifier"`
+	Nonce      uint64        `json:"nonce"`
+	Owners     []*ESDTHolder `json:"owners"`
+}
+
+// CollectionNFTs holds a page of the NFTs of a collection held in the current shard
+type CollectionNFTs struct {
+	Collection string           `json:"collection"`
+	NumNFTs    uint32           `json:"numNFTs"`
+	NFTs       []*CollectionNFT `json:"nfts"`
+}
+
+// EventsQuery holds the filters and the requested page of an events query. Empty filters match every event
+type EventsQuery struct {
+	Address    string
+	Identifier string
+	Topic0     string
+	FromBlock  uint64
+	ToBlock    uint64
+	// DefaultFromBlock is set when the start of the blocks range was not provided
+	DefaultFromBlock bool
+	From             uint32
+	Size             uint32
+}
+
+// Event holds an event emitted during the execution of a transaction from the current shard
+type Event struct {
+	BlockNonce uint64   `json:"blockNonce"`
+	TxHash     string   `json:"txHash"`
+	Index      uint32   `json:"index"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+// Events holds a page of the events matching a query, together with the blocks range that was searched
+type Events struct {
+	FromBlock uint64   `json:"fromBlock"`
+	ToBlock   uint64   `json:"toBlock"`
+	HasMore   bool     `json:"hasMore"`
+	Events    []*Event `json:"events"`
+}
+
+// GasProfileEntry holds the gas consumption of a contract function or of a built-in function over the profiled window
+type GasProfileEntry struct {
+	Contract       string  `json:"contract,omitempty"`
+	Function       string  `json:"function"`
+	NumCalls       uint64  `json:"numCalls"`
+	NumFailed      uint64  `json:"numFailed"`
+	FailureRate    float64 `json:"failureRate"`
+	GasUsed        uint64  `json:"gasUsed"`
+	AverageGasUsed uint64  `json:"averageGasUsed"`
+}
+
+// GasProfile holds the gas consumption per contract function and per built-in function over the profiled window,
+// sorted descending by the gas used
+type GasProfile struct {
+	WindowInSeconds  uint32             `json:"windowInSeconds"`
+	Contracts        []*GasProfileEntry `json:"contracts"`
+	BuiltInFunctions []*GasProfileEntry `json:"builtInFunctions"`
+}
+
+// EconomicsDryRunOutcome holds the gas used, the fee and the refund of a transaction under one economics configuration
+type EconomicsDryRunOutcome struct {
+	GasUsed  uint64 `json:"gasUsed"`
+	Fee      string `json:"fee"`
+	Refund   string `json:"refund"`
+	OutOfGas bool   `json:"outOfGas,omitempty"`
+	Rejected bool   `json:"rejected,omitempty"`
+}
+
+// EconomicsDryRunTransaction holds the outcome of a transaction as it was executed and as it would be under the
+// alternative economics configuration
+type EconomicsDryRunTransaction struct {
+	Hash        string                  `json:"hash"`
+	BlockNonce  uint64                  `json:"blockNonce"`
+	Current     *EconomicsDryRunOutcome `json:"current"`
+	Alternative *EconomicsDryRunOutcome `json:"alternative"`
+}
+
+// EconomicsDryRunTotals holds the aggregated outcome of the evaluated transactions under one economics configuration
+type EconomicsDryRunTotals struct {
+	GasUsed     uint64 `json:"gasUsed"`
+	Fees        string `json:"fees"`
+	Refunds     string `json:"refunds"`
+	NumOutOfGas uint64 `json:"numOutOfGas"`
+	NumRejected uint64 `json:"numRejected"`
+}
+
+// EconomicsDryRunResult holds the per transaction and the aggregated differences between the current and the
+// alternative economics configuration over a range of blocks. The transactions that executed contract code are not
+// evaluated, only their number being reported
+type EconomicsDryRunResult struct {
+	FromNonce       uint64                        `json:"fromNonce"`
+	ToNonce         uint64                        `json:"toNonce"`
+	NumTransactions uint64                        `json:"numTransactions"`
+	NumSkipped      uint64                        `json:"numSkipped"`
+	Current         *EconomicsDryRunTotals        `json:"current"`
+	Alternative     *EconomicsDryRunTotals        `json:"alternative"`
+	Transactions    []*EconomicsDryRunTransaction `json:"transactions"`
+}
+
+// RewardsPreviewNode holds the rewards an eligible node would receive if the current epoch ended now
+type RewardsPreviewNode struct {
+	BLSKey                     string `json:"blsKey"`
+	ShardID                    uint32 `json:"shardID"`
+	OwnerAddress               string `json:"ownerAddress"`
+	RewardAddress              string `json:"rewardAddress"`
+	NumSelectedInSuccessBlocks uint32 `json:"numSelectedInSuccessBlocks"`
+	BaseReward                 string `json:"baseReward"`
+	TopUpReward                string `json:"topUpReward"`
+	LeaderFees                 string `json:"leaderFees"`
+	TotalReward                string `json:"totalReward"`
+}
+
+// RewardsPreviewAccount holds the rewards of the eligible nodes of an owner or of a delegation contract if the
+// current epoch ended now. The estimated APR extrapolates the rewards of the elapsed part of the epoch over a year
+type RewardsPreviewAccount struct {
+	Address          string  `json:"address"`
+	NumEligibleNodes uint32  `json:"numEligibleNodes"`
+	TotalStaked      string  `json:"totalStaked"`
+	TotalReward      string  `json:"totalReward"`
+	EstimatedAPR     float64 `json:"estimatedAPR"`
+}
+
+// RewardsPreview holds the end of epoch economics and the rewards per node, per owner and per delegation contract
+// estimated for the in-progress epoch as if it ended at the current block
+type RewardsPreview struct {
+	Epoch                            uint32                   `json:"epoch"`
+	Nonce                            uint64                   `json:"nonce"`
+	Round                            uint64                   `json:"round"`
+	RoundsPassedInEpoch              uint64                   `json:"roundsPassedInEpoch"`
+	NumBlocksInEpoch                 uint64                   `json:"numBlocksInEpoch"`
+	AccumulatedFees                  string                   `json:"accumulatedFees"`
+	DevFees                          string                   `json:"devFees"`
+	LeaderFees                       string                   `json:"leaderFees"`
+	TotalToDistribute                string                   `json:"totalToDistribute"`
+	TotalNewlyMinted                 string                   `json:"totalNewlyMinted"`
+	RewardsPerBlock                  string                   `json:"rewardsPerBlock"`
+	RewardsForProtocolSustainability string                   `json:"rewardsForProtocolSustainability"`
+	Nodes                            []*RewardsPreviewNode    `json:"nodes"`
+	Owners                           []*RewardsPreviewAccount `json:"owners"`
+	DelegationContracts              []*RewardsPreviewAccount `json:"delegationContracts"`
+}
@@ -137,3 +137,14 @@ type ProcessStatusHandler interface {
 	IsIdle() bool
 	IsInterfaceNil() bool
 }
+
+// MetricsRegistry defines the behavior of a component able to record the histograms and the counters of the node and
+// to render them in the Prometheus exposition format
+type MetricsRegistry interface {
+	ObserveDuration(name string, duration time.Duration, labelValues ...string)
+	ObserveDurationSince(name string, start time.Time, labelValues ...string)
+	IncrementCounter(name string, labelValues ...string)
+	AddToCounter(name string, value uint64, labelValues ...string)
+	PrometheusString(shardID uint32) string
+	IsInterfaceNil() bool
+}
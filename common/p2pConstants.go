@@ -39,3 +39,17 @@ const MetricP2PNumReceiverPeers = "erd_p2p_num_receiver_peers"
 // MetricP2PPeakNumReceiverPeers represents the peak number of connected peer sent messages to the current peer
 // (and have been received by the current peer) in the amount of time
 const MetricP2PPeakNumReceiverPeers = "erd_p2p_peak_num_receiver_peers"
+
+// MetricAntifloodLoadPercent represents the node load, as measured by the adaptive antiflood quotas mechanism
+const MetricAntifloodLoadPercent = "erd_antiflood_load_percent"
+
+// MetricAntifloodQuotaPercent represents the percent applied on the configured antiflood quotas
+const MetricAntifloodQuotaPercent = "erd_antiflood_quota_percent"
+
+// MetricAntifloodMaxNumMessagesPerPeer represents the effective maximum number of messages accepted from a peer in
+// the amount of time. The flood preventer identifier is appended to the metric name
+const MetricAntifloodMaxNumMessagesPerPeer = "erd_antiflood_max_num_messages_per_peer"
+
+// MetricAntifloodMaxTotalSizePerPeer represents the effective maximum size of data accepted from a peer in the amount
+// of time. The flood preventer identifier is appended to the metric name
+const MetricAntifloodMaxTotalSizePerPeer = "erd_antiflood_max_total_size_per_peer"
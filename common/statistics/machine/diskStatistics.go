@@ -0,0 +1,43 @@
+package machine
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/shirou/gopsutil/disk"
+)
+
+// DiskStatistics holds the disk statistics of the volume containing a path
+type DiskStatistics struct {
+	Total       uint64
+	Free        uint64
+	Used        uint64
+	PercentUsed uint64
+}
+
+func (stats *DiskStatistics) String() string {
+	return fmt.Sprintf("total:%s, free:%s, used:%s, percent:%d%%",
+		core.ConvertBytes(stats.Total),
+		core.ConvertBytes(stats.Free),
+		core.ConvertBytes(stats.Used),
+		stats.PercentUsed,
+	)
+}
+
+// AcquireDiskStatistics acquires the disk statistics of the volume containing the provided path
+func AcquireDiskStatistics(path string) (DiskStatistics, error) {
+	usage, err := disk.Usage(path)
+	if err != nil {
+		return DiskStatistics{}, err
+	}
+
+	result := DiskStatistics{
+		Total:       usage.Total,
+		Free:        usage.Free,
+		Used:        usage.Used,
+		PercentUsed: uint64(usage.UsedPercent),
+	}
+
+	log.Trace("AcquireDiskStatistics", "path", path, "stats", result.String())
+	return result, nil
+}
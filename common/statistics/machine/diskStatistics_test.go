@@ -0,0 +1,27 @@
+package machine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcquireDiskStatistics(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid path should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := AcquireDiskStatistics("/path/that/does/not/exist")
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		stats, err := AcquireDiskStatistics(t.TempDir())
+		assert.Nil(t, err)
+		assert.True(t, stats.Total > 0)
+		assert.True(t, stats.Free <= stats.Total)
+		assert.True(t, stats.PercentUsed <= 100)
+	})
+}
@@ -0,0 +1,88 @@
+package tracing
+
+import (
+	"strconv"
+)
+
+// Attribute keys used by the instrumented components
+const (
+	// AttributeShard is the shard of the node or of the processed header
+	AttributeShard = "shard"
+	// AttributeRound is the consensus round or the round of the processed header
+	AttributeRound = "round"
+	// AttributeNonce is the nonce of the processed header
+	AttributeNonce = "nonce"
+	// AttributeEpoch is the epoch of the processed header
+	AttributeEpoch = "epoch"
+	// AttributeBlockType is the type of the miniblocks handled by a preprocessor
+	AttributeBlockType = "block.type"
+	// AttributeNumMiniBlocks is the number of the miniblocks handled by a preprocessor
+	AttributeNumMiniBlocks = "miniblocks.count"
+	// AttributeHTTPMethod is the method of a REST API request
+	AttributeHTTPMethod = "http.method"
+	// AttributeHTTPRoute is the route template of a REST API request
+	AttributeHTTPRoute = "http.route"
+	// AttributeHTTPStatusCode is the status code of a REST API response
+	AttributeHTTPStatusCode = "http.status_code"
+)
+
+type attributeType int
+
+const (
+	stringAttribute attributeType = iota
+	intAttribute
+	boolAttribute
+)
+
+// Attribute is a key-value pair attached to a span
+type Attribute struct {
+	Key       string
+	value     string
+	valueType attributeType
+}
+
+// String creates a string attribute
+func String(key string, value string) Attribute {
+	return Attribute{
+		Key:       key,
+		value:     value,
+		valueType: stringAttribute,
+	}
+}
+
+// Int creates an integer attribute
+func Int(key string, value int) Attribute {
+	return Int64(key, int64(value))
+}
+
+// Int64 creates an integer attribute
+func Int64(key string, value int64) Attribute {
+	return Attribute{
+		Key:       key,
+		value:     strconv.FormatInt(value, 10),
+		valueType: intAttribute,
+	}
+}
+
+// Uint64 creates an integer attribute
+func Uint64(key string, value uint64) Attribute {
+	return Attribute{
+		Key:       key,
+		value:     strconv.FormatUint(value, 10),
+		valueType: intAttribute,
+	}
+}
+
+// Bool creates a boolean attribute
+func Bool(key string, value bool) Attribute {
+	return Attribute{
+		Key:       key,
+		value:     strconv.FormatBool(value),
+		valueType: boolAttribute,
+	}
+}
+
+// Value returns the string representation of the attribute value
+func (a Attribute) Value() string {
+	return a.value
+}
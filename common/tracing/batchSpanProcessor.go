@@ -0,0 +1,110 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type batchSpanProcessor struct {
+	exporter      SpanExporter
+	queue         chan *SpanData
+	batchSize     int
+	flushInterval time.Duration
+	numDropped    uint64
+	cancelFunc    func()
+	chanDone      chan struct{}
+	closeOnce     sync.Once
+}
+
+func newBatchSpanProcessor(exporter SpanExporter, queueSize int, batchSize int, flushInterval time.Duration) *batchSpanProcessor {
+	bsp := &batchSpanProcessor{
+		exporter:      exporter,
+		queue:         make(chan *SpanData, queueSize),
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		chanDone:      make(chan struct{}),
+	}
+
+	var ctx context.Context
+	ctx, bsp.cancelFunc = context.WithCancel(context.Background())
+	go bsp.processLoop(ctx)
+
+	return bsp
+}
+
+// onEnd enqueues the ended span without blocking. The span is dropped if the queue is full
+func (bsp *batchSpanProcessor) onEnd(data *SpanData) {
+	select {
+	case bsp.queue <- data:
+	default:
+		atomic.AddUint64(&bsp.numDropped, 1)
+	}
+}
+
+func (bsp *batchSpanProcessor) processLoop(ctx context.Context) {
+	defer close(bsp.chanDone)
+
+	ticker := time.NewTicker(bsp.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*SpanData, 0, bsp.batchSize)
+	for {
+		select {
+		case data := <-bsp.queue:
+			batch = append(batch, data)
+			if len(batch) >= bsp.batchSize {
+				batch = bsp.export(batch)
+			}
+		case <-ticker.C:
+			batch = bsp.export(batch)
+		case <-ctx.Done():
+			bsp.drain(batch)
+			return
+		}
+	}
+}
+
+func (bsp *batchSpanProcessor) drain(batch []*SpanData) {
+	for {
+		select {
+		case data := <-bsp.queue:
+			batch = append(batch, data)
+			if len(batch) >= bsp.batchSize {
+				batch = bsp.export(batch)
+			}
+		default:
+			bsp.export(batch)
+			return
+		}
+	}
+}
+
+func (bsp *batchSpanProcessor) export(batch []*SpanData) []*SpanData {
+	numDropped := atomic.SwapUint64(&bsp.numDropped, 0)
+	if numDropped > 0 {
+		log.Debug("tracing: spans dropped because the queue was full", "num spans", numDropped)
+	}
+	if len(batch) == 0 {
+		return batch
+	}
+
+	err := bsp.exporter.ExportSpans(batch)
+	if err != nil {
+		log.Debug("tracing: cannot export spans", "num spans", len(batch), "error", err.Error())
+	}
+
+	return make([]*SpanData, 0, bsp.batchSize)
+}
+
+func (bsp *batchSpanProcessor) close() error {
+	var err error
+	bsp.closeOnce.Do(func() {
+		bsp.cancelFunc()
+		<-bsp.chanDone
+		err = bsp.exporter.Close()
+	})
+
+	return err
+}
@@ -0,0 +1,51 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+)
+
+var disabled = &disabledSpan{}
+
+type disabledTracer struct{}
+
+// NewDisabledTracer returns a tracer which does not record any span
+func NewDisabledTracer() *disabledTracer {
+	return &disabledTracer{}
+}
+
+// StartSpan returns the provided context and a span which does nothing
+func (dt *disabledTracer) StartSpan(ctx context.Context, _ string, _ ...Attribute) (context.Context, Span) {
+	return ctx, disabled
+}
+
+// StartServerSpan returns the provided context and a span which does nothing
+func (dt *disabledTracer) StartServerSpan(ctx context.Context, _ string, _ ...Attribute) (context.Context, Span) {
+	return ctx, disabled
+}
+
+// StartRoundSpan returns the provided context and a span which does nothing
+func (dt *disabledTracer) StartRoundSpan(ctx context.Context, _ string, _ uint32, _ uint64, _ ...Attribute) (context.Context, Span) {
+	return ctx, disabled
+}
+
+// StartHeaderSpan returns the provided context and a span which does nothing
+func (dt *disabledTracer) StartHeaderSpan(ctx context.Context, _ string, _ data.HeaderHandler, _ ...Attribute) (context.Context, Span) {
+	return ctx, disabled
+}
+
+// IsEnabled returns false
+func (dt *disabledTracer) IsEnabled() bool {
+	return false
+}
+
+// Close does nothing
+func (dt *disabledTracer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dt *disabledTracer) IsInterfaceNil() bool {
+	return dt == nil
+}
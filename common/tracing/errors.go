@@ -0,0 +1,24 @@
+package tracing
+
+import "errors"
+
+// ErrInvalidExporter signals that an unknown exporter type was provided
+var ErrInvalidExporter = errors.New("invalid tracing exporter")
+
+// ErrEmptyFilePath signals that an empty file path was provided for the file exporter
+var ErrEmptyFilePath = errors.New("empty file path for the tracing file exporter")
+
+// ErrEmptyCollectorURL signals that an empty collector URL was provided for the OTLP exporter
+var ErrEmptyCollectorURL = errors.New("empty collector URL for the tracing OTLP exporter")
+
+// ErrInvalidQueueSize signals that an invalid queue size was provided
+var ErrInvalidQueueSize = errors.New("invalid tracing queue size")
+
+// ErrInvalidBatchSize signals that an invalid batch size was provided
+var ErrInvalidBatchSize = errors.New("invalid tracing batch size")
+
+// ErrInvalidFlushInterval signals that an invalid flush interval was provided
+var ErrInvalidFlushInterval = errors.New("invalid tracing flush interval")
+
+// ErrCollectorResponse signals that the collector did not accept the exported spans
+var ErrCollectorResponse = errors.New("collector did not accept the spans")
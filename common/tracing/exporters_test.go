@@ -0,0 +1,203 @@
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestSpanData() *SpanData {
+	return &SpanData{
+		TraceID:      TraceID{1, 2, 3},
+		SpanID:       SpanID{4, 5, 6},
+		ParentSpanID: SpanID{7, 8, 9},
+		Name:         "operation",
+		Kind:         SpanKindServer,
+		StartTime:    time.Unix(0, 1000),
+		EndTime:      time.Unix(0, 2500),
+		Attributes:   []Attribute{String("s", "value"), Uint64("u", 7), Int("i", -3), Bool("b", true)},
+		HasError:     true,
+		ErrorMessage: "failed",
+	}
+}
+
+func TestMarshalExportRequest(t *testing.T) {
+	t.Parallel()
+
+	buff, err := marshalExportRequest("service", []*SpanData{createTestSpanData(), {Name: "root"}})
+	require.Nil(t, err)
+
+	request := otlpExportRequest{}
+	err = json.Unmarshal(buff, &request)
+	require.Nil(t, err)
+
+	require.Equal(t, 1, len(request.ResourceSpans))
+	resourceSpans := request.ResourceSpans[0]
+	assert.Equal(t, serviceNameAttribute, resourceSpans.Resource.Attributes[0].Key)
+	assert.Equal(t, "service", *resourceSpans.Resource.Attributes[0].Value.StringValue)
+	require.Equal(t, 1, len(resourceSpans.ScopeSpans))
+	spans := resourceSpans.ScopeSpans[0].Spans
+	require.Equal(t, 2, len(spans))
+
+	converted := spans[0]
+	assert.Equal(t, "01020300000000000000000000000000", converted.TraceID)
+	assert.Equal(t, "0405060000000000", converted.SpanID)
+	assert.Equal(t, "0708090000000000", converted.ParentSpanID)
+	assert.Equal(t, int(SpanKindServer), converted.Kind)
+	assert.Equal(t, "1000", converted.StartTimeUnixNano)
+	assert.Equal(t, "2500", converted.EndTimeUnixNano)
+	assert.Equal(t, &otlpStatus{Code: statusCodeError, Message: "failed"}, converted.Status)
+	require.Equal(t, 4, len(converted.Attributes))
+	assert.Equal(t, "value", *converted.Attributes[0].Value.StringValue)
+	assert.Equal(t, "7", *converted.Attributes[1].Value.IntValue)
+	assert.Equal(t, "-3", *converted.Attributes[2].Value.IntValue)
+	assert.True(t, *converted.Attributes[3].Value.BoolValue)
+
+	assert.Empty(t, spans[1].ParentSpanID)
+	assert.Nil(t, spans[1].Status)
+}
+
+func TestFileExporter(t *testing.T) {
+	t.Parallel()
+
+	exporter, err := NewFileExporter("", "service")
+	assert.True(t, check.IfNil(exporter))
+	assert.Equal(t, ErrEmptyFilePath, err)
+
+	filePath := filepath.Join(t.TempDir(), "traces", "spans.json")
+	exporter, err = NewFileExporter(filePath, "service")
+	require.Nil(t, err)
+	assert.False(t, check.IfNil(exporter))
+
+	assert.Nil(t, exporter.ExportSpans([]*SpanData{createTestSpanData()}))
+	assert.Nil(t, exporter.ExportSpans([]*SpanData{createTestSpanData(), createTestSpanData()}))
+	assert.Nil(t, exporter.Close())
+
+	file, err := os.Open(filePath)
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	numSpansPerLine := make([]int, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		request := otlpExportRequest{}
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), &request))
+		numSpansPerLine = append(numSpansPerLine, len(request.ResourceSpans[0].ScopeSpans[0].Spans))
+	}
+	assert.Equal(t, []int{1, 2}, numSpansPerLine)
+}
+
+func TestOTLPHTTPExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty collector URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, err := NewOTLPHTTPExporter("", "service")
+		assert.True(t, check.IfNil(exporter))
+		assert.Equal(t, ErrEmptyCollectorURL, err)
+	})
+	t.Run("should send the spans to the collector", func(t *testing.T) {
+		t.Parallel()
+
+		chanRequests := make(chan otlpExportRequest, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+			body, _ := ioutil.ReadAll(r.Body)
+			request := otlpExportRequest{}
+			assert.Nil(t, json.Unmarshal(body, &request))
+			chanRequests <- request
+		}))
+		defer server.Close()
+
+		exporter, err := NewOTLPHTTPExporter(server.URL, "service")
+		require.Nil(t, err)
+
+		err = exporter.ExportSpans([]*SpanData{createTestSpanData()})
+		assert.Nil(t, err)
+		request := <-chanRequests
+		assert.Equal(t, "operation", request.ResourceSpans[0].ScopeSpans[0].Spans[0].Name)
+		assert.Nil(t, exporter.Close())
+	})
+	t.Run("collector error should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		exporter, _ := NewOTLPHTTPExporter(server.URL, "service")
+		err := exporter.ExportSpans([]*SpanData{createTestSpanData()})
+		assert.ErrorIs(t, err, ErrCollectorResponse)
+	})
+}
+
+func TestCreateTracer(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.TracingConfig{
+		Enabled:                     true,
+		Exporter:                    FileExporter,
+		FilePath:                    "spans.json",
+		ServiceName:                 "service",
+		QueueSize:                   10,
+		BatchSize:                   5,
+		FlushIntervalInMilliseconds: 1000,
+	}
+
+	t.Run("disabled tracing should return a disabled tracer", func(t *testing.T) {
+		t.Parallel()
+
+		disabledCfg := cfg
+		disabledCfg.Enabled = false
+
+		tr, err := CreateTracer(disabledCfg, t.TempDir())
+		assert.Nil(t, err)
+		assert.False(t, tr.IsEnabled())
+		assert.Nil(t, tr.Close())
+	})
+	t.Run("invalid exporter should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidCfg := cfg
+		invalidCfg.Exporter = "invalid"
+
+		tr, err := CreateTracer(invalidCfg, t.TempDir())
+		assert.Nil(t, tr)
+		assert.ErrorIs(t, err, ErrInvalidExporter)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		workingDir := t.TempDir()
+		tr, err := CreateTracer(cfg, workingDir)
+		require.Nil(t, err)
+		assert.True(t, tr.IsEnabled())
+
+		_, s := tr.StartSpan(context.Background(), "operation")
+		s.End()
+
+		assert.Nil(t, tr.Close())
+
+		buff, err := ioutil.ReadFile(filepath.Join(workingDir, "spans.json"))
+		require.Nil(t, err)
+		assert.Contains(t, string(buff), `"name":"operation"`)
+	})
+}
@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+const (
+	// FileExporter is the exporter which writes the spans in a local file
+	FileExporter = "file"
+	// OTLPExporter is the exporter which sends the spans to an OTLP/HTTP collector
+	OTLPExporter = "otlp"
+)
+
+// CreateTracer creates the tracer described by the provided config. A disabled tracer is returned if the tracing is
+// not enabled. The returned tracer has to be closed when the node stops
+func CreateTracer(cfg config.TracingConfig, workingDir string) (Tracer, error) {
+	if !cfg.Enabled {
+		return NewDisabledTracer(), nil
+	}
+
+	exporter, err := createExporter(cfg, workingDir)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := NewTracer(ArgsTracer{
+		Exporter:      exporter,
+		QueueSize:     cfg.QueueSize,
+		BatchSize:     cfg.BatchSize,
+		FlushInterval: time.Duration(cfg.FlushIntervalInMilliseconds) * time.Millisecond,
+	})
+	if err != nil {
+		_ = exporter.Close()
+		return nil, err
+	}
+
+	log.Debug("tracing enabled", "exporter", cfg.Exporter)
+
+	return t, nil
+}
+
+func createExporter(cfg config.TracingConfig, workingDir string) (SpanExporter, error) {
+	switch cfg.Exporter {
+	case FileExporter:
+		filePath := cfg.FilePath
+		if len(filePath) > 0 && !filepath.IsAbs(filePath) {
+			filePath = filepath.Join(workingDir, filePath)
+		}
+
+		return NewFileExporter(filePath, cfg.ServiceName)
+	case OTLPExporter:
+		return NewOTLPHTTPExporter(cfg.CollectorURL, cfg.ServiceName)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidExporter, cfg.Exporter)
+	}
+}
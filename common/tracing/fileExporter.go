@@ -0,0 +1,63 @@
+package tracing
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+type fileExporter struct {
+	serviceName string
+	mutFile     sync.Mutex
+	file        *os.File
+}
+
+// NewFileExporter creates an exporter which appends a line holding an OTLP JSON request for each exported batch
+func NewFileExporter(filePath string, serviceName string) (*fileExporter, error) {
+	if len(filePath) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+
+	err := os.MkdirAll(filepath.Dir(filePath), os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileExporter{
+		serviceName: serviceName,
+		file:        file,
+	}, nil
+}
+
+// ExportSpans writes the provided spans in the file
+func (fe *fileExporter) ExportSpans(spans []*SpanData) error {
+	buff, err := marshalExportRequest(fe.serviceName, spans)
+	if err != nil {
+		return err
+	}
+
+	fe.mutFile.Lock()
+	defer fe.mutFile.Unlock()
+
+	_, err = fe.file.Write(append(buff, '\n'))
+
+	return err
+}
+
+// Close closes the file
+func (fe *fileExporter) Close() error {
+	fe.mutFile.Lock()
+	defer fe.mutFile.Unlock()
+
+	return fe.file.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fe *fileExporter) IsInterfaceNil() bool {
+	return fe == nil
+}
@@ -0,0 +1,32 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+)
+
+// Span defines an operation which is timed and exported when ended
+type Span interface {
+	SetAttributes(attributes ...Attribute)
+	SetError(err error)
+	End()
+}
+
+// SpanExporter defines the component able to send the ended spans to a trace collector
+type SpanExporter interface {
+	ExportSpans(spans []*SpanData) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// Tracer defines the component able to start the spans around the instrumented operations
+type Tracer interface {
+	StartSpan(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span)
+	StartServerSpan(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span)
+	StartRoundSpan(ctx context.Context, name string, shardID uint32, round uint64, attributes ...Attribute) (context.Context, Span)
+	StartHeaderSpan(ctx context.Context, name string, header data.HeaderHandler, attributes ...Attribute) (context.Context, Span)
+	IsEnabled() bool
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,135 @@
+package tracing
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+)
+
+const instrumentationScope = "github.com/ElrondNetwork/elrond-go"
+const serviceNameAttribute = "service.name"
+const statusCodeError = 2
+
+// the structures below follow the JSON encoding of the OTLP ExportTraceServiceRequest message
+
+type otlpExportRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            *otlpStatus    `json:"status,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string `json:"stringValue,omitempty"`
+	IntValue    *string `json:"intValue,omitempty"`
+	BoolValue   *bool   `json:"boolValue,omitempty"`
+}
+
+func marshalExportRequest(serviceName string, spans []*SpanData) ([]byte, error) {
+	otlpSpans := make([]otlpSpan, 0, len(spans))
+	for _, sd := range spans {
+		otlpSpans = append(otlpSpans, convertSpan(sd))
+	}
+
+	request := otlpExportRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpKeyValue{convertAttribute(String(serviceNameAttribute, serviceName))},
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: instrumentationScope},
+						Spans: otlpSpans,
+					},
+				},
+			},
+		},
+	}
+
+	return json.Marshal(request)
+}
+
+func convertSpan(sd *SpanData) otlpSpan {
+	converted := otlpSpan{
+		TraceID:           hex.EncodeToString(sd.TraceID[:]),
+		SpanID:            hex.EncodeToString(sd.SpanID[:]),
+		Name:              sd.Name,
+		Kind:              int(sd.Kind),
+		StartTimeUnixNano: strconv.FormatInt(sd.StartTime.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(sd.EndTime.UnixNano(), 10),
+		Attributes:        make([]otlpKeyValue, 0, len(sd.Attributes)),
+	}
+	if sd.ParentSpanID != (SpanID{}) {
+		converted.ParentSpanID = hex.EncodeToString(sd.ParentSpanID[:])
+	}
+	if sd.HasError {
+		converted.Status = &otlpStatus{
+			Code:    statusCodeError,
+			Message: sd.ErrorMessage,
+		}
+	}
+
+	for _, attribute := range sd.Attributes {
+		converted.Attributes = append(converted.Attributes, convertAttribute(attribute))
+	}
+
+	return converted
+}
+
+func convertAttribute(attribute Attribute) otlpKeyValue {
+	value := otlpValue{}
+	switch attribute.valueType {
+	case intAttribute:
+		intValue := attribute.value
+		value.IntValue = &intValue
+	case boolAttribute:
+		boolValue := attribute.value == "true"
+		value.BoolValue = &boolValue
+	default:
+		stringValue := attribute.value
+		value.StringValue = &stringValue
+	}
+
+	return otlpKeyValue{
+		Key:   attribute.Key,
+		Value: value,
+	}
+}
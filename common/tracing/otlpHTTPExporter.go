@@ -0,0 +1,66 @@
+package tracing
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const exportTimeout = 10 * time.Second
+
+type otlpHTTPExporter struct {
+	serviceName  string
+	collectorURL string
+	httpClient   *http.Client
+}
+
+// NewOTLPHTTPExporter creates an exporter which sends the spans to an OTLP/HTTP collector, JSON encoded
+func NewOTLPHTTPExporter(collectorURL string, serviceName string) (*otlpHTTPExporter, error) {
+	if len(collectorURL) == 0 {
+		return nil, ErrEmptyCollectorURL
+	}
+
+	return &otlpHTTPExporter{
+		serviceName:  serviceName,
+		collectorURL: collectorURL,
+		httpClient:   &http.Client{Timeout: exportTimeout},
+	}, nil
+}
+
+// ExportSpans sends the provided spans to the collector
+func (oe *otlpHTTPExporter) ExportSpans(spans []*SpanData) error {
+	buff, err := marshalExportRequest(oe.serviceName, spans)
+	if err != nil {
+		return err
+	}
+
+	response, err := oe.httpClient.Post(oe.collectorURL, "application/json", bytes.NewReader(buff))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, response.Body)
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: status %s", ErrCollectorResponse, response.Status)
+	}
+
+	return nil
+}
+
+// Close releases the idle connections to the collector
+func (oe *otlpHTTPExporter) Close() error {
+	oe.httpClient.CloseIdleConnections()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (oe *otlpHTTPExporter) IsInterfaceNil() bool {
+	return oe == nil
+}
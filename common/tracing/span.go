@@ -0,0 +1,107 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// SpanKind defines the role of a span, as described by the OTLP specification
+type SpanKind int
+
+const (
+	// SpanKindInternal is the kind of the spans around operations which are internal to the node
+	SpanKindInternal SpanKind = 1
+	// SpanKindServer is the kind of the spans around the handled REST API requests
+	SpanKindServer SpanKind = 2
+)
+
+// TraceID identifies all the spans of the same trace
+type TraceID [16]byte
+
+// SpanID identifies a span inside a trace
+type SpanID [8]byte
+
+// SpanData holds the data of an ended span
+type SpanData struct {
+	TraceID      TraceID
+	SpanID       SpanID
+	ParentSpanID SpanID
+	Name         string
+	Kind         SpanKind
+	StartTime    time.Time
+	EndTime      time.Time
+	Attributes   []Attribute
+	HasError     bool
+	ErrorMessage string
+}
+
+type spanContextKey struct{}
+
+type span struct {
+	mutData sync.Mutex
+	data    *SpanData
+	ended   bool
+	onEnd   func(data *SpanData)
+}
+
+// SetAttributes adds the provided attributes to the span
+func (s *span) SetAttributes(attributes ...Attribute) {
+	s.mutData.Lock()
+	if !s.ended {
+		s.data.Attributes = append(s.data.Attributes, attributes...)
+	}
+	s.mutData.Unlock()
+}
+
+// SetError marks the span as failed if the provided error is not nil
+func (s *span) SetError(err error) {
+	if err == nil {
+		return
+	}
+
+	s.mutData.Lock()
+	if !s.ended {
+		s.data.HasError = true
+		s.data.ErrorMessage = err.Error()
+	}
+	s.mutData.Unlock()
+}
+
+// End sets the end time of the span and sends it to the exporter. Only the first call has effect
+func (s *span) End() {
+	s.mutData.Lock()
+	if s.ended {
+		s.mutData.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.EndTime = time.Now()
+	s.mutData.Unlock()
+
+	s.onEnd(s.data)
+}
+
+func spanFromContext(ctx context.Context) *span {
+	if ctx == nil {
+		return nil
+	}
+
+	s, _ := ctx.Value(spanContextKey{}).(*span)
+
+	return s
+}
+
+type disabledSpan struct{}
+
+// SetAttributes does nothing
+func (ds *disabledSpan) SetAttributes(_ ...Attribute) {
+}
+
+// SetError does nothing
+func (ds *disabledSpan) SetError(_ error) {
+}
+
+// End does nothing
+func (ds *disabledSpan) End() {
+}
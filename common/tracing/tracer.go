@@ -0,0 +1,173 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("common/tracing")
+
+const saltLength = 16
+
+// ArgsTracer is the DTO used to create a new tracer
+type ArgsTracer struct {
+	Exporter      SpanExporter
+	QueueSize     int
+	BatchSize     int
+	FlushInterval time.Duration
+}
+
+type tracer struct {
+	salt      []byte
+	processor *batchSpanProcessor
+}
+
+// NewTracer creates a tracer which exports the ended spans in batches
+func NewTracer(args ArgsTracer) (*tracer, error) {
+	if check.IfNil(args.Exporter) {
+		return nil, ErrInvalidExporter
+	}
+	if args.QueueSize < 1 {
+		return nil, ErrInvalidQueueSize
+	}
+	if args.BatchSize < 1 || args.BatchSize > args.QueueSize {
+		return nil, ErrInvalidBatchSize
+	}
+	if args.FlushInterval <= 0 {
+		return nil, ErrInvalidFlushInterval
+	}
+
+	// the salt keeps apart the round traces of different nodes and of different runs of the same node
+	salt := make([]byte, saltLength)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracer{
+		salt:      salt,
+		processor: newBatchSpanProcessor(args.Exporter, args.QueueSize, args.BatchSize, args.FlushInterval),
+	}, nil
+}
+
+func (t *tracer) startSpan(parent *span, traceID TraceID, name string, kind SpanKind, attributes []Attribute) *span {
+	sd := &SpanData{
+		TraceID:    traceID,
+		SpanID:     newSpanID(),
+		Name:       name,
+		Kind:       kind,
+		StartTime:  time.Now(),
+		Attributes: attributes,
+	}
+	if parent != nil {
+		sd.TraceID = parent.data.TraceID
+		sd.ParentSpanID = parent.data.SpanID
+	}
+
+	return &span{
+		data:  sd,
+		onEnd: t.processor.onEnd,
+	}
+}
+
+func (t *tracer) roundTraceID(shardID uint32, round uint64) TraceID {
+	buff := make([]byte, 0, saltLength+12)
+	buff = append(buff, t.salt...)
+	buff = append(buff, make([]byte, 12)...)
+	binary.BigEndian.PutUint32(buff[saltLength:], shardID)
+	binary.BigEndian.PutUint64(buff[saltLength+4:], round)
+
+	hash := sha256.Sum256(buff)
+	traceID := TraceID{}
+	copy(traceID[:], hash[:])
+
+	return traceID
+}
+
+// Close stops the tracer after exporting the spans that are still in the queue
+func (t *tracer) Close() error {
+	return t.processor.close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (t *tracer) IsInterfaceNil() bool {
+	return t == nil
+}
+
+func newTraceID() TraceID {
+	traceID := TraceID{}
+	_, _ = rand.Read(traceID[:])
+
+	return traceID
+}
+
+func newSpanID() SpanID {
+	spanID := SpanID{}
+	_, _ = rand.Read(spanID[:])
+
+	return spanID
+}
+
+// IsEnabled returns true as the spans are recorded and exported
+func (t *tracer) IsEnabled() bool {
+	return true
+}
+
+// StartSpan starts a span as a child of the span found in the provided context. If the context does not hold a span,
+// the new span starts a new trace
+func (t *tracer) StartSpan(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span) {
+	return t.startSpanWithKind(ctx, name, SpanKindInternal, attributes)
+}
+
+// StartServerSpan starts a span around a handled request, as a child of the span found in the provided context
+func (t *tracer) StartServerSpan(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span) {
+	return t.startSpanWithKind(ctx, name, SpanKindServer, attributes)
+}
+
+func (t *tracer) startSpanWithKind(ctx context.Context, name string, kind SpanKind, attributes []Attribute) (context.Context, Span) {
+	s := t.startSpan(spanFromContext(ctx), newTraceID(), name, kind, attributes)
+
+	return contextWithSpan(ctx, s), s
+}
+
+// StartRoundSpan starts a span as a child of the span found in the provided context. If the context does not hold a
+// span, the new span is placed in the trace of the provided shard and round, so all the operations of the same round
+// can be correlated without passing the context between components
+func (t *tracer) StartRoundSpan(ctx context.Context, name string, shardID uint32, round uint64, attributes ...Attribute) (context.Context, Span) {
+	roundAttributes := make([]Attribute, 0, len(attributes)+2)
+	roundAttributes = append(roundAttributes, Uint64(AttributeShard, uint64(shardID)), Uint64(AttributeRound, round))
+	roundAttributes = append(roundAttributes, attributes...)
+
+	s := t.startSpan(spanFromContext(ctx), t.roundTraceID(shardID, round), name, SpanKindInternal, roundAttributes)
+
+	return contextWithSpan(ctx, s), s
+}
+
+// StartHeaderSpan starts a round span around an operation on the provided header, which carries the header nonce
+// and epoch as attributes
+func (t *tracer) StartHeaderSpan(ctx context.Context, name string, header data.HeaderHandler, attributes ...Attribute) (context.Context, Span) {
+	if check.IfNil(header) {
+		return t.StartSpan(ctx, name, attributes...)
+	}
+
+	headerAttributes := make([]Attribute, 0, len(attributes)+2)
+	headerAttributes = append(headerAttributes, Uint64(AttributeNonce, header.GetNonce()), Uint64(AttributeEpoch, uint64(header.GetEpoch())))
+	headerAttributes = append(headerAttributes, attributes...)
+
+	return t.StartRoundSpan(ctx, name, header.GetShardID(), header.GetRound(), headerAttributes...)
+}
+
+func contextWithSpan(ctx context.Context, s *span) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return context.WithValue(ctx, spanContextKey{}, s)
+}
@@ -0,0 +1,224 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type exporterStub struct {
+	mutSpans  sync.Mutex
+	spans     []*SpanData
+	numCalls  int
+	numCloses int
+}
+
+func (es *exporterStub) ExportSpans(spans []*SpanData) error {
+	es.mutSpans.Lock()
+	es.spans = append(es.spans, spans...)
+	es.numCalls++
+	es.mutSpans.Unlock()
+
+	return nil
+}
+
+func (es *exporterStub) Close() error {
+	es.mutSpans.Lock()
+	es.numCloses++
+	es.mutSpans.Unlock()
+
+	return nil
+}
+
+func (es *exporterStub) IsInterfaceNil() bool {
+	return es == nil
+}
+
+func (es *exporterStub) exportedSpans() []*SpanData {
+	es.mutSpans.Lock()
+	defer es.mutSpans.Unlock()
+
+	return append([]*SpanData{}, es.spans...)
+}
+
+func createTracerArgs(exporter SpanExporter) ArgsTracer {
+	return ArgsTracer{
+		Exporter:      exporter,
+		QueueSize:     100,
+		BatchSize:     10,
+		FlushInterval: time.Hour,
+	}
+}
+
+func TestNewTracer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil exporter should error", func(t *testing.T) {
+		args := createTracerArgs(nil)
+		tr, err := NewTracer(args)
+
+		assert.True(t, check.IfNil(tr))
+		assert.Equal(t, ErrInvalidExporter, err)
+	})
+	t.Run("invalid queue size should error", func(t *testing.T) {
+		args := createTracerArgs(&exporterStub{})
+		args.QueueSize = 0
+		tr, err := NewTracer(args)
+
+		assert.True(t, check.IfNil(tr))
+		assert.Equal(t, ErrInvalidQueueSize, err)
+	})
+	t.Run("batch size larger than queue size should error", func(t *testing.T) {
+		args := createTracerArgs(&exporterStub{})
+		args.BatchSize = args.QueueSize + 1
+		tr, err := NewTracer(args)
+
+		assert.True(t, check.IfNil(tr))
+		assert.Equal(t, ErrInvalidBatchSize, err)
+	})
+	t.Run("invalid flush interval should error", func(t *testing.T) {
+		args := createTracerArgs(&exporterStub{})
+		args.FlushInterval = 0
+		tr, err := NewTracer(args)
+
+		assert.True(t, check.IfNil(tr))
+		assert.Equal(t, ErrInvalidFlushInterval, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		tr, err := NewTracer(createTracerArgs(&exporterStub{}))
+
+		assert.False(t, check.IfNil(tr))
+		assert.Nil(t, err)
+		assert.True(t, tr.IsEnabled())
+		assert.Nil(t, tr.Close())
+	})
+}
+
+func TestDisabledTracer_ShouldNotRecord(t *testing.T) {
+	t.Parallel()
+
+	dt := NewDisabledTracer()
+	assert.False(t, check.IfNil(dt))
+	assert.False(t, dt.IsEnabled())
+
+	ctx := context.Background()
+	newCtx, s := dt.StartSpan(ctx, "operation")
+	s.SetAttributes(String("key", "value"))
+	s.SetError(errors.New("error"))
+	s.End()
+	assert.Equal(t, ctx, newCtx)
+	assert.Equal(t, disabled, s)
+
+	newCtx, s = dt.StartHeaderSpan(ctx, "operation", &block.Header{})
+	assert.Equal(t, ctx, newCtx)
+	assert.Equal(t, disabled, s)
+	assert.Nil(t, dt.Close())
+}
+
+func TestTracer_StartSpanChildSpanShouldBeInTheParentTrace(t *testing.T) {
+	t.Parallel()
+
+	exporter := &exporterStub{}
+	tr, _ := NewTracer(createTracerArgs(exporter))
+
+	ctx, parent := tr.StartSpan(context.Background(), "parent", String("key", "value"))
+	_, child := tr.StartSpan(ctx, "child")
+	expectedErr := errors.New("expected error")
+	child.SetError(expectedErr)
+	child.End()
+	parent.End()
+	parent.End()
+
+	require.Nil(t, tr.Close())
+	spans := exporter.exportedSpans()
+	require.Equal(t, 2, len(spans))
+	childData, parentData := spans[0], spans[1]
+
+	assert.Equal(t, "parent", parentData.Name)
+	assert.Equal(t, SpanID{}, parentData.ParentSpanID)
+	assert.Equal(t, []Attribute{String("key", "value")}, parentData.Attributes)
+	assert.False(t, parentData.HasError)
+	assert.Equal(t, "child", childData.Name)
+	assert.Equal(t, parentData.TraceID, childData.TraceID)
+	assert.Equal(t, parentData.SpanID, childData.ParentSpanID)
+	assert.True(t, childData.HasError)
+	assert.Equal(t, expectedErr.Error(), childData.ErrorMessage)
+	assert.False(t, childData.EndTime.Before(childData.StartTime))
+	assert.Equal(t, 1, exporter.numCloses)
+}
+
+func TestTracer_StartRoundSpanSameRoundShouldShareTheTrace(t *testing.T) {
+	t.Parallel()
+
+	exporter := &exporterStub{}
+	tr, _ := NewTracer(createTracerArgs(exporter))
+
+	_, subround := tr.StartRoundSpan(context.Background(), "subround", 1, 100)
+	subround.End()
+	header := &block.Header{ShardID: 1, Round: 100, Nonce: 90, Epoch: 2}
+	_, processBlock := tr.StartHeaderSpan(context.Background(), "processBlock", header, String("key", "value"))
+	processBlock.End()
+	_, otherRound := tr.StartRoundSpan(context.Background(), "subround", 1, 101)
+	otherRound.End()
+	otherShardHeader := &block.Header{ShardID: 0, Round: 100}
+	_, otherShard := tr.StartHeaderSpan(context.Background(), "processBlock", otherShardHeader)
+	otherShard.End()
+
+	require.Nil(t, tr.Close())
+	spans := exporter.exportedSpans()
+	require.Equal(t, 4, len(spans))
+
+	assert.Equal(t, []Attribute{Uint64(AttributeShard, 1), Uint64(AttributeRound, 100)}, spans[0].Attributes)
+	assert.Equal(t, []Attribute{
+		Uint64(AttributeShard, 1),
+		Uint64(AttributeRound, 100),
+		Uint64(AttributeNonce, 90),
+		Uint64(AttributeEpoch, 2),
+		String("key", "value"),
+	}, spans[1].Attributes)
+	assert.Equal(t, spans[0].TraceID, spans[1].TraceID)
+	assert.NotEqual(t, spans[0].SpanID, spans[1].SpanID)
+	assert.NotEqual(t, spans[0].TraceID, spans[2].TraceID)
+	assert.NotEqual(t, spans[0].TraceID, spans[3].TraceID)
+}
+
+func TestBatchSpanProcessor_ShouldExportInBatches(t *testing.T) {
+	t.Parallel()
+
+	exporter := &exporterStub{}
+	tr, _ := NewTracer(createTracerArgs(exporter))
+
+	numSpans := 25
+	for i := 0; i < numSpans; i++ {
+		tr.startSpan(nil, newTraceID(), "operation", SpanKindInternal, nil).End()
+	}
+
+	require.Nil(t, tr.Close())
+	assert.Equal(t, numSpans, len(exporter.exportedSpans()))
+	assert.Equal(t, 3, exporter.numCalls)
+}
+
+func TestBatchSpanProcessor_FullQueueShouldDropSpans(t *testing.T) {
+	t.Parallel()
+
+	exporter := &exporterStub{}
+	bsp := &batchSpanProcessor{
+		exporter:  exporter,
+		queue:     make(chan *SpanData, 2),
+		batchSize: 2,
+	}
+
+	bsp.onEnd(&SpanData{})
+	bsp.onEnd(&SpanData{})
+	bsp.onEnd(&SpanData{})
+
+	assert.Equal(t, uint64(1), bsp.numDropped)
+	assert.Equal(t, 2, len(bsp.queue))
+}
@@ -62,7 +62,17 @@ type MarshalizerConfig struct {
 
 // ConsensusConfig holds the consensus configuration parameters
 type ConsensusConfig struct {
-	Type string
+	Type               string
+	RoundsTimelineSize int
+	SlashingDetector   SlashingDetectorConfig
+}
+
+// SlashingDetectorConfig will hold the settings of the component that collects the evidence of conflicting
+// consensus messages
+type SlashingDetectorConfig struct {
+	Enabled         bool
+	NumRoundsToKeep int
+	MaxNumEvidences int
 }
 
 // NTPConfig will hold the configuration for NTP queries
@@ -114,6 +124,8 @@ type Config struct {
 	ShardHdrNonceHashStorage        StorageConfig
 	MetaHdrNonceHashStorage         StorageConfig
 	StatusMetricsStorage            StorageConfig
+	SlashingEvidenceStorage         StorageConfig
+	ValidatorHistoryStorage         StorageConfig
 	ReceiptsStorage                 StorageConfig
 	ScheduledSCRsStorage            StorageConfig
 	SmartContractsStorage           StorageConfig
@@ -176,9 +188,11 @@ type Config struct {
 	Hardfork HardforkConfig
 	Debug    DebugConfig
 	Health   HealthServiceConfig
+	Tracing  TracingConfig
 
 	SoftwareVersionConfig SoftwareVersionConfig
 	DbLookupExtensions    DbLookupExtensionsConfig
+	EconomicsDryRun       EconomicsDryRunConfig
 	Versions              VersionsConfig
 	Logs                  LogsConfig
 	TrieSync              TrieSyncConfig
@@ -190,8 +204,14 @@ type Config struct {
 
 // PeersRatingConfig will hold settings related to peers rating
 type PeersRatingConfig struct {
-	TopRatedCacheCapacity int
-	BadRatedCacheCapacity int
+	TopRatedCacheCapacity     int
+	BadRatedCacheCapacity     int
+	PersistenceEnabled        bool
+	PersistIntervalInSeconds  int
+	RatingHalfLifeInMinutes   int
+	MaxNumOfKnownGoodPeers    int
+	MinRatingForKnownGoodPeer int32
+	Storage                   StorageConfig
 }
 
 // LogsConfig will hold settings related to the logging sub-system
@@ -210,6 +230,15 @@ type StoragePruningConfig struct {
 	NumEpochsToKeep                      uint64
 	NumActivePersisters                  uint64
 	FullArchiveNumActivePersisters       uint32
+	MaxSizeInBytesPerStorer              uint64
+	ColdStorage                          ColdStorageConfig
+}
+
+// ColdStorageConfig will hold the configuration for the cold tier where the old epochs persisters are archived
+type ColdStorageConfig struct {
+	Enabled  bool
+	Path     string
+	Compress bool
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -231,6 +260,14 @@ type HeartbeatConfig struct {
 // ValidatorStatisticsConfig will hold validator statistics specific settings
 type ValidatorStatisticsConfig struct {
 	CacheRefreshIntervalInSec uint32
+	History                   ValidatorHistoryConfig
+}
+
+// ValidatorHistoryConfig will hold the settings of the component that records the per epoch rating and signing
+// statistics of each validator
+type ValidatorHistoryConfig struct {
+	Enabled             bool
+	MaxEpochsPerRequest uint32
 }
 
 // MaxNodesChangeConfig defines a config change tuple, with a maximum number enabled in a certain epoch number
@@ -291,6 +328,7 @@ type WebServerAntifloodConfig struct {
 	SameSourceRequests                 uint32
 	SameSourceResetIntervalInSec       uint32
 	TrieOperationsDeadlineMilliseconds uint32
+	MaxAddressesPerMultiProof          uint32
 	EndpointsThrottlers                []EndpointsThrottlersConfig
 }
 
@@ -332,6 +370,28 @@ type AntifloodConfig struct {
 	WebServer                 WebServerAntifloodConfig
 	Topic                     TopicAntifloodConfig
 	TxAccumulator             TxAccumulatorConfig
+	PeersBlacklist            PeersBlacklistConfig
+	AdaptiveQuotas            AdaptiveQuotasConfig
+}
+
+// AdaptiveQuotasConfig will hold the parameters used to adapt the antiflood quotas to the node's load
+type AdaptiveQuotasConfig struct {
+	Enabled                 bool
+	SampleIntervalInSeconds uint32
+	HighLoadPercent         uint32
+	LowLoadPercent          uint32
+	NumConsecutiveSamples   uint32
+	MinQuotaPercent         uint32
+	MaxQuotaPercent         uint32
+	TightenStepPercent      uint32
+	RelaxStepPercent        uint32
+	UseCpuLoad              bool
+}
+
+// PeersBlacklistConfig will hold the peers blacklist registry parameters
+type PeersBlacklistConfig struct {
+	PersistManualDecisions bool
+	Storage                StorageConfig
 }
 
 // FloodPreventerConfig will hold all flood preventer parameters
@@ -380,6 +440,14 @@ type ArwenVersionByEpoch struct {
 type QueryVirtualMachineConfig struct {
 	VirtualMachineConfig
 	NumConcurrentVMs int
+	QueryCache       QueryCacheConfig
+}
+
+// QueryCacheConfig holds the configuration for the smart contract queries results cache
+type QueryCacheConfig struct {
+	Enabled           bool
+	Capacity          int
+	ExcludedContracts []string
 }
 
 // VirtualMachineGasConfig holds the configuration for the virtual machine(s) gas operations
@@ -425,6 +493,10 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	ESDTHoldersIndexEnabled            bool
+	ESDTHoldersStorageConfig           StorageConfig
+	EventsIndexEnabled                 bool
+	EventsIndexStorageConfig           StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -433,6 +505,7 @@ type DebugConfig struct {
 	Antiflood           AntifloodDebugConfig
 	ShuffleOut          ShuffleOutDebugConfig
 	EpochStart          EpochStartDebugConfig
+	GasProfiler         GasProfilerDebugConfig
 }
 
 // HealthServiceConfig will hold health service (monitoring) configuration
@@ -443,6 +516,36 @@ type HealthServiceConfig struct {
 	MemoryUsageToCreateProfiles               int
 	NumMemoryUsageRecordsToKeep               int
 	FolderPath                                string
+	DiskSpaceWatchdog                         DiskSpaceWatchdogConfig
+}
+
+// DiskSpaceWatchdogConfig will hold the disk space watchdog configuration
+type DiskSpaceWatchdogConfig struct {
+	Enabled                       bool
+	CheckIntervalInSeconds        uint32
+	FreeSpaceWarningThresholdInMB uint64
+	FreeSpaceCleanupThresholdInMB uint64
+	FreeSpaceStopThresholdInMB    uint64
+}
+
+// EconomicsDryRunConfig will hold the configuration of the economics and gas schedule dry-run evaluation
+type EconomicsDryRunConfig struct {
+	Enabled                    bool
+	AlternativeEconomicsFile   string
+	AlternativeGasScheduleFile string
+	MaxBlocksPerRun            uint64
+}
+
+// TracingConfig will hold the tracing of the block lifecycle and API requests configuration
+type TracingConfig struct {
+	Enabled                     bool
+	Exporter                    string
+	FilePath                    string
+	CollectorURL                string
+	ServiceName                 string
+	QueueSize                   int
+	BatchSize                   int
+	FlushIntervalInMilliseconds int
 }
 
 // InterceptorResolverDebugConfig will hold the interceptor-resolver debug configuration
@@ -476,6 +579,14 @@ type EpochStartDebugConfig struct {
 	ProcessDataTrieOnCommitEpoch bool
 }
 
+// GasProfilerDebugConfig will hold the gas profiler configuration
+type GasProfilerDebugConfig struct {
+	Enabled             bool
+	WindowInSeconds     uint32
+	NumBuckets          uint32
+	MaxEntriesPerBucket int
+}
+
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	Logging     ApiLoggingConfig
@@ -5,6 +5,7 @@ type P2PConfig struct {
 	Node                NodeConfig
 	KadDhtPeerDiscovery KadDhtPeerDiscoveryConfig
 	Sharding            ShardingConfig
+	PayloadCompression  PayloadCompressionConfig
 }
 
 // NodeConfig will hold basic p2p settings
@@ -45,3 +46,12 @@ type ShardingConfig struct {
 type AdditionalConnectionsConfig struct {
 	MaxFullHistoryObservers uint32
 }
+
+// PayloadCompressionConfig will hold the settings for the compression of the messages payloads
+type PayloadCompressionConfig struct {
+	Enabled               bool
+	Type                  string
+	MinPayloadSizeInBytes uint32
+	Topics                []string
+	CompressBroadcasts    bool
+}
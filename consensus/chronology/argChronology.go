@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/ntp"
 )
@@ -15,4 +17,7 @@ type ArgChronology struct {
 	SyncTimer        ntp.SyncTimer
 	Watchdog         core.WatchdogTimer
 	AppStatusHandler core.AppStatusHandler
+	MetricsRegistry  common.MetricsRegistry
+	Tracer           tracing.Tracer
+	ShardID          uint32
 }
@@ -12,8 +12,10 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/display"
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
 )
 
 var _ consensus.ChronologyHandler = (*chronology)(nil)
@@ -40,6 +42,9 @@ type chronology struct {
 	subroundHandlers []consensus.SubroundHandler
 	mutSubrounds     sync.RWMutex
 	appStatusHandler core.AppStatusHandler
+	metricsRegistry  common.MetricsRegistry
+	tracer           tracing.Tracer
+	shardID          uint32
 	cancelFunc       func()
 
 	watchdog core.WatchdogTimer
@@ -58,6 +63,9 @@ func NewChronology(arg ArgChronology) (*chronology, error) {
 		roundHandler:     arg.RoundHandler,
 		syncTimer:        arg.SyncTimer,
 		appStatusHandler: arg.AppStatusHandler,
+		metricsRegistry:  arg.MetricsRegistry,
+		tracer:           arg.Tracer,
+		shardID:          arg.ShardID,
 		watchdog:         arg.Watchdog,
 	}
 
@@ -83,6 +91,12 @@ func checkNewChronologyParams(arg ArgChronology) error {
 	if check.IfNil(arg.AppStatusHandler) {
 		return ErrNilAppStatusHandler
 	}
+	if check.IfNil(arg.MetricsRegistry) {
+		return ErrNilMetricsRegistry
+	}
+	if check.IfNil(arg.Tracer) {
+		return ErrNilTracer
+	}
 
 	return nil
 }
@@ -149,7 +163,13 @@ func (chr *chronology) startRound(ctx context.Context) {
 	log.Debug(display.Headline(msg, chr.syncTimer.FormattedCurrentTime(), "."))
 	logger.SetCorrelationSubround(sr.Name())
 
-	if !sr.DoWork(ctx, chr.roundHandler) {
+	startTime := time.Now()
+	ctxSubround, span := chr.tracer.StartRoundSpan(ctx, "subround "+sr.Name(), chr.shardID, uint64(chr.roundHandler.Index()))
+	isSubroundDone := sr.DoWork(ctxSubround, chr.roundHandler)
+	span.SetAttributes(tracing.Bool("done", isSubroundDone))
+	span.End()
+	chr.metricsRegistry.ObserveDurationSince(prometheus.ConsensusSubroundDuration, startTime, sr.Name())
+	if !isSubroundDone {
 		chr.subroundId = srBeforeStartRound
 		return
 	}
@@ -5,9 +5,11 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
 	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
@@ -62,6 +64,28 @@ func TestChronology_NewChronologyNilWatchdogShouldFail(t *testing.T) {
 	assert.Equal(t, err, chronology.ErrNilWatchdog)
 }
 
+func TestChronology_NewChronologyNilMetricsRegistryShouldFail(t *testing.T) {
+	t.Parallel()
+
+	arg := getDefaultChronologyArg()
+	arg.MetricsRegistry = nil
+	chr, err := chronology.NewChronology(arg)
+
+	assert.Nil(t, chr)
+	assert.Equal(t, err, chronology.ErrNilMetricsRegistry)
+}
+
+func TestChronology_NewChronologyNilTracerShouldFail(t *testing.T) {
+	t.Parallel()
+
+	arg := getDefaultChronologyArg()
+	arg.Tracer = nil
+	chr, err := chronology.NewChronology(arg)
+
+	assert.Nil(t, chr)
+	assert.Equal(t, err, chronology.ErrNilTracer)
+}
+
 func TestChronology_NewChronologyNilAppStatusHandlerShouldFail(t *testing.T) {
 	t.Parallel()
 
@@ -319,5 +343,7 @@ func getDefaultChronologyArg() chronology.ArgChronology {
 		SyncTimer:        &mock.SyncTimerMock{},
 		AppStatusHandler: statusHandlerMock.NewAppStatusHandlerMock(),
 		Watchdog:         &mock.WatchdogMock{},
+		MetricsRegistry:  &testscommon.MetricsRegistryStub{},
+		Tracer:           tracing.NewDisabledTracer(),
 	}
 }
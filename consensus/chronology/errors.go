@@ -15,3 +15,9 @@ var ErrNilAppStatusHandler = errors.New("nil AppStatusHandler")
 
 // ErrNilWatchdog signals that a nil watchdog has been provided
 var ErrNilWatchdog = errors.New("nil watchdog")
+
+// ErrNilMetricsRegistry is raised when a nil metrics registry is provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry")
+
+// ErrNilTracer is raised when a nil tracer is provided
+var ErrNilTracer = errors.New("nil tracer")
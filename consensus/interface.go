@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-crypto"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
 
@@ -147,3 +148,27 @@ type ScheduledProcessor interface {
 	IsProcessedOKWithTimeout() bool
 	IsInterfaceNil() bool
 }
+
+// RoundTimelineHandler records the moments of the consensus events of the last rounds
+type RoundTimelineHandler interface {
+	StartRound(round int64, leader string, consensusGroupSize int, signaturesThreshold int, selfRole string)
+	BlockProposed(round int64, blockHash []byte)
+	BlockReceived(round int64, blockHash []byte)
+	BlockProcessed(round int64)
+	SignatureSent(round int64)
+	SignatureReceived(round int64, pubKey string)
+	SignaturesThresholdReached(round int64)
+	FinalInfoBroadcast(round int64)
+	FinalInfoReceived(round int64)
+	BlockCommitted(round int64)
+	GetRounds() []*common.ConsensusRoundTimeline
+	IsInterfaceNil() bool
+}
+
+// SlashingDetector collects the evidence of validators that sign conflicting consensus messages in the same round
+type SlashingDetector interface {
+	CheckProposal(cnsMsg *Message, p2pMessage p2p.MessageP2P)
+	CheckSignature(cnsMsg *Message, p2pMessage p2p.MessageP2P)
+	GetEvidences() []*common.SlashingEvidence
+	IsInterfaceNil() bool
+}
@@ -37,6 +37,7 @@ type ConsensusCoreMock struct {
 	fallbackHeaderValidator consensus.FallbackHeaderValidator
 	nodeRedundancyHandler   consensus.NodeRedundancyHandler
 	scheduledProcessor      consensus.ScheduledProcessor
+	roundTimeline           consensus.RoundTimelineHandler
 }
 
 // GetAntiFloodHandler -
@@ -229,6 +230,16 @@ func (ccm *ConsensusCoreMock) SetNodeRedundancyHandler(nodeRedundancyHandler con
 	ccm.nodeRedundancyHandler = nodeRedundancyHandler
 }
 
+// RoundTimeline -
+func (ccm *ConsensusCoreMock) RoundTimeline() consensus.RoundTimelineHandler {
+	return ccm.roundTimeline
+}
+
+// SetRoundTimeline -
+func (ccm *ConsensusCoreMock) SetRoundTimeline(roundTimeline consensus.RoundTimelineHandler) {
+	ccm.roundTimeline = roundTimeline
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
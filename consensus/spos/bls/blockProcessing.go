@@ -0,0 +1,54 @@
+package bls
+
+import (
+	"context"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// the block processors which can trace the block operations continue the trace of the subround found in the context
+
+func createBlockWithContext(
+	ctx context.Context,
+	blockProcessor process.BlockProcessor,
+	initialHdr data.HeaderHandler,
+	haveTime func() bool,
+) (data.HeaderHandler, data.BodyHandler, error) {
+	tracedBlockProcessor, ok := blockProcessor.(process.TracedBlockProcessor)
+	if !ok {
+		return blockProcessor.CreateBlock(initialHdr, haveTime)
+	}
+
+	return tracedBlockProcessor.CreateBlockWithContext(ctx, initialHdr, haveTime)
+}
+
+func processBlockWithContext(
+	ctx context.Context,
+	blockProcessor process.BlockProcessor,
+	header data.HeaderHandler,
+	body data.BodyHandler,
+	haveTime func() time.Duration,
+) error {
+	tracedBlockProcessor, ok := blockProcessor.(process.TracedBlockProcessor)
+	if !ok {
+		return blockProcessor.ProcessBlock(header, body, haveTime)
+	}
+
+	return tracedBlockProcessor.ProcessBlockWithContext(ctx, header, body, haveTime)
+}
+
+func commitBlockWithContext(
+	ctx context.Context,
+	blockProcessor process.BlockProcessor,
+	header data.HeaderHandler,
+	body data.BodyHandler,
+) error {
+	tracedBlockProcessor, ok := blockProcessor.(process.TracedBlockProcessor)
+	if !ok {
+		return blockProcessor.CommitBlock(header, body)
+	}
+
+	return tracedBlockProcessor.CommitBlockWithContext(ctx, header, body)
+}
@@ -0,0 +1,73 @@
+package bls
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+type contextKey struct{}
+
+type tracedBlockProcessorStub struct {
+	*mock.BlockProcessorMock
+	receivedContexts []context.Context
+}
+
+func (tbps *tracedBlockProcessorStub) CreateBlockWithContext(ctx context.Context, _ data.HeaderHandler, _ func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+	tbps.receivedContexts = append(tbps.receivedContexts, ctx)
+	return nil, nil, nil
+}
+
+func (tbps *tracedBlockProcessorStub) ProcessBlockWithContext(ctx context.Context, _ data.HeaderHandler, _ data.BodyHandler, _ func() time.Duration) error {
+	tbps.receivedContexts = append(tbps.receivedContexts, ctx)
+	return nil
+}
+
+func (tbps *tracedBlockProcessorStub) CommitBlockWithContext(ctx context.Context, _ data.HeaderHandler, _ data.BodyHandler) error {
+	tbps.receivedContexts = append(tbps.receivedContexts, ctx)
+	return nil
+}
+
+func TestBlockProcessing_TracedBlockProcessorShouldReceiveTheContext(t *testing.T) {
+	t.Parallel()
+
+	bp := &tracedBlockProcessorStub{BlockProcessorMock: &mock.BlockProcessorMock{}}
+	ctx := context.WithValue(context.Background(), contextKey{}, "subround")
+
+	_, _, _ = createBlockWithContext(ctx, bp, &block.Header{}, func() bool { return true })
+	_ = processBlockWithContext(ctx, bp, &block.Header{}, &block.Body{}, func() time.Duration { return time.Second })
+	_ = commitBlockWithContext(ctx, bp, &block.Header{}, &block.Body{})
+
+	assert.Equal(t, []context.Context{ctx, ctx, ctx}, bp.receivedContexts)
+}
+
+func TestBlockProcessing_NotTracedBlockProcessorShouldBeCalled(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	bp := &mock.BlockProcessorMock{
+		CreateBlockCalled: func(_ data.HeaderHandler, _ func() bool) (data.HeaderHandler, data.BodyHandler, error) {
+			numCalls++
+			return nil, nil, nil
+		},
+		ProcessBlockCalled: func(_ data.HeaderHandler, _ data.BodyHandler, _ func() time.Duration) error {
+			numCalls++
+			return nil
+		},
+		CommitBlockCalled: func(_ data.HeaderHandler, _ data.BodyHandler) error {
+			numCalls++
+			return nil
+		},
+	}
+
+	_, _, _ = createBlockWithContext(context.Background(), bp, &block.Header{}, func() bool { return true })
+	_ = processBlockWithContext(context.Background(), bp, &block.Header{}, &block.Body{}, func() time.Duration { return time.Second })
+	_ = commitBlockWithContext(context.Background(), bp, &block.Header{}, &block.Body{})
+
+	assert.Equal(t, 3, numCalls)
+}
@@ -187,7 +187,7 @@ func (sr *subroundBlock) CreateHeader() (data.HeaderHandler, error) {
 
 // CreateBody method creates the proposed block body in the subround Block
 func (sr *subroundBlock) CreateBlock(hdr data.HeaderHandler) (data.HeaderHandler, data.BodyHandler, error) {
-	return sr.createBlock(hdr)
+	return sr.createBlock(context.Background(), hdr)
 }
 
 // SendBlockBody method sends the proposed block body in the subround Block
@@ -266,7 +266,7 @@ func (sr *subroundEndRound) CheckSignaturesValidity(bitmap []byte) error {
 }
 
 func (sr *subroundEndRound) DoEndRoundJobByParticipant(cnsDta *consensus.Message) bool {
-	return sr.doEndRoundJobByParticipant(cnsDta)
+	return sr.doEndRoundJobByParticipant(context.Background(), cnsDta)
 }
 
 func (sr *subroundEndRound) HaveConsensusHeaderWithFullInfo(cnsDta *consensus.Message) (bool, data.HeaderHandler) {
@@ -88,7 +88,7 @@ func (sr *subroundBlock) doBlockJob(ctx context.Context) bool {
 		return false
 	}
 
-	header, body, err := sr.createBlock(header)
+	header, body, err := sr.createBlock(ctx, header)
 	if err != nil {
 		printLogMessage(ctx, "doBlockJob.createBlock", err)
 		return false
@@ -99,6 +99,8 @@ func (sr *subroundBlock) doBlockJob(ctx context.Context) bool {
 		return false
 	}
 
+	sr.RoundTimeline().BlockProposed(sr.RoundHandler().Index(), sr.Data)
+
 	err = sr.SetSelfJobDone(sr.Current(), true)
 	if err != nil {
 		log.Debug("doBlockJob.SetSelfJobDone", "error", err.Error())
@@ -155,14 +157,16 @@ func (sr *subroundBlock) couldBeSentTogether(marshalizedBody []byte, marshalized
 	return bodyAndHeaderSize <= maxAllowedSizeInBytes
 }
 
-func (sr *subroundBlock) createBlock(header data.HeaderHandler) (data.HeaderHandler, data.BodyHandler, error) {
+func (sr *subroundBlock) createBlock(ctx context.Context, header data.HeaderHandler) (data.HeaderHandler, data.BodyHandler, error) {
 	startTime := sr.RoundTimeStamp
 	maxTime := time.Duration(sr.EndTime())
 	haveTimeInCurrentSubround := func() bool {
 		return sr.RoundHandler().RemainingTime(startTime, maxTime) > 0
 	}
 
-	finalHeader, blockBody, err := sr.BlockProcessor().CreateBlock(
+	finalHeader, blockBody, err := createBlockWithContext(
+		ctx,
+		sr.BlockProcessor(),
 		header,
 		haveTimeInCurrentSubround,
 	)
@@ -531,7 +535,9 @@ func (sr *subroundBlock) processReceivedBlock(ctx context.Context, cnsDta *conse
 	metricStatTime := time.Now()
 	defer sr.computeSubroundProcessingMetric(metricStatTime, common.MetricProcessedProposedBlock)
 
-	err := sr.BlockProcessor().ProcessBlock(
+	err := processBlockWithContext(
+		ctx,
+		sr.BlockProcessor(),
 		sr.Header,
 		sr.Body,
 		remainingTimeInCurrentRound,
@@ -553,6 +559,8 @@ func (sr *subroundBlock) processReceivedBlock(ctx context.Context, cnsDta *conse
 		return false
 	}
 
+	sr.RoundTimeline().BlockProcessed(cnsDta.RoundIndex)
+
 	err = sr.SetJobDone(node, sr.Current(), true)
 	if err != nil {
 		sr.printCancelRoundLogMessage(ctx, err)
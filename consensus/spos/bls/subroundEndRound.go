@@ -69,7 +69,7 @@ func checkNewSubroundEndRoundParams(
 }
 
 // receivedBlockHeaderFinalInfo method is called when a block header final info is received
-func (sr *subroundEndRound) receivedBlockHeaderFinalInfo(_ context.Context, cnsDta *consensus.Message) bool {
+func (sr *subroundEndRound) receivedBlockHeaderFinalInfo(ctx context.Context, cnsDta *consensus.Message) bool {
 	node := string(cnsDta.PubKey)
 
 	if !sr.IsConsensusDataSet() {
@@ -113,7 +113,7 @@ func (sr *subroundEndRound) receivedBlockHeaderFinalInfo(_ context.Context, cnsD
 		spos.LeaderPeerHonestyIncreaseFactor,
 	)
 
-	return sr.doEndRoundJobByParticipant(cnsDta)
+	return sr.doEndRoundJobByParticipant(ctx, cnsDta)
 }
 
 func (sr *subroundEndRound) isBlockHeaderFinalInfoValid(cnsDta *consensus.Message) bool {
@@ -162,11 +162,11 @@ func (sr *subroundEndRound) receivedHeader(headerHandler data.HeaderHandler) {
 
 	sr.AddReceivedHeader(headerHandler)
 
-	sr.doEndRoundJobByParticipant(nil)
+	sr.doEndRoundJobByParticipant(context.Background(), nil)
 }
 
 // doEndRoundJob method does the job of the subround EndRound
-func (sr *subroundEndRound) doEndRoundJob(_ context.Context) bool {
+func (sr *subroundEndRound) doEndRoundJob(ctx context.Context) bool {
 	if !sr.IsSelfLeaderInCurrentRound() {
 		if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
 			err := sr.prepareBroadcastBlockDataForValidator()
@@ -176,13 +176,13 @@ func (sr *subroundEndRound) doEndRoundJob(_ context.Context) bool {
 			}
 		}
 
-		return sr.doEndRoundJobByParticipant(nil)
+		return sr.doEndRoundJobByParticipant(ctx, nil)
 	}
 
-	return sr.doEndRoundJobByLeader()
+	return sr.doEndRoundJobByLeader(ctx)
 }
 
-func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
+func (sr *subroundEndRound) doEndRoundJobByLeader(ctx context.Context) bool {
 	bitmap := sr.GenerateBitmap(SrSignature)
 	err := sr.checkSignaturesValidity(bitmap)
 	if err != nil {
@@ -248,7 +248,7 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	}
 
 	startTime := time.Now()
-	err = sr.BlockProcessor().CommitBlock(sr.Header, sr.Body)
+	err = commitBlockWithContext(ctx, sr.BlockProcessor(), sr.Header, sr.Body)
 	elapsedTime := time.Since(startTime)
 	if elapsedTime >= common.CommitMaxTime {
 		log.Warn("doEndRoundJobByLeader.CommitBlock", "elapsed time", elapsedTime)
@@ -262,6 +262,8 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 		return false
 	}
 
+	sr.RoundTimeline().BlockCommitted(int64(sr.Header.GetRound()))
+
 	sr.SetStatus(sr.Current(), spos.SsFinished)
 
 	sr.displayStatistics()
@@ -304,13 +306,15 @@ func (sr *subroundEndRound) createAndBroadcastHeaderFinalInfo() {
 		return
 	}
 
+	sr.RoundTimeline().FinalInfoBroadcast(sr.RoundHandler().Index())
+
 	log.Debug("step 3: block header final info has been sent",
 		"PubKeysBitmap", sr.Header.GetPubKeysBitmap(),
 		"AggregateSignature", sr.Header.GetSignature(),
 		"LeaderSignature", sr.Header.GetLeaderSignature())
 }
 
-func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message) bool {
+func (sr *subroundEndRound) doEndRoundJobByParticipant(ctx context.Context, cnsDta *consensus.Message) bool {
 	sr.mutProcessingEndRound.Lock()
 	defer sr.mutProcessingEndRound.Unlock()
 
@@ -359,7 +363,7 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 	}
 
 	startTime := time.Now()
-	err := sr.BlockProcessor().CommitBlock(header, sr.Body)
+	err := commitBlockWithContext(ctx, sr.BlockProcessor(), header, sr.Body)
 	elapsedTime := time.Since(startTime)
 	if elapsedTime >= common.CommitMaxTime {
 		log.Warn("doEndRoundJobByParticipant.CommitBlock", "elapsed time", elapsedTime)
@@ -373,6 +377,8 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 		return false
 	}
 
+	sr.RoundTimeline().BlockCommitted(int64(header.GetRound()))
+
 	sr.SetStatus(sr.Current(), spos.SsFinished)
 
 	if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
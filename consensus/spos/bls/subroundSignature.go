@@ -112,6 +112,8 @@ func (sr *subroundSignature) doSignatureJob(_ context.Context) bool {
 		return false
 	}
 
+	sr.RoundTimeline().SignatureSent(sr.RoundHandler().Index())
+
 	if isSelfLeader {
 		go sr.waitAllSignatures()
 	}
@@ -224,6 +226,9 @@ func (sr *subroundSignature) doSignatureConsensusCheck() bool {
 
 	areSignaturesCollected, numSigs := sr.areSignaturesCollected(threshold)
 	areAllSignaturesCollected := numSigs == sr.ConsensusGroupSize()
+	if isSelfLeader && areSignaturesCollected {
+		sr.RoundTimeline().SignaturesThresholdReached(sr.RoundHandler().Index())
+	}
 
 	isJobDoneByLeader := isSelfLeader && (areAllSignaturesCollected || (areSignaturesCollected && sr.WaitingAllSignaturesTimeOut))
 	isJobDoneByConsensusNode := !isSelfLeader && isSelfInConsensusGroup && sr.IsSelfJobDone(sr.Current())
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/timeline"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/outport/disabled"
 )
@@ -181,6 +182,14 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 		sr.AppStatusHandler().SetStringValue(common.MetricConsensusState, "participant")
 	}
 
+	sr.RoundTimeline().StartRound(
+		sr.RoundHandler().Index(),
+		leader,
+		len(pubKeys),
+		sr.Threshold(SrSignature),
+		getSelfRole(leader == sr.SelfPubKey(), err == nil),
+	)
+
 	err = sr.MultiSigner().Reset(pubKeys, uint16(selfIndex))
 	if err != nil {
 		log.Debug("initCurrentRound.Reset", "error", err.Error())
@@ -210,6 +219,17 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 	return true
 }
 
+func getSelfRole(isSelfLeader bool, isSelfInConsensusGroup bool) string {
+	if isSelfLeader {
+		return timeline.RoleLeader
+	}
+	if isSelfInConsensusGroup {
+		return timeline.RoleValidator
+	}
+
+	return timeline.RoleNotInConsensusGroup
+}
+
 func (sr *subroundStartRound) indexRoundIfNeeded(pubKeys []string) {
 	sr.outportMutex.RLock()
 	defer sr.outportMutex.RUnlock()
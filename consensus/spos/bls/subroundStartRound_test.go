@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/bls"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	consensusMocks "github.com/ElrondNetwork/elrond-go/testscommon/consensus"
 	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
@@ -439,6 +440,36 @@ func TestSubroundStartRound_InitCurrentRoundShouldReturnTrue(t *testing.T) {
 	assert.True(t, r)
 }
 
+func TestSubroundStartRound_InitCurrentRoundShouldStartRoundTimeline(t *testing.T) {
+	t.Parallel()
+
+	bootstrapperMock := &mock.BootstrapperStub{}
+	bootstrapperMock.GetNodeStateCalled = func() common.NodeState {
+		return common.NsSynchronized
+	}
+
+	startRoundCalled := false
+	roundTimeline := &consensusMocks.RoundTimelineHandlerStub{
+		StartRoundCalled: func(round int64, leader string, consensusGroupSize int, signaturesThreshold int, selfRole string) {
+			startRoundCalled = true
+			assert.NotEmpty(t, leader)
+			assert.True(t, consensusGroupSize > 0)
+			assert.True(t, signaturesThreshold > 0)
+			assert.NotEmpty(t, selfRole)
+		},
+	}
+
+	container := mock.InitConsensusCore()
+	container.SetBootStrapper(bootstrapperMock)
+	container.SetRoundTimeline(roundTimeline)
+
+	srStartRound := *initSubroundStartRoundWithContainer(container)
+
+	r := srStartRound.InitCurrentRound()
+	assert.True(t, r)
+	assert.True(t, startRoundCalled)
+}
+
 func TestSubroundStartRound_GenerateNextConsensusGroupShouldReturnErr(t *testing.T) {
 	t.Parallel()
 
@@ -64,6 +64,9 @@ func ValidateConsensusCore(container ConsensusCoreHandler) error {
 	if check.IfNil(container.NodeRedundancyHandler()) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(container.RoundTimeline()) {
+		return ErrNilRoundTimelineHandler
+	}
 
 	return nil
 }
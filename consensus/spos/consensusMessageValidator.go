@@ -145,7 +145,20 @@ func (cmv *consensusMessageValidator) checkConsensusMessageValidity(cnsMsg *cons
 			logger.DisplayByteSlice(cnsMsg.PubKey))
 	}
 
-	err = cmv.peerSignatureHandler.VerifyPeerSignature(cnsMsg.PubKey, core.PeerID(cnsMsg.OriginatorPid), cnsMsg.Signature)
+	err = cmv.checkConsensusMessageOrigin(cnsMsg, originator)
+	if err != nil {
+		return err
+	}
+
+	cmv.addMessageTypeToPublicKey(cnsMsg.PubKey, cnsMsg.RoundIndex, msgType)
+
+	return nil
+}
+
+// checkConsensusMessageOrigin verifies that the message was signed by the owner of the public key and that it was
+// published by the peer bound to that key
+func (cmv *consensusMessageValidator) checkConsensusMessageOrigin(cnsMsg *consensus.Message, originator core.PeerID) error {
+	err := cmv.peerSignatureHandler.VerifyPeerSignature(cnsMsg.PubKey, core.PeerID(cnsMsg.OriginatorPid), cnsMsg.Signature)
 	if err != nil {
 		return fmt.Errorf("%w : verify signature for received message from consensus topic failed: %s",
 			ErrInvalidSignature,
@@ -158,8 +171,6 @@ func (cmv *consensusMessageValidator) checkConsensusMessageValidity(cnsMsg *cons
 			ErrOriginatorMismatch, p2p.PeerIdToShortString(originator), p2p.PeerIdToShortString(cnsMsgOriginator))
 	}
 
-	cmv.addMessageTypeToPublicKey(cnsMsg.PubKey, cnsMsg.RoundIndex, msgType)
-
 	return nil
 }
 
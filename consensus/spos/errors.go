@@ -219,3 +219,9 @@ var ErrNilNodeRedundancyHandler = errors.New("nil node redundancy handler")
 
 // ErrNilScheduledProcessor signals that the provided scheduled processor is nil
 var ErrNilScheduledProcessor = errors.New("nil scheduled processor")
+
+// ErrNilRoundTimelineHandler signals that a nil round timeline handler has been provided
+var ErrNilRoundTimelineHandler = errors.New("nil round timeline handler")
+
+// ErrNilSlashingDetector signals that a nil slashing detector has been provided
+var ErrNilSlashingDetector = errors.New("nil slashing detector")
@@ -0,0 +1,33 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+type slashingDetector struct {
+}
+
+// NewSlashingDetector returns a disabled slashing detector implementation
+func NewSlashingDetector() *slashingDetector {
+	return &slashingDetector{}
+}
+
+// CheckProposal does nothing
+func (sd *slashingDetector) CheckProposal(_ *consensus.Message, _ p2p.MessageP2P) {
+}
+
+// CheckSignature does nothing
+func (sd *slashingDetector) CheckSignature(_ *consensus.Message, _ p2p.MessageP2P) {
+}
+
+// GetEvidences returns an empty slice
+func (sd *slashingDetector) GetEvidences() []*common.SlashingEvidence {
+	return make([]*common.SlashingEvidence, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sd *slashingDetector) IsInterfaceNil() bool {
+	return sd == nil
+}
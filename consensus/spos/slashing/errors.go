@@ -0,0 +1,33 @@
+package slashing
+
+import "errors"
+
+// ErrInvalidNumRoundsToKeep signals that an invalid number of rounds to keep was provided
+var ErrInvalidNumRoundsToKeep = errors.New("invalid number of rounds to keep")
+
+// ErrInvalidMaxNumEvidences signals that an invalid maximum number of evidences was provided
+var ErrInvalidMaxNumEvidences = errors.New("invalid maximum number of evidences")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator was provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilOutportHandler signals that a nil outport handler was provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
+
+// ErrNilAppStatusHandler signals that a nil app status handler was provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrNilEvidence signals that a nil evidence was provided
+var ErrNilEvidence = errors.New("nil evidence")
+
+// ErrInvalidNumProofs signals that the evidence does not hold exactly two proofs
+var ErrInvalidNumProofs = errors.New("invalid number of proofs")
@@ -0,0 +1,93 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. slashingEvidence.proto
+
+package slashing
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+func newEvidenceRecord(evidence *common.SlashingEvidence) (*EvidenceRecord, error) {
+	pubKey, err := hex.DecodeString(evidence.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &EvidenceRecord{
+		Type:       evidence.Type,
+		PubKey:     pubKey,
+		ShardID:    evidence.ShardID,
+		Round:      evidence.Round,
+		DetectedAt: evidence.DetectedAt,
+		Proofs:     make([]*ProofRecord, 0, len(evidence.Proofs)),
+		TxData:     evidence.TxData,
+	}
+	for _, proof := range evidence.Proofs {
+		proofRecord, errProof := newProofRecord(proof)
+		if errProof != nil {
+			return nil, errProof
+		}
+
+		record.Proofs = append(record.Proofs, proofRecord)
+	}
+
+	return record, nil
+}
+
+func newProofRecord(proof *common.SlashingEvidenceProof) (*ProofRecord, error) {
+	hexFields := []string{
+		proof.HeaderHash,
+		proof.ConsensusMessage,
+		proof.Payload,
+		proof.From,
+		proof.SeqNo,
+		proof.Signature,
+		proof.Key,
+	}
+	decoded := make([][]byte, len(hexFields))
+	for i, field := range hexFields {
+		var err error
+		decoded[i], err = hex.DecodeString(field)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &ProofRecord{
+		HeaderHash:       decoded[0],
+		ConsensusMessage: decoded[1],
+		Payload:          decoded[2],
+		From:             decoded[3],
+		SeqNo:            decoded[4],
+		Topic:            proof.Topic,
+		Signature:        decoded[5],
+		Key:              decoded[6],
+	}, nil
+}
+
+func (er *EvidenceRecord) toSlashingEvidence() *common.SlashingEvidence {
+	evidence := &common.SlashingEvidence{
+		Type:       er.Type,
+		PubKey:     hex.EncodeToString(er.PubKey),
+		ShardID:    er.ShardID,
+		Round:      er.Round,
+		DetectedAt: er.DetectedAt,
+		Proofs:     make([]*common.SlashingEvidenceProof, 0, len(er.Proofs)),
+		TxData:     er.TxData,
+	}
+	for _, proof := range er.Proofs {
+		evidence.Proofs = append(evidence.Proofs, &common.SlashingEvidenceProof{
+			HeaderHash:       hex.EncodeToString(proof.HeaderHash),
+			ConsensusMessage: hex.EncodeToString(proof.ConsensusMessage),
+			Payload:          hex.EncodeToString(proof.Payload),
+			From:             hex.EncodeToString(proof.From),
+			SeqNo:            hex.EncodeToString(proof.SeqNo),
+			Topic:            proof.Topic,
+			Signature:        hex.EncodeToString(proof.Signature),
+			Key:              hex.EncodeToString(proof.Key),
+		})
+	}
+
+	return evidence
+}
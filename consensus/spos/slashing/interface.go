@@ -0,0 +1,10 @@
+package slashing
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// OutportHandler defines the outport behavior needed to push the slashing evidences
+type OutportHandler interface {
+	SaveSlashingEvidence(evidence *common.SlashingEvidence)
+	HasDrivers() bool
+	IsInterfaceNil() bool
+}
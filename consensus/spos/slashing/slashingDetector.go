@@ -0,0 +1,358 @@
+package slashing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ consensus.SlashingDetector = (*slashingDetector)(nil)
+
+var log = logger.GetOrCreate("consensus/spos/slashing")
+
+const (
+	// DoubleSigningEvidence is the type of the evidence collected when a validator signs two different headers in
+	// the same round
+	DoubleSigningEvidence = "doubleSigning"
+	// EquivocatingProposerEvidence is the type of the evidence collected when a leader proposes two different blocks
+	// in the same round
+	EquivocatingProposerEvidence = "equivocatingProposer"
+)
+
+// ArgsSlashingDetector is the DTO used to create a new slashing detector
+type ArgsSlashingDetector struct {
+	NumRoundsToKeep  int
+	MaxNumEvidences  int
+	ShardCoordinator sharding.Coordinator
+	Storer           storage.Storer
+	Marshalizer      marshal.Marshalizer
+	Hasher           hashing.Hasher
+	OutportHandler   OutportHandler
+	AppStatusHandler core.AppStatusHandler
+}
+
+// signedMessage holds the first consensus message of a kind received from a validator in a round. The raw fields are
+// only encoded when the message becomes part of an evidence
+type signedMessage struct {
+	headerHash       []byte
+	consensusMessage []byte
+	payload          []byte
+	from             []byte
+	seqNo            []byte
+	topic            string
+	signature        []byte
+	key              []byte
+}
+
+type storedEvidence struct {
+	key      []byte
+	evidence *common.SlashingEvidence
+}
+
+type slashingDetector struct {
+	mutDetector      sync.RWMutex
+	signedMessages   map[int64]map[string]*signedMessage
+	highestRound     int64
+	evidences        []*storedEvidence
+	evidencesKeys    map[string]struct{}
+	numRoundsToKeep  int64
+	maxNumEvidences  int
+	shardID          uint32
+	storer           storage.Storer
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	outportHandler   OutportHandler
+	appStatusHandler core.AppStatusHandler
+	getTimeHandler   func() time.Time
+}
+
+// NewSlashingDetector creates a component that compares the consensus messages received in the last rounds and
+// collects the evidence of the validators that sign conflicting headers or propose conflicting blocks
+func NewSlashingDetector(args ArgsSlashingDetector) (*slashingDetector, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	sd := &slashingDetector{
+		signedMessages:   make(map[int64]map[string]*signedMessage),
+		evidences:        make([]*storedEvidence, 0),
+		evidencesKeys:    make(map[string]struct{}),
+		numRoundsToKeep:  int64(args.NumRoundsToKeep),
+		maxNumEvidences:  args.MaxNumEvidences,
+		shardID:          args.ShardCoordinator.SelfId(),
+		storer:           args.Storer,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		outportHandler:   args.OutportHandler,
+		appStatusHandler: args.AppStatusHandler,
+		getTimeHandler:   time.Now,
+	}
+
+	sd.loadPersistedEvidences()
+
+	return sd, nil
+}
+
+func checkArgs(args ArgsSlashingDetector) error {
+	if args.NumRoundsToKeep < 1 {
+		return fmt.Errorf("%w: %d", ErrInvalidNumRoundsToKeep, args.NumRoundsToKeep)
+	}
+	if args.MaxNumEvidences < 1 {
+		return fmt.Errorf("%w: %d", ErrInvalidMaxNumEvidences, args.MaxNumEvidences)
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return ErrNilShardCoordinator
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+	if check.IfNil(args.OutportHandler) {
+		return ErrNilOutportHandler
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return ErrNilAppStatusHandler
+	}
+
+	return nil
+}
+
+func (sd *slashingDetector) loadPersistedEvidences() {
+	sd.storer.RangeKeys(func(key []byte, val []byte) bool {
+		record := &EvidenceRecord{}
+		err := sd.marshalizer.Unmarshal(record, val)
+		if err != nil {
+			log.Warn("slashingDetector: can not unmarshal a persisted evidence", "error", err)
+			return true
+		}
+		evidence := record.toSlashingEvidence()
+
+		keyCopy := make([]byte, len(key))
+		copy(keyCopy, key)
+		sd.evidences = append(sd.evidences, &storedEvidence{
+			key:      keyCopy,
+			evidence: evidence,
+		})
+		sd.evidencesKeys[string(keyCopy)] = struct{}{}
+
+		return true
+	})
+
+	sort.SliceStable(sd.evidences, func(i, j int) bool {
+		return sd.evidences[i].evidence.DetectedAt < sd.evidences[j].evidence.DetectedAt
+	})
+	sd.removeOldestEvidencesIfNeeded()
+
+	log.Debug("slashingDetector: loaded the persisted evidences", "num evidences", len(sd.evidences))
+	sd.appStatusHandler.SetUInt64Value(common.MetricNumSlashingEvidences, uint64(len(sd.evidences)))
+}
+
+// CheckProposal compares the block proposed in the consensus message with the other blocks proposed by the same
+// leader in the same round
+func (sd *slashingDetector) CheckProposal(cnsMsg *consensus.Message, p2pMessage p2p.MessageP2P) {
+	sd.checkMessage(EquivocatingProposerEvidence, cnsMsg, p2pMessage)
+}
+
+// CheckSignature compares the header signed in the consensus message with the other headers signed by the same
+// validator in the same round
+func (sd *slashingDetector) CheckSignature(cnsMsg *consensus.Message, p2pMessage p2p.MessageP2P) {
+	sd.checkMessage(DoubleSigningEvidence, cnsMsg, p2pMessage)
+}
+
+func (sd *slashingDetector) checkMessage(evidenceType string, cnsMsg *consensus.Message, p2pMessage p2p.MessageP2P) {
+	if cnsMsg == nil || check.IfNil(p2pMessage) {
+		return
+	}
+
+	sd.mutDetector.Lock()
+	defer sd.mutDetector.Unlock()
+
+	round := cnsMsg.RoundIndex
+	sd.removeOldRounds(round)
+	if round <= sd.highestRound-sd.numRoundsToKeep {
+		return
+	}
+
+	roundMessages, ok := sd.signedMessages[round]
+	if !ok {
+		roundMessages = make(map[string]*signedMessage)
+		sd.signedMessages[round] = roundMessages
+	}
+
+	messageKey := evidenceType + string(cnsMsg.PubKey)
+	firstMessage, ok := roundMessages[messageKey]
+	if !ok {
+		roundMessages[messageKey] = newSignedMessage(cnsMsg, p2pMessage)
+		return
+	}
+	if bytes.Equal(firstMessage.headerHash, cnsMsg.BlockHeaderHash) {
+		return
+	}
+
+	sd.addEvidence(evidenceType, cnsMsg, firstMessage, newSignedMessage(cnsMsg, p2pMessage))
+}
+
+func newSignedMessage(cnsMsg *consensus.Message, p2pMessage p2p.MessageP2P) *signedMessage {
+	return &signedMessage{
+		headerHash:       cnsMsg.BlockHeaderHash,
+		consensusMessage: p2pMessage.Data(),
+		payload:          p2pMessage.Payload(),
+		from:             p2pMessage.From(),
+		seqNo:            p2pMessage.SeqNo(),
+		topic:            p2pMessage.Topic(),
+		signature:        p2pMessage.Signature(),
+		key:              p2pMessage.Key(),
+	}
+}
+
+func (sd *slashingDetector) removeOldRounds(round int64) {
+	if round <= sd.highestRound {
+		return
+	}
+
+	sd.highestRound = round
+	for storedRound := range sd.signedMessages {
+		if storedRound <= sd.highestRound-sd.numRoundsToKeep {
+			delete(sd.signedMessages, storedRound)
+		}
+	}
+}
+
+func (sd *slashingDetector) addEvidence(
+	evidenceType string,
+	cnsMsg *consensus.Message,
+	firstMessage *signedMessage,
+	secondMessage *signedMessage,
+) {
+	key := sd.hasher.Compute(fmt.Sprintf("%s_%s_%d", evidenceType, string(cnsMsg.PubKey), cnsMsg.RoundIndex))
+	_, alreadyCollected := sd.evidencesKeys[string(key)]
+	if alreadyCollected {
+		return
+	}
+
+	evidence := &common.SlashingEvidence{
+		Type:       evidenceType,
+		PubKey:     hex.EncodeToString(cnsMsg.PubKey),
+		ShardID:    sd.shardID,
+		Round:      cnsMsg.RoundIndex,
+		DetectedAt: sd.getTimeHandler().Unix(),
+		Proofs: []*common.SlashingEvidenceProof{
+			firstMessage.toProof(),
+			secondMessage.toProof(),
+		},
+	}
+
+	var err error
+	evidence.TxData, err = CreateTxData(evidence)
+	if err != nil {
+		log.Warn("slashingDetector: can not create the transaction data of the evidence", "error", err)
+	}
+
+	log.Warn("slashing evidence collected",
+		"type", evidenceType,
+		"public key", cnsMsg.PubKey,
+		"round", cnsMsg.RoundIndex,
+		"first header hash", firstMessage.headerHash,
+		"second header hash", secondMessage.headerHash,
+	)
+
+	sd.persistEvidence(key, evidence)
+	sd.evidences = append(sd.evidences, &storedEvidence{
+		key:      key,
+		evidence: evidence,
+	})
+	sd.evidencesKeys[string(key)] = struct{}{}
+	sd.removeOldestEvidencesIfNeeded()
+	sd.appStatusHandler.SetUInt64Value(common.MetricNumSlashingEvidences, uint64(len(sd.evidences)))
+
+	if sd.outportHandler.HasDrivers() {
+		// the outport retries until its drivers accept the data, so the consensus messages processing should not wait
+		go sd.outportHandler.SaveSlashingEvidence(evidence)
+	}
+}
+
+func (sm *signedMessage) toProof() *common.SlashingEvidenceProof {
+	return &common.SlashingEvidenceProof{
+		HeaderHash:       hex.EncodeToString(sm.headerHash),
+		ConsensusMessage: hex.EncodeToString(sm.consensusMessage),
+		Payload:          hex.EncodeToString(sm.payload),
+		From:             hex.EncodeToString(sm.from),
+		SeqNo:            hex.EncodeToString(sm.seqNo),
+		Topic:            sm.topic,
+		Signature:        hex.EncodeToString(sm.signature),
+		Key:              hex.EncodeToString(sm.key),
+	}
+}
+
+func (sd *slashingDetector) persistEvidence(key []byte, evidence *common.SlashingEvidence) {
+	record, err := newEvidenceRecord(evidence)
+	if err != nil {
+		log.Warn("slashingDetector: can not create the evidence record", "error", err)
+		return
+	}
+
+	buff, err := sd.marshalizer.Marshal(record)
+	if err != nil {
+		log.Warn("slashingDetector: can not marshal the evidence", "error", err)
+		return
+	}
+
+	err = sd.storer.Put(key, buff)
+	if err != nil {
+		log.Warn("slashingDetector: can not persist the evidence", "error", err)
+	}
+}
+
+func (sd *slashingDetector) removeOldestEvidencesIfNeeded() {
+	numEvidencesToRemove := len(sd.evidences) - sd.maxNumEvidences
+	if numEvidencesToRemove <= 0 {
+		return
+	}
+
+	for _, se := range sd.evidences[:numEvidencesToRemove] {
+		delete(sd.evidencesKeys, string(se.key))
+		err := sd.storer.Remove(se.key)
+		if err != nil {
+			log.Debug("slashingDetector: can not remove an old evidence", "error", err)
+		}
+	}
+	sd.evidences = sd.evidences[numEvidencesToRemove:]
+}
+
+// GetEvidences returns the collected evidences, the oldest first
+func (sd *slashingDetector) GetEvidences() []*common.SlashingEvidence {
+	sd.mutDetector.RLock()
+	defer sd.mutDetector.RUnlock()
+
+	evidences := make([]*common.SlashingEvidence, 0, len(sd.evidences))
+	for _, se := range sd.evidences {
+		evidences = append(evidences, se.evidence)
+	}
+
+	return evidences
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sd *slashingDetector) IsInterfaceNil() bool {
+	return sd == nil
+}
@@ -0,0 +1,270 @@
+package slashing
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSlashingDetector() ArgsSlashingDetector {
+	return ArgsSlashingDetector{
+		NumRoundsToKeep:  2,
+		MaxNumEvidences:  10,
+		ShardCoordinator: &testscommon.ShardsCoordinatorMock{CurrentShard: 1},
+		Storer:           genericMocks.NewStorerMock("SlashingEvidence", 0),
+		Marshalizer:      &marshal.GogoProtoMarshalizer{},
+		Hasher:           &hashingMocks.HasherMock{},
+		OutportHandler:   &testscommon.OutportStub{},
+		AppStatusHandler: statusHandler.NewAppStatusHandlerMock(),
+	}
+}
+
+func createConsensusMessage(pubKey string, round int64, headerHash string) (*consensus.Message, *mock.P2PMessageMock) {
+	cnsMsg := &consensus.Message{
+		BlockHeaderHash: []byte(headerHash),
+		PubKey:          []byte(pubKey),
+		RoundIndex:      round,
+	}
+	p2pMessage := &mock.P2PMessageMock{
+		DataField:      []byte("data " + headerHash),
+		PayloadField:   []byte("payload " + headerHash),
+		FromField:      []byte("from"),
+		SeqNoField:     []byte("seqNo " + headerHash),
+		TopicField:     "consensus_1",
+		SignatureField: []byte("signature " + headerHash),
+		KeyField:       []byte("key"),
+	}
+
+	return cnsMsg, p2pMessage
+}
+
+func TestNewSlashingDetector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of rounds to keep should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSlashingDetector()
+		args.NumRoundsToKeep = 0
+
+		sd, err := NewSlashingDetector(args)
+		assert.True(t, errors.Is(err, ErrInvalidNumRoundsToKeep))
+		assert.True(t, check.IfNil(sd))
+	})
+	t.Run("invalid maximum number of evidences should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSlashingDetector()
+		args.MaxNumEvidences = 0
+
+		sd, err := NewSlashingDetector(args)
+		assert.True(t, errors.Is(err, ErrInvalidMaxNumEvidences))
+		assert.True(t, check.IfNil(sd))
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSlashingDetector()
+		args.ShardCoordinator = nil
+
+		sd, err := NewSlashingDetector(args)
+		assert.Equal(t, ErrNilShardCoordinator, err)
+		assert.True(t, check.IfNil(sd))
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSlashingDetector()
+		args.Storer = nil
+
+		sd, err := NewSlashingDetector(args)
+		assert.Equal(t, ErrNilStorer, err)
+		assert.True(t, check.IfNil(sd))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSlashingDetector()
+		args.Marshalizer = nil
+
+		sd, err := NewSlashingDetector(args)
+		assert.Equal(t, ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(sd))
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSlashingDetector()
+		args.Hasher = nil
+
+		sd, err := NewSlashingDetector(args)
+		assert.Equal(t, ErrNilHasher, err)
+		assert.True(t, check.IfNil(sd))
+	})
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSlashingDetector()
+		args.OutportHandler = nil
+
+		sd, err := NewSlashingDetector(args)
+		assert.Equal(t, ErrNilOutportHandler, err)
+		assert.True(t, check.IfNil(sd))
+	})
+	t.Run("nil app status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSlashingDetector()
+		args.AppStatusHandler = nil
+
+		sd, err := NewSlashingDetector(args)
+		assert.Equal(t, ErrNilAppStatusHandler, err)
+		assert.True(t, check.IfNil(sd))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sd, err := NewSlashingDetector(createMockArgsSlashingDetector())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(sd))
+		assert.Equal(t, 0, len(sd.GetEvidences()))
+	})
+}
+
+func TestSlashingDetector_CheckSignatureShouldCollectTheDoubleSigning(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingDetector()
+	appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+	args.AppStatusHandler = appStatusHandler
+	sd, _ := NewSlashingDetector(args)
+	sd.getTimeHandler = func() time.Time {
+		return time.Unix(1000, 0)
+	}
+
+	firstMsg, firstP2PMsg := createConsensusMessage("validator", 5, "hash1")
+	sd.CheckSignature(firstMsg, firstP2PMsg)
+	sd.CheckSignature(firstMsg, firstP2PMsg)
+	otherValidatorMsg, otherValidatorP2PMsg := createConsensusMessage("other validator", 5, "hash2")
+	sd.CheckSignature(otherValidatorMsg, otherValidatorP2PMsg)
+	assert.Equal(t, 0, len(sd.GetEvidences()), "the same header signed twice or different validators are not an evidence")
+
+	secondMsg, secondP2PMsg := createConsensusMessage("validator", 5, "hash2")
+	sd.CheckSignature(secondMsg, secondP2PMsg)
+	thirdMsg, thirdP2PMsg := createConsensusMessage("validator", 5, "hash3")
+	sd.CheckSignature(thirdMsg, thirdP2PMsg)
+
+	evidences := sd.GetEvidences()
+	require.Equal(t, 1, len(evidences), "only one evidence should be collected for a validator in a round")
+	evidence := evidences[0]
+	assert.Equal(t, DoubleSigningEvidence, evidence.Type)
+	assert.Equal(t, hex.EncodeToString([]byte("validator")), evidence.PubKey)
+	assert.Equal(t, uint32(1), evidence.ShardID)
+	assert.Equal(t, int64(5), evidence.Round)
+	assert.Equal(t, int64(1000), evidence.DetectedAt)
+	require.Equal(t, 2, len(evidence.Proofs))
+	assert.Equal(t, &common.SlashingEvidenceProof{
+		HeaderHash:       hex.EncodeToString([]byte("hash1")),
+		ConsensusMessage: hex.EncodeToString([]byte("data hash1")),
+		Payload:          hex.EncodeToString([]byte("payload hash1")),
+		From:             hex.EncodeToString([]byte("from")),
+		SeqNo:            hex.EncodeToString([]byte("seqNo hash1")),
+		Topic:            "consensus_1",
+		Signature:        hex.EncodeToString([]byte("signature hash1")),
+		Key:              hex.EncodeToString([]byte("key")),
+	}, evidence.Proofs[0])
+	assert.Equal(t, hex.EncodeToString([]byte("hash2")), evidence.Proofs[1].HeaderHash)
+	expectedTxData, _ := CreateTxData(evidence)
+	assert.Equal(t, expectedTxData, evidence.TxData)
+	assert.Equal(t, uint64(1), appStatusHandler.GetUint64(common.MetricNumSlashingEvidences))
+}
+
+func TestSlashingDetector_CheckProposalShouldCollectTheEquivocatingProposer(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := NewSlashingDetector(createMockArgsSlashingDetector())
+
+	firstMsg, firstP2PMsg := createConsensusMessage("leader", 5, "hash1")
+	sd.CheckProposal(firstMsg, firstP2PMsg)
+	signatureMsg, signatureP2PMsg := createConsensusMessage("leader", 5, "hash2")
+	sd.CheckSignature(signatureMsg, signatureP2PMsg)
+	assert.Equal(t, 0, len(sd.GetEvidences()), "the proposals and the signatures should not be compared")
+
+	secondMsg, secondP2PMsg := createConsensusMessage("leader", 5, "hash2")
+	sd.CheckProposal(secondMsg, secondP2PMsg)
+
+	evidences := sd.GetEvidences()
+	require.Equal(t, 1, len(evidences))
+	assert.Equal(t, EquivocatingProposerEvidence, evidences[0].Type)
+}
+
+func TestSlashingDetector_ShouldIgnoreTheOldRounds(t *testing.T) {
+	t.Parallel()
+
+	sd, _ := NewSlashingDetector(createMockArgsSlashingDetector())
+
+	firstMsg, firstP2PMsg := createConsensusMessage("validator", 5, "hash1")
+	sd.CheckSignature(firstMsg, firstP2PMsg)
+
+	newRoundMsg, newRoundP2PMsg := createConsensusMessage("validator", 7, "hash7")
+	sd.CheckSignature(newRoundMsg, newRoundP2PMsg)
+
+	secondMsg, secondP2PMsg := createConsensusMessage("validator", 5, "hash2")
+	sd.CheckSignature(secondMsg, secondP2PMsg)
+	assert.Equal(t, 0, len(sd.GetEvidences()))
+	assert.Equal(t, 1, len(sd.signedMessages))
+}
+
+func TestSlashingDetector_ShouldPersistAndPushTheEvidences(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSlashingDetector()
+	args.MaxNumEvidences = 2
+	wg := sync.WaitGroup{}
+	wg.Add(3)
+	args.OutportHandler = &testscommon.OutportStub{
+		HasDriversCalled: func() bool {
+			return true
+		},
+		SaveSlashingEvidenceCalled: func(evidence *common.SlashingEvidence) {
+			wg.Done()
+		},
+	}
+	sd, _ := NewSlashingDetector(args)
+
+	for round := int64(1); round <= 3; round++ {
+		sd.getTimeHandler = func() time.Time {
+			return time.Unix(round, 0)
+		}
+		firstMsg, firstP2PMsg := createConsensusMessage("validator", round, fmt.Sprintf("hash%d_1", round))
+		sd.CheckSignature(firstMsg, firstP2PMsg)
+		secondMsg, secondP2PMsg := createConsensusMessage("validator", round, fmt.Sprintf("hash%d_2", round))
+		sd.CheckSignature(secondMsg, secondP2PMsg)
+	}
+	wg.Wait()
+
+	evidences := sd.GetEvidences()
+	require.Equal(t, 2, len(evidences), "the oldest evidence should have been removed")
+	assert.Equal(t, int64(2), evidences[0].Round)
+	assert.Equal(t, int64(3), evidences[1].Round)
+
+	reloadedDetector, _ := NewSlashingDetector(args)
+	reloadedEvidences := reloadedDetector.GetEvidences()
+	require.Equal(t, 2, len(reloadedEvidences))
+	assert.Equal(t, evidences, reloadedEvidences)
+}
@@ -0,0 +1,1323 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: slashingEvidence.proto
+
+package slashing
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EvidenceRecord is the persisted form of a slashing evidence
+type EvidenceRecord struct {
+	Type       string         `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	PubKey     []byte         `protobuf:"bytes,2,opt,name=PubKey,proto3" json:"PubKey,omitempty"`
+	ShardID    uint32         `protobuf:"varint,3,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	Round      int64          `protobuf:"varint,4,opt,name=Round,proto3" json:"Round,omitempty"`
+	DetectedAt int64          `protobuf:"varint,5,opt,name=DetectedAt,proto3" json:"DetectedAt,omitempty"`
+	Proofs     []*ProofRecord `protobuf:"bytes,6,rep,name=Proofs,proto3" json:"Proofs,omitempty"`
+	TxData     string         `protobuf:"bytes,7,opt,name=TxData,proto3" json:"TxData,omitempty"`
+}
+
+func (m *EvidenceRecord) Reset()      { *m = EvidenceRecord{} }
+func (*EvidenceRecord) ProtoMessage() {}
+func (*EvidenceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_257934781876b4bf, []int{0}
+}
+func (m *EvidenceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvidenceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EvidenceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvidenceRecord.Merge(m, src)
+}
+func (m *EvidenceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvidenceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvidenceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvidenceRecord proto.InternalMessageInfo
+
+func (m *EvidenceRecord) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EvidenceRecord) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *EvidenceRecord) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *EvidenceRecord) GetRound() int64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *EvidenceRecord) GetDetectedAt() int64 {
+	if m != nil {
+		return m.DetectedAt
+	}
+	return 0
+}
+
+func (m *EvidenceRecord) GetProofs() []*ProofRecord {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+func (m *EvidenceRecord) GetTxData() string {
+	if m != nil {
+		return m.TxData
+	}
+	return ""
+}
+
+// ProofRecord is the persisted form of a consensus message together with its p2p envelope
+type ProofRecord struct {
+	HeaderHash       []byte `protobuf:"bytes,1,opt,name=HeaderHash,proto3" json:"HeaderHash,omitempty"`
+	ConsensusMessage []byte `protobuf:"bytes,2,opt,name=ConsensusMessage,proto3" json:"ConsensusMessage,omitempty"`
+	Payload          []byte `protobuf:"bytes,3,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	From             []byte `protobuf:"bytes,4,opt,name=From,proto3" json:"From,omitempty"`
+	SeqNo            []byte `protobuf:"bytes,5,opt,name=SeqNo,proto3" json:"SeqNo,omitempty"`
+	Topic            string `protobuf:"bytes,6,opt,name=Topic,proto3" json:"Topic,omitempty"`
+	Signature        []byte `protobuf:"bytes,7,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	Key              []byte `protobuf:"bytes,8,opt,name=Key,proto3" json:"Key,omitempty"`
+}
+
+func (m *ProofRecord) Reset()      { *m = ProofRecord{} }
+func (*ProofRecord) ProtoMessage() {}
+func (*ProofRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_257934781876b4bf, []int{1}
+}
+func (m *ProofRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProofRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofRecord.Merge(m, src)
+}
+func (m *ProofRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofRecord proto.InternalMessageInfo
+
+func (m *ProofRecord) GetHeaderHash() []byte {
+	if m != nil {
+		return m.HeaderHash
+	}
+	return nil
+}
+
+func (m *ProofRecord) GetConsensusMessage() []byte {
+	if m != nil {
+		return m.ConsensusMessage
+	}
+	return nil
+}
+
+func (m *ProofRecord) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *ProofRecord) GetFrom() []byte {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *ProofRecord) GetSeqNo() []byte {
+	if m != nil {
+		return m.SeqNo
+	}
+	return nil
+}
+
+func (m *ProofRecord) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *ProofRecord) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *ProofRecord) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EvidenceRecord)(nil), "proto.EvidenceRecord")
+	proto.RegisterType((*ProofRecord)(nil), "proto.ProofRecord")
+}
+
+func init() { proto.RegisterFile("slashingEvidence.proto", fileDescriptor_257934781876b4bf) }
+
+var fileDescriptor_257934781876b4bf = []byte{
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xb1, 0x6e, 0xea, 0x30,
+	0x18, 0x85, 0xe3, 0x1b, 0x12, 0xe0, 0x27, 0x70, 0xef, 0xf5, 0x70, 0x65, 0xdd, 0xc1, 0x8a, 0x98,
+	0xb2, 0x14, 0xa4, 0xf6, 0x09, 0x4a, 0x69, 0x45, 0x55, 0xb5, 0x42, 0xc0, 0xd4, 0xcd, 0x49, 0x4c,
+	0x12, 0x09, 0x62, 0x1a, 0x27, 0x55, 0xd9, 0xfa, 0x08, 0x9d, 0xaa, 0x3e, 0x42, 0x1f, 0xa5, 0x23,
+	0x23, 0x63, 0x31, 0x4b, 0x47, 0x1e, 0xa1, 0x8a, 0x29, 0x6a, 0x27, 0xfb, 0x1c, 0xcb, 0x3e, 0xdf,
+	0x7f, 0x0c, 0xff, 0xe4, 0x8c, 0xc9, 0x38, 0x49, 0xa3, 0xf3, 0xfb, 0x24, 0xe4, 0x69, 0xc0, 0x3b,
+	0x8b, 0x4c, 0xe4, 0x02, 0x5b, 0x7a, 0xf9, 0x7f, 0x14, 0x25, 0x79, 0x5c, 0xf8, 0x9d, 0x40, 0xcc,
+	0xbb, 0x91, 0x88, 0x44, 0x57, 0xdb, 0x7e, 0x31, 0xd5, 0x4a, 0x0b, 0xbd, 0xdb, 0xdf, 0x6a, 0x3f,
+	0x23, 0x68, 0x1d, 0x1e, 0x1a, 0xf1, 0x40, 0x64, 0x21, 0x76, 0xa0, 0x32, 0x59, 0x2e, 0x38, 0x41,
+	0x2e, 0xf2, 0xea, 0xb8, 0x05, 0xf6, 0xb0, 0xf0, 0xaf, 0xf8, 0x92, 0xfc, 0x72, 0x91, 0xe7, 0xe0,
+	0xdf, 0x50, 0x1d, 0xc7, 0x2c, 0x0b, 0x2f, 0xfb, 0xc4, 0x74, 0x91, 0xd7, 0xc4, 0x4d, 0xb0, 0x46,
+	0xa2, 0x48, 0x43, 0x52, 0x71, 0x91, 0x67, 0x62, 0x0c, 0xd0, 0xe7, 0x39, 0x0f, 0x72, 0x1e, 0x9e,
+	0xe6, 0xc4, 0xd2, 0x5e, 0x1b, 0xec, 0x61, 0x26, 0xc4, 0x54, 0x12, 0xdb, 0x35, 0xbd, 0xc6, 0x31,
+	0xde, 0x87, 0x77, 0xb4, 0xf9, 0x95, 0xda, 0x02, 0x7b, 0xf2, 0xd0, 0x67, 0x39, 0x23, 0xd5, 0x32,
+	0xb7, 0xfd, 0x82, 0xa0, 0xf1, 0xf3, 0x1c, 0x03, 0x0c, 0x38, 0x0b, 0x79, 0x36, 0x60, 0x32, 0xd6,
+	0x6c, 0x0e, 0x26, 0xf0, 0xe7, 0x4c, 0xa4, 0x92, 0xa7, 0xb2, 0x90, 0xd7, 0x5c, 0x4a, 0x16, 0xf1,
+	0x6f, 0xca, 0x21, 0x5b, 0xce, 0x04, 0x0b, 0x35, 0xa5, 0x53, 0x0e, 0x75, 0x91, 0x89, 0xb9, 0x86,
+	0x74, 0x4a, 0xe6, 0x31, 0xbf, 0xbb, 0x11, 0xc4, 0x3a, 0xc8, 0x89, 0x58, 0x24, 0x01, 0xb1, 0xf5,
+	0xc8, 0x7f, 0xa1, 0x3e, 0x4e, 0xa2, 0x94, 0xe5, 0x45, 0xc6, 0x35, 0x8d, 0x83, 0x1b, 0x60, 0x96,
+	0x15, 0xd4, 0x4a, 0xd1, 0xeb, 0xad, 0x36, 0xd4, 0x58, 0x6f, 0xa8, 0xb1, 0xdb, 0x50, 0xf4, 0xa8,
+	0x28, 0x7a, 0x55, 0x14, 0xbd, 0x29, 0x8a, 0x56, 0x8a, 0xa2, 0xb5, 0xa2, 0xe8, 0x5d, 0x51, 0xf4,
+	0xa1, 0xa8, 0xb1, 0x53, 0x14, 0x3d, 0x6d, 0xa9, 0xb1, 0xda, 0x52, 0x63, 0xbd, 0xa5, 0xc6, 0x6d,
+	0xed, 0xf0, 0x77, 0xbe, 0xad, 0x1b, 0x38, 0xf9, 0x1c, 0x00, 0x22, 0x59, 0x6f, 0x09, 0xce, 0x01,
+	0x00, 0x00,
+}
+
+func (this *EvidenceRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EvidenceRecord)
+	if !ok {
+		that2, ok := that.(EvidenceRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.DetectedAt != that1.DetectedAt {
+		return false
+	}
+	if len(this.Proofs) != len(that1.Proofs) {
+		return false
+	}
+	for i := range this.Proofs {
+		if !this.Proofs[i].Equal(that1.Proofs[i]) {
+			return false
+		}
+	}
+	if this.TxData != that1.TxData {
+		return false
+	}
+	return true
+}
+func (this *ProofRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ProofRecord)
+	if !ok {
+		that2, ok := that.(ProofRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.HeaderHash, that1.HeaderHash) {
+		return false
+	}
+	if !bytes.Equal(this.ConsensusMessage, that1.ConsensusMessage) {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
+	if !bytes.Equal(this.From, that1.From) {
+		return false
+	}
+	if !bytes.Equal(this.SeqNo, that1.SeqNo) {
+		return false
+	}
+	if this.Topic != that1.Topic {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	return true
+}
+func (this *EvidenceRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&slashing.EvidenceRecord{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "PubKey: "+fmt.Sprintf("%#v", this.PubKey)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "DetectedAt: "+fmt.Sprintf("%#v", this.DetectedAt)+",\n")
+	if this.Proofs != nil {
+		s = append(s, "Proofs: "+fmt.Sprintf("%#v", this.Proofs)+",\n")
+	}
+	s = append(s, "TxData: "+fmt.Sprintf("%#v", this.TxData)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ProofRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&slashing.ProofRecord{")
+	s = append(s, "HeaderHash: "+fmt.Sprintf("%#v", this.HeaderHash)+",\n")
+	s = append(s, "ConsensusMessage: "+fmt.Sprintf("%#v", this.ConsensusMessage)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	s = append(s, "SeqNo: "+fmt.Sprintf("%#v", this.SeqNo)+",\n")
+	s = append(s, "Topic: "+fmt.Sprintf("%#v", this.Topic)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringSlashingEvidence(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *EvidenceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvidenceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxData) > 0 {
+		i -= len(m.TxData)
+		copy(dAtA[i:], m.TxData)
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(len(m.TxData)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSlashingEvidence(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.DetectedAt != 0 {
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(m.DetectedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Round != 0 {
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(len(m.Topic)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SeqNo) > 0 {
+		i -= len(m.SeqNo)
+		copy(dAtA[i:], m.SeqNo)
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(len(m.SeqNo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsensusMessage) > 0 {
+		i -= len(m.ConsensusMessage)
+		copy(dAtA[i:], m.ConsensusMessage)
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(len(m.ConsensusMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HeaderHash) > 0 {
+		i -= len(m.HeaderHash)
+		copy(dAtA[i:], m.HeaderHash)
+		i = encodeVarintSlashingEvidence(dAtA, i, uint64(len(m.HeaderHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSlashingEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSlashingEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EvidenceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovSlashingEvidence(uint64(l))
+	}
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovSlashingEvidence(uint64(l))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovSlashingEvidence(uint64(m.ShardID))
+	}
+	if m.Round != 0 {
+		n += 1 + sovSlashingEvidence(uint64(m.Round))
+	}
+	if m.DetectedAt != 0 {
+		n += 1 + sovSlashingEvidence(uint64(m.DetectedAt))
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovSlashingEvidence(uint64(l))
+		}
+	}
+	l = len(m.TxData)
+	if l > 0 {
+		n += 1 + l + sovSlashingEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HeaderHash)
+	if l > 0 {
+		n += 1 + l + sovSlashingEvidence(uint64(l))
+	}
+	l = len(m.ConsensusMessage)
+	if l > 0 {
+		n += 1 + l + sovSlashingEvidence(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovSlashingEvidence(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovSlashingEvidence(uint64(l))
+	}
+	l = len(m.SeqNo)
+	if l > 0 {
+		n += 1 + l + sovSlashingEvidence(uint64(l))
+	}
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovSlashingEvidence(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovSlashingEvidence(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovSlashingEvidence(uint64(l))
+	}
+	return n
+}
+
+func sovSlashingEvidence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSlashingEvidence(x uint64) (n int) {
+	return sovSlashingEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EvidenceRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForProofs := "[]*ProofRecord{"
+	for _, f := range this.Proofs {
+		repeatedStringForProofs += strings.Replace(f.String(), "ProofRecord", "ProofRecord", 1) + ","
+	}
+	repeatedStringForProofs += "}"
+	s := strings.Join([]string{`&EvidenceRecord{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`PubKey:` + fmt.Sprintf("%v", this.PubKey) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`DetectedAt:` + fmt.Sprintf("%v", this.DetectedAt) + `,`,
+		`Proofs:` + repeatedStringForProofs + `,`,
+		`TxData:` + fmt.Sprintf("%v", this.TxData) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProofRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProofRecord{`,
+		`HeaderHash:` + fmt.Sprintf("%v", this.HeaderHash) + `,`,
+		`ConsensusMessage:` + fmt.Sprintf("%v", this.ConsensusMessage) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`SeqNo:` + fmt.Sprintf("%v", this.SeqNo) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringSlashingEvidence(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EvidenceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashingEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvidenceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvidenceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectedAt", wireType)
+			}
+			m.DetectedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DetectedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, &ProofRecord{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashingEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSlashingEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderHash = append(m.HeaderHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeaderHash == nil {
+				m.HeaderHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusMessage", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusMessage = append(m.ConsensusMessage[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsensusMessage == nil {
+				m.ConsensusMessage = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = append(m.From[:0], dAtA[iNdEx:postIndex]...)
+			if m.From == nil {
+				m.From = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeqNo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeqNo = append(m.SeqNo[:0], dAtA[iNdEx:postIndex]...)
+			if m.SeqNo == nil {
+				m.SeqNo = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSlashingEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthSlashingEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSlashingEvidence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSlashingEvidence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSlashingEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSlashingEvidence
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSlashingEvidence
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSlashingEvidence
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSlashingEvidence        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSlashingEvidence          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSlashingEvidence = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,54 @@
+package slashing
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// ReportSlashingEvidenceFunction is the name of the system smart contract function that receives the evidences
+const ReportSlashingEvidenceFunction = "reportSlashingEvidence"
+
+const numProofs = 2
+
+// CreateTxData packages the evidence as the data field of a transaction:
+// reportSlashingEvidence@type@pubKey@shardID@round followed, for each of the two proofs, by
+// @headerHash@consensusMessage@payload@from@seqNo@topic@signature@key
+// All the arguments are hex encoded, the numeric ones as big endian unsigned integers
+func CreateTxData(evidence *common.SlashingEvidence) (string, error) {
+	if evidence == nil {
+		return "", ErrNilEvidence
+	}
+	if len(evidence.Proofs) != numProofs {
+		return "", fmt.Errorf("%w: %d", ErrInvalidNumProofs, len(evidence.Proofs))
+	}
+
+	arguments := []string{
+		ReportSlashingEvidenceFunction,
+		hex.EncodeToString([]byte(evidence.Type)),
+		evidence.PubKey,
+		hex.EncodeToString(big.NewInt(0).SetUint64(uint64(evidence.ShardID)).Bytes()),
+		hex.EncodeToString(big.NewInt(evidence.Round).Bytes()),
+	}
+	for _, proof := range evidence.Proofs {
+		if proof == nil {
+			return "", ErrNilEvidence
+		}
+
+		arguments = append(arguments,
+			proof.HeaderHash,
+			proof.ConsensusMessage,
+			proof.Payload,
+			proof.From,
+			proof.SeqNo,
+			hex.EncodeToString([]byte(proof.Topic)),
+			proof.Signature,
+			proof.Key,
+		)
+	}
+
+	return strings.Join(arguments, "@"), nil
+}
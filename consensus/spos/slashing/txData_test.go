@@ -0,0 +1,75 @@
+package slashing
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTxData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil evidence should error", func(t *testing.T) {
+		t.Parallel()
+
+		txData, err := CreateTxData(nil)
+		assert.Equal(t, ErrNilEvidence, err)
+		assert.Empty(t, txData)
+	})
+	t.Run("invalid number of proofs should error", func(t *testing.T) {
+		t.Parallel()
+
+		txData, err := CreateTxData(&common.SlashingEvidence{
+			Proofs: []*common.SlashingEvidenceProof{{}},
+		})
+		assert.True(t, errors.Is(err, ErrInvalidNumProofs))
+		assert.Empty(t, txData)
+	})
+	t.Run("nil proof should error", func(t *testing.T) {
+		t.Parallel()
+
+		txData, err := CreateTxData(&common.SlashingEvidence{
+			Proofs: []*common.SlashingEvidenceProof{{}, nil},
+		})
+		assert.Equal(t, ErrNilEvidence, err)
+		assert.Empty(t, txData)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proof := &common.SlashingEvidenceProof{
+			HeaderHash:       "01",
+			ConsensusMessage: "02",
+			Payload:          "03",
+			From:             "04",
+			SeqNo:            "05",
+			Topic:            "t",
+			Signature:        "06",
+			Key:              "07",
+		}
+		txData, err := CreateTxData(&common.SlashingEvidence{
+			Type:    "ab",
+			PubKey:  "aabb",
+			ShardID: 0,
+			Round:   258,
+			Proofs:  []*common.SlashingEvidenceProof{proof, proof},
+		})
+		require.Nil(t, err)
+
+		expectedProofArguments := "01@02@03@04@05@74@06@07"
+		expectedTxData := strings.Join([]string{
+			"reportSlashingEvidence",
+			"6162",
+			"aabb",
+			"",
+			"0102",
+			expectedProofArguments,
+			expectedProofArguments,
+		}, "@")
+		assert.Equal(t, expectedTxData, txData)
+	})
+}
@@ -74,6 +74,8 @@ type Worker struct {
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
 	nodeRedundancyHandler     consensus.NodeRedundancyHandler
+	roundTimeline             consensus.RoundTimelineHandler
+	slashingDetector          consensus.SlashingDetector
 	closer                    core.SafeCloser
 }
 
@@ -103,6 +105,8 @@ type WorkerArgs struct {
 	PublicKeySize            int
 	AppStatusHandler         core.AppStatusHandler
 	NodeRedundancyHandler    consensus.NodeRedundancyHandler
+	RoundTimeline            consensus.RoundTimelineHandler
+	SlashingDetector         consensus.SlashingDetector
 }
 
 // NewWorker creates a new Worker object
@@ -149,6 +153,8 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
 		nodeRedundancyHandler:    args.NodeRedundancyHandler,
+		roundTimeline:            args.RoundTimeline,
+		slashingDetector:         args.SlashingDetector,
 		closer:                   closing.NewSafeChanCloser(),
 	}
 
@@ -247,6 +253,12 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.NodeRedundancyHandler) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(args.RoundTimeline) {
+		return ErrNilRoundTimelineHandler
+	}
+	if check.IfNil(args.SlashingDetector) {
+		return ErrNilSlashingDetector
+	}
 
 	return nil
 }
@@ -377,6 +389,9 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	)
 
 	err = wrk.consensusMessageValidator.checkConsensusMessageValidity(cnsMsg, message.Peer())
+	if errors.Is(err, ErrMessageTypeLimitReached) {
+		wrk.checkMessageOverLimit(cnsMsg, msgType, message)
+	}
 	if err != nil {
 		return err
 	}
@@ -387,6 +402,9 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	isMessageWithBlockHeader := wrk.consensusService.IsMessageWithBlockHeader(msgType)
 	isMessageWithBlockBodyAndHeader := wrk.consensusService.IsMessageWithBlockBodyAndHeader(msgType)
 
+	wrk.recordMessageArrival(cnsMsg, msgType)
+	wrk.collectSlashingEvidence(cnsMsg, msgType, message)
+
 	if isMessageWithBlockBody || isMessageWithBlockBodyAndHeader {
 		wrk.doJobOnMessageWithBlockBody(cnsMsg)
 	}
@@ -415,6 +433,47 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	return nil
 }
 
+// recordMessageArrival records the moment when the consensus message was received, before it waits for the subround
+// which handles it
+func (wrk *Worker) recordMessageArrival(cnsMsg *consensus.Message, msgType consensus.MessageType) {
+	if wrk.consensusService.IsMessageWithBlockHeader(msgType) || wrk.consensusService.IsMessageWithBlockBodyAndHeader(msgType) {
+		wrk.roundTimeline.BlockReceived(cnsMsg.RoundIndex, cnsMsg.BlockHeaderHash)
+	}
+	if wrk.consensusService.IsMessageWithSignature(msgType) {
+		wrk.roundTimeline.SignatureReceived(cnsMsg.RoundIndex, string(cnsMsg.PubKey))
+	}
+	if wrk.consensusService.IsMessageWithFinalInfo(msgType) {
+		wrk.roundTimeline.FinalInfoReceived(cnsMsg.RoundIndex)
+	}
+}
+
+// checkMessageOverLimit hands to the slashing detector the authentic messages of a type already received from the same
+// public key in the same round, as they can be the proof of a conflicting proposal or signature
+func (wrk *Worker) checkMessageOverLimit(cnsMsg *consensus.Message, msgType consensus.MessageType, message p2p.MessageP2P) {
+	err := wrk.consensusMessageValidator.checkConsensusMessageOrigin(cnsMsg, message.Peer())
+	if err != nil {
+		log.Trace("checkMessageOverLimit", "error", err.Error())
+		return
+	}
+
+	wrk.collectSlashingEvidence(cnsMsg, msgType, message)
+}
+
+func (wrk *Worker) collectSlashingEvidence(cnsMsg *consensus.Message, msgType consensus.MessageType, message p2p.MessageP2P) {
+	isProposal := wrk.consensusService.IsMessageWithBlockHeader(msgType) || wrk.consensusService.IsMessageWithBlockBodyAndHeader(msgType)
+	if isProposal && wrk.isLeaderInMessageRound(cnsMsg) {
+		wrk.slashingDetector.CheckProposal(cnsMsg, message)
+	}
+	if wrk.consensusService.IsMessageWithSignature(msgType) {
+		wrk.slashingDetector.CheckSignature(cnsMsg, message)
+	}
+}
+
+func (wrk *Worker) isLeaderInMessageRound(cnsMsg *consensus.Message) bool {
+	return cnsMsg.RoundIndex == wrk.consensusState.RoundIndex &&
+		wrk.consensusState.IsNodeLeaderInCurrentRound(string(cnsMsg.PubKey))
+}
+
 func (wrk *Worker) shouldBlacklistPeer(err error) bool {
 	if err == nil ||
 		errors.Is(err, ErrMessageForPastRound) ||
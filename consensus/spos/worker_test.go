@@ -21,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	consensusMocks "github.com/ElrondNetwork/elrond-go/testscommon/consensus"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
@@ -110,6 +111,8 @@ func createDefaultWorkerArgs(appStatusHandler core.AppStatusHandler) *spos.Worke
 		PublicKeySize:            PublicKeySize,
 		AppStatusHandler:         appStatusHandler,
 		NodeRedundancyHandler:    &mock.NodeRedundancyHandlerStub{},
+		RoundTimeline:            &consensusMocks.RoundTimelineHandlerStub{},
+		SlashingDetector:         &consensusMocks.SlashingDetectorStub{},
 	}
 
 	return workerArgs
@@ -364,6 +367,28 @@ func TestWorker_NewWorkerNodeRedundancyHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
 }
 
+func TestWorker_NewWorkerNilRoundTimelineShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(statusHandlerMock.NewAppStatusHandlerMock())
+	workerArgs.RoundTimeline = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilRoundTimelineHandler, err)
+}
+
+func TestWorker_NewWorkerNilSlashingDetectorShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(statusHandlerMock.NewAppStatusHandlerMock())
+	workerArgs.SlashingDetector = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilSlashingDetector, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1065,6 +1090,140 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestWorker_ProcessReceivedMessageShouldRecordTheBlockArrival(t *testing.T) {
+	t.Parallel()
+
+	var recordedRound int64 = -1
+	var recordedHash []byte
+	workerArgs := createDefaultWorkerArgs(&statusHandlerMock.AppStatusHandlerStub{})
+	workerArgs.BlockProcessor = &mock.BlockProcessorMock{
+		DecodeBlockHeaderCalled: func(dta []byte) data.HeaderHandler {
+			return &testscommon.HeaderHandlerStub{
+				CheckChainIDCalled: func(reference []byte) error {
+					return nil
+				},
+				GetPrevHashCalled: func() []byte {
+					return make([]byte, 0)
+				},
+			}
+		},
+		RevertCurrentBlockCalled: func() {
+		},
+		DecodeBlockBodyCalled: func(dta []byte) data.BodyHandler {
+			return nil
+		},
+	}
+	workerArgs.RoundTimeline = &consensusMocks.RoundTimelineHandlerStub{
+		BlockReceivedCalled: func(round int64, blockHash []byte) {
+			recordedRound = round
+			recordedHash = blockHash
+		},
+		SignatureReceivedCalled: func(round int64, pubKey string) {
+			assert.Fail(t, "should have not recorded a signature")
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	hdr := &block.Header{ChainID: chainID}
+	hdrHash, _ := core.CalculateHash(mock.MarshalizerMock{}, &hashingMocks.HasherMock{}, hdr)
+	hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+	cnsMsg := consensus.NewConsensusMessage(
+		hdrHash,
+		nil,
+		nil,
+		hdrStr,
+		[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+		signature,
+		int(bls.MtBlockHeader),
+		0,
+		chainID,
+		nil,
+		nil,
+		nil,
+		currentPid,
+	)
+	buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+	msg := &mock.P2PMessageMock{
+		DataField: buff,
+		PeerField: currentPid,
+	}
+	err := wrk.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), recordedRound)
+	assert.Equal(t, hdrHash, recordedHash)
+}
+
+func TestWorker_ProcessReceivedMessageShouldCheckTheConflictingProposals(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(&statusHandlerMock.AppStatusHandlerStub{})
+	workerArgs.BlockProcessor = &mock.BlockProcessorMock{
+		DecodeBlockHeaderCalled: func(dta []byte) data.HeaderHandler {
+			return &testscommon.HeaderHandlerStub{
+				CheckChainIDCalled: func(reference []byte) error {
+					return nil
+				},
+				GetPrevHashCalled: func() []byte {
+					return make([]byte, 0)
+				},
+			}
+		},
+		RevertCurrentBlockCalled: func() {
+		},
+		DecodeBlockBodyCalled: func(dta []byte) data.BodyHandler {
+			return nil
+		},
+	}
+	checkedHashes := make([][]byte, 0)
+	workerArgs.SlashingDetector = &consensusMocks.SlashingDetectorStub{
+		CheckProposalCalled: func(cnsMsg *consensus.Message, p2pMessage p2p.MessageP2P) {
+			checkedHashes = append(checkedHashes, cnsMsg.BlockHeaderHash)
+		},
+		CheckSignatureCalled: func(cnsMsg *consensus.Message, p2pMessage p2p.MessageP2P) {
+			assert.Fail(t, "should have not checked a signature")
+		},
+	}
+	wrk, _ := spos.NewWorker(workerArgs)
+
+	createHeaderMessage := func(nonce uint64) (*mock.P2PMessageMock, []byte) {
+		hdr := &block.Header{ChainID: chainID, Nonce: nonce}
+		hdrHash, _ := core.CalculateHash(mock.MarshalizerMock{}, &hashingMocks.HasherMock{}, hdr)
+		hdrStr, _ := mock.MarshalizerMock{}.Marshal(hdr)
+		cnsMsg := consensus.NewConsensusMessage(
+			hdrHash,
+			nil,
+			nil,
+			hdrStr,
+			[]byte(wrk.ConsensusState().ConsensusGroup()[0]),
+			signature,
+			int(bls.MtBlockHeader),
+			0,
+			chainID,
+			nil,
+			nil,
+			nil,
+			currentPid,
+		)
+		buff, _ := wrk.Marshalizer().Marshal(cnsMsg)
+
+		return &mock.P2PMessageMock{
+			DataField: buff,
+			PeerField: currentPid,
+		}, hdrHash
+	}
+
+	firstMsg, firstHash := createHeaderMessage(1)
+	err := wrk.ProcessReceivedMessage(firstMsg, fromConnectedPeerId)
+	assert.Nil(t, err)
+
+	secondMsg, secondHash := createHeaderMessage(2)
+	err = wrk.ProcessReceivedMessage(secondMsg, fromConnectedPeerId)
+	assert.True(t, errors.Is(err, spos.ErrMessageTypeLimitReached))
+
+	assert.Equal(t, [][]byte{firstHash, secondHash}, checkedHashes)
+}
+
 func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
 	t.Parallel()
 	wrk := *initWorker(&statusHandlerMock.AppStatusHandlerStub{})
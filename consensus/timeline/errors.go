@@ -0,0 +1,12 @@
+package timeline
+
+import "errors"
+
+// ErrInvalidNumRoundsToKeep signals that an invalid number of rounds to keep was provided
+var ErrInvalidNumRoundsToKeep = errors.New("invalid number of rounds to keep")
+
+// ErrNilRoundHandler signals that a nil round handler was provided
+var ErrNilRoundHandler = errors.New("nil round handler")
+
+// ErrNilAppStatusHandler signals that a nil app status handler was provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
@@ -0,0 +1,404 @@
+package timeline
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+)
+
+const (
+	// RoleLeader is the role of the node when it proposes the block of the round
+	RoleLeader = "leader"
+	// RoleValidator is the role of the node when it is a member of the consensus group, other than the leader
+	RoleValidator = "validator"
+	// RoleNotInConsensusGroup is the role of the node when it only follows the consensus of the round
+	RoleNotInConsensusGroup = "notInConsensusGroup"
+)
+
+const (
+	// OutcomeCommitted is the outcome of the rounds in which the node committed the proposed block
+	OutcomeCommitted = "committed"
+	// OutcomeNotCommitted is the outcome of the ended rounds in which the node did not commit any block
+	OutcomeNotCommitted = "notCommitted"
+	// OutcomeInProgress is the outcome of the current round, while the block is not committed
+	OutcomeInProgress = "inProgress"
+)
+
+const notAvailable = "n/a"
+
+// ArgsRoundTimelineRecorder is the DTO used to create a new round timeline recorder
+type ArgsRoundTimelineRecorder struct {
+	NumRoundsToKeep  int
+	RoundHandler     consensus.RoundHandler
+	AppStatusHandler core.AppStatusHandler
+}
+
+type roundEvents struct {
+	round               int64
+	startTime           time.Time
+	leader              string
+	selfRole            string
+	consensusGroupSize  int
+	signaturesThreshold int
+	blockHash           []byte
+	blockProposed       time.Time
+	blockReceived       time.Time
+	blockProcessed      time.Time
+	signatureSent       time.Time
+	signatures          map[string]time.Time
+	thresholdReached    time.Time
+	finalInfoBroadcast  time.Time
+	finalInfoReceived   time.Time
+	blockCommitted      time.Time
+	isSummaryPublished  bool
+}
+
+type roundTimelineRecorder struct {
+	mutRounds        sync.RWMutex
+	rounds           map[int64]*roundEvents
+	orderedRounds    []int64
+	lastStartedRound int64
+	numRoundsToKeep  int
+	roundHandler     consensus.RoundHandler
+	appStatusHandler core.AppStatusHandler
+	getTimeHandler   func() time.Time
+}
+
+// NewRoundTimelineRecorder creates a recorder which keeps the consensus events of the last rounds
+func NewRoundTimelineRecorder(args ArgsRoundTimelineRecorder) (*roundTimelineRecorder, error) {
+	if args.NumRoundsToKeep < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidNumRoundsToKeep, args.NumRoundsToKeep)
+	}
+	if check.IfNil(args.RoundHandler) {
+		return nil, ErrNilRoundHandler
+	}
+	if check.IfNil(args.AppStatusHandler) {
+		return nil, ErrNilAppStatusHandler
+	}
+
+	return &roundTimelineRecorder{
+		rounds:           make(map[int64]*roundEvents),
+		orderedRounds:    make([]int64, 0, args.NumRoundsToKeep+1),
+		lastStartedRound: -1,
+		numRoundsToKeep:  args.NumRoundsToKeep,
+		roundHandler:     args.RoundHandler,
+		appStatusHandler: args.AppStatusHandler,
+		getTimeHandler:   time.Now,
+	}, nil
+}
+
+// StartRound records the consensus group data of the provided round and publishes the summary of the previous round
+func (rtr *roundTimelineRecorder) StartRound(round int64, leader string, consensusGroupSize int, signaturesThreshold int, selfRole string) {
+	rtr.mutRounds.Lock()
+	defer rtr.mutRounds.Unlock()
+
+	re := rtr.getOrCreateRound(round)
+	if re == nil {
+		return
+	}
+
+	re.leader = hex.EncodeToString([]byte(leader))
+	re.consensusGroupSize = consensusGroupSize
+	re.signaturesThreshold = signaturesThreshold
+	re.selfRole = selfRole
+
+	if round > rtr.lastStartedRound {
+		rtr.lastStartedRound = round
+	}
+	rtr.publishEndedRoundsSummary()
+}
+
+// BlockProposed records the moment when the node, as leader, sent the block of the round
+func (rtr *roundTimelineRecorder) BlockProposed(round int64, blockHash []byte) {
+	rtr.recordEvent(round, func(re *roundEvents, now time.Time) {
+		setIfNotRecorded(&re.blockProposed, now)
+		setBlockHashIfNotRecorded(re, blockHash)
+	})
+}
+
+// BlockReceived records the moment when the block proposed by the leader was received
+func (rtr *roundTimelineRecorder) BlockReceived(round int64, blockHash []byte) {
+	rtr.recordEvent(round, func(re *roundEvents, now time.Time) {
+		setIfNotRecorded(&re.blockReceived, now)
+		setBlockHashIfNotRecorded(re, blockHash)
+	})
+}
+
+// BlockProcessed records the moment when the proposed block was processed successfully
+func (rtr *roundTimelineRecorder) BlockProcessed(round int64) {
+	rtr.recordEvent(round, func(re *roundEvents, now time.Time) {
+		setIfNotRecorded(&re.blockProcessed, now)
+	})
+}
+
+// SignatureSent records the moment when the node signed the proposed block
+func (rtr *roundTimelineRecorder) SignatureSent(round int64) {
+	rtr.recordEvent(round, func(re *roundEvents, now time.Time) {
+		setIfNotRecorded(&re.signatureSent, now)
+	})
+}
+
+// SignatureReceived records the moment when the signature of the provided consensus group member was received
+func (rtr *roundTimelineRecorder) SignatureReceived(round int64, pubKey string) {
+	rtr.recordEvent(round, func(re *roundEvents, now time.Time) {
+		_, exists := re.signatures[pubKey]
+		if !exists {
+			re.signatures[pubKey] = now
+		}
+	})
+}
+
+// SignaturesThresholdReached records the moment when the leader collected enough signatures
+func (rtr *roundTimelineRecorder) SignaturesThresholdReached(round int64) {
+	rtr.recordEvent(round, func(re *roundEvents, now time.Time) {
+		setIfNotRecorded(&re.thresholdReached, now)
+	})
+}
+
+// FinalInfoBroadcast records the moment when the node, as leader, sent the block final info
+func (rtr *roundTimelineRecorder) FinalInfoBroadcast(round int64) {
+	rtr.recordEvent(round, func(re *roundEvents, now time.Time) {
+		setIfNotRecorded(&re.finalInfoBroadcast, now)
+	})
+}
+
+// FinalInfoReceived records the moment when the block final info sent by the leader was received
+func (rtr *roundTimelineRecorder) FinalInfoReceived(round int64) {
+	rtr.recordEvent(round, func(re *roundEvents, now time.Time) {
+		setIfNotRecorded(&re.finalInfoReceived, now)
+	})
+}
+
+// BlockCommitted records the moment when the block of the round was committed and publishes the round summary
+func (rtr *roundTimelineRecorder) BlockCommitted(round int64) {
+	rtr.mutRounds.Lock()
+	defer rtr.mutRounds.Unlock()
+
+	re := rtr.getOrCreateRound(round)
+	if re == nil {
+		return
+	}
+
+	setIfNotRecorded(&re.blockCommitted, rtr.getTimeHandler())
+	rtr.publishSummary(re)
+}
+
+func (rtr *roundTimelineRecorder) recordEvent(round int64, handler func(re *roundEvents, now time.Time)) {
+	rtr.mutRounds.Lock()
+	defer rtr.mutRounds.Unlock()
+
+	re := rtr.getOrCreateRound(round)
+	if re == nil {
+		return
+	}
+
+	handler(re, rtr.getTimeHandler())
+}
+
+// getOrCreateRound returns the events of the provided round, creating them if needed. It returns nil if the round
+// is older than all the kept rounds. The mutex should be locked before calling this method
+func (rtr *roundTimelineRecorder) getOrCreateRound(round int64) *roundEvents {
+	re, exists := rtr.rounds[round]
+	if exists {
+		return re
+	}
+
+	isTooOld := len(rtr.orderedRounds) >= rtr.numRoundsToKeep && round < rtr.orderedRounds[0]
+	if isTooOld {
+		return nil
+	}
+
+	// the start time of any round is computed from the current round, as the events of a round can be received
+	// before the node starts that round
+	currentRound := rtr.roundHandler.Index()
+	roundsDelta := time.Duration(round-currentRound) * rtr.roundHandler.TimeDuration()
+	re = &roundEvents{
+		round:      round,
+		startTime:  rtr.roundHandler.TimeStamp().Add(roundsDelta),
+		signatures: make(map[string]time.Time),
+	}
+	rtr.rounds[round] = re
+
+	position := sort.Search(len(rtr.orderedRounds), func(i int) bool {
+		return rtr.orderedRounds[i] > round
+	})
+	rtr.orderedRounds = append(rtr.orderedRounds, 0)
+	copy(rtr.orderedRounds[position+1:], rtr.orderedRounds[position:])
+	rtr.orderedRounds[position] = round
+
+	for len(rtr.orderedRounds) > rtr.numRoundsToKeep {
+		delete(rtr.rounds, rtr.orderedRounds[0])
+		rtr.orderedRounds = rtr.orderedRounds[1:]
+	}
+
+	return re
+}
+
+// publishEndedRoundsSummary publishes the summary of the last ended round, if it was not published when its block
+// was committed. The mutex should be locked before calling this method
+func (rtr *roundTimelineRecorder) publishEndedRoundsSummary() {
+	var lastEndedRound *roundEvents
+	for _, round := range rtr.orderedRounds {
+		re := rtr.rounds[round]
+		if round >= rtr.lastStartedRound || re.isSummaryPublished {
+			continue
+		}
+
+		re.isSummaryPublished = true
+		lastEndedRound = re
+	}
+
+	if lastEndedRound != nil {
+		rtr.appStatusHandler.SetStringValue(common.MetricConsensusLastRoundTimeline, rtr.summary(rtr.convert(lastEndedRound)))
+	}
+}
+
+func (rtr *roundTimelineRecorder) publishSummary(re *roundEvents) {
+	re.isSummaryPublished = true
+	rtr.appStatusHandler.SetStringValue(common.MetricConsensusLastRoundTimeline, rtr.summary(rtr.convert(re)))
+}
+
+// GetRounds returns the timelines of the kept rounds, ordered by round
+func (rtr *roundTimelineRecorder) GetRounds() []*common.ConsensusRoundTimeline {
+	rtr.mutRounds.RLock()
+	defer rtr.mutRounds.RUnlock()
+
+	timelines := make([]*common.ConsensusRoundTimeline, 0, len(rtr.orderedRounds))
+	for _, round := range rtr.orderedRounds {
+		timelines = append(timelines, rtr.convert(rtr.rounds[round]))
+	}
+
+	return timelines
+}
+
+func (rtr *roundTimelineRecorder) convert(re *roundEvents) *common.ConsensusRoundTimeline {
+	timeline := &common.ConsensusRoundTimeline{
+		Round:                re.round,
+		RoundStartTimestamp:  re.startTime.Unix(),
+		Leader:               re.leader,
+		SelfRole:             re.selfRole,
+		ConsensusGroupSize:   re.consensusGroupSize,
+		BlockHash:            hex.EncodeToString(re.blockHash),
+		BlockProposedMs:      elapsedMs(re.startTime, re.blockProposed),
+		BlockReceivedMs:      elapsedMs(re.startTime, re.blockReceived),
+		BlockProcessedMs:     elapsedMs(re.startTime, re.blockProcessed),
+		SelfSignatureSentMs:  elapsedMs(re.startTime, re.signatureSent),
+		Signatures:           make([]common.ConsensusSignatureArrival, 0, len(re.signatures)),
+		SignaturesThreshold:  re.signaturesThreshold,
+		ThresholdReachedMs:   elapsedMs(re.startTime, re.thresholdReached),
+		FinalInfoBroadcastMs: elapsedMs(re.startTime, re.finalInfoBroadcast),
+		FinalInfoReceivedMs:  elapsedMs(re.startTime, re.finalInfoReceived),
+		BlockCommittedMs:     elapsedMs(re.startTime, re.blockCommitted),
+		Outcome:              rtr.outcome(re),
+	}
+
+	for pubKey, received := range re.signatures {
+		timeline.Signatures = append(timeline.Signatures, common.ConsensusSignatureArrival{
+			PubKey:     hex.EncodeToString([]byte(pubKey)),
+			ReceivedMs: received.Sub(re.startTime).Milliseconds(),
+		})
+	}
+	sort.Slice(timeline.Signatures, func(i, j int) bool {
+		if timeline.Signatures[i].ReceivedMs == timeline.Signatures[j].ReceivedMs {
+			return timeline.Signatures[i].PubKey < timeline.Signatures[j].PubKey
+		}
+		return timeline.Signatures[i].ReceivedMs < timeline.Signatures[j].ReceivedMs
+	})
+
+	numSignatures := len(timeline.Signatures)
+	if numSignatures > 0 {
+		median := timeline.Signatures[numSignatures/2].ReceivedMs
+		if numSignatures%2 == 0 {
+			median = (timeline.Signatures[numSignatures/2-1].ReceivedMs + median) / 2
+		}
+		timeline.MedianSignatureMs = &median
+	}
+
+	// only the leader knows when it collected enough signatures. The other nodes estimate this moment from the
+	// received signatures, taking into account that the leader does not broadcast its own signature
+	numNeededReceived := re.signaturesThreshold - 1
+	canEstimateThreshold := timeline.ThresholdReachedMs == nil && numNeededReceived > 0 && numSignatures >= numNeededReceived
+	if canEstimateThreshold {
+		thresholdReached := timeline.Signatures[numNeededReceived-1].ReceivedMs
+		timeline.ThresholdReachedMs = &thresholdReached
+	}
+
+	return timeline
+}
+
+func (rtr *roundTimelineRecorder) outcome(re *roundEvents) string {
+	if !re.blockCommitted.IsZero() {
+		return OutcomeCommitted
+	}
+	if re.round < rtr.lastStartedRound {
+		return OutcomeNotCommitted
+	}
+
+	return OutcomeInProgress
+}
+
+func (rtr *roundTimelineRecorder) summary(timeline *common.ConsensusRoundTimeline) string {
+	blockMs := timeline.BlockReceivedMs
+	if timeline.BlockProposedMs != nil {
+		blockMs = timeline.BlockProposedMs
+	}
+
+	parts := []string{
+		fmt.Sprintf("block %s", formatElapsed(blockMs)),
+		fmt.Sprintf("processed %s", formatElapsed(timeline.BlockProcessedMs)),
+		fmt.Sprintf("signed %s (median %s)", formatElapsed(timeline.SelfSignatureSentMs), formatElapsed(timeline.MedianSignatureMs)),
+		fmt.Sprintf("signatures %d/%d", len(timeline.Signatures), timeline.ConsensusGroupSize),
+		fmt.Sprintf("threshold %s", formatElapsed(timeline.ThresholdReachedMs)),
+		fmt.Sprintf("committed %s", formatElapsed(timeline.BlockCommittedMs)),
+	}
+
+	role := timeline.SelfRole
+	if len(role) == 0 {
+		role = notAvailable
+	}
+
+	return fmt.Sprintf("round %d (%s): %s, %s", timeline.Round, role, strings.Join(parts, ", "), timeline.Outcome)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rtr *roundTimelineRecorder) IsInterfaceNil() bool {
+	return rtr == nil
+}
+
+func setIfNotRecorded(moment *time.Time, now time.Time) {
+	if moment.IsZero() {
+		*moment = now
+	}
+}
+
+func setBlockHashIfNotRecorded(re *roundEvents, blockHash []byte) {
+	if len(re.blockHash) == 0 {
+		re.blockHash = blockHash
+	}
+}
+
+func elapsedMs(start time.Time, moment time.Time) *int64 {
+	if moment.IsZero() {
+		return nil
+	}
+
+	elapsed := moment.Sub(start).Milliseconds()
+
+	return &elapsed
+}
+
+func formatElapsed(elapsed *int64) string {
+	if elapsed == nil {
+		return notAvailable
+	}
+
+	return fmt.Sprintf("%+dms", *elapsed)
+}
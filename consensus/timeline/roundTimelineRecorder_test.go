@@ -0,0 +1,229 @@
+package timeline
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const roundDuration = 6 * time.Second
+
+var roundTimeStamp = time.Unix(1000, 0)
+
+func createMockArgsRoundTimelineRecorder() ArgsRoundTimelineRecorder {
+	return ArgsRoundTimelineRecorder{
+		NumRoundsToKeep: 3,
+		RoundHandler: &mock.RoundHandlerMock{
+			RoundIndex: 10,
+			TimeStampCalled: func() time.Time {
+				return roundTimeStamp
+			},
+			TimeDurationCalled: func() time.Duration {
+				return roundDuration
+			},
+		},
+		AppStatusHandler: &statusHandler.AppStatusHandlerStub{},
+	}
+}
+
+func createRecorderWithClock(t *testing.T, args ArgsRoundTimelineRecorder) (*roundTimelineRecorder, *time.Time) {
+	rtr, err := NewRoundTimelineRecorder(args)
+	require.Nil(t, err)
+
+	now := roundTimeStamp
+	rtr.getTimeHandler = func() time.Time {
+		return now
+	}
+
+	return rtr, &now
+}
+
+func int64Ptr(value int64) *int64 {
+	return &value
+}
+
+func TestNewRoundTimelineRecorder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of rounds should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoundTimelineRecorder()
+		args.NumRoundsToKeep = 0
+		rtr, err := NewRoundTimelineRecorder(args)
+
+		assert.True(t, check.IfNil(rtr))
+		assert.True(t, errors.Is(err, ErrInvalidNumRoundsToKeep))
+	})
+	t.Run("nil round handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoundTimelineRecorder()
+		args.RoundHandler = nil
+		rtr, err := NewRoundTimelineRecorder(args)
+
+		assert.True(t, check.IfNil(rtr))
+		assert.Equal(t, ErrNilRoundHandler, err)
+	})
+	t.Run("nil app status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoundTimelineRecorder()
+		args.AppStatusHandler = nil
+		rtr, err := NewRoundTimelineRecorder(args)
+
+		assert.True(t, check.IfNil(rtr))
+		assert.Equal(t, ErrNilAppStatusHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rtr, err := NewRoundTimelineRecorder(createMockArgsRoundTimelineRecorder())
+
+		assert.False(t, check.IfNil(rtr))
+		assert.Nil(t, err)
+		assert.Empty(t, rtr.GetRounds())
+	})
+}
+
+func TestRoundTimelineRecorder_ValidatorRound(t *testing.T) {
+	t.Parallel()
+
+	publishedSummary := ""
+	args := createMockArgsRoundTimelineRecorder()
+	args.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+		SetStringValueHandler: func(key string, value string) {
+			if key == common.MetricConsensusLastRoundTimeline {
+				publishedSummary = value
+			}
+		},
+	}
+	rtr, now := createRecorderWithClock(t, args)
+
+	// the block is received before the node starts the round
+	*now = roundTimeStamp.Add(100 * time.Millisecond)
+	rtr.BlockReceived(10, []byte("hash"))
+	*now = roundTimeStamp.Add(150 * time.Millisecond)
+	rtr.StartRound(10, "leader", 4, 3, RoleValidator)
+	*now = roundTimeStamp.Add(400 * time.Millisecond)
+	rtr.BlockProcessed(10)
+	*now = roundTimeStamp.Add(450 * time.Millisecond)
+	rtr.SignatureSent(10)
+	*now = roundTimeStamp.Add(500 * time.Millisecond)
+	rtr.SignatureReceived(10, "validator1")
+	*now = roundTimeStamp.Add(700 * time.Millisecond)
+	rtr.SignatureReceived(10, "validator2")
+	rtr.SignatureReceived(10, "validator1")
+	*now = roundTimeStamp.Add(1000 * time.Millisecond)
+	rtr.FinalInfoReceived(10)
+	*now = roundTimeStamp.Add(1200 * time.Millisecond)
+	rtr.BlockCommitted(10)
+
+	rounds := rtr.GetRounds()
+	require.Equal(t, 1, len(rounds))
+	expected := &common.ConsensusRoundTimeline{
+		Round:               10,
+		RoundStartTimestamp: roundTimeStamp.Unix(),
+		Leader:              hex.EncodeToString([]byte("leader")),
+		SelfRole:            RoleValidator,
+		ConsensusGroupSize:  4,
+		BlockHash:           hex.EncodeToString([]byte("hash")),
+		BlockReceivedMs:     int64Ptr(100),
+		BlockProcessedMs:    int64Ptr(400),
+		SelfSignatureSentMs: int64Ptr(450),
+		Signatures: []common.ConsensusSignatureArrival{
+			{PubKey: hex.EncodeToString([]byte("validator1")), ReceivedMs: 500},
+			{PubKey: hex.EncodeToString([]byte("validator2")), ReceivedMs: 700},
+		},
+		MedianSignatureMs:   int64Ptr(600),
+		SignaturesThreshold: 3,
+		ThresholdReachedMs:  int64Ptr(700),
+		FinalInfoReceivedMs: int64Ptr(1000),
+		BlockCommittedMs:    int64Ptr(1200),
+		Outcome:             OutcomeCommitted,
+	}
+	assert.Equal(t, expected, rounds[0])
+	assert.Equal(t, "round 10 (validator): block +100ms, processed +400ms, signed +450ms (median +600ms), "+
+		"signatures 2/4, threshold +700ms, committed +1200ms, committed", publishedSummary)
+}
+
+func TestRoundTimelineRecorder_LeaderRound(t *testing.T) {
+	t.Parallel()
+
+	rtr, now := createRecorderWithClock(t, createMockArgsRoundTimelineRecorder())
+
+	rtr.StartRound(10, "self", 3, 3, RoleLeader)
+	*now = roundTimeStamp.Add(300 * time.Millisecond)
+	rtr.BlockProposed(10, []byte("hash"))
+	rtr.SignatureSent(10)
+	*now = roundTimeStamp.Add(600 * time.Millisecond)
+	rtr.SignatureReceived(10, "validator1")
+	*now = roundTimeStamp.Add(900 * time.Millisecond)
+	rtr.SignaturesThresholdReached(10)
+	*now = roundTimeStamp.Add(950 * time.Millisecond)
+	rtr.FinalInfoBroadcast(10)
+
+	rounds := rtr.GetRounds()
+	require.Equal(t, 1, len(rounds))
+	assert.Equal(t, int64Ptr(300), rounds[0].BlockProposedMs)
+	assert.Nil(t, rounds[0].BlockReceivedMs)
+	assert.Equal(t, int64Ptr(900), rounds[0].ThresholdReachedMs)
+	assert.Equal(t, int64Ptr(950), rounds[0].FinalInfoBroadcastMs)
+	assert.Equal(t, OutcomeInProgress, rounds[0].Outcome)
+}
+
+func TestRoundTimelineRecorder_NotCommittedRoundShouldPublishTheSummaryOnNextRound(t *testing.T) {
+	t.Parallel()
+
+	publishedSummaries := make([]string, 0)
+	args := createMockArgsRoundTimelineRecorder()
+	args.AppStatusHandler = &statusHandler.AppStatusHandlerStub{
+		SetStringValueHandler: func(key string, value string) {
+			publishedSummaries = append(publishedSummaries, value)
+		},
+	}
+	rtr, _ := createRecorderWithClock(t, args)
+
+	rtr.StartRound(10, "leader", 3, 3, RoleNotInConsensusGroup)
+	assert.Empty(t, publishedSummaries)
+
+	rtr.StartRound(11, "leader", 3, 3, RoleNotInConsensusGroup)
+	require.Equal(t, 1, len(publishedSummaries))
+	assert.Equal(t, "round 10 (notInConsensusGroup): block n/a, processed n/a, signed n/a (median n/a), "+
+		"signatures 0/3, threshold n/a, committed n/a, notCommitted", publishedSummaries[0])
+
+	rounds := rtr.GetRounds()
+	require.Equal(t, 2, len(rounds))
+	assert.Equal(t, OutcomeNotCommitted, rounds[0].Outcome)
+	assert.Equal(t, OutcomeInProgress, rounds[1].Outcome)
+	assert.Equal(t, roundTimeStamp.Add(roundDuration).Unix(), rounds[1].RoundStartTimestamp)
+
+	rtr.StartRound(12, "leader", 3, 3, RoleNotInConsensusGroup)
+	assert.Equal(t, 2, len(publishedSummaries))
+}
+
+func TestRoundTimelineRecorder_ShouldKeepOnlyTheLastRounds(t *testing.T) {
+	t.Parallel()
+
+	rtr, _ := createRecorderWithClock(t, createMockArgsRoundTimelineRecorder())
+
+	rtr.StartRound(12, "leader", 3, 3, RoleValidator)
+	rtr.StartRound(10, "leader", 3, 3, RoleValidator)
+	rtr.StartRound(11, "leader", 3, 3, RoleValidator)
+	rtr.StartRound(13, "leader", 3, 3, RoleValidator)
+	rtr.BlockReceived(9, []byte("hash"))
+
+	rounds := rtr.GetRounds()
+	require.Equal(t, 3, len(rounds))
+	assert.Equal(t, int64(11), rounds[0].Round)
+	assert.Equal(t, int64(12), rounds[1].Round)
+	assert.Equal(t, int64(13), rounds[2].Round)
+}
@@ -27,6 +27,7 @@ type FactoryArgs struct {
 	CurrentNetworkEpochProvider dataRetriever.CurrentNetworkEpochProviderHandler
 	PreferredPeersHolder        p2p.PreferredPeersHolderHandler
 	PeersRatingHandler          dataRetriever.PeersRatingHandler
+	MetricsRegistry             common.MetricsRegistry
 	SizeCheckDelta              uint32
 	IsFullHistoryNode           bool
 }
@@ -40,6 +40,7 @@ type baseResolversContainerFactory struct {
 	currentNetworkEpochProvider dataRetriever.CurrentNetworkEpochProviderHandler
 	preferredPeersHolder        dataRetriever.PreferredPeersHolderHandler
 	peersRatingHandler          dataRetriever.PeersRatingHandler
+	metricsRegistry             common.MetricsRegistry
 	numCrossShardPeers          int
 	numIntraShardPeers          int
 	numFullHistoryPeers         int
@@ -85,6 +86,9 @@ func (brcf *baseResolversContainerFactory) checkParams() error {
 	if check.IfNil(brcf.preferredPeersHolder) {
 		return dataRetriever.ErrNilPreferredPeersHolder
 	}
+	if check.IfNil(brcf.metricsRegistry) {
+		return dataRetriever.ErrNilMetricsRegistry
+	}
 	if check.IfNil(brcf.peersRatingHandler) {
 		return dataRetriever.ErrNilPeersRatingHandler
 	}
@@ -163,6 +167,7 @@ func (brcf *baseResolversContainerFactory) createTxResolver(
 		DataPacker:        brcf.dataPacker,
 		AntifloodHandler:  brcf.inputAntifloodHandler,
 		Throttler:         brcf.throttler,
+		MetricsRegistry:   brcf.metricsRegistry,
 		IsFullHistoryNode: brcf.isFullHistoryNode,
 	}
 	resolver, err := resolvers.NewTxResolver(arg)
@@ -239,6 +244,7 @@ func (brcf *baseResolversContainerFactory) createMiniBlocksResolver(
 		Marshalizer:       brcf.marshalizer,
 		AntifloodHandler:  brcf.inputAntifloodHandler,
 		Throttler:         brcf.throttler,
+		MetricsRegistry:   brcf.metricsRegistry,
 		DataPacker:        brcf.dataPacker,
 		IsFullHistoryNode: brcf.isFullHistoryNode,
 	}
@@ -344,6 +350,7 @@ func (brcf *baseResolversContainerFactory) createTrieNodesResolver(
 		Marshalizer:      brcf.marshalizer,
 		AntifloodHandler: brcf.inputAntifloodHandler,
 		Throttler:        brcf.throttler,
+		MetricsRegistry:  brcf.metricsRegistry,
 	}
 	resolver, err := resolvers.NewTrieNodeResolver(argTrie)
 	if err != nil {
@@ -52,6 +52,7 @@ func NewMetaResolversContainerFactory(
 		isFullHistoryNode:           args.IsFullHistoryNode,
 		currentNetworkEpochProvider: args.CurrentNetworkEpochProvider,
 		preferredPeersHolder:        args.PreferredPeersHolder,
+		metricsRegistry:             args.MetricsRegistry,
 		peersRatingHandler:          args.PeersRatingHandler,
 		numCrossShardPeers:          int(args.ResolverConfig.NumCrossShardPeers),
 		numIntraShardPeers:          int(args.ResolverConfig.NumIntraShardPeers),
@@ -206,6 +207,7 @@ func (mrcf *metaResolversContainerFactory) createShardHeaderResolver(
 		ShardCoordinator:     mrcf.shardCoordinator,
 		AntifloodHandler:     mrcf.inputAntifloodHandler,
 		Throttler:            mrcf.throttler,
+		MetricsRegistry:      mrcf.metricsRegistry,
 		IsFullHistoryNode:    mrcf.isFullHistoryNode,
 	}
 	resolver, err := resolvers.NewHeaderResolver(arg)
@@ -255,6 +257,7 @@ func (mrcf *metaResolversContainerFactory) createMetaChainHeaderResolver(
 		ShardCoordinator:     mrcf.shardCoordinator,
 		AntifloodHandler:     mrcf.inputAntifloodHandler,
 		Throttler:            mrcf.throttler,
+		MetricsRegistry:      mrcf.metricsRegistry,
 		IsFullHistoryNode:    mrcf.isFullHistoryNode,
 	}
 	resolver, err := resolvers.NewHeaderResolver(arg)
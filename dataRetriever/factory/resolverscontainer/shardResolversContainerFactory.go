@@ -50,6 +50,7 @@ func NewShardResolversContainerFactory(
 		isFullHistoryNode:           args.IsFullHistoryNode,
 		currentNetworkEpochProvider: args.CurrentNetworkEpochProvider,
 		preferredPeersHolder:        args.PreferredPeersHolder,
+		metricsRegistry:             args.MetricsRegistry,
 		peersRatingHandler:          args.PeersRatingHandler,
 		numCrossShardPeers:          int(args.ResolverConfig.NumCrossShardPeers),
 		numIntraShardPeers:          int(args.ResolverConfig.NumIntraShardPeers),
@@ -147,6 +148,7 @@ func (srcf *shardResolversContainerFactory) generateHeaderResolvers() error {
 		ShardCoordinator:     srcf.shardCoordinator,
 		AntifloodHandler:     srcf.inputAntifloodHandler,
 		Throttler:            srcf.throttler,
+		MetricsRegistry:      srcf.metricsRegistry,
 		IsFullHistoryNode:    srcf.isFullHistoryNode,
 	}
 	resolver, err := resolvers.NewHeaderResolver(arg)
@@ -186,6 +188,7 @@ func (srcf *shardResolversContainerFactory) generateMetablockHeaderResolvers() e
 		ShardCoordinator:     srcf.shardCoordinator,
 		AntifloodHandler:     srcf.inputAntifloodHandler,
 		Throttler:            srcf.throttler,
+		MetricsRegistry:      srcf.metricsRegistry,
 		IsFullHistoryNode:    srcf.isFullHistoryNode,
 	}
 	resolver, err := resolvers.NewHeaderResolver(arg)
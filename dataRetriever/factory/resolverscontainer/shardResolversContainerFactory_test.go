@@ -197,6 +197,17 @@ func TestNewShardResolversContainerFactory_NilPreferredPeersHolderShouldErr(t *t
 	assert.Equal(t, dataRetriever.ErrNilPreferredPeersHolder, err)
 }
 
+func TestNewShardResolversContainerFactory_NilMetricsRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.MetricsRegistry = nil
+	rcf, err := resolverscontainer.NewShardResolversContainerFactory(args)
+
+	assert.Nil(t, rcf)
+	assert.Equal(t, dataRetriever.ErrNilMetricsRegistry, err)
+}
+
 func TestNewShardResolversContainerFactory_NilPeersRatingHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -376,6 +387,7 @@ func getArgumentsShard() resolverscontainer.FactoryArgs {
 		NumConcurrentResolvingJobs:  10,
 		CurrentNetworkEpochProvider: &mock.CurrentNetworkEpochProviderStub{},
 		PreferredPeersHolder:        &p2pmocks.PeersHolderStub{},
+		MetricsRegistry:             &testscommon.MetricsRegistryStub{},
 		ResolverConfig: config.ResolverConfig{
 			NumCrossShardPeers:  1,
 			NumIntraShardPeers:  2,
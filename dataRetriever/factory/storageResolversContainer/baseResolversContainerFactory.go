@@ -222,6 +222,7 @@ func (brcf *baseResolversContainerFactory) newImportDBTrieStorage(
 		CheckpointsEnabled: brcf.generalConfig.StateTriesConfig.CheckpointsEnabled,
 		MaxTrieLevelInMem:  brcf.generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
 		IdleProvider:       disabled.NewProcessStatusHandler(),
+		MetricsRegistry:    disabled.NewMetricsRegistry(),
 	}
 	return trieFactoryInstance.Create(args)
 }
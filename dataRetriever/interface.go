@@ -45,6 +45,14 @@ func (ut UnitType) String() string {
 		return "TrieEpochRootHashUnit"
 	case ScheduledSCRsUnit:
 		return "ScheduledSCRsUnit"
+	case SlashingEvidenceUnit:
+		return "SlashingEvidenceUnit"
+	case ValidatorHistoryUnit:
+		return "ValidatorHistoryUnit"
+	case ESDTHoldersUnit:
+		return "ESDTHoldersUnit"
+	case EventsIndexUnit:
+		return "EventsIndexUnit"
 	}
 
 	if ut < ShardHdrNonceHashDataUnit {
@@ -105,6 +113,14 @@ const (
 	PeerAccountsCheckpointsUnit UnitType = 23
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 24
+	// SlashingEvidenceUnit is the slashing evidence storage unit identifier
+	SlashingEvidenceUnit UnitType = 25
+	// ValidatorHistoryUnit is the validator rating and signing history storage unit identifier
+	ValidatorHistoryUnit UnitType = 26
+	// ESDTHoldersUnit is the ESDT holders and NFT collections index storage unit identifier
+	ESDTHoldersUnit UnitType = 27
+	// EventsIndexUnit is the events by address, identifier and topic index storage unit identifier
+	EventsIndexUnit UnitType = 28
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	//TODO: Add only unit types lower than 100
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers/epochproviders/disabled"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -28,6 +29,7 @@ type ArgHeaderResolver struct {
 	ShardCoordinator     sharding.Coordinator
 	AntifloodHandler     dataRetriever.P2PAntifloodHandler
 	Throttler            dataRetriever.ResolverThrottler
+	MetricsRegistry      common.MetricsRegistry
 	IsFullHistoryNode    bool
 }
 
@@ -72,6 +74,9 @@ func NewHeaderResolver(arg ArgHeaderResolver) (*HeaderResolver, error) {
 	if check.IfNil(arg.Throttler) {
 		return nil, dataRetriever.ErrNilThrottler
 	}
+	if check.IfNil(arg.MetricsRegistry) {
+		return nil, dataRetriever.ErrNilMetricsRegistry
+	}
 
 	epochHandler := disabled.NewEpochHandler()
 	hdrResolver := &HeaderResolver{
@@ -87,6 +92,7 @@ func NewHeaderResolver(arg ArgHeaderResolver) (*HeaderResolver, error) {
 			antifloodHandler: arg.AntifloodHandler,
 			topic:            arg.SenderResolver.RequestTopic(),
 			throttler:        arg.Throttler,
+			metricsRegistry:  arg.MetricsRegistry,
 		},
 	}
 
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
 	"github.com/stretchr/testify/assert"
 )
@@ -28,6 +29,7 @@ func createMockArgHeaderResolver() resolvers.ArgHeaderResolver {
 		ShardCoordinator:     mock.NewOneShardCoordinatorMock(),
 		AntifloodHandler:     &mock.P2PAntifloodHandlerStub{},
 		Throttler:            &mock.ThrottlerStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 }
 
@@ -134,6 +136,17 @@ func TestNewHeaderResolver_NilThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, hdrRes)
 }
 
+func TestNewHeaderResolver_NilMetricsRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgHeaderResolver()
+	arg.MetricsRegistry = nil
+	hdrRes, err := resolvers.NewHeaderResolver(arg)
+
+	assert.Equal(t, dataRetriever.ErrNilMetricsRegistry, err)
+	assert.Nil(t, hdrRes)
+}
+
 func TestNewHeaderResolver_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
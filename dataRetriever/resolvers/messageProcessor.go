@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
 )
 
 // messageProcessor is used for basic message validity and parsing
@@ -16,6 +17,7 @@ type messageProcessor struct {
 	marshalizer      marshal.Marshalizer
 	antifloodHandler dataRetriever.P2PAntifloodHandler
 	throttler        dataRetriever.ResolverThrottler
+	metricsRegistry  common.MetricsRegistry
 	topic            string
 }
 
@@ -23,6 +25,8 @@ func (mp *messageProcessor) canProcessMessage(message p2p.MessageP2P, fromConnec
 	if check.IfNil(message) {
 		return dataRetriever.ErrNilMessage
 	}
+	mp.metricsRegistry.IncrementCounter(prometheus.ResolverRequests, mp.topic)
+
 	err := mp.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
 	if err != nil {
 		return fmt.Errorf("%w on resolver topic %s", err, mp.topic)
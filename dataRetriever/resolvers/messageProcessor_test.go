@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -22,6 +23,7 @@ func TestMessageProcessor_CanProcessErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	mp := &messageProcessor{
+		metricsRegistry: &testscommon.MetricsRegistryStub{},
 		antifloodHandler: &mock.P2PAntifloodHandlerStub{
 			CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 				return expectedErr
@@ -39,6 +41,7 @@ func TestMessageProcessor_CanProcessOnTopicErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	mp := &messageProcessor{
+		metricsRegistry: &testscommon.MetricsRegistryStub{},
 		antifloodHandler: &mock.P2PAntifloodHandlerStub{
 			CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 				return nil
@@ -59,6 +62,7 @@ func TestMessageProcessor_CanProcessThrottlerNotAllowingShouldErr(t *testing.T)
 
 	canProcessWasCalled := false
 	mp := &messageProcessor{
+		metricsRegistry: &testscommon.MetricsRegistryStub{},
 		antifloodHandler: &mock.P2PAntifloodHandlerStub{
 			CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 				return nil
@@ -86,6 +90,7 @@ func TestMessageProcessor_CanProcessShouldWork(t *testing.T) {
 
 	canProcessWasCalled := false
 	mp := &messageProcessor{
+		metricsRegistry: &testscommon.MetricsRegistryStub{},
 		antifloodHandler: &mock.P2PAntifloodHandlerStub{
 			CanProcessMessageCalled: func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 				return nil
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/batch"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -25,6 +26,7 @@ type ArgMiniblockResolver struct {
 	Marshalizer       marshal.Marshalizer
 	AntifloodHandler  dataRetriever.P2PAntifloodHandler
 	Throttler         dataRetriever.ResolverThrottler
+	MetricsRegistry   common.MetricsRegistry
 	DataPacker        dataRetriever.DataPacker
 	IsFullHistoryNode bool
 }
@@ -59,6 +61,9 @@ func NewMiniblockResolver(arg ArgMiniblockResolver) (*miniblockResolver, error)
 	if check.IfNil(arg.Throttler) {
 		return nil, dataRetriever.ErrNilThrottler
 	}
+	if check.IfNil(arg.MetricsRegistry) {
+		return nil, dataRetriever.ErrNilMetricsRegistry
+	}
 	if check.IfNil(arg.DataPacker) {
 		return nil, dataRetriever.ErrNilDataPacker
 	}
@@ -73,6 +78,7 @@ func NewMiniblockResolver(arg ArgMiniblockResolver) (*miniblockResolver, error)
 			antifloodHandler: arg.AntifloodHandler,
 			topic:            arg.SenderResolver.RequestTopic(),
 			throttler:        arg.Throttler,
+			metricsRegistry:  arg.MetricsRegistry,
 		},
 	}
 
@@ -29,6 +29,7 @@ func createMockArgMiniblockResolver() resolvers.ArgMiniblockResolver {
 		Marshalizer:      &mock.MarshalizerMock{},
 		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
 		Throttler:        &mock.ThrottlerStub{},
+		MetricsRegistry:  &testscommon.MetricsRegistryStub{},
 		DataPacker:       &mock.DataPackerStub{},
 	}
 }
@@ -101,6 +102,17 @@ func TestNewMiniblockResolver_NilThrottlerShouldErr(t *testing.T) {
 	assert.True(t, check.IfNil(mbRes))
 }
 
+func TestNewMiniblockResolver_NilMetricsRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgMiniblockResolver()
+	arg.MetricsRegistry = nil
+	mbRes, err := resolvers.NewMiniblockResolver(arg)
+
+	assert.Equal(t, dataRetriever.ErrNilMetricsRegistry, err)
+	assert.True(t, check.IfNil(mbRes))
+}
+
 func TestNewMiniblockResolver_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
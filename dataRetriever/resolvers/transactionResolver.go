@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/batch"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/requestHandlers"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -32,6 +33,7 @@ type ArgTxResolver struct {
 	DataPacker        dataRetriever.DataPacker
 	AntifloodHandler  dataRetriever.P2PAntifloodHandler
 	Throttler         dataRetriever.ResolverThrottler
+	MetricsRegistry   common.MetricsRegistry
 	IsFullHistoryNode bool
 }
 
@@ -67,6 +69,9 @@ func NewTxResolver(arg ArgTxResolver) (*TxResolver, error) {
 	if check.IfNil(arg.Throttler) {
 		return nil, dataRetriever.ErrNilThrottler
 	}
+	if check.IfNil(arg.MetricsRegistry) {
+		return nil, dataRetriever.ErrNilMetricsRegistry
+	}
 
 	txResolver := &TxResolver{
 		TopicResolverSender: arg.SenderResolver,
@@ -78,6 +83,7 @@ func NewTxResolver(arg ArgTxResolver) (*TxResolver, error) {
 			antifloodHandler: arg.AntifloodHandler,
 			topic:            arg.SenderResolver.RequestTopic(),
 			throttler:        arg.Throttler,
+			metricsRegistry:  arg.MetricsRegistry,
 		},
 	}
 
@@ -30,6 +30,7 @@ func createMockArgTxResolver() resolvers.ArgTxResolver {
 		DataPacker:       &mock.DataPackerStub{},
 		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
 		Throttler:        &mock.ThrottlerStub{},
+		MetricsRegistry:  &testscommon.MetricsRegistryStub{},
 	}
 }
 
@@ -112,6 +113,17 @@ func TestNewTxResolver_NilThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, txRes)
 }
 
+func TestNewTxResolver_NilMetricsRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTxResolver()
+	arg.MetricsRegistry = nil
+	txRes, err := resolvers.NewTxResolver(arg)
+
+	assert.Equal(t, dataRetriever.ErrNilMetricsRegistry, err)
+	assert.Nil(t, txRes)
+}
+
 func TestNewTxResolver_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/batch"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 )
@@ -20,6 +21,7 @@ type ArgTrieNodeResolver struct {
 	Marshalizer      marshal.Marshalizer
 	AntifloodHandler dataRetriever.P2PAntifloodHandler
 	Throttler        dataRetriever.ResolverThrottler
+	MetricsRegistry  common.MetricsRegistry
 }
 
 // TrieNodeResolver is a wrapper over Resolver that is specialized in resolving trie node requests
@@ -46,6 +48,9 @@ func NewTrieNodeResolver(arg ArgTrieNodeResolver) (*TrieNodeResolver, error) {
 	if check.IfNil(arg.Throttler) {
 		return nil, dataRetriever.ErrNilThrottler
 	}
+	if check.IfNil(arg.MetricsRegistry) {
+		return nil, dataRetriever.ErrNilMetricsRegistry
+	}
 
 	return &TrieNodeResolver{
 		TopicResolverSender: arg.SenderResolver,
@@ -55,6 +60,7 @@ func NewTrieNodeResolver(arg ArgTrieNodeResolver) (*TrieNodeResolver, error) {
 			antifloodHandler: arg.AntifloodHandler,
 			topic:            arg.SenderResolver.RequestTopic(),
 			throttler:        arg.Throttler,
+			metricsRegistry:  arg.MetricsRegistry,
 		},
 	}, nil
 }
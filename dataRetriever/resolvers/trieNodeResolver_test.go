@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/resolvers"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,6 +29,7 @@ func createMockArgTrieNodeResolver() resolvers.ArgTrieNodeResolver {
 		Marshalizer:      &mock.MarshalizerMock{},
 		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
 		Throttler:        &mock.ThrottlerStub{},
+		MetricsRegistry:  &testscommon.MetricsRegistryStub{},
 	}
 }
 
@@ -86,6 +88,17 @@ func TestNewTrieNodeResolver_NilThrottlerShouldErr(t *testing.T) {
 	assert.Nil(t, tnRes)
 }
 
+func TestNewTrieNodeResolver_NilMetricsRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTrieNodeResolver()
+	arg.MetricsRegistry = nil
+	tnRes, err := resolvers.NewTrieNodeResolver(arg)
+
+	assert.Equal(t, dataRetriever.ErrNilMetricsRegistry, err)
+	assert.Nil(t, tnRes)
+}
+
 func TestNewTrieNodeResolver_OkValsShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,38 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/eventsIndex"
+)
+
+var errorDisabledEventsIndex = errors.New("events index is disabled")
+
+type eventsIndexer struct {
+}
+
+// NewDisabledEventsIndexer returns an events indexer that does not index anything
+func NewDisabledEventsIndexer() *eventsIndexer {
+	return &eventsIndexer{}
+}
+
+// ProcessLogs does nothing
+func (ei *eventsIndexer) ProcessLogs(_ uint64, _ []*data.LogData) error {
+	return nil
+}
+
+// RevertChanges does nothing
+func (ei *eventsIndexer) RevertChanges(_ data.HeaderHandler, _ data.BodyHandler) error {
+	return nil
+}
+
+// GetEvents returns the disabled events index error
+func (ei *eventsIndexer) GetEvents(_ eventsIndex.EventsQuery, _ uint32, _ uint32) (*eventsIndex.EventsPage, error) {
+	return nil, errorDisabledEventsIndex
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ei *eventsIndexer) IsInterfaceNil() bool {
+	return ei == nil
+}
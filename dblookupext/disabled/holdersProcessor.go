@@ -0,0 +1,49 @@
+package disabled
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+)
+
+var errorDisabledHoldersIndex = errors.New("esdt holders index is disabled")
+
+type holdersProcessor struct {
+}
+
+// NewDisabledHoldersProcessor returns a holders processor that does not index anything
+func NewDisabledHoldersProcessor() *holdersProcessor {
+	return &holdersProcessor{}
+}
+
+// ProcessLogs does nothing
+func (hp *holdersProcessor) ProcessLogs(_ uint64, _ []*data.LogData) error {
+	return nil
+}
+
+// RevertChanges does nothing
+func (hp *holdersProcessor) RevertChanges(_ data.HeaderHandler, _ data.BodyHandler) error {
+	return nil
+}
+
+// GetESDTHolders returns the disabled holders index error
+func (hp *holdersProcessor) GetESDTHolders(_ string, _ uint32, _ uint32) ([]*esdtSupply.HolderBalance, uint32, error) {
+	return nil, 0, errorDisabledHoldersIndex
+}
+
+// GetCollectionNFTs returns the disabled holders index error
+func (hp *holdersProcessor) GetCollectionNFTs(_ string, _ uint32, _ uint32) ([]*esdtSupply.NFTHolders, uint32, error) {
+	return nil, 0, errorDisabledHoldersIndex
+}
+
+// GetWipedBalance returns nil as the wiped balances are not known
+func (hp *holdersProcessor) GetWipedBalance(_ uint64, _ []byte, _ []byte) (*big.Int, error) {
+	return nil, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hp *holdersProcessor) IsInterfaceNil() bool {
+	return hp == nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/eventsIndex"
 )
 
 var errorDisabledHistoryRepository = errors.New("history repository is disabled")
@@ -52,6 +53,21 @@ func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (*esdtSupply.SupplyESDT
 	return nil, errorDisabledHistoryRepository
 }
 
+// GetESDTHolders -
+func (nhr *nilHistoryRepository) GetESDTHolders(_ string, _ uint32, _ uint32) ([]*esdtSupply.HolderBalance, uint32, error) {
+	return nil, 0, errorDisabledHistoryRepository
+}
+
+// GetCollectionNFTs -
+func (nhr *nilHistoryRepository) GetCollectionNFTs(_ string, _ uint32, _ uint32) ([]*esdtSupply.NFTHolders, uint32, error) {
+	return nil, 0, errorDisabledHistoryRepository
+}
+
+// GetEvents -
+func (nhr *nilHistoryRepository) GetEvents(_ eventsIndex.EventsQuery, _ uint32, _ uint32) (*eventsIndex.EventsPage, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // GetResultsHashesByTxHash -
 func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
 	return nil, nil
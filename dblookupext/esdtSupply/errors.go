@@ -3,3 +3,7 @@ package esdtSupply
 import "errors"
 
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
+
+var errNilShardCoordinator = errors.New("nil shard coordinator")
+
+var errNilWipedBalancesHandler = errors.New("nil wiped balances handler")
@@ -21,11 +21,13 @@ type suppliesProcessor struct {
 	mutex    sync.Mutex
 }
 
-// NewSuppliesProcessor will create a new instance of the supplies processor
+// NewSuppliesProcessor will create a new instance of the supplies processor. The supplies indexed with older rules by
+// the provided storer are upgraded to the current ones
 func NewSuppliesProcessor(
 	marshalizer marshal.Marshalizer,
 	suppliesStorer storage.Storer,
 	logsStorer storage.Storer,
+	wipedBalancesHandler WipedBalancesHandler,
 ) (*suppliesProcessor, error) {
 	if check.IfNil(marshalizer) {
 		return nil, core.ErrNilMarshalizer
@@ -36,9 +38,16 @@ func NewSuppliesProcessor(
 	if check.IfNil(logsStorer) {
 		return nil, core.ErrNilStore
 	}
+	if check.IfNil(wipedBalancesHandler) {
+		return nil, errNilWipedBalancesHandler
+	}
 
 	logsGet := newLogsGetter(marshalizer, logsStorer)
-	logsProc := newLogsProcessor(marshalizer, suppliesStorer)
+	logsProc := newLogsProcessor(marshalizer, suppliesStorer, wipedBalancesHandler)
+	err := logsProc.checkSuppliesVersion()
+	if err != nil {
+		return nil, err
+	}
 
 	return &suppliesProcessor{
 		logsProc: logsProc,
@@ -78,7 +87,8 @@ func (sp *suppliesProcessor) RevertChanges(header data.HeaderHandler, body data.
 	return sp.logsProc.processLogs(header.GetNonce(), logsFromDB, true)
 }
 
-// GetESDTSupply will return the supply from the storage for the given token
+// GetESDTSupply will return the supply from the storage for the given token. The token can be a fungible token, a
+// collection-nonce identifier or a collection, in which case the aggregated supply of all its nonces is returned
 func (sp *suppliesProcessor) GetESDTSupply(token string) (*SupplyESDT, error) {
 	return sp.logsProc.getESDTSupply([]byte(token))
 }
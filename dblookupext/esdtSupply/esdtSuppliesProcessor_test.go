@@ -30,21 +30,184 @@ const (
 func TestNewSuppliesProcessor(t *testing.T) {
 	t.Parallel()
 
-	_, err := NewSuppliesProcessor(nil, &storageStubs.StorerStub{}, &storageStubs.StorerStub{})
+	_, err := NewSuppliesProcessor(nil, &storageStubs.StorerStub{}, &storageStubs.StorerStub{}, &wipedBalancesHandlerStub{})
 	require.Equal(t, core.ErrNilMarshalizer, err)
 
-	_, err = NewSuppliesProcessor(&testscommon.MarshalizerMock{}, nil, &storageStubs.StorerStub{})
+	_, err = NewSuppliesProcessor(&testscommon.MarshalizerMock{}, nil, &storageStubs.StorerStub{}, &wipedBalancesHandlerStub{})
 	require.Equal(t, core.ErrNilStore, err)
 
-	_, err = NewSuppliesProcessor(&testscommon.MarshalizerMock{}, &storageStubs.StorerStub{}, nil)
+	_, err = NewSuppliesProcessor(&testscommon.MarshalizerMock{}, &storageStubs.StorerStub{}, nil, &wipedBalancesHandlerStub{})
 	require.Equal(t, core.ErrNilStore, err)
 
-	proc, err := NewSuppliesProcessor(&testscommon.MarshalizerMock{}, &storageStubs.StorerStub{}, &storageStubs.StorerStub{})
+	_, err = NewSuppliesProcessor(&testscommon.MarshalizerMock{}, &storageStubs.StorerStub{}, &storageStubs.StorerStub{}, nil)
+	require.Equal(t, errNilWipedBalancesHandler, err)
+
+	proc, err := NewSuppliesProcessor(&testscommon.MarshalizerMock{}, testscommon.CreateMemUnit(), &storageStubs.StorerStub{}, &wipedBalancesHandlerStub{})
 	require.Nil(t, err)
 	require.NotNil(t, proc)
 	require.False(t, proc.IsInterfaceNil())
 }
 
+func createCurrentSuppliesVersionBytes(marshalizer marshal.Marshalizer) []byte {
+	versionBytes, _ := marshalizer.Marshal(&SuppliesVersion{Version: currentSuppliesVersion})
+
+	return versionBytes
+}
+
+func TestNewSuppliesProcessor_ShouldMarkAnEmptyDatabaseWithTheCurrentVersion(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := testscommon.MarshalizerMock{}
+	suppliesStorer := testscommon.CreateMemUnit()
+	_, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{}, &wipedBalancesHandlerStub{})
+	require.Nil(t, err)
+	versionBytes, err := suppliesStorer.Get([]byte(suppliesVersionKey))
+	require.Nil(t, err)
+	require.Equal(t, createCurrentSuppliesVersionBytes(marshalizer), versionBytes, "an empty database should be marked")
+
+	_, err = NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{}, &wipedBalancesHandlerStub{})
+	require.Nil(t, err)
+
+}
+
+func TestNewSuppliesProcessor_ShouldUpgradeTheSuppliesIndexedByAnOlderVersion(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := testscommon.MarshalizerMock{}
+	suppliesStorer := testscommon.CreateMemUnit()
+	putSupply := func(key string, supply int64, minted int64, burned int64) {
+		supplyBytes, _ := marshalizer.Marshal(&SupplyESDT{
+			Supply: big.NewInt(supply),
+			Minted: big.NewInt(minted),
+			Burned: big.NewInt(burned),
+		})
+		_ = suppliesStorer.Put([]byte(key), supplyBytes)
+	}
+
+	// the older versions did not store the supplies version nor the supplies of the collections
+	processedBlockBytes, _ := marshalizer.Marshal(&ProcessedBlockNonce{Nonce: 5})
+	_ = suppliesStorer.Put([]byte(processedBlockKey), processedBlockBytes)
+	putSupply("TKN-1q2w3e", 100, 150, 50)
+	putSupply("NFT-1q2w3e-01", 10, 10, 0)
+	putSupply("NFT-1q2w3e-02", 5, 20, 15)
+
+	proc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{}, &wipedBalancesHandlerStub{})
+	require.Nil(t, err)
+
+	versionBytes, err := suppliesStorer.Get([]byte(suppliesVersionKey))
+	require.Nil(t, err)
+	require.Equal(t, createCurrentSuppliesVersionBytes(marshalizer), versionBytes)
+
+	requireSupply := func(token string, supply int64, minted int64, burned int64) {
+		supplyESDT, errGet := proc.GetESDTSupply(token)
+		require.Nil(t, errGet)
+		require.Equal(t, &SupplyESDT{
+			Supply:  big.NewInt(supply),
+			Minted:  big.NewInt(minted),
+			Burned:  big.NewInt(burned),
+			Partial: true,
+		}, supplyESDT)
+	}
+	requireSupply("TKN-1q2w3e", 100, 150, 50)
+	requireSupply("NFT-1q2w3e-01", 10, 10, 0)
+	requireSupply("NFT-1q2w3e-02", 5, 20, 15)
+	requireSupply("NFT-1q2w3e", 15, 30, 15)
+
+	err = proc.ProcessLogs(6, []*data.LogData{
+		createLogData("tx0", createESDTEvent(core.BuiltInFunctionESDTNFTAddQuantity, testCollection, 1, 5, testAlice)),
+	})
+	require.Nil(t, err)
+	requireSupply("NFT-1q2w3e-01", 15, 15, 0)
+	requireSupply("NFT-1q2w3e", 20, 35, 15)
+
+	// the upgrade is done only once
+	putSupply("NFT-1q2w3e-03", 1, 1, 0)
+	proc, err = NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{}, &wipedBalancesHandlerStub{})
+	require.Nil(t, err)
+	requireSupply("NFT-1q2w3e", 20, 35, 15)
+}
+
+func TestSuppliesProcessor_WipeShouldSubtractTheWipedBalance(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := testscommon.MarshalizerMock{}
+	logsStorer := genericMocks.NewStorerMockWithErrKeyNotFound("", 0)
+	wipedBalances := &wipedBalancesHandlerStub{
+		GetWipedBalanceCalled: func(blockNonce uint64, token []byte, address []byte) (*big.Int, error) {
+			if blockNonce == 7 && string(token) == string(testCollection)+"-02" && string(address) == string(testBob) {
+				return big.NewInt(4), nil
+			}
+
+			return nil, nil
+		},
+	}
+	proc, _ := NewSuppliesProcessor(marshalizer, testscommon.CreateMemUnit(), logsStorer, wipedBalances)
+
+	requireSupply := func(token string, supply int64, minted int64, burned int64, partial bool) {
+		supplyESDT, errGet := proc.GetESDTSupply(token)
+		require.Nil(t, errGet)
+		require.Equal(t, &SupplyESDT{
+			Supply:  big.NewInt(supply),
+			Minted:  big.NewInt(minted),
+			Burned:  big.NewInt(burned),
+			Partial: partial,
+		}, supplyESDT)
+	}
+
+	err := proc.ProcessLogs(6, []*data.LogData{
+		createLogData("tx0", createESDTEvent(core.BuiltInFunctionESDTNFTCreate, testCollection, 2, 10, testAlice, []byte("esdt data"))),
+		createLogData("tx1", createESDTEvent(core.BuiltInFunctionESDTLocalMint, testToken, 0, 100, testAlice)),
+	})
+	require.Nil(t, err)
+
+	wipeLog := &transaction.Log{
+		Events: []*transaction.Event{
+			createESDTEvent(core.BuiltInFunctionESDTWipe, testCollection, 2, 0, testCarol, testBob),
+		},
+	}
+	wipeLogBytes, _ := marshalizer.Marshal(wipeLog)
+	_ = logsStorer.Put([]byte("txHash2"), wipeLogBytes)
+
+	err = proc.ProcessLogs(7, []*data.LogData{{TxHash: "txHash2", LogHandler: wipeLog}})
+	require.Nil(t, err)
+	requireSupply(string(testCollection)+"-02", 6, 10, 4, false)
+	requireSupply(string(testCollection), 6, 10, 4, false)
+
+	err = proc.RevertChanges(&block.Header{Nonce: 7}, &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("txHash2")}},
+		},
+	})
+	require.Nil(t, err)
+	requireSupply(string(testCollection)+"-02", 10, 10, 0, false)
+	requireSupply(string(testCollection), 10, 10, 0, false)
+
+	// the balance wiped from bob is not known
+	err = proc.ProcessLogs(8, []*data.LogData{
+		createLogData("tx3", createESDTEvent(core.BuiltInFunctionESDTWipe, testToken, 0, 0, testCarol, testBob)),
+	})
+	require.Nil(t, err)
+	requireSupply(string(testToken), 100, 100, 0, true)
+}
+
+type wipedBalancesHandlerStub struct {
+	GetWipedBalanceCalled func(blockNonce uint64, token []byte, address []byte) (*big.Int, error)
+}
+
+// GetWipedBalance -
+func (stub *wipedBalancesHandlerStub) GetWipedBalance(blockNonce uint64, token []byte, address []byte) (*big.Int, error) {
+	if stub.GetWipedBalanceCalled != nil {
+		return stub.GetWipedBalanceCalled(blockNonce, token, address)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *wipedBalancesHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
 func TestProcessLogsSaveSupply(t *testing.T) {
 	t.Parallel()
 
@@ -87,6 +250,9 @@ func TestProcessLogsSaveSupply(t *testing.T) {
 	marshalizer := testscommon.MarshalizerMock{}
 	suppliesStorer := &storageStubs.StorerStub{
 		GetCalled: func(key []byte) ([]byte, error) {
+			if string(key) == suppliesVersionKey {
+				return createCurrentSuppliesVersionBytes(marshalizer), nil
+			}
 			if string(key) == "processed-block" {
 				pbn := ProcessedBlockNonce{Nonce: 5}
 				pbnB, _ := marshalizer.Marshal(pbn)
@@ -101,7 +267,8 @@ func TestProcessLogsSaveSupply(t *testing.T) {
 			}
 
 			supplyKey := string(token) + "-" + hex.EncodeToString(big.NewInt(2).Bytes())
-			require.Equal(t, supplyKey, string(key))
+			collectionKey := string(token)
+			require.Contains(t, []string{supplyKey, collectionKey}, string(key))
 
 			var supplyESDT SupplyESDT
 			_ = marshalizer.Unmarshal(&supplyESDT, data)
@@ -113,7 +280,7 @@ func TestProcessLogsSaveSupply(t *testing.T) {
 		},
 	}
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{})
+	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{}, &wipedBalancesHandlerStub{})
 	require.Nil(t, err)
 
 	err = suppliesProc.ProcessLogs(6, logs)
@@ -196,6 +363,9 @@ func TestProcessLogsSaveSupplyShouldUpdateSupplyMintedAndBurned(t *testing.T) {
 	numTimesCalled := 0
 	suppliesStorer := &storageStubs.StorerStub{
 		GetCalled: func(key []byte) ([]byte, error) {
+			if string(key) == suppliesVersionKey {
+				return createCurrentSuppliesVersionBytes(marshalizer), nil
+			}
 			if string(key) == "processed-block" {
 				pbn := ProcessedBlockNonce{Nonce: 5}
 				pbnB, _ := marshalizer.Marshal(pbn)
@@ -242,7 +412,7 @@ func TestProcessLogsSaveSupplyShouldUpdateSupplyMintedAndBurned(t *testing.T) {
 		},
 	}
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{})
+	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{}, &wipedBalancesHandlerStub{})
 	require.Nil(t, err)
 
 	err = suppliesProc.ProcessLogs(6, logsCreate)
@@ -318,7 +488,7 @@ func TestProcessLogs_RevertChangesShouldWorkForRevertingMinting(t *testing.T) {
 
 	suppliesStorer := genericMocks.NewStorerMockWithErrKeyNotFound("", 0)
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer)
+	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer, &wipedBalancesHandlerStub{})
 	require.Nil(t, err)
 
 	err = suppliesProc.ProcessLogs(6, logsMintNoRevert)
@@ -409,7 +579,7 @@ func TestProcessLogs_RevertChangesShouldWorkForRevertingBurning(t *testing.T) {
 
 	suppliesStorer := genericMocks.NewStorerMockWithErrKeyNotFound("", 0)
 
-	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer)
+	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer, &wipedBalancesHandlerStub{})
 	require.Nil(t, err)
 
 	err = suppliesProc.ProcessLogs(6, logsMintNoRevert)
@@ -475,13 +645,16 @@ func TestSupplyESDT_GetSupply(t *testing.T) {
 	marshalizer := &testscommon.MarshalizerMock{}
 	proc, _ := NewSuppliesProcessor(marshalizer, &storageStubs.StorerStub{
 		GetCalled: func(key []byte) ([]byte, error) {
+			if string(key) == suppliesVersionKey {
+				return createCurrentSuppliesVersionBytes(marshalizer), nil
+			}
 			if string(key) == "my-token" {
 				supply := &SupplyESDT{Supply: big.NewInt(123456)}
 				return marshalizer.Marshal(supply)
 			}
 			return nil, errors.New("local err")
 		},
-	}, &storageStubs.StorerStub{})
+	}, &storageStubs.StorerStub{}, &wipedBalancesHandlerStub{})
 
 	res, err := proc.GetESDTSupply("my-token")
 	require.Nil(t, err)
@@ -0,0 +1,16 @@
+package esdtSupply
+
+import "math/big"
+
+// HolderBalance holds the balance of a token holder
+type HolderBalance struct {
+	Address []byte   `json:"address"`
+	Balance *big.Int `json:"balance"`
+}
+
+// NFTHolders holds the holders of a single nonce of a collection
+type NFTHolders struct {
+	Identifier string
+	Nonce      uint64
+	Holders    []*HolderBalance
+}
@@ -0,0 +1,1014 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: holders.proto
+
+package esdtSupply
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	github_com_ElrondNetwork_elrond_go_core_data "github.com/ElrondNetwork/elrond-go-core/data"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_big "math/big"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HolderRecord holds the balance of a token holder as computed from the indexed events. The balance is signed, as the
+// holder might have received tokens before the index was started
+type HolderRecord struct {
+	Balance *math_big.Int `protobuf:"bytes,1,opt,name=Balance,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"balance"`
+}
+
+func (m *HolderRecord) Reset()      { *m = HolderRecord{} }
+func (*HolderRecord) ProtoMessage() {}
+func (*HolderRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_487ffa5b7bfa0923, []int{0}
+}
+func (m *HolderRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HolderRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HolderRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HolderRecord.Merge(m, src)
+}
+func (m *HolderRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *HolderRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_HolderRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HolderRecord proto.InternalMessageInfo
+
+func (m *HolderRecord) GetBalance() *math_big.Int {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// SetCount holds the number of members of an indexed set
+type SetCount struct {
+	Count uint64 `protobuf:"varint,1,opt,name=Count,proto3" json:"count"`
+}
+
+func (m *SetCount) Reset()      { *m = SetCount{} }
+func (*SetCount) ProtoMessage() {}
+func (*SetCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_487ffa5b7bfa0923, []int{1}
+}
+func (m *SetCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SetCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetCount.Merge(m, src)
+}
+func (m *SetCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetCount proto.InternalMessageInfo
+
+func (m *SetCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// SetMember holds the member stored at a position of an indexed set
+type SetMember struct {
+	Member []byte `protobuf:"bytes,1,opt,name=Member,proto3" json:"member"`
+}
+
+func (m *SetMember) Reset()      { *m = SetMember{} }
+func (*SetMember) ProtoMessage() {}
+func (*SetMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_487ffa5b7bfa0923, []int{2}
+}
+func (m *SetMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SetMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetMember.Merge(m, src)
+}
+func (m *SetMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetMember proto.InternalMessageInfo
+
+func (m *SetMember) GetMember() []byte {
+	if m != nil {
+		return m.Member
+	}
+	return nil
+}
+
+// SetPosition holds the position of a member of an indexed set
+type SetPosition struct {
+	Position uint64 `protobuf:"varint,1,opt,name=Position,proto3" json:"position"`
+}
+
+func (m *SetPosition) Reset()      { *m = SetPosition{} }
+func (*SetPosition) ProtoMessage() {}
+func (*SetPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_487ffa5b7bfa0923, []int{3}
+}
+func (m *SetPosition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SetPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPosition.Merge(m, src)
+}
+func (m *SetPosition) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPosition proto.InternalMessageInfo
+
+func (m *SetPosition) GetPosition() uint64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*HolderRecord)(nil), "proto.HolderRecord")
+	proto.RegisterType((*SetCount)(nil), "proto.SetCount")
+	proto.RegisterType((*SetMember)(nil), "proto.SetMember")
+	proto.RegisterType((*SetPosition)(nil), "proto.SetPosition")
+}
+
+func init() { proto.RegisterFile("holders.proto", fileDescriptor_487ffa5b7bfa0923) }
+
+var fileDescriptor_487ffa5b7bfa0923 = []byte{
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbd, 0x4e, 0xe3, 0x40,
+	0x14, 0x85, 0x3d, 0xd2, 0xe6, 0x6f, 0x36, 0xdb, 0xb8, 0x8a, 0x52, 0x5c, 0xaf, 0xac, 0x95, 0x76,
+	0x1b, 0xdb, 0xc5, 0x96, 0xdb, 0x39, 0x0b, 0x22, 0x05, 0x08, 0x61, 0xd1, 0xd0, 0xf9, 0x67, 0xe2,
+	0x58, 0xd8, 0xbe, 0xd6, 0xf8, 0x1a, 0x44, 0xc7, 0x23, 0xf0, 0x18, 0x88, 0x27, 0xa1, 0x4c, 0x99,
+	0xca, 0x90, 0x49, 0x83, 0x5c, 0xe5, 0x11, 0x10, 0x93, 0x20, 0x51, 0xcd, 0x77, 0x3e, 0x69, 0xce,
+	0x19, 0x0d, 0xff, 0xb1, 0xc4, 0x3c, 0x11, 0xb2, 0x76, 0x2b, 0x89, 0x84, 0x66, 0x4f, 0x1f, 0x53,
+	0x27, 0xcd, 0x68, 0xd9, 0x44, 0x6e, 0x8c, 0x85, 0x97, 0x62, 0x8a, 0x9e, 0xd6, 0x51, 0xb3, 0xd0,
+	0x49, 0x07, 0x4d, 0xfb, 0x5b, 0xf6, 0x0d, 0x1f, 0x9f, 0xe8, 0x9a, 0x0b, 0x11, 0xa3, 0x4c, 0xcc,
+	0x05, 0x1f, 0xf8, 0x61, 0x1e, 0x96, 0xb1, 0x98, 0xb0, 0x9f, 0xec, 0xcf, 0xd8, 0xbf, 0xec, 0x5a,
+	0x6b, 0x10, 0xed, 0xd5, 0xd3, 0x8b, 0x75, 0x5c, 0x84, 0xb4, 0xf4, 0xa2, 0x2c, 0x75, 0xe7, 0x25,
+	0xfd, 0xfb, 0xb2, 0x75, 0x94, 0x4b, 0x2c, 0x93, 0x33, 0x41, 0xb7, 0x28, 0xaf, 0x3d, 0xa1, 0x93,
+	0x93, 0xa2, 0x13, 0xa3, 0x14, 0x5e, 0x12, 0x52, 0xe8, 0xfa, 0x59, 0x3a, 0x2f, 0x69, 0x16, 0xd6,
+	0x24, 0xa4, 0xfd, 0x8b, 0x0f, 0x03, 0x41, 0x33, 0x6c, 0x4a, 0x32, 0x27, 0xbc, 0xa7, 0x41, 0x2f,
+	0x7e, 0xf3, 0x47, 0x5d, 0x6b, 0xf5, 0xe2, 0x0f, 0x61, 0xff, 0xe6, 0xa3, 0x40, 0xd0, 0xa9, 0x28,
+	0x22, 0x21, 0xcd, 0x29, 0xef, 0xef, 0xe9, 0xf0, 0x32, 0xde, 0xb5, 0x56, 0xbf, 0xd0, 0xc6, 0x76,
+	0xf8, 0xf7, 0x40, 0xd0, 0x39, 0xd6, 0x19, 0x65, 0x58, 0x9a, 0xc0, 0x87, 0x9f, 0x7c, 0x28, 0x1d,
+	0x77, 0xad, 0x35, 0xac, 0x0e, 0xce, 0xff, 0xbf, 0xda, 0x80, 0xb1, 0xde, 0x80, 0xb1, 0xdb, 0x00,
+	0xbb, 0x57, 0xc0, 0x1e, 0x15, 0xb0, 0x67, 0x05, 0x6c, 0xa5, 0x80, 0xad, 0x15, 0xb0, 0x57, 0x05,
+	0xec, 0x4d, 0x81, 0xb1, 0x53, 0xc0, 0x1e, 0xb6, 0x60, 0xac, 0xb6, 0x60, 0xac, 0xb7, 0x60, 0x5c,
+	0x71, 0x51, 0x27, 0x14, 0x34, 0x55, 0x95, 0xdf, 0x45, 0x7d, 0xfd, 0x85, 0x7f, 0xdf, 0x07, 0x00,
+	0xdc, 0x45, 0x6c, 0xe2, 0x89, 0x01, 0x00, 0x00,
+}
+
+func (this *HolderRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HolderRecord)
+	if !ok {
+		that2, ok := that.(HolderRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		if !__caster.Equal(this.Balance, that1.Balance) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SetCount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetCount)
+	if !ok {
+		that2, ok := that.(SetCount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *SetMember) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetMember)
+	if !ok {
+		that2, ok := that.(SetMember)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Member, that1.Member) {
+		return false
+	}
+	return true
+}
+func (this *SetPosition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetPosition)
+	if !ok {
+		that2, ok := that.(SetPosition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Position != that1.Position {
+		return false
+	}
+	return true
+}
+func (this *HolderRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&esdtSupply.HolderRecord{")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetCount) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&esdtSupply.SetCount{")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetMember) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&esdtSupply.SetMember{")
+	s = append(s, "Member: "+fmt.Sprintf("%#v", this.Member)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetPosition) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&esdtSupply.SetPosition{")
+	s = append(s, "Position: "+fmt.Sprintf("%#v", this.Position)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringHolders(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *HolderRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HolderRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HolderRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		size := __caster.Size(m.Balance)
+		i -= size
+		if _, err := __caster.MarshalTo(m.Balance, dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintHolders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SetCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintHolders(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintHolders(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Position != 0 {
+		i = encodeVarintHolders(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHolders(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHolders(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HolderRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	{
+		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+		l = __caster.Size(m.Balance)
+		n += 1 + l + sovHolders(uint64(l))
+	}
+	return n
+}
+
+func (m *SetCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovHolders(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *SetMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovHolders(uint64(l))
+	}
+	return n
+}
+
+func (m *SetPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Position != 0 {
+		n += 1 + sovHolders(uint64(m.Position))
+	}
+	return n
+}
+
+func sovHolders(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHolders(x uint64) (n int) {
+	return sovHolders(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *HolderRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HolderRecord{`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetCount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetCount{`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetMember) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetMember{`,
+		`Member:` + fmt.Sprintf("%v", this.Member) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetPosition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetPosition{`,
+		`Position:` + fmt.Sprintf("%v", this.Position) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringHolders(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *HolderRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHolders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HolderRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HolderRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHolders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHolders
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHolders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			{
+				__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
+				if tmp, err := __caster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				} else {
+					m.Balance = tmp
+				}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHolders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHolders
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHolders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHolders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHolders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHolders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHolders
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHolders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHolders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHolders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHolders
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHolders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = append(m.Member[:0], dAtA[iNdEx:postIndex]...)
+			if m.Member == nil {
+				m.Member = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHolders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHolders
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHolders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHolders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHolders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHolders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHolders
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHolders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHolders(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHolders
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHolders
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHolders
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHolders
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHolders
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHolders
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHolders        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHolders          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHolders = fmt.Errorf("proto: unexpected end of group")
+)
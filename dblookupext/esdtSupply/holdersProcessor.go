@@ -0,0 +1,525 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. holders.proto
+
+package esdtSupply
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	balanceKeyPrefix = "balance"
+	holdersKeyPrefix = "holders@"
+	noncesKeyPrefix  = "nonces@"
+	wipedKeyPrefix   = "wiped"
+)
+
+type tokenKey struct {
+	collection string
+	nonce      uint64
+}
+
+type holderChange struct {
+	delta         *big.Int
+	wiped         bool
+	revertedWiped bool
+}
+
+// ArgsHoldersProcessor holds the arguments needed to create a holders processor
+type ArgsHoldersProcessor struct {
+	Marshalizer      marshal.Marshalizer
+	ShardCoordinator sharding.Coordinator
+	HoldersStorer    storage.Storer
+	LogsStorer       storage.Storer
+}
+
+type holdersProcessor struct {
+	marshalizer        marshal.Marshalizer
+	shardCoordinator   sharding.Coordinator
+	holdersStorer      storage.Storer
+	nonceProc          *nonceProcessor
+	logsGet            *logsGetter
+	transferOperations map[string]struct{}
+	mintOperations     map[string]struct{}
+	burnOperations     map[string]struct{}
+	mutex              sync.RWMutex
+}
+
+// NewHoldersProcessor will create a new instance of the holders processor which indexes, for the addresses of the
+// current shard, the holders of every token and the nonces of every collection
+func NewHoldersProcessor(args ArgsHoldersProcessor) (*holdersProcessor, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, errNilShardCoordinator
+	}
+	if check.IfNil(args.HoldersStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.LogsStorer) {
+		return nil, core.ErrNilStore
+	}
+
+	return &holdersProcessor{
+		marshalizer:      args.Marshalizer,
+		shardCoordinator: args.ShardCoordinator,
+		holdersStorer:    args.HoldersStorer,
+		nonceProc:        newNonceProcessor(args.Marshalizer, args.HoldersStorer),
+		logsGet:          newLogsGetter(args.Marshalizer, args.LogsStorer),
+		transferOperations: map[string]struct{}{
+			core.BuiltInFunctionESDTTransfer:         {},
+			core.BuiltInFunctionESDTNFTTransfer:      {},
+			core.BuiltInFunctionMultiESDTNFTTransfer: {},
+		},
+		mintOperations: map[string]struct{}{
+			core.BuiltInFunctionESDTLocalMint:      {},
+			core.BuiltInFunctionESDTNFTCreate:      {},
+			core.BuiltInFunctionESDTNFTAddQuantity: {},
+		},
+		burnOperations: map[string]struct{}{
+			core.BuiltInFunctionESDTLocalBurn: {},
+			core.BuiltInFunctionESDTNFTBurn:   {},
+			core.BuiltInFunctionESDTBurn:      {},
+		},
+	}, nil
+}
+
+// ProcessLogs will update the holders based on the provided logs
+func (hp *holdersProcessor) ProcessLogs(blockNonce uint64, logs []*data.LogData) error {
+	hp.mutex.Lock()
+	defer hp.mutex.Unlock()
+
+	logsMap := make(map[string]*data.LogData)
+	for _, logData := range logs {
+		if logData != nil {
+			logsMap[logData.TxHash] = logData
+		}
+	}
+
+	return hp.processLogs(blockNonce, logsMap, false)
+}
+
+// RevertChanges will revert the holders changes based on the provided block body
+func (hp *holdersProcessor) RevertChanges(header data.HeaderHandler, body data.BodyHandler) error {
+	if check.IfNil(header) || check.IfNil(body) {
+		return nil
+	}
+
+	hp.mutex.Lock()
+	defer hp.mutex.Unlock()
+
+	logsFromDB, err := hp.logsGet.getLogsBasedOnBody(body)
+	if err != nil {
+		return err
+	}
+
+	return hp.processLogs(header.GetNonce(), logsFromDB, true)
+}
+
+func (hp *holdersProcessor) processLogs(blockNonce uint64, logs map[string]*data.LogData, isRevert bool) error {
+	shouldProcess, err := hp.nonceProc.shouldProcessLog(blockNonce, isRevert)
+	if err != nil {
+		return err
+	}
+	if !shouldProcess {
+		return nil
+	}
+
+	changes := make(map[tokenKey]map[string]*holderChange)
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		for _, entryHandler := range logData.LogHandler.GetLogEvents() {
+			event, ok := entryHandler.(*transaction.Event)
+			if !ok || event == nil {
+				continue
+			}
+
+			hp.processEvent(event, changes, isRevert)
+		}
+	}
+
+	keys := make([]tokenKey, 0, len(changes))
+	for key := range changes {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].collection != keys[j].collection {
+			return keys[i].collection < keys[j].collection
+		}
+
+		return keys[i].nonce < keys[j].nonce
+	})
+
+	for _, key := range keys {
+		err = hp.applyChanges(blockNonce, key, changes[key])
+		if err != nil {
+			return err
+		}
+	}
+
+	return hp.nonceProc.saveNonceInStorage(blockNonce)
+}
+
+func (hp *holdersProcessor) processEvent(event *transaction.Event, changes map[tokenKey]map[string]*holderChange, isRevert bool) {
+	if len(event.Topics) < 3 {
+		return
+	}
+
+	key := tokenKey{
+		collection: string(event.Topics[0]),
+		nonce:      big.NewInt(0).SetBytes(event.Topics[1]).Uint64(),
+	}
+	value := big.NewInt(0).SetBytes(event.Topics[2])
+	if isRevert {
+		value.Neg(value)
+	}
+	negativeValue := big.NewInt(0).Neg(value)
+
+	identifier := string(event.Identifier)
+	_, isTransfer := hp.transferOperations[identifier]
+	_, isMint := hp.mintOperations[identifier]
+	_, isBurn := hp.burnOperations[identifier]
+	isWipe := identifier == core.BuiltInFunctionESDTWipe
+
+	switch {
+	case isTransfer && len(event.Topics) > 3:
+		// for cross shard transfers each shard receives the same event, so only the address from the
+		// current shard is updated
+		hp.addChange(changes, key, event.Address, negativeValue)
+		hp.addChange(changes, key, event.Topics[3], value)
+	case isMint:
+		hp.addChange(changes, key, event.Address, value)
+	case isBurn:
+		hp.addChange(changes, key, event.Address, negativeValue)
+	case isWipe && len(event.Topics) > 3:
+		// the wiped balance is not part of the event, so it is stored by the block nonce in order to be subtracted
+		// from the supplies and to be restored if the block is reverted
+		holderChanges := hp.getHolderChange(changes, key, event.Topics[3])
+		if holderChanges == nil {
+			return
+		}
+		if isRevert {
+			holderChanges.revertedWiped = true
+		} else {
+			holderChanges.wiped = true
+		}
+	}
+}
+
+func (hp *holdersProcessor) addChange(changes map[tokenKey]map[string]*holderChange, key tokenKey, address []byte, value *big.Int) {
+	change := hp.getHolderChange(changes, key, address)
+	if change == nil {
+		return
+	}
+
+	change.delta.Add(change.delta, value)
+}
+
+func (hp *holdersProcessor) getHolderChange(changes map[tokenKey]map[string]*holderChange, key tokenKey, address []byte) *holderChange {
+	if len(address) == 0 || hp.shardCoordinator.ComputeId(address) != hp.shardCoordinator.SelfId() {
+		return nil
+	}
+
+	holderChanges, found := changes[key]
+	if !found {
+		holderChanges = make(map[string]*holderChange)
+		changes[key] = holderChanges
+	}
+
+	change, found := holderChanges[string(address)]
+	if !found {
+		change = &holderChange{delta: big.NewInt(0)}
+		holderChanges[string(address)] = change
+	}
+
+	return change
+}
+
+func (hp *holdersProcessor) applyChanges(blockNonce uint64, key tokenKey, holderChanges map[string]*holderChange) error {
+	identifier := computeTokenIdentifier([]byte(key.collection), key.nonce)
+	holders := hp.newHoldersSet(identifier)
+	numHoldersBefore, err := holders.count()
+	if err != nil {
+		return err
+	}
+
+	addresses := make([]string, 0, len(holderChanges))
+	for address := range holderChanges {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		err = hp.applyHolderChange(blockNonce, identifier, []byte(address), holderChanges[address], holders)
+		if err != nil {
+			return err
+		}
+	}
+
+	numHoldersAfter, err := holders.count()
+	if err != nil {
+		return err
+	}
+
+	wasHeld := numHoldersBefore > 0
+	isHeld := numHoldersAfter > 0
+	if key.nonce == 0 || wasHeld == isHeld {
+		return nil
+	}
+
+	nonces := hp.newNoncesSet([]byte(key.collection))
+	nonceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonceBytes, key.nonce)
+	if isHeld {
+		return nonces.add(nonceBytes)
+	}
+
+	return nonces.remove(nonceBytes)
+}
+
+func (hp *holdersProcessor) applyHolderChange(
+	blockNonce uint64,
+	identifier []byte,
+	address []byte,
+	change *holderChange,
+	holders *indexedSet,
+) error {
+	balance, err := hp.getBalance(identifier, address)
+	if err != nil {
+		return err
+	}
+
+	balance.Add(balance, change.delta)
+	err = hp.applyWipe(blockNonce, identifier, address, change, balance)
+	if err != nil {
+		return err
+	}
+
+	// a negative balance means the holder received tokens before the index was started, so it is kept in order not
+	// to lose the changes but the address is not listed as a holder
+	storageKey := hp.balanceKey(identifier, address)
+	if balance.Sign() == 0 {
+		err = hp.holdersStorer.Remove(storageKey)
+	} else {
+		err = hp.put(storageKey, &HolderRecord{Balance: balance})
+	}
+	if err != nil {
+		return err
+	}
+
+	if balance.Sign() > 0 {
+		return holders.add(address)
+	}
+
+	return holders.remove(address)
+}
+
+func (hp *holdersProcessor) applyWipe(blockNonce uint64, identifier []byte, address []byte, change *holderChange, balance *big.Int) error {
+	wipedKey := hp.wipedKey(blockNonce, identifier, address)
+	if change.revertedWiped {
+		wipedBalance, err := hp.getWipedBalance(wipedKey)
+		if err != nil || wipedBalance == nil {
+			return err
+		}
+
+		balance.Add(balance, wipedBalance)
+		return hp.holdersStorer.Remove(wipedKey)
+	}
+	if !change.wiped {
+		return nil
+	}
+
+	// a balance which is not positive means the holder received tokens before the index was started, so the wiped
+	// balance is not known
+	if balance.Sign() > 0 {
+		err := hp.put(wipedKey, &HolderRecord{Balance: big.NewInt(0).Set(balance)})
+		if err != nil {
+			return err
+		}
+	}
+	balance.SetInt64(0)
+
+	return nil
+}
+
+func (hp *holdersProcessor) put(key []byte, value interface{}) error {
+	buff, err := hp.marshalizer.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return hp.holdersStorer.Put(key, buff)
+}
+
+// GetESDTHolders will return the requested page of the token holders, together with the total number of holders. The
+// holders are not returned in any particular order
+func (hp *holdersProcessor) GetESDTHolders(token string, from uint32, size uint32) ([]*HolderBalance, uint32, error) {
+	hp.mutex.RLock()
+	defer hp.mutex.RUnlock()
+
+	return hp.getHoldersPage([]byte(token), from, size)
+}
+
+// GetCollectionNFTs will return the requested page of the collection nonces together with their holders and the
+// total number of nonces. The nonces are not returned in any particular order and their holders are limited to the
+// requested size
+func (hp *holdersProcessor) GetCollectionNFTs(collection string, from uint32, size uint32) ([]*NFTHolders, uint32, error) {
+	hp.mutex.RLock()
+	defer hp.mutex.RUnlock()
+
+	noncesBytes, numNonces, err := hp.newNoncesSet([]byte(collection)).page(from, size)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	nfts := make([]*NFTHolders, 0, len(noncesBytes))
+	for _, nonceBytes := range noncesBytes {
+		nonce := binary.BigEndian.Uint64(nonceBytes)
+		identifier := computeTokenIdentifier([]byte(collection), nonce)
+		holders, _, errGet := hp.getHoldersPage(identifier, 0, size)
+		if errGet != nil {
+			return nil, 0, errGet
+		}
+
+		nfts = append(nfts, &NFTHolders{
+			Identifier: string(identifier),
+			Nonce:      nonce,
+			Holders:    holders,
+		})
+	}
+
+	return nfts, numNonces, nil
+}
+
+func (hp *holdersProcessor) getHoldersPage(identifier []byte, from uint32, size uint32) ([]*HolderBalance, uint32, error) {
+	addresses, numHolders, err := hp.newHoldersSet(identifier).page(from, size)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	holders := make([]*HolderBalance, 0, len(addresses))
+	for _, address := range addresses {
+		balance, errGet := hp.getBalance(identifier, address)
+		if errGet != nil {
+			return nil, 0, errGet
+		}
+
+		holders = append(holders, &HolderBalance{
+			Address: address,
+			Balance: balance,
+		})
+	}
+
+	return holders, numHolders, nil
+}
+
+// GetWipedBalance will return the balance wiped from the provided holder of the token in the block with the given
+// nonce. It returns nil if there was no wipe or if the wiped balance is not known
+func (hp *holdersProcessor) GetWipedBalance(blockNonce uint64, token []byte, address []byte) (*big.Int, error) {
+	hp.mutex.RLock()
+	defer hp.mutex.RUnlock()
+
+	return hp.getWipedBalance(hp.wipedKey(blockNonce, token, address))
+}
+
+func (hp *holdersProcessor) getWipedBalance(wipedKey []byte) (*big.Int, error) {
+	buff, err := hp.holdersStorer.Get(wipedKey)
+	if err == storage.ErrKeyNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := &HolderRecord{}
+	err = hp.marshalizer.Unmarshal(record, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return record.Balance, nil
+}
+
+func (hp *holdersProcessor) getBalance(identifier []byte, address []byte) (*big.Int, error) {
+	buff, err := hp.holdersStorer.Get(hp.balanceKey(identifier, address))
+	if err == storage.ErrKeyNotFound {
+		return big.NewInt(0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := &HolderRecord{}
+	err = hp.marshalizer.Unmarshal(record, buff)
+	if err != nil {
+		return nil, err
+	}
+	if record.Balance == nil {
+		return big.NewInt(0), nil
+	}
+
+	return record.Balance, nil
+}
+
+func (hp *holdersProcessor) balanceKey(identifier []byte, address []byte) []byte {
+	return bytes.Join([][]byte{[]byte(balanceKeyPrefix), identifier, address}, []byte("@"))
+}
+
+func (hp *holdersProcessor) wipedKey(blockNonce uint64, identifier []byte, address []byte) []byte {
+	nonceBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(nonceBytes, blockNonce)
+
+	return bytes.Join([][]byte{[]byte(wipedKeyPrefix), nonceBytes, identifier, address}, []byte("@"))
+}
+
+func (hp *holdersProcessor) newHoldersSet(identifier []byte) *indexedSet {
+	return newIndexedSet(hp.marshalizer, hp.holdersStorer, holdersKeyPrefix+string(identifier))
+}
+
+func (hp *holdersProcessor) newNoncesSet(collection []byte) *indexedSet {
+	return newIndexedSet(hp.marshalizer, hp.holdersStorer, noncesKeyPrefix+string(collection))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hp *holdersProcessor) IsInterfaceNil() bool {
+	return hp == nil
+}
+
+func computeTokenIdentifier(collection []byte, nonce uint64) []byte {
+	if nonce == 0 {
+		return collection
+	}
+
+	nonceHexStr := hex.EncodeToString(big.NewInt(0).SetUint64(nonce).Bytes())
+
+	return bytes.Join([][]byte{collection, []byte(nonceHexStr)}, []byte("-"))
+}
+
+func computePage(total uint32, from uint32, size uint32) (uint32, uint32) {
+	if from >= total {
+		return total, total
+	}
+
+	end := uint64(from) + uint64(size)
+	if end > uint64(total) {
+		end = uint64(total)
+	}
+
+	return from, uint32(end)
+}
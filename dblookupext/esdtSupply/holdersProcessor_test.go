@@ -0,0 +1,335 @@
+package esdtSupply
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testToken      = []byte("TKN-1q2w3e")
+	testCollection = []byte("NFT-1q2w3e")
+	testAlice      = []byte("alice")
+	testBob        = []byte("bob")
+	testCarol      = []byte("carol")
+	testOtherShard = []byte("other shard")
+)
+
+func createMockArgsHoldersProcessor() ArgsHoldersProcessor {
+	return ArgsHoldersProcessor{
+		Marshalizer: testscommon.MarshalizerMock{},
+		ShardCoordinator: &testscommon.ShardsCoordinatorMock{
+			ComputeIdCalled: func(address []byte) uint32 {
+				if string(address) == string(testOtherShard) {
+					return 1
+				}
+
+				return 0
+			},
+		},
+		HoldersStorer: testscommon.CreateMemUnit(),
+		LogsStorer:    genericMocks.NewStorerMockWithErrKeyNotFound("", 0),
+	}
+}
+
+func createESDTEvent(identifier string, token []byte, nonce uint64, value int64, address []byte, extraTopics ...[]byte) *transaction.Event {
+	topics := [][]byte{token, big.NewInt(0).SetUint64(nonce).Bytes(), big.NewInt(value).Bytes()}
+
+	return &transaction.Event{
+		Address:    address,
+		Identifier: []byte(identifier),
+		Topics:     append(topics, extraTopics...),
+	}
+}
+
+func createLogData(txHash string, events ...*transaction.Event) *data.LogData {
+	return &data.LogData{
+		TxHash:     txHash,
+		LogHandler: &transaction.Log{Events: events},
+	}
+}
+
+func requireHolders(t *testing.T, hp *holdersProcessor, token string, expected ...*HolderBalance) {
+	holders, numHolders, err := hp.GetESDTHolders(token, 0, 100)
+	require.Nil(t, err)
+	require.Equal(t, uint32(len(expected)), numHolders)
+	require.Equal(t, expected, holders)
+}
+
+func TestNewHoldersProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHoldersProcessor()
+	args.Marshalizer = nil
+	_, err := NewHoldersProcessor(args)
+	require.Equal(t, core.ErrNilMarshalizer, err)
+
+	args = createMockArgsHoldersProcessor()
+	args.ShardCoordinator = nil
+	_, err = NewHoldersProcessor(args)
+	require.Equal(t, errNilShardCoordinator, err)
+
+	args = createMockArgsHoldersProcessor()
+	args.HoldersStorer = nil
+	_, err = NewHoldersProcessor(args)
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockArgsHoldersProcessor()
+	args.LogsStorer = nil
+	_, err = NewHoldersProcessor(args)
+	require.Equal(t, core.ErrNilStore, err)
+
+	proc, err := NewHoldersProcessor(createMockArgsHoldersProcessor())
+	require.Nil(t, err)
+	require.False(t, proc.IsInterfaceNil())
+}
+
+func TestHoldersProcessor_ProcessLogsShouldTrackFungibleHolders(t *testing.T) {
+	t.Parallel()
+
+	hp, _ := NewHoldersProcessor(createMockArgsHoldersProcessor())
+
+	err := hp.ProcessLogs(6, []*data.LogData{
+		createLogData("tx0", createESDTEvent(core.BuiltInFunctionESDTLocalMint, testToken, 0, 100, testAlice)),
+		createLogData("tx1",
+			createESDTEvent(core.BuiltInFunctionESDTTransfer, testToken, 0, 30, testAlice, testBob),
+			createESDTEvent(core.BuiltInFunctionESDTTransfer, testToken, 0, 20, testAlice, testOtherShard),
+			&transaction.Event{Identifier: []byte("something")},
+		),
+		nil,
+	})
+	require.Nil(t, err)
+	requireHolders(t, hp, string(testToken),
+		&HolderBalance{Address: testAlice, Balance: big.NewInt(50)},
+		&HolderBalance{Address: testBob, Balance: big.NewInt(30)},
+	)
+
+	err = hp.ProcessLogs(7, []*data.LogData{
+		createLogData("tx2", createESDTEvent(core.BuiltInFunctionESDTLocalBurn, testToken, 0, 50, testAlice)),
+		createLogData("tx3", createESDTEvent(core.BuiltInFunctionESDTTransfer, testToken, 0, 5, testOtherShard, testCarol)),
+	})
+	require.Nil(t, err)
+	requireHolders(t, hp, string(testToken),
+		&HolderBalance{Address: testBob, Balance: big.NewInt(30)},
+		&HolderBalance{Address: testCarol, Balance: big.NewInt(5)},
+	)
+
+	holders, numHolders, err := hp.GetESDTHolders(string(testToken), 1, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint32(2), numHolders)
+	require.Equal(t, []*HolderBalance{{Address: testCarol, Balance: big.NewInt(5)}}, holders)
+
+	holders, numHolders, err = hp.GetESDTHolders(string(testToken), 5, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint32(2), numHolders)
+	require.Empty(t, holders)
+}
+
+func TestHoldersProcessor_ProcessLogsAlreadyProcessedNonceShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	hp, _ := NewHoldersProcessor(createMockArgsHoldersProcessor())
+	logs := []*data.LogData{
+		createLogData("tx0", createESDTEvent(core.BuiltInFunctionESDTLocalMint, testToken, 0, 100, testAlice)),
+	}
+
+	err := hp.ProcessLogs(6, logs)
+	require.Nil(t, err)
+	err = hp.ProcessLogs(6, logs)
+	require.Nil(t, err)
+
+	requireHolders(t, hp, string(testToken), &HolderBalance{Address: testAlice, Balance: big.NewInt(100)})
+}
+
+func TestHoldersProcessor_WipeShouldRemoveTheHolderAndKeepTheWipedBalance(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHoldersProcessor()
+	hp, _ := NewHoldersProcessor(args)
+
+	err := hp.ProcessLogs(6, []*data.LogData{
+		createLogData("tx0", createESDTEvent(core.BuiltInFunctionESDTLocalMint, testToken, 0, 100, testAlice)),
+		createLogData("tx1", createESDTEvent(core.BuiltInFunctionESDTTransfer, testToken, 0, 40, testAlice, testBob)),
+	})
+	require.Nil(t, err)
+
+	wipeLog := &transaction.Log{
+		Events: []*transaction.Event{
+			createESDTEvent(core.BuiltInFunctionESDTWipe, testToken, 0, 0, testCarol, testBob),
+		},
+	}
+	wipeLogBytes, _ := args.Marshalizer.Marshal(wipeLog)
+	_ = args.LogsStorer.Put([]byte("txHash2"), wipeLogBytes)
+
+	err = hp.ProcessLogs(7, []*data.LogData{{TxHash: "txHash2", LogHandler: wipeLog}})
+	require.Nil(t, err)
+	requireHolders(t, hp, string(testToken), &HolderBalance{Address: testAlice, Balance: big.NewInt(60)})
+
+	wipedBalance, err := hp.GetWipedBalance(7, testToken, testBob)
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(40), wipedBalance)
+	wipedBalance, err = hp.GetWipedBalance(6, testToken, testBob)
+	require.Nil(t, err)
+	require.Nil(t, wipedBalance)
+
+	err = hp.RevertChanges(&block.Header{Nonce: 7}, &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("txHash2")}},
+		},
+	})
+	require.Nil(t, err)
+	requireHolders(t, hp, string(testToken),
+		&HolderBalance{Address: testAlice, Balance: big.NewInt(60)},
+		&HolderBalance{Address: testBob, Balance: big.NewInt(40)},
+	)
+
+	wipedBalance, err = hp.GetWipedBalance(7, testToken, testBob)
+	require.Nil(t, err)
+	require.Nil(t, wipedBalance)
+}
+
+func TestHoldersProcessor_WipeOfAHolderPriorToTheIndexShouldNotKeepTheWipedBalance(t *testing.T) {
+	t.Parallel()
+
+	hp, _ := NewHoldersProcessor(createMockArgsHoldersProcessor())
+
+	// bob received the tokens before the index was started
+	err := hp.ProcessLogs(6, []*data.LogData{
+		createLogData("tx0", createESDTEvent(core.BuiltInFunctionESDTWipe, testToken, 0, 0, testCarol, testBob)),
+	})
+	require.Nil(t, err)
+
+	wipedBalance, err := hp.GetWipedBalance(6, testToken, testBob)
+	require.Nil(t, err)
+	require.Nil(t, wipedBalance)
+}
+
+func TestHoldersProcessor_ShouldKeepTheChangesOfTheHoldersPriorToTheIndex(t *testing.T) {
+	t.Parallel()
+
+	hp, _ := NewHoldersProcessor(createMockArgsHoldersProcessor())
+
+	// bob received the tokens before the index was started
+	err := hp.ProcessLogs(6, []*data.LogData{
+		createLogData("tx0", createESDTEvent(core.BuiltInFunctionESDTTransfer, testToken, 0, 40, testBob, testAlice)),
+	})
+	require.Nil(t, err)
+	requireHolders(t, hp, string(testToken), &HolderBalance{Address: testAlice, Balance: big.NewInt(40)})
+
+	err = hp.ProcessLogs(7, []*data.LogData{
+		createLogData("tx1", createESDTEvent(core.BuiltInFunctionESDTTransfer, testToken, 0, 50, testAlice, testBob)),
+	})
+	require.Nil(t, err)
+	requireHolders(t, hp, string(testToken), &HolderBalance{Address: testBob, Balance: big.NewInt(10)})
+}
+
+func TestHoldersProcessor_ShouldTrackCollectionNonces(t *testing.T) {
+	t.Parallel()
+
+	hp, _ := NewHoldersProcessor(createMockArgsHoldersProcessor())
+
+	err := hp.ProcessLogs(6, []*data.LogData{
+		createLogData("tx0",
+			createESDTEvent(core.BuiltInFunctionESDTNFTCreate, testCollection, 2, 1, testAlice, []byte("esdt data")),
+			createESDTEvent(core.BuiltInFunctionESDTNFTCreate, testCollection, 1, 10, testAlice, []byte("esdt data")),
+			createESDTEvent(core.BuiltInFunctionESDTNFTCreate, testCollection, 3, 1, testAlice, []byte("esdt data")),
+		),
+		createLogData("tx1", createESDTEvent(core.BuiltInFunctionESDTNFTTransfer, testCollection, 1, 4, testAlice, testBob)),
+		createLogData("tx2", createESDTEvent(core.BuiltInFunctionMultiESDTNFTTransfer, testCollection, 2, 1, testAlice, testOtherShard)),
+	})
+	require.Nil(t, err)
+
+	nfts, numNFTs, err := hp.GetCollectionNFTs(string(testCollection), 0, 100)
+	require.Nil(t, err)
+	require.Equal(t, uint32(2), numNFTs)
+	require.Equal(t, []*NFTHolders{
+		{
+			Identifier: string(testCollection) + "-01",
+			Nonce:      1,
+			Holders: []*HolderBalance{
+				{Address: testAlice, Balance: big.NewInt(6)},
+				{Address: testBob, Balance: big.NewInt(4)},
+			},
+		},
+		{
+			Identifier: string(testCollection) + "-03",
+			Nonce:      3,
+			Holders:    []*HolderBalance{{Address: testAlice, Balance: big.NewInt(1)}},
+		},
+	}, nfts)
+	requireHolders(t, hp, string(testCollection)+"-03", &HolderBalance{Address: testAlice, Balance: big.NewInt(1)})
+
+	err = hp.ProcessLogs(7, []*data.LogData{
+		createLogData("tx3", createESDTEvent(core.BuiltInFunctionESDTNFTBurn, testCollection, 3, 1, testAlice)),
+	})
+	require.Nil(t, err)
+
+	nfts, numNFTs, err = hp.GetCollectionNFTs(string(testCollection), 0, 100)
+	require.Nil(t, err)
+	require.Equal(t, uint32(1), numNFTs)
+	require.Equal(t, uint64(1), nfts[0].Nonce)
+}
+
+func TestHoldersProcessor_RevertChangesShouldRestoreTheHolders(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsHoldersProcessor()
+	hp, _ := NewHoldersProcessor(args)
+
+	err := hp.ProcessLogs(6, []*data.LogData{
+		createLogData("tx0", createESDTEvent(core.BuiltInFunctionESDTLocalMint, testToken, 0, 100, testAlice)),
+	})
+	require.Nil(t, err)
+
+	transferLog := &transaction.Log{
+		Events: []*transaction.Event{
+			createESDTEvent(core.BuiltInFunctionESDTTransfer, testToken, 0, 100, testAlice, testBob),
+			createESDTEvent(core.BuiltInFunctionESDTNFTCreate, testCollection, 1, 1, testBob, []byte("esdt data")),
+		},
+	}
+	transferLogBytes, _ := args.Marshalizer.Marshal(transferLog)
+	_ = args.LogsStorer.Put([]byte("txHash1"), transferLogBytes)
+
+	err = hp.ProcessLogs(7, []*data.LogData{{TxHash: "txHash1", LogHandler: transferLog}})
+	require.Nil(t, err)
+	requireHolders(t, hp, string(testToken), &HolderBalance{Address: testBob, Balance: big.NewInt(100)})
+
+	err = hp.RevertChanges(&block.Header{Nonce: 7}, &block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{TxHashes: [][]byte{[]byte("txHash1")}},
+		},
+	})
+	require.Nil(t, err)
+	requireHolders(t, hp, string(testToken), &HolderBalance{Address: testAlice, Balance: big.NewInt(100)})
+
+	_, numNFTs, err := hp.GetCollectionNFTs(string(testCollection), 0, 100)
+	require.Nil(t, err)
+	require.Zero(t, numNFTs)
+}
+
+func TestHoldersProcessor_GetESDTHoldersStorerErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	args := createMockArgsHoldersProcessor()
+	args.HoldersStorer = &storageStubs.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, errExpected
+		},
+	}
+	hp, _ := NewHoldersProcessor(args)
+
+	holders, numHolders, err := hp.GetESDTHolders(string(testToken), 0, 10)
+	require.Equal(t, errExpected, err)
+	require.Nil(t, holders)
+	require.Zero(t, numHolders)
+}
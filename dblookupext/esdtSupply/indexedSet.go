@@ -0,0 +1,168 @@
+package esdtSupply
+
+import (
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	setCountSuffix    = "@count"
+	setPositionPrefix = "@position@"
+	setMemberPrefix   = "@member@"
+)
+
+// indexedSet keeps the members of a set under consecutive positions, so a page of members is read with one storer
+// access per member. A removed member is replaced by the last one, so the members are not kept in any order
+type indexedSet struct {
+	marshalizer marshal.Marshalizer
+	storer      storage.Storer
+	prefix      string
+}
+
+func newIndexedSet(marshalizer marshal.Marshalizer, storer storage.Storer, prefix string) *indexedSet {
+	return &indexedSet{
+		marshalizer: marshalizer,
+		storer:      storer,
+		prefix:      prefix,
+	}
+}
+
+func (is *indexedSet) count() (uint64, error) {
+	setCount := &SetCount{}
+	_, err := is.get(is.countKey(), setCount)
+
+	return setCount.Count, err
+}
+
+func (is *indexedSet) add(member []byte) error {
+	found, err := is.get(is.memberKey(member), &SetPosition{})
+	if err != nil || found {
+		return err
+	}
+
+	numMembers, err := is.count()
+	if err != nil {
+		return err
+	}
+
+	err = is.put(is.positionKey(numMembers), &SetMember{Member: member})
+	if err != nil {
+		return err
+	}
+
+	err = is.put(is.memberKey(member), &SetPosition{Position: numMembers})
+	if err != nil {
+		return err
+	}
+
+	return is.put(is.countKey(), &SetCount{Count: numMembers + 1})
+}
+
+func (is *indexedSet) remove(member []byte) error {
+	setPosition := &SetPosition{}
+	found, err := is.get(is.memberKey(member), setPosition)
+	if err != nil || !found {
+		return err
+	}
+
+	numMembers, err := is.count()
+	if err != nil {
+		return err
+	}
+
+	lastPosition := numMembers - 1
+	if setPosition.Position != lastPosition {
+		lastMember := &SetMember{}
+		_, err = is.get(is.positionKey(lastPosition), lastMember)
+		if err != nil {
+			return err
+		}
+
+		err = is.put(is.positionKey(setPosition.Position), lastMember)
+		if err != nil {
+			return err
+		}
+
+		err = is.put(is.memberKey(lastMember.Member), setPosition)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = is.storer.Remove(is.positionKey(lastPosition))
+	if err != nil {
+		return err
+	}
+
+	err = is.storer.Remove(is.memberKey(member))
+	if err != nil {
+		return err
+	}
+
+	if lastPosition == 0 {
+		return is.storer.Remove(is.countKey())
+	}
+
+	return is.put(is.countKey(), &SetCount{Count: lastPosition})
+}
+
+func (is *indexedSet) page(from uint32, size uint32) ([][]byte, uint32, error) {
+	numMembers, err := is.count()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := uint32(numMembers)
+	start, end := computePage(total, from, size)
+	members := make([][]byte, 0, end-start)
+	for position := start; position < end; position++ {
+		setMember := &SetMember{}
+		_, err = is.get(is.positionKey(uint64(position)), setMember)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		members = append(members, setMember.Member)
+	}
+
+	return members, total, nil
+}
+
+func (is *indexedSet) get(key []byte, value interface{}) (bool, error) {
+	buff, err := is.storer.Get(key)
+	if err == storage.ErrKeyNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, is.marshalizer.Unmarshal(value, buff)
+}
+
+func (is *indexedSet) put(key []byte, value interface{}) error {
+	buff, err := is.marshalizer.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return is.storer.Put(key, buff)
+}
+
+func (is *indexedSet) countKey() []byte {
+	return []byte(is.prefix + setCountSuffix)
+}
+
+func (is *indexedSet) positionKey(position uint64) []byte {
+	key := make([]byte, len(is.prefix)+len(setPositionPrefix)+8)
+	copy(key, is.prefix+setPositionPrefix)
+	binary.BigEndian.PutUint64(key[len(is.prefix)+len(setPositionPrefix):], position)
+
+	return key
+}
+
+func (is *indexedSet) memberKey(member []byte) []byte {
+	return append([]byte(is.prefix+setMemberPrefix), member...)
+}
@@ -0,0 +1,51 @@
+package esdtSupply
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/require"
+)
+
+func requireMembers(t *testing.T, set *indexedSet, expected ...string) {
+	members, total, err := set.page(0, 100)
+	require.Nil(t, err)
+	require.Equal(t, uint32(len(expected)), total)
+
+	var actual []string
+	for _, member := range members {
+		actual = append(actual, string(member))
+	}
+	require.Equal(t, expected, actual)
+}
+
+func TestIndexedSet_AddAndRemoveShouldKeepThePositionsContiguous(t *testing.T) {
+	t.Parallel()
+
+	set := newIndexedSet(testscommon.MarshalizerMock{}, testscommon.CreateMemUnit(), "set")
+
+	require.Nil(t, set.add([]byte("a")))
+	require.Nil(t, set.add([]byte("b")))
+	require.Nil(t, set.add([]byte("c")))
+	require.Nil(t, set.add([]byte("b")))
+	requireMembers(t, set, "a", "b", "c")
+
+	require.Nil(t, set.remove([]byte("a")))
+	requireMembers(t, set, "c", "b")
+
+	require.Nil(t, set.remove([]byte("missing")))
+	require.Nil(t, set.remove([]byte("b")))
+	requireMembers(t, set, "c")
+
+	members, total, err := set.page(1, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint32(1), total)
+	require.Empty(t, members)
+
+	require.Nil(t, set.remove([]byte("c")))
+	requireMembers(t, set)
+
+	numMembers, err := set.count()
+	require.Nil(t, err)
+	require.Zero(t, numMembers)
+}
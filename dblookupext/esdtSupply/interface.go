@@ -0,0 +1,9 @@
+package esdtSupply
+
+import "math/big"
+
+// WipedBalancesHandler defines the component which provides the balances wiped from the holders of a token
+type WipedBalancesHandler interface {
+	GetWipedBalance(blockNonce uint64, token []byte, address []byte) (*big.Int, error)
+	IsInterfaceNil() bool
+}
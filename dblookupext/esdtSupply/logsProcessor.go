@@ -15,9 +15,19 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
+const (
+	suppliesVersionKey = "supplies-version"
+
+	// currentSuppliesVersion is increased whenever the indexing rules change in a way that the supplies already stored
+	// have to be upgraded. Version 1 added the supplies aggregated per collection and the subtraction of the wiped
+	// balances
+	currentSuppliesVersion = 1
+)
+
 type logsProcessor struct {
 	marshalizer        marshal.Marshalizer
 	suppliesStorer     storage.Storer
+	wipedBalances      WipedBalancesHandler
 	nonceProc          *nonceProcessor
 	fungibleOperations map[string]struct{}
 }
@@ -25,6 +35,7 @@ type logsProcessor struct {
 func newLogsProcessor(
 	marshalizer marshal.Marshalizer,
 	suppliesStorer storage.Storer,
+	wipedBalances WipedBalancesHandler,
 ) *logsProcessor {
 	nonceProc := newNonceProcessor(marshalizer, suppliesStorer)
 
@@ -32,6 +43,7 @@ func newLogsProcessor(
 		nonceProc:      nonceProc,
 		marshalizer:    marshalizer,
 		suppliesStorer: suppliesStorer,
+		wipedBalances:  wipedBalances,
 		fungibleOperations: map[string]struct{}{
 			core.BuiltInFunctionESDTLocalBurn:      {},
 			core.BuiltInFunctionESDTLocalMint:      {},
@@ -43,6 +55,115 @@ func newLogsProcessor(
 	}
 }
 
+// checkSuppliesVersion upgrades the supplies indexed with older rules and marks the database with the current version
+func (lp *logsProcessor) checkSuppliesVersion() error {
+	suppliesVersion, err := lp.getSuppliesVersion()
+	if err != nil {
+		return err
+	}
+	if suppliesVersion >= currentSuppliesVersion {
+		return nil
+	}
+
+	// an empty database has nothing to upgrade
+	_, err = lp.suppliesStorer.Get([]byte(processedBlockKey))
+	isEmptyDatabase := err == storage.ErrKeyNotFound
+	if err != nil && !isEmptyDatabase {
+		return err
+	}
+	if !isEmptyDatabase {
+		err = lp.upgradeSupplies()
+		if err != nil {
+			return err
+		}
+	}
+
+	versionBytes, err := lp.marshalizer.Marshal(&SuppliesVersion{Version: currentSuppliesVersion})
+	if err != nil {
+		return err
+	}
+
+	return lp.suppliesStorer.Put([]byte(suppliesVersionKey), versionBytes)
+}
+
+func (lp *logsProcessor) getSuppliesVersion() (uint32, error) {
+	versionBytes, err := lp.suppliesStorer.Get([]byte(suppliesVersionKey))
+	if err == storage.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	suppliesVersion := &SuppliesVersion{}
+	err = lp.marshalizer.Unmarshal(suppliesVersion, versionBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	return suppliesVersion.Version, nil
+}
+
+// upgradeSupplies aggregates the supplies of the collections from the supplies of their nonces and marks all the
+// supplies indexed until now as partial, as the wiped balances were not subtracted from them
+func (lp *logsProcessor) upgradeSupplies() error {
+	supplies := make(map[string]*SupplyESDT)
+	collectionsSupplies := make(map[string]*SupplyESDT)
+	var errUnmarshal error
+	lp.suppliesStorer.RangeKeys(func(key []byte, value []byte) bool {
+		if string(key) == processedBlockKey || string(key) == suppliesVersionKey {
+			return true
+		}
+
+		supply := &SupplyESDT{}
+		errUnmarshal = lp.marshalizer.Unmarshal(supply, value)
+		if errUnmarshal != nil {
+			return false
+		}
+		makePropertiesNotNil(supply)
+		supply.Partial = true
+		supplies[string(key)] = supply
+
+		collection, isNonceSupply := getCollectionOfNonceSupply(key)
+		if !isNonceSupply {
+			return true
+		}
+
+		collectionSupply, found := collectionsSupplies[collection]
+		if !found {
+			collectionSupply = newSupplyESDTZero()
+			collectionSupply.Partial = true
+			collectionsSupplies[collection] = collectionSupply
+		}
+		collectionSupply.Supply.Add(collectionSupply.Supply, supply.Supply)
+		collectionSupply.Minted.Add(collectionSupply.Minted, supply.Minted)
+		collectionSupply.Burned.Add(collectionSupply.Burned, supply.Burned)
+
+		return true
+	})
+	if errUnmarshal != nil {
+		return errUnmarshal
+	}
+
+	for collection, collectionSupply := range collectionsSupplies {
+		supplies[collection] = collectionSupply
+	}
+
+	log.Info("upgraded the ESDT supplies indexed by an older node version", "num supplies", len(supplies))
+
+	return lp.saveSupplies(supplies)
+}
+
+// getCollectionOfNonceSupply returns the collection of a collection-nonce identifier, the collection identifiers
+// having the ticker-random format
+func getCollectionOfNonceSupply(tokenIdentifier []byte) (string, bool) {
+	if bytes.Count(tokenIdentifier, []byte("-")) != 2 {
+		return "", false
+	}
+
+	return string(tokenIdentifier[:bytes.LastIndexByte(tokenIdentifier, '-')]), true
+}
+
 func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.LogData, isRevert bool) error {
 	shouldProcess, err := lp.nonceProc.shouldProcessLog(blockNonce, isRevert)
 	if err != nil {
@@ -58,7 +179,7 @@ func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.Lo
 			continue
 		}
 
-		errProc := lp.processLog(logHandler.LogHandler, supplies, isRevert)
+		errProc := lp.processLog(blockNonce, logHandler.LogHandler, supplies, isRevert)
 		if errProc != nil {
 			return errProc
 		}
@@ -72,7 +193,7 @@ func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.Lo
 	return lp.nonceProc.saveNonceInStorage(blockNonce)
 }
 
-func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*SupplyESDT, isRevert bool) error {
+func (lp *logsProcessor) processLog(blockNonce uint64, txLog data.LogHandler, supplies map[string]*SupplyESDT, isRevert bool) error {
 	for _, entryHandler := range txLog.GetLogEvents() {
 		if check.IfNil(entryHandler) {
 			continue
@@ -87,7 +208,7 @@ func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*
 			continue
 		}
 
-		err := lp.processEvent(event, supplies, isRevert)
+		err := lp.processEvent(blockNonce, event, supplies, isRevert)
 		if err != nil {
 			return err
 		}
@@ -112,37 +233,80 @@ func (lp *logsProcessor) saveSupplies(supplies map[string]*SupplyESDT) error {
 	return nil
 }
 
-func (lp *logsProcessor) processEvent(txLog *transaction.Event, supplies map[string]*SupplyESDT, isRevert bool) error {
+func (lp *logsProcessor) processEvent(blockNonce uint64, txLog *transaction.Event, supplies map[string]*SupplyESDT, isRevert bool) error {
 	if len(txLog.Topics) < 3 {
 		return nil
 	}
 
-	tokenIdentifier := txLog.Topics[0]
+	collectionIdentifier := txLog.Topics[0]
+	tokenIdentifiers := [][]byte{collectionIdentifier}
 	if len(txLog.Topics[1]) != 0 {
 		nonceBytes := txLog.Topics[1]
 		nonceHexStr := hex.EncodeToString(nonceBytes)
 
-		tokenIdentifier = bytes.Join([][]byte{tokenIdentifier, []byte(nonceHexStr)}, []byte("-"))
+		// the supply of a NFT/SFT/MetaESDT is tracked both for the token nonce and aggregated for the whole collection
+		tokenIdentifier := bytes.Join([][]byte{collectionIdentifier, []byte(nonceHexStr)}, []byte("-"))
+		tokenIdentifiers = [][]byte{tokenIdentifier, collectionIdentifier}
 	}
 
 	valueFromEvent := big.NewInt(0).SetBytes(txLog.Topics[2])
+	isValueKnown := true
+	if string(txLog.Identifier) == core.BuiltInFunctionESDTWipe {
+		var err error
+		valueFromEvent, isValueKnown, err = lp.getWipedValue(blockNonce, tokenIdentifiers[0], txLog)
+		if err != nil {
+			return err
+		}
+	}
 
+	for _, tokenIdentifier := range tokenIdentifiers {
+		tokenSupply, err := lp.getSupplyOfToken(tokenIdentifier, supplies)
+		if err != nil {
+			return err
+		}
+
+		lp.updateTokenSupply(tokenSupply, valueFromEvent, string(txLog.Identifier), isRevert)
+		if !isValueKnown {
+			tokenSupply.Partial = true
+		}
+	}
+
+	return nil
+}
+
+// getWipedValue returns the balance wiped by an ESDTWipe event, as the event carries a zero value. The supplies of
+// the token are marked as partial if the wiped balance is not known
+func (lp *logsProcessor) getWipedValue(blockNonce uint64, tokenIdentifier []byte, txLog *transaction.Event) (*big.Int, bool, error) {
+	if len(txLog.Topics) < 4 {
+		return big.NewInt(0), false, nil
+	}
+
+	wipedBalance, err := lp.wipedBalances.GetWipedBalance(blockNonce, tokenIdentifier, txLog.Topics[3])
+	if err != nil {
+		return nil, false, err
+	}
+	if wipedBalance == nil {
+		return big.NewInt(0), false, nil
+	}
+
+	return wipedBalance, true, nil
+}
+
+func (lp *logsProcessor) getSupplyOfToken(tokenIdentifier []byte, supplies map[string]*SupplyESDT) (*SupplyESDT, error) {
 	tokenIDStr := string(tokenIdentifier)
 	tokenSupply, found := supplies[tokenIDStr]
 	if found {
-		lp.updateTokenSupply(tokenSupply, valueFromEvent, string(txLog.Identifier), isRevert)
-		return nil
+		return tokenSupply, nil
 	}
 
-	supply, err := lp.getESDTSupply(tokenIdentifier)
+	tokenSupply, err := lp.getESDTSupply(tokenIdentifier)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	supplies[tokenIDStr] = supply
-	lp.updateTokenSupply(supplies[tokenIDStr], valueFromEvent, string(txLog.Identifier), isRevert)
+	supplies[tokenIDStr] = tokenSupply
 
-	return nil
+	return tokenSupply, nil
 }
 
 func (lp *logsProcessor) updateTokenSupply(tokenSupply *SupplyESDT, valueFromEvent *big.Int, eventIdentifier string, isRevert bool) {
@@ -51,6 +51,7 @@ func TestProcessLogsSaveSupplyNothingInStorage(t *testing.T) {
 	}
 
 	marshalizer := testscommon.MarshalizerMock{}
+	savedKeys := make(map[string]struct{})
 	storer := &storageStubs.StorerStub{
 		GetCalled: func(key []byte) ([]byte, error) {
 			return nil, storage.ErrKeyNotFound
@@ -60,8 +61,7 @@ func TestProcessLogsSaveSupplyNothingInStorage(t *testing.T) {
 				return nil
 			}
 
-			supplyKey := string(token) + "-" + hex.EncodeToString(big.NewInt(2).Bytes())
-			require.Equal(t, supplyKey, string(key))
+			savedKeys[string(key)] = struct{}{}
 
 			var supplyESDT SupplyESDT
 			_ = marshalizer.Unmarshal(&supplyESDT, data)
@@ -71,10 +71,72 @@ func TestProcessLogsSaveSupplyNothingInStorage(t *testing.T) {
 		},
 	}
 
-	logsProc := newLogsProcessor(marshalizer, storer)
+	logsProc := newLogsProcessor(marshalizer, storer, &wipedBalancesHandlerStub{})
 
 	err := logsProc.processLogs(1, logs, false)
 	require.Nil(t, err)
+
+	supplyKey := string(token) + "-" + hex.EncodeToString(big.NewInt(2).Bytes())
+	require.Equal(t, map[string]struct{}{supplyKey: {}, string(token): {}}, savedKeys)
+}
+
+func TestProcessLogsShouldAggregateTheSupplyOfTheCollection(t *testing.T) {
+	t.Parallel()
+
+	collection := []byte("SFT-abcdef")
+	createEvent := func(identifier string, nonce int64, value int64) *transaction.Event {
+		return &transaction.Event{
+			Identifier: []byte(identifier),
+			Topics:     [][]byte{collection, big.NewInt(nonce).Bytes(), big.NewInt(value).Bytes()},
+		}
+	}
+	logs := map[string]*data.LogData{
+		"txLog": {
+			LogHandler: &transaction.Log{
+				Events: []*transaction.Event{
+					createEvent(core.BuiltInFunctionESDTNFTCreate, 1, 100),
+					createEvent(core.BuiltInFunctionESDTNFTCreate, 2, 1),
+					createEvent(core.BuiltInFunctionESDTNFTAddQuantity, 1, 20),
+					createEvent(core.BuiltInFunctionESDTNFTBurn, 1, 5),
+					{
+						Identifier: []byte(core.BuiltInFunctionESDTWipe),
+						Topics:     [][]byte{collection, big.NewInt(2).Bytes(), big.NewInt(0).Bytes(), []byte("holder")},
+					},
+				},
+			},
+		},
+	}
+
+	marshalizer := testscommon.MarshalizerMock{}
+	storer := testscommon.CreateMemUnit()
+	wipedBalances := &wipedBalancesHandlerStub{
+		GetWipedBalanceCalled: func(blockNonce uint64, token []byte, address []byte) (*big.Int, error) {
+			return big.NewInt(1), nil
+		},
+	}
+	logsProc := newLogsProcessor(marshalizer, storer, wipedBalances)
+
+	err := logsProc.processLogs(1, logs, false)
+	require.Nil(t, err)
+
+	supply, err := logsProc.getESDTSupply([]byte("SFT-abcdef-01"))
+	require.Nil(t, err)
+	require.Equal(t, &SupplyESDT{Supply: big.NewInt(115), Minted: big.NewInt(120), Burned: big.NewInt(5)}, supply)
+
+	supply, err = logsProc.getESDTSupply([]byte("SFT-abcdef-02"))
+	require.Nil(t, err)
+	require.Equal(t, &SupplyESDT{Supply: big.NewInt(0), Minted: big.NewInt(1), Burned: big.NewInt(1)}, supply)
+
+	supply, err = logsProc.getESDTSupply(collection)
+	require.Nil(t, err)
+	require.Equal(t, &SupplyESDT{Supply: big.NewInt(115), Minted: big.NewInt(121), Burned: big.NewInt(6)}, supply)
+
+	err = logsProc.processLogs(1, logs, true)
+	require.Nil(t, err)
+
+	supply, err = logsProc.getESDTSupply(collection)
+	require.Nil(t, err)
+	require.Equal(t, newSupplyESDTZero(), supply)
 }
 
 func TestTestProcessLogsSaveSupplyExistsInStorage(t *testing.T) {
@@ -124,7 +186,7 @@ func TestTestProcessLogsSaveSupplyExistsInStorage(t *testing.T) {
 		},
 	}
 
-	logsProc := newLogsProcessor(marshalizer, storer)
+	logsProc := newLogsProcessor(marshalizer, storer, &wipedBalancesHandlerStub{})
 
 	err := logsProc.processLogs(0, logs, false)
 	require.Nil(t, err)
@@ -29,9 +29,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // SupplyESDT is used to store information a shard esdt token supply
 type SupplyESDT struct {
-	Supply *math_big.Int `protobuf:"bytes,1,opt,name=Supply,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"value"`
-	Burned *math_big.Int `protobuf:"bytes,2,opt,name=Burned,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"burned"`
-	Minted *math_big.Int `protobuf:"bytes,3,opt,name=Minted,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"minted"`
+	Supply  *math_big.Int `protobuf:"bytes,1,opt,name=Supply,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"value"`
+	Burned  *math_big.Int `protobuf:"bytes,2,opt,name=Burned,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"burned"`
+	Minted  *math_big.Int `protobuf:"bytes,3,opt,name=Minted,proto3,casttypewith=math/big.Int;github.com/ElrondNetwork/elrond-go-core/data.BigIntCaster" json:"minted"`
+	Partial bool          `protobuf:"varint,4,opt,name=Partial,proto3" json:"partial"`
 }
 
 func (m *SupplyESDT) Reset()      { *m = SupplyESDT{} }
@@ -83,6 +84,13 @@ func (m *SupplyESDT) GetMinted() *math_big.Int {
 	return nil
 }
 
+func (m *SupplyESDT) GetPartial() bool {
+	if m != nil {
+		return m.Partial
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*SupplyESDT)(nil), "proto.SupplyESDT")
 }
@@ -90,26 +98,26 @@ func init() {
 func init() { proto.RegisterFile("supplyESDT.proto", fileDescriptor_173c6d56cc05b222) }
 
 var fileDescriptor_173c6d56cc05b222 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0xd1, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x06, 0x60, 0xbb, 0xa8, 0x19, 0x2c, 0x06, 0x94, 0x29, 0x62, 0x78, 0x41, 0x4c, 0x2c, 0x49,
-	0x06, 0x46, 0xb6, 0xd0, 0x22, 0x75, 0x80, 0x81, 0x74, 0x62, 0x4b, 0x1a, 0xe3, 0x46, 0x4d, 0xe2,
-	0xc8, 0x71, 0x40, 0x6c, 0x1c, 0x81, 0x63, 0x20, 0x4e, 0xc2, 0x98, 0x05, 0x29, 0x53, 0x21, 0xce,
-	0x82, 0x3a, 0xf5, 0x08, 0x08, 0x1b, 0x01, 0x07, 0xe8, 0x64, 0xff, 0xbf, 0x64, 0x7f, 0xd2, 0x7b,
-	0xe4, 0xa0, 0x6e, 0xaa, 0x2a, 0x7f, 0x98, 0x46, 0x93, 0xb9, 0x5f, 0x09, 0x2e, 0xb9, 0x3d, 0xd6,
-	0xc7, 0xa1, 0xc7, 0x32, 0xb9, 0x6c, 0x12, 0x7f, 0xc1, 0x8b, 0x80, 0x71, 0xc6, 0x03, 0x5d, 0x27,
-	0xcd, 0xad, 0x4e, 0x3a, 0xe8, 0x9b, 0x79, 0x75, 0xfc, 0x36, 0x22, 0x24, 0xfa, 0xfd, 0xca, 0x5e,
-	0x11, 0xcb, 0x24, 0x07, 0x1f, 0xe1, 0x93, 0xfd, 0x30, 0xda, 0xac, 0xdd, 0xf1, 0x5d, 0x9c, 0x37,
-	0xf4, 0xe5, 0xdd, 0xbd, 0x28, 0x62, 0xb9, 0x0c, 0x92, 0x8c, 0xf9, 0xb3, 0x52, 0x9e, 0xfd, 0x73,
-	0xa6, 0xb9, 0xe0, 0x65, 0x7a, 0x45, 0xe5, 0x3d, 0x17, 0xab, 0x80, 0xea, 0xe4, 0x31, 0xee, 0x2d,
-	0xb8, 0xa0, 0x41, 0x1a, 0xcb, 0xd8, 0x0f, 0x33, 0x36, 0x2b, 0xe5, 0x79, 0x5c, 0x4b, 0x2a, 0xae,
-	0x7f, 0x08, 0x3b, 0x27, 0x56, 0xd8, 0x88, 0x92, 0xa6, 0xce, 0x48, 0x63, 0xf3, 0xcd, 0xda, 0xb5,
-	0x12, 0xdd, 0xec, 0x52, 0x33, 0xc6, 0xb7, 0x76, 0x99, 0x95, 0x92, 0xa6, 0xce, 0xde, 0x9f, 0x56,
-	0xe8, 0x66, 0x97, 0x9a, 0x31, 0xc2, 0x49, 0xdb, 0x03, 0xea, 0x7a, 0x40, 0xdb, 0x1e, 0xf0, 0xa3,
-	0x02, 0xfc, 0xac, 0x00, 0xbf, 0x2a, 0xc0, 0xad, 0x02, 0xdc, 0x29, 0xc0, 0x1f, 0x0a, 0xf0, 0xa7,
-	0x02, 0xb4, 0x55, 0x80, 0x9f, 0x06, 0x40, 0xed, 0x00, 0xa8, 0x1b, 0x00, 0xdd, 0x10, 0x5a, 0xa7,
-	0xd2, 0x4c, 0x28, 0xb1, 0xf4, 0x92, 0x4e, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x16, 0xcf, 0x36,
-	0xdc, 0xee, 0x01, 0x00, 0x00,
+	// 303 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0x31, 0x4e, 0xf3, 0x30,
+	0x1c, 0x47, 0xed, 0x7e, 0x5f, 0x53, 0x64, 0x18, 0x50, 0xa7, 0x08, 0xa1, 0x7f, 0x2a, 0xa6, 0x2e,
+	0x4d, 0x06, 0x46, 0xb6, 0xd0, 0x22, 0x75, 0x00, 0x21, 0xb5, 0x13, 0x9b, 0x53, 0x1b, 0x37, 0x22,
+	0x8d, 0x23, 0xd7, 0x01, 0xb1, 0x71, 0x04, 0x8e, 0x81, 0x38, 0x09, 0x63, 0xc7, 0x48, 0x48, 0x81,
+	0x38, 0x0b, 0xca, 0xd4, 0x23, 0x20, 0x39, 0x12, 0x70, 0x80, 0x4e, 0xf6, 0x7b, 0x92, 0xfd, 0x86,
+	0x1f, 0x39, 0x5c, 0xe7, 0x59, 0x96, 0x3c, 0x4e, 0x66, 0xe3, 0xb9, 0x9f, 0x29, 0xa9, 0x65, 0xbf,
+	0x6b, 0x8f, 0xa3, 0x91, 0x88, 0xf5, 0x32, 0x8f, 0xfc, 0x85, 0x5c, 0x05, 0x42, 0x0a, 0x19, 0x58,
+	0x1d, 0xe5, 0xb7, 0x96, 0x2c, 0xd8, 0x5b, 0xfb, 0xea, 0xe4, 0xbd, 0x43, 0xc8, 0xec, 0xe7, 0xab,
+	0xfe, 0x82, 0x38, 0x2d, 0xb9, 0x78, 0x80, 0x87, 0x07, 0xe1, 0xac, 0x29, 0xbd, 0xee, 0x3d, 0x4d,
+	0x72, 0xfe, 0xfa, 0xe1, 0x5d, 0xac, 0xa8, 0x5e, 0x06, 0x51, 0x2c, 0xfc, 0x69, 0xaa, 0xcf, 0xfe,
+	0x74, 0x26, 0x89, 0x92, 0x29, 0xbb, 0xe2, 0xfa, 0x41, 0xaa, 0xbb, 0x80, 0x5b, 0x1a, 0x09, 0x39,
+	0x5a, 0x48, 0xc5, 0x03, 0x46, 0x35, 0xf5, 0xc3, 0x58, 0x4c, 0x53, 0x7d, 0x4e, 0xd7, 0x9a, 0xab,
+	0x3e, 0x23, 0x4e, 0x98, 0xab, 0x94, 0x33, 0xb7, 0x63, 0x23, 0xf3, 0xa6, 0xf4, 0x9c, 0xc8, 0x9a,
+	0xdd, 0x56, 0x2e, 0xe3, 0x54, 0x73, 0xe6, 0xfe, 0xfb, 0xad, 0xac, 0xac, 0xd9, 0x61, 0xe5, 0x98,
+	0xf4, 0xae, 0xa9, 0xd2, 0x31, 0x4d, 0xdc, 0xff, 0x03, 0x3c, 0xdc, 0x0b, 0xf7, 0x9b, 0xd2, 0xeb,
+	0x65, 0xad, 0x0a, 0xc7, 0x9b, 0x0a, 0x50, 0x51, 0x01, 0xda, 0x56, 0x80, 0x9f, 0x0c, 0xe0, 0x17,
+	0x03, 0xf8, 0xcd, 0x00, 0xde, 0x18, 0xc0, 0x85, 0x01, 0xfc, 0x69, 0x00, 0x7f, 0x19, 0x40, 0x5b,
+	0x03, 0xf8, 0xb9, 0x06, 0xb4, 0xa9, 0x01, 0x15, 0x35, 0xa0, 0x1b, 0xc2, 0xd7, 0x4c, 0xb7, 0x53,
+	0x44, 0x8e, 0x9d, 0xea, 0xf4, 0x7b, 0x00, 0x47, 0xdc, 0x44, 0xbf, 0xf4, 0x01, 0x00, 0x00,
 }
 
 func (this *SupplyESDT) Equal(that interface{}) bool {
@@ -149,17 +157,21 @@ func (this *SupplyESDT) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Partial != that1.Partial {
+		return false
+	}
 	return true
 }
 func (this *SupplyESDT) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&esdtSupply.SupplyESDT{")
 	s = append(s, "Supply: "+fmt.Sprintf("%#v", this.Supply)+",\n")
 	s = append(s, "Burned: "+fmt.Sprintf("%#v", this.Burned)+",\n")
 	s = append(s, "Minted: "+fmt.Sprintf("%#v", this.Minted)+",\n")
+	s = append(s, "Partial: "+fmt.Sprintf("%#v", this.Partial)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -191,6 +203,16 @@ func (m *SupplyESDT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Partial {
+		i--
+		if m.Partial {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		__caster := &github_com_ElrondNetwork_elrond_go_core_data.BigIntCaster{}
 		size := __caster.Size(m.Minted)
@@ -259,6 +281,9 @@ func (m *SupplyESDT) Size() (n int) {
 		l = __caster.Size(m.Minted)
 		n += 1 + l + sovSupplyESDT(uint64(l))
 	}
+	if m.Partial {
+		n += 2
+	}
 	return n
 }
 
@@ -276,6 +301,7 @@ func (this *SupplyESDT) String() string {
 		`Supply:` + fmt.Sprintf("%v", this.Supply) + `,`,
 		`Burned:` + fmt.Sprintf("%v", this.Burned) + `,`,
 		`Minted:` + fmt.Sprintf("%v", this.Minted) + `,`,
+		`Partial:` + fmt.Sprintf("%v", this.Partial) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -431,6 +457,26 @@ func (m *SupplyESDT) Unmarshal(dAtA []byte) error {
 				}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partial", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSupplyESDT
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Partial = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSupplyESDT(dAtA[iNdEx:])
@@ -0,0 +1,14 @@
+package eventsIndex
+
+import "errors"
+
+// ErrInvalidBlocksRange signals that the provided blocks range is invalid
+var ErrInvalidBlocksRange = errors.New("invalid blocks range")
+
+// ErrBlocksRangeTooLarge signals that the provided blocks range requires too many storage reads
+var ErrBlocksRangeTooLarge = errors.New("blocks range too large for the provided filters")
+
+// IsQueryError returns true if the provided error was caused by an events query that can not be served
+func IsQueryError(err error) bool {
+	return errors.Is(err, ErrInvalidBlocksRange) || errors.Is(err, ErrBlocksRangeTooLarge)
+}
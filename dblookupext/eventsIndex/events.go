@@ -0,0 +1,21 @@
+package eventsIndex
+
+// EventsQuery holds the filters of an events query. Empty filters match every event
+type EventsQuery struct {
+	Address    []byte
+	Identifier []byte
+	Topic0     []byte
+	FromBlock  uint64
+	ToBlock    uint64
+	// DefaultFromBlock is set when the start of the blocks range was not provided, in which case the range covers the
+	// last defaultBlocksRange blocks up to its end
+	DefaultFromBlock bool
+}
+
+// EventsPage holds a page of the events matching a query, together with the blocks range that was searched
+type EventsPage struct {
+	Events    []*IndexedEvent
+	FromBlock uint64
+	ToBlock   uint64
+	HasMore   bool
+}
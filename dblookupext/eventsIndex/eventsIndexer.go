@@ -0,0 +1,486 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. events.proto
+
+package eventsIndex
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	blockKeyPrefix      = "block@"
+	addressKeyPrefix    = "address@"
+	identifierKeyPrefix = "identifier@"
+	topic0KeyPrefix     = "topic0@"
+	lastIndexedBlockKey = "last-indexed-block"
+
+	// noncesPerBucket is the number of consecutive block nonces grouped under the same index key
+	noncesPerBucket = 1000
+	// defaultBlocksRange is the number of blocks searched by the queries which do not provide the start of the range
+	defaultBlocksRange = 1000
+	// maxStorageReadsPerQuery limits the number of index records read while searching the blocks range of a query
+	maxStorageReadsPerQuery = 10000
+)
+
+type filter struct {
+	prefix string
+	value  []byte
+}
+
+// ArgsEventsIndexer holds the arguments needed to create an events indexer
+type ArgsEventsIndexer struct {
+	Marshalizer  marshal.Marshalizer
+	EventsStorer storage.Storer
+}
+
+type eventsIndexer struct {
+	marshalizer  marshal.Marshalizer
+	eventsStorer storage.Storer
+	mutex        sync.RWMutex
+}
+
+// NewEventsIndexer will create a new instance of the events indexer which indexes the events emitted in the blocks of
+// the current shard by their emitter address, identifier and first topic
+func NewEventsIndexer(args ArgsEventsIndexer) (*eventsIndexer, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.EventsStorer) {
+		return nil, core.ErrNilStore
+	}
+
+	return &eventsIndexer{
+		marshalizer:  args.Marshalizer,
+		eventsStorer: args.EventsStorer,
+	}, nil
+}
+
+// ProcessLogs will index the events from the provided logs of the block with the given nonce
+func (ei *eventsIndexer) ProcessLogs(blockNonce uint64, logs []*data.LogData) error {
+	ei.mutex.Lock()
+	defer ei.mutex.Unlock()
+
+	// a block with the same nonce might have been indexed on a fork that was not reverted through RevertChanges
+	err := ei.removeBlock(blockNonce)
+	if err != nil {
+		return err
+	}
+
+	events := extractEvents(blockNonce, logs)
+	if len(events) > 0 {
+		err = ei.put(computeBlockKey(blockNonce), &BlockEvents{Events: events})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range computeBucketKeys(blockNonce, events) {
+			err = ei.addNonceToBucket(key, blockNonce)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return ei.put([]byte(lastIndexedBlockKey), &LastIndexedBlock{Nonce: blockNonce})
+}
+
+// RevertChanges will remove the events of the provided block from the index
+func (ei *eventsIndexer) RevertChanges(header data.HeaderHandler, _ data.BodyHandler) error {
+	if check.IfNil(header) {
+		return nil
+	}
+
+	ei.mutex.Lock()
+	defer ei.mutex.Unlock()
+
+	blockNonce := header.GetNonce()
+	err := ei.removeBlock(blockNonce)
+	if err != nil {
+		return err
+	}
+
+	lastIndexedBlock, err := ei.getLastIndexedBlock()
+	if err != nil {
+		return err
+	}
+	if blockNonce == 0 || lastIndexedBlock.Nonce < blockNonce {
+		return nil
+	}
+
+	return ei.put([]byte(lastIndexedBlockKey), &LastIndexedBlock{Nonce: blockNonce - 1})
+}
+
+func extractEvents(blockNonce uint64, logs []*data.LogData) []*IndexedEvent {
+	events := make([]*IndexedEvent, 0)
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		for index, entryHandler := range logData.LogHandler.GetLogEvents() {
+			event, ok := entryHandler.(*transaction.Event)
+			if !ok || event == nil {
+				continue
+			}
+
+			events = append(events, &IndexedEvent{
+				BlockNonce: blockNonce,
+				TxHash:     []byte(logData.TxHash),
+				Index:      uint32(index),
+				Address:    event.Address,
+				Identifier: event.Identifier,
+				Topics:     event.Topics,
+				Data:       event.Data,
+			})
+		}
+	}
+
+	return events
+}
+
+func computeEventFilters(event *IndexedEvent) []filter {
+	filters := make([]filter, 0, 3)
+	if len(event.Address) > 0 {
+		filters = append(filters, filter{prefix: addressKeyPrefix, value: event.Address})
+	}
+	if len(event.Identifier) > 0 {
+		filters = append(filters, filter{prefix: identifierKeyPrefix, value: event.Identifier})
+	}
+	if len(event.Topics) > 0 && len(event.Topics[0]) > 0 {
+		filters = append(filters, filter{prefix: topic0KeyPrefix, value: event.Topics[0]})
+	}
+
+	return filters
+}
+
+func computeQueryFilters(query EventsQuery) []filter {
+	filters := make([]filter, 0, 3)
+	if len(query.Address) > 0 {
+		filters = append(filters, filter{prefix: addressKeyPrefix, value: query.Address})
+	}
+	if len(query.Identifier) > 0 {
+		filters = append(filters, filter{prefix: identifierKeyPrefix, value: query.Identifier})
+	}
+	if len(query.Topic0) > 0 {
+		filters = append(filters, filter{prefix: topic0KeyPrefix, value: query.Topic0})
+	}
+
+	return filters
+}
+
+func computeBucketKeys(blockNonce uint64, events []*IndexedEvent) [][]byte {
+	uniqueKeys := make(map[string]struct{})
+	keys := make([][]byte, 0)
+	for _, event := range events {
+		for _, f := range computeEventFilters(event) {
+			key := computeBucketKey(f, blockNonce/noncesPerBucket)
+			_, found := uniqueKeys[string(key)]
+			if found {
+				continue
+			}
+
+			uniqueKeys[string(key)] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func (ei *eventsIndexer) removeBlock(blockNonce uint64) error {
+	blockEvents := &BlockEvents{}
+	found, err := ei.get(computeBlockKey(blockNonce), blockEvents)
+	if err != nil || !found {
+		return err
+	}
+
+	for _, key := range computeBucketKeys(blockNonce, blockEvents.Events) {
+		err = ei.removeNonceFromBucket(key, blockNonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return ei.eventsStorer.Remove(computeBlockKey(blockNonce))
+}
+
+func (ei *eventsIndexer) addNonceToBucket(key []byte, blockNonce uint64) error {
+	bucket := &NoncesBucket{}
+	_, err := ei.get(key, bucket)
+	if err != nil {
+		return err
+	}
+
+	nonces := bucket.Nonces
+	index := sort.Search(len(nonces), func(i int) bool {
+		return nonces[i] >= blockNonce
+	})
+	if index < len(nonces) && nonces[index] == blockNonce {
+		return nil
+	}
+
+	nonces = append(nonces, 0)
+	copy(nonces[index+1:], nonces[index:])
+	nonces[index] = blockNonce
+
+	return ei.put(key, &NoncesBucket{Nonces: nonces})
+}
+
+func (ei *eventsIndexer) removeNonceFromBucket(key []byte, blockNonce uint64) error {
+	bucket := &NoncesBucket{}
+	_, err := ei.get(key, bucket)
+	if err != nil {
+		return err
+	}
+
+	nonces := bucket.Nonces
+	index := sort.Search(len(nonces), func(i int) bool {
+		return nonces[i] >= blockNonce
+	})
+	if index == len(nonces) || nonces[index] != blockNonce {
+		return nil
+	}
+
+	nonces = append(nonces[:index], nonces[index+1:]...)
+	if len(nonces) == 0 {
+		return ei.eventsStorer.Remove(key)
+	}
+
+	return ei.put(key, &NoncesBucket{Nonces: nonces})
+}
+
+// GetEvents will return the requested page of the events matching the provided query. The end of the blocks range is
+// capped to the last indexed block and, if not provided, the start of the range is set defaultBlocksRange blocks before
+// its end
+func (ei *eventsIndexer) GetEvents(query EventsQuery, from uint32, size uint32) (*EventsPage, error) {
+	if query.FromBlock > query.ToBlock {
+		return nil, ErrInvalidBlocksRange
+	}
+
+	ei.mutex.RLock()
+	defer ei.mutex.RUnlock()
+
+	lastIndexedBlock, err := ei.getLastIndexedBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	page := &EventsPage{
+		Events:    make([]*IndexedEvent, 0),
+		FromBlock: query.FromBlock,
+		ToBlock:   query.ToBlock,
+	}
+	if page.ToBlock > lastIndexedBlock.Nonce {
+		page.ToBlock = lastIndexedBlock.Nonce
+	}
+	if query.DefaultFromBlock && page.ToBlock >= defaultBlocksRange {
+		page.FromBlock = page.ToBlock - defaultBlocksRange + 1
+	}
+	if page.FromBlock > page.ToBlock || size == 0 {
+		return page, nil
+	}
+
+	filters := computeQueryFilters(query)
+	numReads := page.ToBlock - page.FromBlock
+	if len(filters) > 0 {
+		numReads = (page.ToBlock/noncesPerBucket - page.FromBlock/noncesPerBucket) * uint64(len(filters))
+	}
+	if numReads >= maxStorageReadsPerQuery {
+		return nil, ErrBlocksRangeTooLarge
+	}
+
+	numSkipped := uint32(0)
+	collectEvents := func(blockNonce uint64) (bool, error) {
+		blockEvents := &BlockEvents{}
+		_, errGet := ei.get(computeBlockKey(blockNonce), blockEvents)
+		if errGet != nil {
+			return false, errGet
+		}
+
+		for _, event := range blockEvents.Events {
+			if !eventMatchesQuery(event, query) {
+				continue
+			}
+			if numSkipped < from {
+				numSkipped++
+				continue
+			}
+			if uint32(len(page.Events)) == size {
+				page.HasMore = true
+				return false, nil
+			}
+
+			page.Events = append(page.Events, event)
+		}
+
+		return true, nil
+	}
+
+	if len(filters) == 0 {
+		err = ei.iterateBlocks(page.FromBlock, page.ToBlock, collectEvents)
+	} else {
+		err = ei.iterateFilteredBlocks(filters, page.FromBlock, page.ToBlock, collectEvents)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (ei *eventsIndexer) iterateBlocks(fromBlock uint64, toBlock uint64, handler func(blockNonce uint64) (bool, error)) error {
+	for blockNonce := fromBlock; blockNonce <= toBlock; blockNonce++ {
+		shouldContinue, err := handler(blockNonce)
+		if err != nil || !shouldContinue {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ei *eventsIndexer) iterateFilteredBlocks(
+	filters []filter,
+	fromBlock uint64,
+	toBlock uint64,
+	handler func(blockNonce uint64) (bool, error),
+) error {
+	for bucketIndex := fromBlock / noncesPerBucket; bucketIndex <= toBlock/noncesPerBucket; bucketIndex++ {
+		nonces, err := ei.getMatchingNonces(filters, bucketIndex)
+		if err != nil {
+			return err
+		}
+
+		for _, blockNonce := range nonces {
+			if blockNonce < fromBlock || blockNonce > toBlock {
+				continue
+			}
+
+			shouldContinue, errHandle := handler(blockNonce)
+			if errHandle != nil || !shouldContinue {
+				return errHandle
+			}
+		}
+	}
+
+	return nil
+}
+
+func (ei *eventsIndexer) getMatchingNonces(filters []filter, bucketIndex uint64) ([]uint64, error) {
+	var nonces []uint64
+	for i, f := range filters {
+		bucket := &NoncesBucket{}
+		_, err := ei.get(computeBucketKey(f, bucketIndex), bucket)
+		if err != nil {
+			return nil, err
+		}
+
+		if i == 0 {
+			nonces = bucket.Nonces
+		} else {
+			nonces = intersectSortedNonces(nonces, bucket.Nonces)
+		}
+		if len(nonces) == 0 {
+			return nil, nil
+		}
+	}
+
+	return nonces, nil
+}
+
+func intersectSortedNonces(first []uint64, second []uint64) []uint64 {
+	result := make([]uint64, 0)
+	i, j := 0, 0
+	for i < len(first) && j < len(second) {
+		switch {
+		case first[i] < second[j]:
+			i++
+		case first[i] > second[j]:
+			j++
+		default:
+			result = append(result, first[i])
+			i++
+			j++
+		}
+	}
+
+	return result
+}
+
+func eventMatchesQuery(event *IndexedEvent, query EventsQuery) bool {
+	if len(query.Address) > 0 && !bytes.Equal(event.Address, query.Address) {
+		return false
+	}
+	if len(query.Identifier) > 0 && !bytes.Equal(event.Identifier, query.Identifier) {
+		return false
+	}
+	if len(query.Topic0) > 0 && (len(event.Topics) == 0 || !bytes.Equal(event.Topics[0], query.Topic0)) {
+		return false
+	}
+
+	return true
+}
+
+func (ei *eventsIndexer) getLastIndexedBlock() (*LastIndexedBlock, error) {
+	lastIndexedBlock := &LastIndexedBlock{}
+	_, err := ei.get([]byte(lastIndexedBlockKey), lastIndexedBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return lastIndexedBlock, nil
+}
+
+func (ei *eventsIndexer) put(key []byte, value interface{}) error {
+	buff, err := ei.marshalizer.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return ei.eventsStorer.Put(key, buff)
+}
+
+func (ei *eventsIndexer) get(key []byte, value interface{}) (bool, error) {
+	buff, err := ei.eventsStorer.Get(key)
+	if err == storage.ErrKeyNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, ei.marshalizer.Unmarshal(value, buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ei *eventsIndexer) IsInterfaceNil() bool {
+	return ei == nil
+}
+
+func computeBlockKey(blockNonce uint64) []byte {
+	return append([]byte(blockKeyPrefix), uint64ToBytes(blockNonce)...)
+}
+
+func computeBucketKey(f filter, bucketIndex uint64) []byte {
+	key := make([]byte, 0, len(f.prefix)+len(f.value)+8)
+	key = append(key, f.prefix...)
+	key = append(key, f.value...)
+
+	return append(key, uint64ToBytes(bucketIndex)...)
+}
+
+func uint64ToBytes(value uint64) []byte {
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint64(buff, value)
+
+	return buff
+}
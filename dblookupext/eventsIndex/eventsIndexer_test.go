@@ -0,0 +1,229 @@
+package eventsIndex
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testAlice    = []byte("alice")
+	testBob      = []byte("bob")
+	testTransfer = []byte("transfer")
+	testSwap     = []byte("swap")
+	testToken    = []byte("TKN-1q2w3e")
+)
+
+func createEvent(address []byte, identifier []byte, topics ...[]byte) *transaction.Event {
+	return &transaction.Event{
+		Address:    address,
+		Identifier: identifier,
+		Topics:     topics,
+		Data:       []byte("data"),
+	}
+}
+
+func createLogData(txHash string, events ...*transaction.Event) *data.LogData {
+	return &data.LogData{
+		TxHash:     txHash,
+		LogHandler: &transaction.Log{Events: events},
+	}
+}
+
+func createIndexer(t *testing.T) *eventsIndexer {
+	ei, err := NewEventsIndexer(ArgsEventsIndexer{
+		Marshalizer:  &marshal.GogoProtoMarshalizer{},
+		EventsStorer: testscommon.CreateMemUnit(),
+	})
+	require.Nil(t, err)
+
+	return ei
+}
+
+func requireEvents(t *testing.T, ei *eventsIndexer, query EventsQuery, expectedTxHashes ...string) {
+	page, err := ei.GetEvents(query, 0, 100)
+	require.Nil(t, err)
+
+	var txHashes []string
+	for _, event := range page.Events {
+		txHashes = append(txHashes, string(event.TxHash))
+	}
+	require.Equal(t, expectedTxHashes, txHashes)
+}
+
+func TestNewEventsIndexer(t *testing.T) {
+	t.Parallel()
+
+	ei, err := NewEventsIndexer(ArgsEventsIndexer{EventsStorer: testscommon.CreateMemUnit()})
+	require.Equal(t, core.ErrNilMarshalizer, err)
+	require.True(t, check.IfNil(ei))
+
+	ei, err = NewEventsIndexer(ArgsEventsIndexer{Marshalizer: &marshal.GogoProtoMarshalizer{}})
+	require.Equal(t, core.ErrNilStore, err)
+	require.True(t, check.IfNil(ei))
+
+	ei, err = NewEventsIndexer(ArgsEventsIndexer{
+		Marshalizer:  &marshal.GogoProtoMarshalizer{},
+		EventsStorer: testscommon.CreateMemUnit(),
+	})
+	require.Nil(t, err)
+	require.False(t, check.IfNil(ei))
+}
+
+func TestEventsIndexer_ProcessLogsAndGetEvents(t *testing.T) {
+	t.Parallel()
+
+	ei := createIndexer(t)
+	err := ei.ProcessLogs(5, []*data.LogData{
+		createLogData("tx1", createEvent(testAlice, testTransfer, testToken), createEvent(testBob, testSwap)),
+		nil,
+		createLogData("tx2", createEvent(testBob, testTransfer, []byte("other"))),
+	})
+	require.Nil(t, err)
+	err = ei.ProcessLogs(1500, []*data.LogData{
+		createLogData("tx3", createEvent(testAlice, testSwap, testToken)),
+	})
+	require.Nil(t, err)
+
+	allBlocks := EventsQuery{ToBlock: math.MaxUint64}
+	requireEvents(t, ei, allBlocks, "tx1", "tx1", "tx2", "tx3")
+
+	query := allBlocks
+	query.Address = testAlice
+	requireEvents(t, ei, query, "tx1", "tx3")
+
+	query = allBlocks
+	query.Identifier = testTransfer
+	requireEvents(t, ei, query, "tx1", "tx2")
+
+	query.Topic0 = testToken
+	requireEvents(t, ei, query, "tx1")
+
+	query = EventsQuery{Topic0: testToken, FromBlock: 6, ToBlock: 2000}
+	requireEvents(t, ei, query, "tx3")
+
+	page, err := ei.GetEvents(EventsQuery{Address: testBob, ToBlock: 10}, 0, 1)
+	require.Nil(t, err)
+	require.True(t, page.HasMore)
+	require.Equal(t, uint64(10), page.ToBlock)
+	require.Equal(t, &IndexedEvent{
+		BlockNonce: 5,
+		TxHash:     []byte("tx1"),
+		Index:      1,
+		Address:    testBob,
+		Identifier: testSwap,
+		Data:       []byte("data"),
+	}, page.Events[0])
+
+	page, err = ei.GetEvents(EventsQuery{Address: testBob, ToBlock: math.MaxUint64}, 1, 1)
+	require.Nil(t, err)
+	require.False(t, page.HasMore)
+	require.Equal(t, uint64(1500), page.ToBlock)
+	require.Equal(t, []byte("tx2"), page.Events[0].TxHash)
+}
+
+func TestEventsIndexer_RevertChangesShouldRemoveBlockEvents(t *testing.T) {
+	t.Parallel()
+
+	ei := createIndexer(t)
+	_ = ei.ProcessLogs(5, []*data.LogData{createLogData("tx1", createEvent(testAlice, testTransfer, testToken))})
+	_ = ei.ProcessLogs(6, []*data.LogData{createLogData("tx2", createEvent(testAlice, testTransfer, testToken))})
+
+	err := ei.RevertChanges(&block.Header{Nonce: 6}, &block.Body{})
+	require.Nil(t, err)
+
+	query := EventsQuery{Address: testAlice, ToBlock: math.MaxUint64}
+	requireEvents(t, ei, query, "tx1")
+	page, _ := ei.GetEvents(query, 0, 10)
+	require.Equal(t, uint64(5), page.ToBlock)
+
+	// another block with the same nonce is indexed after the revert
+	_ = ei.ProcessLogs(6, []*data.LogData{createLogData("tx3", createEvent(testBob, testSwap))})
+	requireEvents(t, ei, query, "tx1")
+	requireEvents(t, ei, EventsQuery{Address: testBob, ToBlock: math.MaxUint64}, "tx3")
+
+	// a block with the same nonce from another fork replaces the indexed one
+	_ = ei.ProcessLogs(6, []*data.LogData{createLogData("tx4", createEvent(testAlice, testSwap))})
+	requireEvents(t, ei, query, "tx1", "tx4")
+	requireEvents(t, ei, EventsQuery{Address: testBob, ToBlock: math.MaxUint64})
+
+	err = ei.RevertChanges(nil, nil)
+	require.Nil(t, err)
+}
+
+func TestEventsIndexer_GetEventsInvalidRanges(t *testing.T) {
+	t.Parallel()
+
+	ei := createIndexer(t)
+	_ = ei.ProcessLogs(maxStorageReadsPerQuery+1, []*data.LogData{createLogData("tx1", createEvent(testAlice, testSwap))})
+
+	_, err := ei.GetEvents(EventsQuery{FromBlock: 10, ToBlock: 9}, 0, 10)
+	require.Equal(t, ErrInvalidBlocksRange, err)
+
+	_, err = ei.GetEvents(EventsQuery{ToBlock: math.MaxUint64}, 0, 10)
+	require.Equal(t, ErrBlocksRangeTooLarge, err)
+
+	requireEvents(t, ei, EventsQuery{Address: testAlice, ToBlock: math.MaxUint64}, "tx1")
+	requireEvents(t, ei, EventsQuery{FromBlock: 2, ToBlock: math.MaxUint64}, "tx1")
+
+	page, err := ei.GetEvents(EventsQuery{FromBlock: maxStorageReadsPerQuery + 2, ToBlock: math.MaxUint64}, 0, 10)
+	require.Nil(t, err)
+	require.Empty(t, page.Events)
+}
+
+func TestEventsIndexer_GetEventsDefaultFromBlock(t *testing.T) {
+	t.Parallel()
+
+	ei := createIndexer(t)
+	_ = ei.ProcessLogs(5, []*data.LogData{createLogData("tx1", createEvent(testAlice, testSwap))})
+	_ = ei.ProcessLogs(20000, []*data.LogData{createLogData("tx2", createEvent(testAlice, testSwap))})
+
+	query := EventsQuery{ToBlock: math.MaxUint64, DefaultFromBlock: true}
+	page, err := ei.GetEvents(query, 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(20000-defaultBlocksRange+1), page.FromBlock)
+	require.Equal(t, uint64(20000), page.ToBlock)
+	requireEvents(t, ei, query, "tx2")
+
+	query.Address = testAlice
+	requireEvents(t, ei, query, "tx2")
+
+	query.ToBlock = 10
+	page, err = ei.GetEvents(query, 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, uint64(0), page.FromBlock)
+	requireEvents(t, ei, query, "tx1")
+}
+
+func TestEventsIndexer_StorerErrorShouldBeReturned(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	ei, _ := NewEventsIndexer(ArgsEventsIndexer{
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+		EventsStorer: &storageStubs.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+		},
+	})
+
+	err := ei.ProcessLogs(1, nil)
+	require.Equal(t, expectedErr, err)
+
+	err = ei.RevertChanges(&block.Header{Nonce: 1}, &block.Body{})
+	require.Equal(t, expectedErr, err)
+
+	_, err = ei.GetEvents(EventsQuery{}, 0, 10)
+	require.Equal(t, expectedErr, err)
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/disabled"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/eventsIndex"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
 )
 
 // ArgsHistoryRepositoryFactory holds all dependencies required by the history processor factory in order to create
@@ -23,6 +25,7 @@ type ArgsHistoryRepositoryFactory struct {
 	Marshalizer              marshal.Marshalizer
 	Hasher                   hashing.Hasher
 	Uint64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator         sharding.Coordinator
 }
 
 type historyRepositoryFactory struct {
@@ -32,6 +35,7 @@ type historyRepositoryFactory struct {
 	marshalizer              marshal.Marshalizer
 	hasher                   hashing.Hasher
 	uInt64ByteSliceConverter typeConverters.Uint64ByteSliceConverter
+	shardCoordinator         sharding.Coordinator
 }
 
 // NewHistoryRepositoryFactory creates an instance of historyRepositoryFactory
@@ -48,6 +52,9 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 	if check.IfNil(args.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
 
 	return &historyRepositoryFactory{
 		selfShardID:              args.SelfShardID,
@@ -56,6 +63,7 @@ func NewHistoryRepositoryFactory(args *ArgsHistoryRepositoryFactory) (dblookupex
 		marshalizer:              args.Marshalizer,
 		hasher:                   args.Hasher,
 		uInt64ByteSliceConverter: args.Uint64ByteSliceConverter,
+		shardCoordinator:         args.ShardCoordinator,
 	}, nil
 }
 
@@ -65,15 +73,26 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return disabled.NewNilHistoryRepository()
 	}
 
+	esdtHoldersHandler, err := hpf.createESDTHoldersHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	esdtSuppliesHandler, err := esdtSupply.NewSuppliesProcessor(
 		hpf.marshalizer,
 		hpf.store.GetStorer(dataRetriever.ESDTSuppliesUnit),
 		hpf.store.GetStorer(dataRetriever.TxLogsUnit),
+		esdtHoldersHandler,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	eventsHandler, err := hpf.createEventsHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	historyRepArgs := dblookupext.HistoryRepositoryArguments{
 		SelfShardID:                 hpf.selfShardID,
 		Hasher:                      hpf.hasher,
@@ -85,10 +104,36 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		ESDTHoldersHandler:          esdtHoldersHandler,
+		EventsHandler:               eventsHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
+func (hpf *historyRepositoryFactory) createESDTHoldersHandler() (dblookupext.HoldersHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.ESDTHoldersIndexEnabled {
+		return disabled.NewDisabledHoldersProcessor(), nil
+	}
+
+	return esdtSupply.NewHoldersProcessor(esdtSupply.ArgsHoldersProcessor{
+		Marshalizer:      hpf.marshalizer,
+		ShardCoordinator: hpf.shardCoordinator,
+		HoldersStorer:    hpf.store.GetStorer(dataRetriever.ESDTHoldersUnit),
+		LogsStorer:       hpf.store.GetStorer(dataRetriever.TxLogsUnit),
+	})
+}
+
+func (hpf *historyRepositoryFactory) createEventsHandler() (dblookupext.EventsHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.EventsIndexEnabled {
+		return disabled.NewDisabledEventsIndexer(), nil
+	}
+
+	return eventsIndex.NewEventsIndexer(eventsIndex.ArgsEventsIndexer{
+		Marshalizer:  hpf.marshalizer,
+		EventsStorer: hpf.store.GetStorer(dataRetriever.EventsIndexUnit),
+	})
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hpf *historyRepositoryFactory) IsInterfaceNil() bool {
 	return hpf == nil
@@ -12,8 +12,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	processMock "github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
-	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,6 +44,12 @@ func TestNewHistoryRepositoryFactory(t *testing.T) {
 	require.Equal(t, process.ErrNilUint64Converter, err)
 	require.Nil(t, hrf)
 
+	argsNilShardCoordinator := getArgs()
+	argsNilShardCoordinator.ShardCoordinator = nil
+	hrf, err = factory.NewHistoryRepositoryFactory(argsNilShardCoordinator)
+	require.Equal(t, process.ErrNilShardCoordinator, err)
+	require.Nil(t, hrf)
+
 	hrf, err = factory.NewHistoryRepositoryFactory(args)
 	require.NoError(t, err)
 	require.False(t, check.IfNil(hrf))
@@ -63,7 +69,7 @@ func TestHistoryRepositoryFactory_CreateShouldCreateRegularRepository(t *testing
 	args.Config.Enabled = true
 	args.Store = &mock.ChainStorerMock{
 		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
-			return &storageStubs.StorerStub{}
+			return testscommon.CreateMemUnit()
 		},
 	}
 
@@ -75,6 +81,47 @@ func TestHistoryRepositoryFactory_CreateShouldCreateRegularRepository(t *testing
 	require.True(t, repository.IsEnabled())
 }
 
+func TestHistoryRepositoryFactory_CreateWithHoldersIndexShouldWork(t *testing.T) {
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Config.ESDTHoldersIndexEnabled = true
+	requestedUnits := make(map[dataRetriever.UnitType]struct{})
+	args.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			requestedUnits[unitType] = struct{}{}
+			return testscommon.CreateMemUnit()
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	require.True(t, repository.IsEnabled())
+	require.Contains(t, requestedUnits, dataRetriever.ESDTHoldersUnit)
+}
+
+func TestHistoryRepositoryFactory_CreateWithEventsIndexShouldWork(t *testing.T) {
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Config.EventsIndexEnabled = true
+	requestedUnits := make(map[dataRetriever.UnitType]struct{})
+	args.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			requestedUnits[unitType] = struct{}{}
+			return testscommon.CreateMemUnit()
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	require.True(t, repository.IsEnabled())
+	require.Contains(t, requestedUnits, dataRetriever.EventsIndexUnit)
+	require.NotContains(t, requestedUnits, dataRetriever.ESDTHoldersUnit)
+}
+
 func getArgs() *factory.ArgsHistoryRepositoryFactory {
 	return &factory.ArgsHistoryRepositoryFactory{
 		SelfShardID:              0,
@@ -83,5 +130,6 @@ func getArgs() *factory.ArgsHistoryRepositoryFactory {
 		Marshalizer:              &mock.MarshalizerMock{},
 		Hasher:                   &hashingMocks.HasherMock{},
 		Uint64ByteSliceConverter: &processMock.Uint64ByteSliceConverterMock{},
+		ShardCoordinator:         testscommon.NewMultiShardsCoordinatorMock(1),
 	}
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/eventsIndex"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
@@ -37,6 +38,8 @@ type HistoryRepositoryArguments struct {
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
+	ESDTHoldersHandler          HoldersHandler
+	EventsHandler               EventsHandler
 }
 
 type historyRepository struct {
@@ -50,6 +53,8 @@ type historyRepository struct {
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
+	esdtHoldersHandler         HoldersHandler
+	eventsHandler              EventsHandler
 
 	// These maps temporarily hold notifications of "notarized at source or destination", to deal with unwanted concurrency effects
 	// The unwanted concurrency effects could be accentuated by the fast db-replay-validate mechanism.
@@ -93,6 +98,12 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.ESDTSuppliesHandler) {
 		return nil, errNilESDTSuppliesHandler
 	}
+	if check.IfNil(arguments.ESDTHoldersHandler) {
+		return nil, errNilESDTHoldersHandler
+	}
+	if check.IfNil(arguments.EventsHandler) {
+		return nil, errNilEventsHandler
+	}
 	if check.IfNil(arguments.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
@@ -116,6 +127,8 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		deduplicationCacheForInsertMiniblockMetadata: deduplicationCacheForInsertMiniblockMetadata,
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
+		esdtHoldersHandler:                           arguments.ESDTHoldersHandler,
+		eventsHandler:                                arguments.EventsHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
 	}, nil
 }
@@ -161,11 +174,22 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	// the holders are processed first, as the supplies subtract the balances wiped from the holders
+	err = hr.esdtHoldersHandler.ProcessLogs(blockHeader.GetNonce(), logs)
+	if err != nil {
+		return err
+	}
+
 	err = hr.esdtSuppliesHandler.ProcessLogs(blockHeader.GetNonce(), logs)
 	if err != nil {
 		return err
 	}
 
+	err = hr.eventsHandler.ProcessLogs(blockHeader.GetNonce(), logs)
+	if err != nil {
+		return err
+	}
+
 	err = hr.putHashByRound(blockHeaderHash, blockHeader)
 	if err != nil {
 		return err
@@ -466,7 +490,18 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	// the supplies are reverted first, as the holders remove the balances wiped in the reverted block
+	err := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	err = hr.esdtHoldersHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	return hr.eventsHandler.RevertChanges(blockHeader, blockBody)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -474,6 +509,21 @@ func (hr *historyRepository) GetESDTSupply(token string) (*esdtSupply.SupplyESDT
 	return hr.esdtSuppliesHandler.GetESDTSupply(token)
 }
 
+// GetESDTHolders will return the requested page of the holders of the given token from the current shard
+func (hr *historyRepository) GetESDTHolders(token string, from uint32, size uint32) ([]*esdtSupply.HolderBalance, uint32, error) {
+	return hr.esdtHoldersHandler.GetESDTHolders(token, from, size)
+}
+
+// GetCollectionNFTs will return the requested page of the nonces of the given collection held in the current shard
+func (hr *historyRepository) GetCollectionNFTs(collection string, from uint32, size uint32) ([]*esdtSupply.NFTHolders, uint32, error) {
+	return hr.esdtHoldersHandler.GetCollectionNFTs(collection, from, size)
+}
+
+// GetEvents will return the requested page of the events from the current shard matching the given query
+func (hr *historyRepository) GetEvents(query eventsIndex.EventsQuery, from uint32, size uint32) (*eventsIndex.EventsPage, error) {
+	return hr.eventsHandler.GetEvents(query, from, size)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hr *historyRepository) IsInterfaceNil() bool {
 	return hr == nil
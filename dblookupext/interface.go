@@ -1,8 +1,11 @@
 package dblookupext
 
 import (
+	"math/big"
+
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/eventsIndex"
 )
 
 // HistoryRepositoryFactory can create new instances of HistoryRepository
@@ -25,6 +28,9 @@ type HistoryRepository interface {
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetESDTHolders(token string, from uint32, size uint32) ([]*esdtSupply.HolderBalance, uint32, error)
+	GetCollectionNFTs(collection string, from uint32, size uint32) ([]*esdtSupply.NFTHolders, uint32, error)
+	GetEvents(query eventsIndex.EventsQuery, from uint32, size uint32) (*eventsIndex.EventsPage, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -45,3 +51,21 @@ type SuppliesHandler interface {
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
 	IsInterfaceNil() bool
 }
+
+// HoldersHandler defines the interface of a token holders processor
+type HoldersHandler interface {
+	ProcessLogs(blockNonce uint64, logs []*data.LogData) error
+	RevertChanges(header data.HeaderHandler, body data.BodyHandler) error
+	GetESDTHolders(token string, from uint32, size uint32) ([]*esdtSupply.HolderBalance, uint32, error)
+	GetCollectionNFTs(collection string, from uint32, size uint32) ([]*esdtSupply.NFTHolders, uint32, error)
+	GetWipedBalance(blockNonce uint64, token []byte, address []byte) (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// EventsHandler defines the interface of an events index
+type EventsHandler interface {
+	ProcessLogs(blockNonce uint64, logs []*data.LogData) error
+	RevertChanges(header data.HeaderHandler, body data.BodyHandler) error
+	GetEvents(query eventsIndex.EventsQuery, from uint32, size uint32) (*eventsIndex.EventsPage, error)
+	IsInterfaceNil() bool
+}
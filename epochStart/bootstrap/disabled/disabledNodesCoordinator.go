@@ -88,6 +88,11 @@ func (n *nodesCoordinator) ShardIdForEpoch(_ uint32) (uint32, error) {
 func (ncm *nodesCoordinator) ShuffleOutForEpoch(_ uint32) {
 }
 
+// PreviewNodesConfig -
+func (n *nodesCoordinator) PreviewNodesConfig(_ uint32, _ []byte, _ []*state.ShardValidatorInfo) (*nodesCoord.NodesConfigPreview, error) {
+	return nil, nil
+}
+
 // GetConsensusWhitelistedNodes -
 func (n *nodesCoordinator) GetConsensusWhitelistedNodes(_ uint32) (map[string]struct{}, error) {
 	return nil, nil
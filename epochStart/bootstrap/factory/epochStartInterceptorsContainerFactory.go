@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/throttler"
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -20,6 +21,7 @@ import (
 )
 
 const timeSpanForBadHeaders = time.Minute
+const numInterceptorsGoRoutines = 100
 
 // ArgsEpochStartInterceptorContainer holds the arguments needed for creating a new epoch start interceptors
 // container factory
@@ -71,6 +73,10 @@ func NewEpochStartInterceptorsContainer(args ArgsEpochStartInterceptorContainer)
 	sizeCheckDelta := 0
 	validityAttester := disabled.NewValidityAttester()
 	epochStartTrigger := disabled.NewEpochStartTrigger()
+	globalThrottler, err := throttler.NewNumGoRoutinesThrottler(numInterceptorsGoRoutines)
+	if err != nil {
+		return nil, err
+	}
 
 	containerFactoryArgs := interceptorscontainer.CommonInterceptorsContainerFactoryArgs{
 		CoreComponents:            args.CoreComponents,
@@ -96,6 +102,7 @@ func NewEpochStartInterceptorsContainer(args ArgsEpochStartInterceptorContainer)
 		EnableSignTxWithHashEpoch: args.EnableSignTxWithHashEpoch,
 		PreferredPeersHolder:      disabled.NewPreferredPeersHolder(),
 		RequestHandler:            args.RequestHandler,
+		GlobalThrottler:           globalThrottler,
 	}
 
 	interceptorsContainerFactory, err := interceptorscontainer.NewMetaInterceptorsContainerFactory(containerFactoryArgs)
@@ -1151,6 +1151,7 @@ func (e *epochStartBootstrap) createRequestHandler() error {
 		PreferredPeersHolder:        disabled.NewPreferredPeersHolder(),
 		ResolverConfig:              e.generalConfig.Resolvers,
 		PeersRatingHandler:          disabled.NewDisabledPeersRatingHandler(),
+		MetricsRegistry:             e.coreComponentsHolder.MetricsRegistry(),
 	}
 	resolverFactory, err := resolverscontainer.NewMetaResolversContainerFactory(resolversContainerArgs)
 	if err != nil {
@@ -74,6 +74,7 @@ func createComponentsForEpochStart() (*mock.CoreComponentsMock, *mock.CryptoComp
 			TxVersionCheckField:          versioning.NewTxVersionChecker(1),
 			NodeTypeProviderField:        &nodeTypeProviderMock.NodeTypeProviderStub{},
 			ProcessStatusHandlerInstance: &testscommon.ProcessStatusHandlerStub{},
+			MetricsRegistryInstance:      &testscommon.MetricsRegistryStub{},
 		},
 		&mock.CryptoComponentsMock{
 			PubKey:   &cryptoMocks.PublicKeyStub{},
@@ -108,6 +109,8 @@ func createMockEpochStartBootstrapArgs(
 			SmartContractsStorage:              generalCfg.SmartContractsStorage,
 			SmartContractsStorageForSCQuery:    generalCfg.SmartContractsStorageForSCQuery,
 			TrieEpochRootHashStorage:           generalCfg.TrieEpochRootHashStorage,
+			SlashingEvidenceStorage:            generalCfg.SlashingEvidenceStorage,
+			ValidatorHistoryStorage:            generalCfg.ValidatorHistoryStorage,
 			BootstrapStorage:                   generalCfg.BootstrapStorage,
 			MetaBlockStorage:                   generalCfg.MetaBlockStorage,
 			AccountsTrieStorage:                generalCfg.AccountsTrieStorage,
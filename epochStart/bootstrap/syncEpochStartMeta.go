@@ -99,6 +99,7 @@ func NewEpochStartMetaSyncer(args ArgsNewEpochStartMetaSyncer) (*epochStartMetaS
 			WhiteListRequest:     args.WhitelistHandler,
 			CurrentPeerId:        args.Messenger.ID(),
 			PreferredPeersHolder: disabled.NewPreferredPeersHolder(),
+			MetricsRegistry:      args.CoreComponentsHolder.MetricsRegistry(),
 		},
 	)
 	if err != nil {
@@ -141,6 +141,7 @@ func getEpochStartSyncerArgs() ArgsNewEpochStartMetaSyncer {
 			ChainIdCalled: func() string {
 				return "chain-ID"
 			},
+			MetricsRegistryInstance: &testscommon.MetricsRegistryStub{},
 		},
 		CryptoComponentsHolder: &mock.CryptoComponentsMock{
 			PubKey:   &cryptoMocks.PublicKeyStub{},
@@ -331,3 +331,15 @@ var ErrNilScheduledTxsHandler = errors.New("nil scheduled transactions handler")
 
 // ErrNilScheduledDataSyncerFactory signals that a nil scheduled data syncer factory was provided
 var ErrNilScheduledDataSyncerFactory = errors.New("nil scheduled data syncer factory")
+
+// ErrNilValidatorsInfoProvider signals that a nil validators info provider has been provided
+var ErrNilValidatorsInfoProvider = errors.New("nil validators info provider")
+
+// ErrNilSCQueryService signals that a nil smart contract query service has been provided
+var ErrNilSCQueryService = errors.New("nil smart contract query service")
+
+// ErrDeployNotAllowedInQueries signals that a smart contract deploy was attempted through a query
+var ErrDeployNotAllowedInQueries = errors.New("smart contract deploy is not allowed in queries")
+
+// ErrRewardsPreviewNotAvailable signals that the rewards preview can only be computed by a metachain node
+var ErrRewardsPreviewNotAvailable = errors.New("rewards preview is available only on metachain nodes")
@@ -91,6 +91,12 @@ type ValidatorStatisticsProcessorHandler interface {
 	IsInterfaceNil() bool
 }
 
+// ValidatorsInfoProvider is able to provide the validators info stored under a validator statistics root hash
+type ValidatorsInfoProvider interface {
+	GetValidatorInfoForRootHash(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error)
+	IsInterfaceNil() bool
+}
+
 // ValidatorInfoCreator defines the methods to create a validator info
 type ValidatorInfoCreator interface {
 	PeerAccountToValidatorInfo(peerAccount state.PeerAccountHandler) *state.ValidatorInfo
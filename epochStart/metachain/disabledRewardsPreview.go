@@ -0,0 +1,24 @@
+package metachain
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+)
+
+type disabledRewardsPreview struct {
+}
+
+// NewDisabledRewardsPreview returns a rewards preview component to be used on shard nodes
+func NewDisabledRewardsPreview() *disabledRewardsPreview {
+	return &disabledRewardsPreview{}
+}
+
+// ComputeRewardsPreview returns ErrRewardsPreviewNotAvailable
+func (drp *disabledRewardsPreview) ComputeRewardsPreview() (*common.RewardsPreview, error) {
+	return nil, epochStart.ErrRewardsPreviewNotAvailable
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (drp *disabledRewardsPreview) IsInterfaceNil() bool {
+	return drp == nil
+}
@@ -0,0 +1,50 @@
+package metachain
+
+import (
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// queryServiceVMAdapter exposes a smart contract query service as a system VM so the staking data provider can read
+// the staking data through queries, without altering the state
+type queryServiceVMAdapter struct {
+	queryService process.SCQueryService
+}
+
+// RunSmartContractCreate returns ErrDeployNotAllowedInQueries as deploys can not be run through queries
+func (adapter *queryServiceVMAdapter) RunSmartContractCreate(_ *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+	return nil, epochStart.ErrDeployNotAllowedInQueries
+}
+
+// RunSmartContractCall runs the call as a smart contract query
+func (adapter *queryServiceVMAdapter) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	query := &process.SCQuery{
+		ScAddress:  input.RecipientAddr,
+		FuncName:   input.Function,
+		CallerAddr: input.CallerAddr,
+		CallValue:  input.CallValue,
+		Arguments:  input.Arguments,
+	}
+
+	return adapter.queryService.ExecuteQuery(query)
+}
+
+// GasScheduleChange does nothing as the gas schedule is handled by the query service
+func (adapter *queryServiceVMAdapter) GasScheduleChange(_ map[string]map[string]uint64) {
+}
+
+// GetVersion returns an empty string
+func (adapter *queryServiceVMAdapter) GetVersion() string {
+	return ""
+}
+
+// Close does nothing as the query service is not owned by the adapter
+func (adapter *queryServiceVMAdapter) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adapter *queryServiceVMAdapter) IsInterfaceNil() bool {
+	return adapter == nil
+}
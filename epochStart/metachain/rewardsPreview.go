@@ -0,0 +1,389 @@
+package metachain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/validatorInfo"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+const numberOfSecondsInYear = numberOfDaysInYear * numberOfSecondsInDay
+
+// ArgsRewardsPreview holds the arguments needed to create a rewards preview component
+type ArgsRewardsPreview struct {
+	Marshalizer                   marshal.Marshalizer
+	Hasher                        hashing.Hasher
+	Store                         dataRetriever.StorageService
+	ShardCoordinator              sharding.Coordinator
+	RewardsHandler                process.RewardsHandler
+	RoundTime                     process.RoundTimeDurationHandler
+	GenesisEpoch                  uint32
+	GenesisNonce                  uint64
+	GenesisTotalSupply            *big.Int
+	StakingV2EnableEpoch          uint32
+	NodesConfigProvider           epochStart.NodesConfigProvider
+	BlockChain                    data.ChainHandler
+	BlockTracker                  process.BlockTracker
+	ValidatorsInfoProvider        epochStart.ValidatorsInfoProvider
+	QueryService                  process.SCQueryService
+	MinNodePrice                  string
+	PubkeyConverter               core.PubkeyConverter
+	DataPool                      dataRetriever.PoolsHolder
+	UserAccountsDB                state.AccountsAdapter
+	ProtocolSustainabilityAddress string
+}
+
+// rewardsPreview estimates the end of epoch rewards of the in-progress epoch by running the end of epoch economics
+// and the rewards V2 computation over a synthetic epoch start block built from the current block
+type rewardsPreview struct {
+	mutPreview            sync.Mutex
+	economics             *economics
+	economicsDataProvider *epochEconomicsStatistics
+	stakingDataProvider   *stakingDataProvider
+	rewardsComputer       *rewardsCreatorV2
+	shardCoordinator      sharding.Coordinator
+	roundTime             process.RoundTimeDurationHandler
+	blockChain            data.ChainHandler
+	blockTracker          process.BlockTracker
+	validatorsInfo        epochStart.ValidatorsInfoProvider
+	pubkeyConverter       core.PubkeyConverter
+	lastHeaderHash        []byte
+	lastPreview           *common.RewardsPreview
+}
+
+// NewRewardsPreview creates a new rewards preview component
+func NewRewardsPreview(args ArgsRewardsPreview) (*rewardsPreview, error) {
+	if check.IfNil(args.NodesConfigProvider) {
+		return nil, epochStart.ErrNilNodesConfigProvider
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(args.BlockTracker) {
+		return nil, process.ErrNilBlockTracker
+	}
+	if check.IfNil(args.ValidatorsInfoProvider) {
+		return nil, epochStart.ErrNilValidatorsInfoProvider
+	}
+	if check.IfNil(args.QueryService) {
+		return nil, epochStart.ErrNilSCQueryService
+	}
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, epochStart.ErrNilPubkeyConverter
+	}
+
+	economicsDataProvider := NewEpochEconomicsStatistics()
+	argsEconomics := ArgsNewEpochEconomics{
+		Marshalizer:           args.Marshalizer,
+		Hasher:                args.Hasher,
+		Store:                 args.Store,
+		ShardCoordinator:      args.ShardCoordinator,
+		RewardsHandler:        args.RewardsHandler,
+		RoundTime:             args.RoundTime,
+		GenesisEpoch:          args.GenesisEpoch,
+		GenesisNonce:          args.GenesisNonce,
+		GenesisTotalSupply:    args.GenesisTotalSupply,
+		EconomicsDataNotified: economicsDataProvider,
+		StakingV2EnableEpoch:  args.StakingV2EnableEpoch,
+	}
+	economicsComputer, err := NewEndOfEpochEconomicsDataCreator(argsEconomics)
+	if err != nil {
+		return nil, err
+	}
+
+	sdp, err := NewStakingDataProvider(&queryServiceVMAdapter{queryService: args.QueryService}, args.MinNodePrice)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the rewards per node computation of this rewards V2 creator is used, its rewards miniblocks are never created
+	argsRewardsComputer := RewardsCreatorArgsV2{
+		BaseRewardsCreatorArgs: BaseRewardsCreatorArgs{
+			ShardCoordinator:              args.ShardCoordinator,
+			PubkeyConverter:               args.PubkeyConverter,
+			RewardsStorage:                args.Store.GetStorer(dataRetriever.RewardTransactionUnit),
+			MiniBlockStorage:              args.Store.GetStorer(dataRetriever.MiniBlockUnit),
+			Hasher:                        args.Hasher,
+			Marshalizer:                   args.Marshalizer,
+			DataPool:                      args.DataPool,
+			ProtocolSustainabilityAddress: args.ProtocolSustainabilityAddress,
+			NodesConfigProvider:           args.NodesConfigProvider,
+			UserAccountsDB:                args.UserAccountsDB,
+		},
+		StakingDataProvider:   sdp,
+		EconomicsDataProvider: economicsDataProvider,
+		RewardsHandler:        args.RewardsHandler,
+	}
+	rewardsComputer, err := NewRewardsCreatorV2(argsRewardsComputer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rewardsPreview{
+		economics:             economicsComputer,
+		economicsDataProvider: economicsDataProvider,
+		stakingDataProvider:   sdp,
+		rewardsComputer:       rewardsComputer,
+		shardCoordinator:      args.ShardCoordinator,
+		roundTime:             args.RoundTime,
+		blockChain:            args.BlockChain,
+		blockTracker:          args.BlockTracker,
+		validatorsInfo:        args.ValidatorsInfoProvider,
+		pubkeyConverter:       args.PubkeyConverter,
+	}, nil
+}
+
+// ComputeRewardsPreview estimates the rewards per node, per owner and per delegation contract as if the current epoch
+// ended at the current block. The result is computed once per block.
+func (rp *rewardsPreview) ComputeRewardsPreview() (*common.RewardsPreview, error) {
+	rp.mutPreview.Lock()
+	defer rp.mutPreview.Unlock()
+
+	currentHeader, ok := rp.blockChain.GetCurrentBlockHeader().(*block.MetaBlock)
+	if !ok || check.IfNil(currentHeader) {
+		return nil, epochStart.ErrNilHeaderHandler
+	}
+
+	currentHeaderHash := rp.blockChain.GetCurrentBlockHeaderHash()
+	if rp.lastPreview != nil && bytes.Equal(rp.lastHeaderHash, currentHeaderHash) {
+		return rp.lastPreview, nil
+	}
+
+	preview, err := rp.computeRewardsPreview(currentHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	rp.lastHeaderHash = currentHeaderHash
+	rp.lastPreview = preview
+
+	return preview, nil
+}
+
+func (rp *rewardsPreview) computeRewardsPreview(currentHeader *block.MetaBlock) (*common.RewardsPreview, error) {
+	epochStartBlock, err := rp.createEpochStartBlock(currentHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	computedEconomics, err := rp.economics.ComputeEndOfEpochEconomics(epochStartBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorsInfo, err := rp.validatorsInfo.GetValidatorInfoForRootHash(currentHeader.GetValidatorStatsRootHash())
+	if err != nil {
+		return nil, err
+	}
+
+	err = rp.stakingDataProvider.PrepareStakingDataForRewards(getEligibleNodesKeys(validatorsInfo))
+	if err != nil {
+		return nil, err
+	}
+
+	nodesRewardInfo, _, _, _ := rp.rewardsComputer.computeNodesRewards(validatorsInfo)
+
+	roundsPassedInEpoch := currentHeader.GetRound() - computedEconomics.PrevEpochStartRound
+	preview := &common.RewardsPreview{
+		Epoch:                            currentHeader.GetEpoch(),
+		Nonce:                            currentHeader.GetNonce(),
+		Round:                            currentHeader.GetRound(),
+		RoundsPassedInEpoch:              roundsPassedInEpoch,
+		NumBlocksInEpoch:                 rp.economicsDataProvider.NumberOfBlocks(),
+		AccumulatedFees:                  epochStartBlock.AccumulatedFeesInEpoch.String(),
+		DevFees:                          epochStartBlock.DevFeesInEpoch.String(),
+		LeaderFees:                       rp.economicsDataProvider.LeaderFees().String(),
+		TotalToDistribute:                computedEconomics.TotalToDistribute.String(),
+		TotalNewlyMinted:                 computedEconomics.TotalNewlyMinted.String(),
+		RewardsPerBlock:                  computedEconomics.RewardsPerBlock.String(),
+		RewardsForProtocolSustainability: computedEconomics.RewardsForProtocolSustainability.String(),
+	}
+
+	secondsPassedInEpoch := roundsPassedInEpoch * uint64(rp.roundTime.TimeDuration().Seconds())
+	rp.fillRewardsPerNodeAndAccount(preview, nodesRewardInfo, secondsPassedInEpoch)
+
+	return preview, nil
+}
+
+// createEpochStartBlock builds the epoch start block the current block would be followed by if the epoch ended now:
+// it carries the fees accumulated so far and the last notarized header of each shard
+func (rp *rewardsPreview) createEpochStartBlock(currentHeader *block.MetaBlock) (*block.MetaBlock, error) {
+	lastFinalizedHeaders := make([]block.EpochStartShardData, 0, rp.shardCoordinator.NumberOfShards())
+	for shardID := uint32(0); shardID < rp.shardCoordinator.NumberOfShards(); shardID++ {
+		lastCrossNotarizedHeader, _, err := rp.blockTracker.GetLastCrossNotarizedHeader(shardID)
+		if err != nil {
+			return nil, err
+		}
+
+		lastFinalizedHeaders = append(lastFinalizedHeaders, block.EpochStartShardData{
+			ShardID: lastCrossNotarizedHeader.GetShardID(),
+			Epoch:   lastCrossNotarizedHeader.GetEpoch(),
+			Round:   lastCrossNotarizedHeader.GetRound(),
+			Nonce:   lastCrossNotarizedHeader.GetNonce(),
+		})
+	}
+
+	accumulatedFees := big.NewInt(0)
+	if currentHeader.AccumulatedFeesInEpoch != nil {
+		accumulatedFees.Set(currentHeader.AccumulatedFeesInEpoch)
+	}
+	devFees := big.NewInt(0)
+	if currentHeader.DevFeesInEpoch != nil {
+		devFees.Set(currentHeader.DevFeesInEpoch)
+	}
+
+	return &block.MetaBlock{
+		Nonce:                  currentHeader.GetNonce(),
+		Round:                  currentHeader.GetRound(),
+		Epoch:                  currentHeader.GetEpoch() + 1,
+		AccumulatedFeesInEpoch: accumulatedFees,
+		DevFeesInEpoch:         devFees,
+		EpochStart: block.EpochStart{
+			LastFinalizedHeaders: lastFinalizedHeaders,
+		},
+	}, nil
+}
+
+func (rp *rewardsPreview) fillRewardsPerNodeAndAccount(
+	preview *common.RewardsPreview,
+	nodesRewardInfo map[uint32][]*nodeRewardsData,
+	secondsPassedInEpoch uint64,
+) {
+	ownersStats := rp.stakingDataProvider.getOwnersStats()
+	ownerOfKey := createOwnersMap(ownersStats)
+	ownersRewards := make(map[string]*big.Int)
+	ownersNumNodes := make(map[string]uint32)
+
+	preview.Nodes = make([]*common.RewardsPreviewNode, 0)
+	for shardID, nodeInfoList := range nodesRewardInfo {
+		for _, nodeInfo := range nodeInfoList {
+			// nodes which did not take part in any successful block do not receive rewards, as it happens at epoch end
+			baseReward, topUpReward, leaderFees := big.NewInt(0), big.NewInt(0), big.NewInt(0)
+			if nodeInfo.valInfo.LeaderSuccess != 0 || nodeInfo.valInfo.ValidatorSuccess != 0 {
+				baseReward.Set(nodeInfo.baseReward)
+				topUpReward.Set(nodeInfo.topUpReward)
+				leaderFees.Set(nodeInfo.valInfo.AccumulatedFees)
+			}
+			totalReward := big.NewInt(0).Add(baseReward, topUpReward)
+			totalReward.Add(totalReward, leaderFees)
+
+			owner := ownerOfKey[string(nodeInfo.valInfo.PublicKey)]
+			ownerAddress := ""
+			if len(owner) > 0 {
+				ownerAddress = rp.pubkeyConverter.Encode([]byte(owner))
+				if _, exists := ownersRewards[owner]; !exists {
+					ownersRewards[owner] = big.NewInt(0)
+				}
+				ownersRewards[owner].Add(ownersRewards[owner], totalReward)
+				ownersNumNodes[owner]++
+			}
+
+			preview.Nodes = append(preview.Nodes, &common.RewardsPreviewNode{
+				BLSKey:                     hex.EncodeToString(nodeInfo.valInfo.PublicKey),
+				ShardID:                    shardID,
+				OwnerAddress:               ownerAddress,
+				RewardAddress:              rp.pubkeyConverter.Encode(nodeInfo.valInfo.RewardAddress),
+				NumSelectedInSuccessBlocks: nodeInfo.valInfo.NumSelectedInSuccessBlocks,
+				BaseReward:                 baseReward.String(),
+				TopUpReward:                topUpReward.String(),
+				LeaderFees:                 leaderFees.String(),
+				TotalReward:                totalReward.String(),
+			})
+		}
+	}
+
+	sort.Slice(preview.Nodes, func(i, j int) bool {
+		if preview.Nodes[i].ShardID != preview.Nodes[j].ShardID {
+			return preview.Nodes[i].ShardID < preview.Nodes[j].ShardID
+		}
+		return preview.Nodes[i].BLSKey < preview.Nodes[j].BLSKey
+	})
+
+	preview.Owners = make([]*common.RewardsPreviewAccount, 0, len(ownersRewards))
+	preview.DelegationContracts = make([]*common.RewardsPreviewAccount, 0)
+	for owner, totalReward := range ownersRewards {
+		totalStaked := big.NewInt(0)
+		ownerData, ok := ownersStats[owner]
+		if ok && ownerData.totalStaked != nil {
+			totalStaked.Set(ownerData.totalStaked)
+		}
+
+		account := &common.RewardsPreviewAccount{
+			Address:          rp.pubkeyConverter.Encode([]byte(owner)),
+			NumEligibleNodes: ownersNumNodes[owner],
+			TotalStaked:      totalStaked.String(),
+			TotalReward:      totalReward.String(),
+			EstimatedAPR:     computeEstimatedAPR(totalReward, totalStaked, secondsPassedInEpoch),
+		}
+		preview.Owners = append(preview.Owners, account)
+
+		// the only metachain addresses able to own nodes are the delegation system smart contracts
+		if rp.shardCoordinator.ComputeId([]byte(owner)) == core.MetachainShardId {
+			preview.DelegationContracts = append(preview.DelegationContracts, account)
+		}
+	}
+
+	sortAccountsByAddress(preview.Owners)
+	sortAccountsByAddress(preview.DelegationContracts)
+}
+
+func createOwnersMap(ownersStats map[string]*ownerStats) map[string]string {
+	ownerOfKey := make(map[string]string)
+	for owner, ownerData := range ownersStats {
+		for _, blsKey := range ownerData.blsKeys {
+			ownerOfKey[string(blsKey)] = owner
+		}
+	}
+
+	return ownerOfKey
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rp *rewardsPreview) IsInterfaceNil() bool {
+	return rp == nil
+}
+
+func getEligibleNodesKeys(validatorsInfo map[uint32][]*state.ValidatorInfo) map[uint32][][]byte {
+	eligibleNodesKeys := make(map[uint32][][]byte)
+	for shardID, validatorsInfoSlice := range validatorsInfo {
+		eligibleNodesKeys[shardID] = make([][]byte, 0, len(validatorsInfoSlice))
+		for _, valInfo := range validatorsInfoSlice {
+			if validatorInfo.WasEligibleInCurrentEpoch(valInfo) {
+				eligibleNodesKeys[shardID] = append(eligibleNodesKeys[shardID], valInfo.PublicKey)
+			}
+		}
+	}
+
+	return eligibleNodesKeys
+}
+
+func computeEstimatedAPR(reward *big.Int, staked *big.Int, secondsPassed uint64) float64 {
+	if secondsPassed == 0 || staked.Sign() <= 0 {
+		return 0
+	}
+
+	rewardsRatio, _ := big.NewFloat(0).Quo(big.NewFloat(0).SetInt(reward), big.NewFloat(0).SetInt(staked)).Float64()
+
+	return rewardsRatio * float64(numberOfSecondsInYear) / float64(secondsPassed)
+}
+
+func sortAccountsByAddress(accounts []*common.RewardsPreviewAccount) {
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Address < accounts[j].Address
+	})
+}
@@ -0,0 +1,374 @@
+package metachain
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/epochStart/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	processMock "github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	previewOwner      = []byte("owner")
+	previewDelegation = []byte("delegation")
+)
+
+func createMockRewardsPreviewArgs() ArgsRewardsPreview {
+	genesisSupply, _ := big.NewInt(0).SetString("20000000"+"000000000000000000", 10)
+	nodePrice, _ := big.NewInt(0).SetString("2500"+"000000000000000000", 10)
+
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(1)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, previewDelegation) {
+			return core.MetachainShardId
+		}
+		return 0
+	}
+
+	prevEpochStart := block.MetaBlock{
+		EpochStart: block.EpochStart{
+			Economics: block.Economics{
+				TotalSupply: genesisSupply,
+				NodePrice:   nodePrice,
+			},
+		},
+	}
+
+	return ArgsRewardsPreview{
+		Marshalizer: &mock.MarshalizerMock{},
+		Hasher:      &hashingMocks.HasherMock{},
+		Store: &mock.ChainStorerStub{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				return &storageStubs.StorerStub{GetCalled: func(key []byte) ([]byte, error) {
+					return json.Marshal(prevEpochStart)
+				}}
+			},
+		},
+		ShardCoordinator: shardCoordinator,
+		RewardsHandler: &mock.RewardsHandlerStub{
+			MaxInflationRateCalled: func(_ uint32) float64 {
+				return 0.1
+			},
+			ProtocolSustainabilityPercentageCalled: func() float64 {
+				return 0.1
+			},
+			LeaderPercentageCalled: func() float64 {
+				return 0.1
+			},
+			RewardsTopUpFactorCalled: func() float64 {
+				return 0.25
+			},
+			RewardsTopUpGradientPointCalled: func() *big.Int {
+				return big.NewInt(0).Div(genesisSupply, big.NewInt(10))
+			},
+		},
+		RoundTime: &mock.RoundTimeDurationHandler{
+			TimeDurationCalled: func() time.Duration {
+				return 6 * time.Second
+			},
+		},
+		GenesisTotalSupply: genesisSupply,
+		NodesConfigProvider: &shardingMocks.NodesCoordinatorStub{
+			ConsensusGroupSizeCalled: func(shardID uint32) int {
+				return 2
+			},
+		},
+		BlockChain: &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.MetaBlock{
+					Nonce:                  1000,
+					Round:                  1000,
+					AccumulatedFeesInEpoch: big.NewInt(1000000),
+					DevFeesInEpoch:         big.NewInt(100000),
+					ValidatorStatsRootHash: []byte("validators root hash"),
+				}
+			},
+			GetCurrentBlockHeaderHashCalled: func() []byte {
+				return []byte("current header hash")
+			},
+		},
+		BlockTracker: &processMock.BlockTrackerMock{
+			GetLastCrossNotarizedHeaderCalled: func(shardID uint32) (data.HeaderHandler, []byte, error) {
+				return &block.Header{ShardID: shardID, Round: 1000, Nonce: 1000}, []byte("shard header hash"), nil
+			},
+		},
+		ValidatorsInfoProvider: &processMock.ValidatorStatisticsProcessorStub{
+			GetValidatorInfoForRootHashCalled: func(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error) {
+				return createRewardsPreviewValidatorsInfo(), nil
+			},
+		},
+		QueryService: &processMock.ScQueryStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				return executeRewardsPreviewQuery(query, nodePrice)
+			},
+		},
+		MinNodePrice:                  nodePrice.String(),
+		PubkeyConverter:               mock.NewPubkeyConverterMock(32),
+		DataPool:                      dataRetrieverMock.NewPoolsHolderMock(),
+		UserAccountsDB:                &stateMock.AccountsStub{},
+		ProtocolSustainabilityAddress: "11",
+	}
+}
+
+func createRewardsPreviewValidatorsInfo() map[uint32][]*state.ValidatorInfo {
+	createValidatorInfo := func(blsKey string, shardID uint32, rewardAddress []byte, numSelected uint32) *state.ValidatorInfo {
+		return &state.ValidatorInfo{
+			PublicKey:                  []byte(blsKey),
+			ShardId:                    shardID,
+			List:                       string(common.EligibleList),
+			RewardAddress:              rewardAddress,
+			LeaderSuccess:              numSelected / 10,
+			ValidatorSuccess:           numSelected - numSelected/10,
+			NumSelectedInSuccessBlocks: numSelected,
+			AccumulatedFees:            big.NewInt(int64(numSelected)),
+		}
+	}
+
+	return map[uint32][]*state.ValidatorInfo{
+		0: {
+			createValidatorInfo("blsKey0", 0, previewOwner, 1000),
+			createValidatorInfo("blsKey1", 0, previewOwner, 0),
+		},
+		core.MetachainShardId: {
+			createValidatorInfo("blsKey2", core.MetachainShardId, previewDelegation, 1000),
+			createValidatorInfo("blsKey3", core.MetachainShardId, previewDelegation, 1000),
+		},
+	}
+}
+
+func executeRewardsPreviewQuery(query *process.SCQuery, nodePrice *big.Int) (*vmcommon.VMOutput, error) {
+	switch query.FuncName {
+	case "getOwner":
+		owner := previewDelegation
+		if bytes.Equal(query.Arguments[0], []byte("blsKey0")) || bytes.Equal(query.Arguments[0], []byte("blsKey1")) {
+			owner = previewOwner
+		}
+		return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, ReturnData: [][]byte{owner}}, nil
+	case "getTotalStakedTopUpStakedBlsKeys":
+		topUp := big.NewInt(0).Set(nodePrice)
+		totalStaked := big.NewInt(0).Add(big.NewInt(0).Mul(nodePrice, big.NewInt(2)), topUp)
+		returnData := [][]byte{topUp.Bytes(), totalStaked.Bytes(), big.NewInt(2).Bytes(), []byte("blsKey2"), []byte("blsKey3")}
+		if bytes.Equal(query.Arguments[0], previewOwner) {
+			returnData = [][]byte{big.NewInt(0).Bytes(), big.NewInt(0).Mul(nodePrice, big.NewInt(2)).Bytes(), big.NewInt(2).Bytes(), []byte("blsKey0"), []byte("blsKey1")}
+		}
+		return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, ReturnData: returnData}, nil
+	default:
+		return &vmcommon.VMOutput{ReturnCode: vmcommon.FunctionNotFound}, nil
+	}
+}
+
+func TestNewRewardsPreview(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil nodes config provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRewardsPreviewArgs()
+		args.NodesConfigProvider = nil
+		rp, err := NewRewardsPreview(args)
+		assert.Nil(t, rp)
+		assert.Equal(t, epochStart.ErrNilNodesConfigProvider, err)
+	})
+	t.Run("nil block chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRewardsPreviewArgs()
+		args.BlockChain = nil
+		rp, err := NewRewardsPreview(args)
+		assert.Nil(t, rp)
+		assert.Equal(t, process.ErrNilBlockChain, err)
+	})
+	t.Run("nil block tracker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRewardsPreviewArgs()
+		args.BlockTracker = nil
+		rp, err := NewRewardsPreview(args)
+		assert.Nil(t, rp)
+		assert.Equal(t, process.ErrNilBlockTracker, err)
+	})
+	t.Run("nil validators info provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRewardsPreviewArgs()
+		args.ValidatorsInfoProvider = nil
+		rp, err := NewRewardsPreview(args)
+		assert.Nil(t, rp)
+		assert.Equal(t, epochStart.ErrNilValidatorsInfoProvider, err)
+	})
+	t.Run("nil query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRewardsPreviewArgs()
+		args.QueryService = nil
+		rp, err := NewRewardsPreview(args)
+		assert.Nil(t, rp)
+		assert.Equal(t, epochStart.ErrNilSCQueryService, err)
+	})
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRewardsPreviewArgs()
+		args.PubkeyConverter = nil
+		rp, err := NewRewardsPreview(args)
+		assert.Nil(t, rp)
+		assert.Equal(t, epochStart.ErrNilPubkeyConverter, err)
+	})
+	t.Run("nil data pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRewardsPreviewArgs()
+		args.DataPool = nil
+		rp, err := NewRewardsPreview(args)
+		assert.Nil(t, rp)
+		assert.Equal(t, epochStart.ErrNilDataPoolsHolder, err)
+	})
+	t.Run("nil user accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRewardsPreviewArgs()
+		args.UserAccountsDB = nil
+		rp, err := NewRewardsPreview(args)
+		assert.Nil(t, rp)
+		assert.Equal(t, epochStart.ErrNilAccountsDB, err)
+	})
+	t.Run("invalid min node price should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockRewardsPreviewArgs()
+		args.MinNodePrice = "invalid"
+		rp, err := NewRewardsPreview(args)
+		assert.Nil(t, rp)
+		assert.Equal(t, epochStart.ErrInvalidMinNodePrice, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rp, err := NewRewardsPreview(createMockRewardsPreviewArgs())
+		assert.Nil(t, err)
+		assert.False(t, rp.IsInterfaceNil())
+	})
+}
+
+func TestRewardsPreview_ComputeRewardsPreviewNotMetaHeaderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockRewardsPreviewArgs()
+	args.BlockChain = &testscommon.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.Header{}
+		},
+	}
+	rp, _ := NewRewardsPreview(args)
+
+	preview, err := rp.ComputeRewardsPreview()
+	assert.Nil(t, preview)
+	assert.Equal(t, epochStart.ErrNilHeaderHandler, err)
+}
+
+func TestRewardsPreview_ComputeRewardsPreviewValidatorsInfoErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockRewardsPreviewArgs()
+	args.ValidatorsInfoProvider = &processMock.ValidatorStatisticsProcessorStub{
+		GetValidatorInfoForRootHashCalled: func(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error) {
+			return nil, expectedErr
+		},
+	}
+	rp, _ := NewRewardsPreview(args)
+
+	preview, err := rp.ComputeRewardsPreview()
+	assert.Nil(t, preview)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestRewardsPreview_ComputeRewardsPreview(t *testing.T) {
+	t.Parallel()
+
+	numQueries := 0
+	args := createMockRewardsPreviewArgs()
+	nodePrice, _ := big.NewInt(0).SetString(args.MinNodePrice, 10)
+	args.QueryService = &processMock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			numQueries++
+			return executeRewardsPreviewQuery(query, nodePrice)
+		},
+	}
+	rp, _ := NewRewardsPreview(args)
+
+	preview, err := rp.ComputeRewardsPreview()
+	require.Nil(t, err)
+
+	assert.Equal(t, uint32(0), preview.Epoch)
+	assert.Equal(t, uint64(1000), preview.Nonce)
+	assert.Equal(t, uint64(1000), preview.RoundsPassedInEpoch)
+	assert.Equal(t, uint64(2000), preview.NumBlocksInEpoch)
+	assert.Equal(t, "1000000", preview.AccumulatedFees)
+	assert.Equal(t, "100000", preview.DevFees)
+
+	require.Equal(t, 4, len(preview.Nodes))
+	assert.Equal(t, hex.EncodeToString([]byte("blsKey0")), preview.Nodes[0].BLSKey)
+	assert.Equal(t, hex.EncodeToString(previewOwner), preview.Nodes[0].OwnerAddress)
+	assert.Equal(t, "1000", preview.Nodes[0].LeaderFees)
+	assert.NotEqual(t, "0", preview.Nodes[0].TotalReward)
+
+	// a node without any successful block does not receive rewards
+	assert.Equal(t, hex.EncodeToString([]byte("blsKey1")), preview.Nodes[1].BLSKey)
+	assert.Equal(t, "0", preview.Nodes[1].TotalReward)
+	assert.Equal(t, core.MetachainShardId, preview.Nodes[2].ShardID)
+
+	require.Equal(t, 2, len(preview.Owners))
+	require.Equal(t, 1, len(preview.DelegationContracts))
+	delegation := preview.DelegationContracts[0]
+	assert.Equal(t, hex.EncodeToString(previewDelegation), delegation.Address)
+	assert.Equal(t, uint32(2), delegation.NumEligibleNodes)
+	assert.True(t, delegation.EstimatedAPR > 0)
+
+	expectedDelegationReward := big.NewInt(0)
+	for _, node := range preview.Nodes[2:] {
+		nodeReward, _ := big.NewInt(0).SetString(node.TotalReward, 10)
+		expectedDelegationReward.Add(expectedDelegationReward, nodeReward)
+	}
+	assert.Equal(t, expectedDelegationReward.String(), delegation.TotalReward)
+
+	numQueriesForFirstPreview := numQueries
+	secondPreview, err := rp.ComputeRewardsPreview()
+	assert.Nil(t, err)
+	assert.True(t, preview == secondPreview)
+	assert.Equal(t, numQueriesForFirstPreview, numQueries)
+}
+
+func TestDisabledRewardsPreview_ComputeRewardsPreview(t *testing.T) {
+	t.Parallel()
+
+	drp := NewDisabledRewardsPreview()
+	assert.False(t, drp.IsInterfaceNil())
+
+	preview, err := drp.ComputeRewardsPreview()
+	assert.Nil(t, preview)
+	assert.Equal(t, epochStart.ErrRewardsPreviewNotAvailable, err)
+}
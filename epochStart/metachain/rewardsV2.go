@@ -264,13 +264,28 @@ func (rc *rewardsCreatorV2) IsInterfaceNil() bool {
 func (rc *rewardsCreatorV2) computeRewardsPerNode(
 	validatorsInfo map[uint32][]*state.ValidatorInfo,
 ) (map[uint32][]*nodeRewardsData, *big.Int) {
+	nodesRewardInfo, accumulatedDust, baseRewards, topUpRewards := rc.computeNodesRewards(validatorsInfo)
+
+	log.Info("rewards to be distributed",
+		"totalStakeEligible", rc.stakingDataProvider.GetTotalStakeEligibleNodes().String(),
+		"totalTopUpEligible", rc.stakingDataProvider.GetTotalTopUpStakeEligibleNodes().String(),
+		"baseRewards", baseRewards.String(),
+		"topUpRewards", topUpRewards.String())
+
+	return nodesRewardInfo, accumulatedDust
+}
+
+// computeNodesRewards returns the rewards per node, the dust and the base and top up rewards to be distributed. It
+// does not log the distributed rewards, so the estimations can use it as well
+func (rc *rewardsCreatorV2) computeNodesRewards(
+	validatorsInfo map[uint32][]*state.ValidatorInfo,
+) (map[uint32][]*nodeRewardsData, *big.Int, *big.Int, *big.Int) {
 
 	var baseRewardsPerBlock *big.Int
 
 	nodesRewardInfo := rc.initNodesRewardsInfo(validatorsInfo)
 
 	// totalTopUpEligible is the cumulative top-up stake value for eligible nodes
-	totalStakeEligible := rc.stakingDataProvider.GetTotalStakeEligibleNodes()
 	totalTopUpEligible := rc.stakingDataProvider.GetTotalTopUpStakeEligibleNodes()
 	remainingToBeDistributed := rc.economicsDataProvider.RewardsToBeDistributedForBlocks()
 	topUpRewards := rc.computeTopUpRewards(remainingToBeDistributed, totalTopUpEligible)
@@ -282,12 +297,6 @@ func (rc *rewardsCreatorV2) computeRewardsPerNode(
 		baseRewardsPerBlock = big.NewInt(0).Div(baseRewards, nbBlocks)
 	}
 
-	log.Info("rewards to be distributed",
-		"totalStakeEligible", totalStakeEligible.String(),
-		"totalTopUpEligible", totalTopUpEligible.String(),
-		"baseRewards", baseRewards.String(),
-		"topUpRewards", topUpRewards.String())
-
 	rc.fillBaseRewardsPerBlockPerNode(baseRewardsPerBlock)
 
 	accumulatedDust := big.NewInt(0)
@@ -297,7 +306,7 @@ func (rc *rewardsCreatorV2) computeRewardsPerNode(
 	accumulatedDust.Add(accumulatedDust, dust)
 	aggregateBaseAndTopUpRewardsPerNode(nodesRewardInfo)
 
-	return nodesRewardInfo, accumulatedDust
+	return nodesRewardInfo, accumulatedDust, baseRewards, topUpRewards
 }
 
 func (rc *rewardsCreatorV2) initNodesRewardsInfo(
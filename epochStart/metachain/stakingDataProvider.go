@@ -105,6 +105,26 @@ func (sdp *stakingDataProvider) GetNodeStakedTopUp(blsKey []byte) (*big.Int, err
 	return ownerInfo.topUpPerNode, nil
 }
 
+// getOwnersStats returns a copy of the staked values and of the BLS keys of the owners loaded by a previous call to
+// PrepareStakingDataForRewards, keyed by the owner address
+func (sdp *stakingDataProvider) getOwnersStats() map[string]*ownerStats {
+	sdp.mutStakingData.RLock()
+	defer sdp.mutStakingData.RUnlock()
+
+	ownersStats := make(map[string]*ownerStats, len(sdp.cache))
+	for owner, stats := range sdp.cache {
+		statsCopy := *stats
+		statsCopy.blsKeys = make([][]byte, len(stats.blsKeys))
+		copy(statsCopy.blsKeys, stats.blsKeys)
+		if stats.totalStaked != nil {
+			statsCopy.totalStaked = big.NewInt(0).Set(stats.totalStaked)
+		}
+		ownersStats[owner] = &statsCopy
+	}
+
+	return ownersStats
+}
+
 // PrepareStakingDataForRewards prepares the staking data for the given map of node keys per shard
 func (sdp *stakingDataProvider) PrepareStakingDataForRewards(keys map[uint32][][]byte) error {
 	sdp.Clean()
@@ -881,6 +881,7 @@ func createAccountsDB(
 		StoragePruningManager: spm,
 		ProcessingMode:        common.Normal,
 		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:       &testscommon.MetricsRegistryStub{},
 	}
 	adb, _ := state.NewAccountsDB(args)
 	return adb
@@ -908,6 +909,7 @@ func createFullArgumentsForSystemSCProcessing(stakingV2EnableEpoch uint32, trieS
 		MaxComputableRounds:                  1,
 		MaxConsecutiveRoundsOfRatingDecrease: 2000,
 		EpochNotifier:                        en,
+		HistoryHandler:                       &testscommon.ValidatorHistoryHandlerStub{},
 		StakingV2EnableEpoch:                 stakingV2EnableEpoch,
 	}
 	vCreator, _ := peer.NewValidatorStatisticsProcessor(argsValidatorsProcessor)
@@ -33,6 +33,7 @@ type CoreComponentsMock struct {
 	ChanStopNode                 chan endProcess.ArgEndProcess
 	NodeTypeProviderField        core.NodeTypeProviderHandler
 	ProcessStatusHandlerInstance common.ProcessStatusHandler
+	MetricsRegistryInstance      common.MetricsRegistry
 	mutCore                      sync.RWMutex
 }
 
@@ -147,6 +148,11 @@ func (ccm *CoreComponentsMock) GenesisNodesSetup() sharding.GenesisNodesSetupHan
 	return nil
 }
 
+// MetricsRegistry -
+func (ccm *CoreComponentsMock) MetricsRegistry() common.MetricsRegistry {
+	return ccm.MetricsRegistryInstance
+}
+
 // ProcessStatusHandler -
 func (ccm *CoreComponentsMock) ProcessStatusHandler() common.ProcessStatusHandler {
 	return ccm.ProcessStatusHandlerInstance
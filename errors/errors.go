@@ -332,6 +332,9 @@ var ErrNilStatusComponentsHolder = errors.New("nil status components holder")
 // ErrNilStatusHandler signals that a nil status handler was provided
 var ErrNilStatusHandler = errors.New("nil status handler provided")
 
+// ErrNilMetricsRegistry signals that a nil metrics registry was provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry provided")
+
 // ErrNilHardforkTrigger signals that a nil hardfork trigger was provided
 var ErrNilHardforkTrigger = errors.New("nil hardfork trigger")
 
@@ -377,6 +380,15 @@ var ErrNilValidatorPublicKeyConverter = errors.New("validator public key convert
 // ErrNilValidatorsProvider signals a nil validators provider
 var ErrNilValidatorsProvider = errors.New("nil validator provider")
 
+// ErrNilShufflingPreviewer signals a nil shuffling previewer
+var ErrNilShufflingPreviewer = errors.New("nil shuffling previewer")
+
+// ErrNilValidatorHistory signals a nil validator history handler
+var ErrNilValidatorHistory = errors.New("nil validator history")
+
+// ErrNilGasProfiler signals a nil gas profiler
+var ErrNilGasProfiler = errors.New("nil gas profiler")
+
 // ErrNilValidatorsStatistics signals a that nil validators statistics was handler was provided
 var ErrNilValidatorsStatistics = errors.New("nil validator statistics")
 
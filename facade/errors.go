@@ -34,3 +34,6 @@ var ErrEmptyRootHash = errors.New("empty current root hash")
 
 // ErrNilGenesisNodes signals that the provided genesis nodes configuration is nil
 var ErrNilGenesisNodes = errors.New("nil genesis nodes")
+
+// ErrTooManyAddressesInMultiProof signals that too many addresses were provided for a Merkle multi-proof
+var ErrTooManyAddressesInMultiProof = errors.New("too many addresses in multi-proof")
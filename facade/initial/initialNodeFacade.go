@@ -58,6 +58,26 @@ func (inf *initialNodeFacade) VerifyProof(_ string, _ string, _ [][]byte) (bool,
 	return false, errNodeStarting
 }
 
+// GetMultiProof -
+func (inf *initialNodeFacade) GetMultiProof(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+	return nil, errNodeStarting
+}
+
+// VerifyMultiProof -
+func (inf *initialNodeFacade) VerifyMultiProof(_ string, _ []string, _ [][]byte) (bool, error) {
+	return false, errNodeStarting
+}
+
+// GetProofESDTBalance -
+func (inf *initialNodeFacade) GetProofESDTBalance(_ string, _ string, _ string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+	return nil, nil, errNodeStarting
+}
+
+// VerifyESDTBalanceProof -
+func (inf *initialNodeFacade) VerifyESDTBalanceProof(_ string, _ string, _ string, _ [][]byte, _ [][]byte) (*esdt.ESDigitalToken, bool, error) {
+	return nil, false, errNodeStarting
+}
+
 // SetSyncer does nothing
 func (inf *initialNodeFacade) SetSyncer(_ ntp.SyncTimer) {
 }
@@ -141,6 +161,16 @@ func (inf *initialNodeFacade) ValidatorStatisticsApi() (map[string]*state.Valida
 	return nil, errNodeStarting
 }
 
+// GetNextEpochShufflingPreview returns nil and error
+func (inf *initialNodeFacade) GetNextEpochShufflingPreview() (*common.NextEpochShufflingPreview, error) {
+	return nil, errNodeStarting
+}
+
+// GetValidatorHistory returns nil and error
+func (inf *initialNodeFacade) GetValidatorHistory(_ string, _ uint32, _ uint32) ([]*common.ValidatorEpochHistory, error) {
+	return nil, errNodeStarting
+}
+
 // SendBulkTransactions returns 0 and error
 func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction) (uint64, error) {
 	return uint64(0), errNodeStarting
@@ -231,6 +261,41 @@ func (inf *initialNodeFacade) GetPeerInfo(_ string) ([]core.QueryP2PPeerInfo, er
 	return nil, errNodeStarting
 }
 
+// GetConsensusRoundsTimeline returns nil and error
+func (inf *initialNodeFacade) GetConsensusRoundsTimeline() ([]*common.ConsensusRoundTimeline, error) {
+	return nil, errNodeStarting
+}
+
+// GetSlashingEvidences returns nil and error
+func (inf *initialNodeFacade) GetSlashingEvidences() ([]*common.SlashingEvidence, error) {
+	return nil, errNodeStarting
+}
+
+// GetGasProfile returns nil and error
+func (inf *initialNodeFacade) GetGasProfile() (*common.GasProfile, error) {
+	return nil, errNodeStarting
+}
+
+// GetAntifloodQuotaInfo returns nil and error
+func (inf *initialNodeFacade) GetAntifloodQuotaInfo() (*common.AntifloodQuotaInfo, error) {
+	return nil, errNodeStarting
+}
+
+// GetBlacklistedPeers returns nil and error
+func (inf *initialNodeFacade) GetBlacklistedPeers() ([]common.BlacklistedPeerInfo, error) {
+	return nil, errNodeStarting
+}
+
+// BlacklistPeerManually returns error
+func (inf *initialNodeFacade) BlacklistPeerManually(_ string, _ string, _ uint32) error {
+	return errNodeStarting
+}
+
+// PardonPeer returns error
+func (inf *initialNodeFacade) PardonPeer(_ string) error {
+	return errNodeStarting
+}
+
 // GetThrottlerForEndpoint returns nil and false
 func (inf *initialNodeFacade) GetThrottlerForEndpoint(_ string) (core.Throttler, bool) {
 	return nil, false
@@ -327,7 +392,22 @@ func (inf *initialNodeFacade) GetAllIssuedESDTs(_ string) ([]string, error) {
 }
 
 // GetTokenSupply returns nil and error
-func (inf *initialNodeFacade) GetTokenSupply(_ string) (*api.ESDTSupply, error) {
+func (inf *initialNodeFacade) GetTokenSupply(_ string) (*common.ESDTSupply, error) {
+	return nil, errNodeStarting
+}
+
+// GetESDTHolders returns nil and error
+func (inf *initialNodeFacade) GetESDTHolders(_ string, _ uint32, _ uint32) (*common.ESDTHolders, error) {
+	return nil, errNodeStarting
+}
+
+// GetCollectionNFTs returns nil and error
+func (inf *initialNodeFacade) GetCollectionNFTs(_ string, _ uint32, _ uint32) (*common.CollectionNFTs, error) {
+	return nil, errNodeStarting
+}
+
+// GetEvents returns nil and error
+func (inf *initialNodeFacade) GetEvents(_ common.EventsQuery) (*common.Events, error) {
 	return nil, errNodeStarting
 }
 
@@ -336,11 +416,61 @@ func (inf *initialNodeFacade) GetGenesisNodesPubKeys() (map[uint32][]string, map
 	return nil, nil, errNodeStarting
 }
 
+// GetGovernanceProposals returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposals() ([]*common.GovernanceProposal, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceProposal returns nil and error
+func (inf *initialNodeFacade) GetGovernanceProposal(_ string) (*common.GovernanceProposal, error) {
+	return nil, errNodeStarting
+}
+
+// GetGovernanceVotingPower returns nil and error
+func (inf *initialNodeFacade) GetGovernanceVotingPower(_ string) (*common.GovernanceVotingPower, error) {
+	return nil, errNodeStarting
+}
+
+// GetUserDelegations returns nil and error
+func (inf *initialNodeFacade) GetUserDelegations(_ string) ([]*common.UserDelegation, error) {
+	return nil, errNodeStarting
+}
+
+// GetDelegationProvider returns nil and error
+func (inf *initialNodeFacade) GetDelegationProvider(_ string) (*common.DelegationProvider, error) {
+	return nil, errNodeStarting
+}
+
+// GetStakingQueue returns nil and error
+func (inf *initialNodeFacade) GetStakingQueue(_ uint32, _ uint32) (*common.StakingQueue, error) {
+	return nil, errNodeStarting
+}
+
+// GetStakingNode returns nil and error
+func (inf *initialNodeFacade) GetStakingNode(_ string) (*common.StakingNode, error) {
+	return nil, errNodeStarting
+}
+
+// DryRunEconomics returns nil and error
+func (inf *initialNodeFacade) DryRunEconomics(_ uint64, _ uint64) (*common.EconomicsDryRunResult, error) {
+	return nil, errNodeStarting
+}
+
+// GetRewardsPreview returns nil and error
+func (inf *initialNodeFacade) GetRewardsPreview() (*common.RewardsPreview, error) {
+	return nil, errNodeStarting
+}
+
 // GetTransactionsPool returns a nil structure and error
 func (inf *initialNodeFacade) GetTransactionsPool() (*common.TransactionsPoolAPIResponse, error) {
 	return nil, errNodeStarting
 }
 
+// GetTransactionInclusionProof returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionInclusionProof(_ string) (*common.TransactionInclusionProof, error) {
+	return nil, errNodeStarting
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (inf *initialNodeFacade) IsInterfaceNil() bool {
 	return inf == nil
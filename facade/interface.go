@@ -51,7 +51,16 @@ type NodeHandler interface {
 	GetAllESDTTokens(address string, ctx context.Context) (map[string]*esdt.ESDigitalToken, error)
 
 	// GetTokenSupply returns the provided token supply from current shard
-	GetTokenSupply(token string) (*api.ESDTSupply, error)
+	GetTokenSupply(token string) (*common.ESDTSupply, error)
+
+	// GetESDTHolders returns a page of the holders of the provided token from current shard
+	GetESDTHolders(token string, from uint32, size uint32) (*common.ESDTHolders, error)
+
+	// GetCollectionNFTs returns a page of the NFTs of the provided collection held in current shard
+	GetCollectionNFTs(collection string, from uint32, size uint32) (*common.CollectionNFTs, error)
+
+	// GetEvents returns a page of the events from current shard matching the provided query
+	GetEvents(query common.EventsQuery) (*common.Events, error)
 
 	// CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
@@ -79,6 +88,10 @@ type NodeHandler interface {
 
 	// ValidatorStatisticsApi return the statistics for all the validators
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
+	// GetNextEpochShufflingPreview returns the previewed nodes shuffling of the next epoch
+	GetNextEpochShufflingPreview() (*common.NextEpochShufflingPreview, error)
+	// GetValidatorHistory returns the recorded per epoch statistics of a validator
+	GetValidatorHistory(pubKey string, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error)
 	DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTrigger() bool
 
@@ -87,10 +100,21 @@ type NodeHandler interface {
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRoundsTimeline() ([]*common.ConsensusRoundTimeline, error)
+	GetSlashingEvidences() ([]*common.SlashingEvidence, error)
+	GetGasProfile() (*common.GasProfile, error)
+	GetAntifloodQuotaInfo() (*common.AntifloodQuotaInfo, error)
+	GetBlacklistedPeers() ([]common.BlacklistedPeerInfo, error)
+	BlacklistPeerManually(pid string, reason string, durationInSeconds uint32) error
+	PardonPeer(pid string) error
 
 	GetProof(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProof(rootHash string, addresses []string, proof [][]byte) (bool, error)
+	GetProofESDTBalance(rootHash string, address string, tokenIdentifier string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyESDTBalanceProof(rootHash string, address string, tokenIdentifier string, mainProof [][]byte, dataTrieProof [][]byte) (*esdt.ESDigitalToken, bool, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
@@ -109,6 +133,7 @@ type ApiResolver interface {
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPool() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionInclusionProof(hash string) (*common.TransactionInclusionProof, error)
 	GetBlockByHash(hash string, withTxs bool) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, withTxs bool) (*api.Block, error)
 	GetBlockByRound(round uint64, withTxs bool) (*api.Block, error)
@@ -121,6 +146,15 @@ type ApiResolver interface {
 	GetInternalStartOfEpochMetaBlock(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
 	GetInternalMiniBlock(format common.ApiOutputFormat, txHash string, epoch uint32) (interface{}, error)
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string)
+	GetGovernanceProposals(ctx context.Context) ([]*common.GovernanceProposal, error)
+	GetGovernanceProposal(reference string) (*common.GovernanceProposal, error)
+	GetGovernanceVotingPower(address string) (*common.GovernanceVotingPower, error)
+	GetUserDelegations(address string) ([]*common.UserDelegation, error)
+	GetDelegationProvider(contract string) (*common.DelegationProvider, error)
+	GetStakingQueue(from uint32, size uint32) (*common.StakingQueue, error)
+	GetStakingNode(blsKey string) (*common.StakingNode, error)
+	DryRunEconomics(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error)
+	GetRewardsPreview() (*common.RewardsPreview, error)
 	Close() error
 	IsInterfaceNil() bool
 }
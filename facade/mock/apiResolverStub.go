@@ -32,7 +32,17 @@ type ApiResolverStub struct {
 	GetInternalMiniBlockCalled             func(format common.ApiOutputFormat, hash string, epoch uint32) (interface{}, error)
 	GetInternalStartOfEpochMetaBlockCalled func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
 	GetGenesisNodesPubKeysCalled           func() (map[uint32][]string, map[uint32][]string)
+	GetGovernanceProposalsCalled           func(ctx context.Context) ([]*common.GovernanceProposal, error)
+	GetGovernanceProposalCalled            func(reference string) (*common.GovernanceProposal, error)
+	GetGovernanceVotingPowerCalled         func(address string) (*common.GovernanceVotingPower, error)
+	GetUserDelegationsCalled               func(address string) ([]*common.UserDelegation, error)
+	GetDelegationProviderCalled            func(contract string) (*common.DelegationProvider, error)
+	GetStakingQueueCalled                  func(from uint32, size uint32) (*common.StakingQueue, error)
+	GetStakingNodeCalled                   func(blsKey string) (*common.StakingNode, error)
+	DryRunEconomicsCalled                  func(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error)
+	GetRewardsPreviewCalled                func() (*common.RewardsPreview, error)
 	GetTransactionsPoolCalled              func() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionInclusionProofCalled     func(hash string) (*common.TransactionInclusionProof, error)
 }
 
 // GetTransaction -
@@ -166,6 +176,15 @@ func (ars *ApiResolverStub) GetTransactionsPool() (*common.TransactionsPoolAPIRe
 	return nil, nil
 }
 
+// GetTransactionInclusionProof -
+func (ars *ApiResolverStub) GetTransactionInclusionProof(hash string) (*common.TransactionInclusionProof, error) {
+	if ars.GetTransactionInclusionProofCalled != nil {
+		return ars.GetTransactionInclusionProofCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // GetInternalMetaBlockByHash -
 func (ars *ApiResolverStub) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	if ars.GetInternalMetaBlockByHashCalled != nil {
@@ -206,6 +225,78 @@ func (ars *ApiResolverStub) GetGenesisNodesPubKeys() (map[uint32][]string, map[u
 	return nil, nil
 }
 
+// GetGovernanceProposals -
+func (ars *ApiResolverStub) GetGovernanceProposals(ctx context.Context) ([]*common.GovernanceProposal, error) {
+	if ars.GetGovernanceProposalsCalled != nil {
+		return ars.GetGovernanceProposalsCalled(ctx)
+	}
+	return nil, nil
+}
+
+// GetGovernanceProposal -
+func (ars *ApiResolverStub) GetGovernanceProposal(reference string) (*common.GovernanceProposal, error) {
+	if ars.GetGovernanceProposalCalled != nil {
+		return ars.GetGovernanceProposalCalled(reference)
+	}
+	return nil, nil
+}
+
+// GetGovernanceVotingPower -
+func (ars *ApiResolverStub) GetGovernanceVotingPower(address string) (*common.GovernanceVotingPower, error) {
+	if ars.GetGovernanceVotingPowerCalled != nil {
+		return ars.GetGovernanceVotingPowerCalled(address)
+	}
+	return nil, nil
+}
+
+// GetUserDelegations -
+func (ars *ApiResolverStub) GetUserDelegations(address string) ([]*common.UserDelegation, error) {
+	if ars.GetUserDelegationsCalled != nil {
+		return ars.GetUserDelegationsCalled(address)
+	}
+	return nil, nil
+}
+
+// GetDelegationProvider -
+func (ars *ApiResolverStub) GetDelegationProvider(contract string) (*common.DelegationProvider, error) {
+	if ars.GetDelegationProviderCalled != nil {
+		return ars.GetDelegationProviderCalled(contract)
+	}
+	return nil, nil
+}
+
+// GetStakingQueue -
+func (ars *ApiResolverStub) GetStakingQueue(from uint32, size uint32) (*common.StakingQueue, error) {
+	if ars.GetStakingQueueCalled != nil {
+		return ars.GetStakingQueueCalled(from, size)
+	}
+	return nil, nil
+}
+
+// GetStakingNode -
+func (ars *ApiResolverStub) GetStakingNode(blsKey string) (*common.StakingNode, error) {
+	if ars.GetStakingNodeCalled != nil {
+		return ars.GetStakingNodeCalled(blsKey)
+	}
+	return nil, nil
+}
+
+// DryRunEconomics -
+func (ars *ApiResolverStub) DryRunEconomics(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error) {
+	if ars.DryRunEconomicsCalled != nil {
+		return ars.DryRunEconomicsCalled(fromNonce, toNonce)
+	}
+	return nil, nil
+}
+
+// GetRewardsPreview -
+func (ars *ApiResolverStub) GetRewardsPreview() (*common.RewardsPreview, error) {
+	if ars.GetRewardsPreviewCalled != nil {
+		return ars.GetRewardsPreviewCalled()
+	}
+	return nil, nil
+}
+
 // Close -
 func (ars *ApiResolverStub) Close() error {
 	return nil
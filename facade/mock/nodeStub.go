@@ -33,11 +33,23 @@ type NodeStub struct {
 	GenerateAndSendBulkTransactionsOneByOneHandler func(destination string, value *big.Int, nrTransactions uint64) error
 	GetHeartbeatsHandler                           func() []data.PubKeyHeartbeat
 	ValidatorStatisticsApiCalled                   func() (map[string]*state.ValidatorApiResponse, error)
+	GetNextEpochShufflingPreviewCalled             func() (*common.NextEpochShufflingPreview, error)
+	GetValidatorHistoryCalled                      func(pubKey string, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error)
+	GetESDTHoldersCalled                           func(token string, from uint32, size uint32) (*common.ESDTHolders, error)
+	GetCollectionNFTsCalled                        func(collection string, from uint32, size uint32) (*common.CollectionNFTs, error)
+	GetEventsCalled                                func(query common.EventsQuery) (*common.Events, error)
 	DirectTriggerCalled                            func(epoch uint32, withEarlyEndOfEpoch bool) error
 	IsSelfTriggerCalled                            func() bool
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                           func(address string, key string) (string, error)
 	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetConsensusRoundsTimelineCalled               func() ([]*common.ConsensusRoundTimeline, error)
+	GetSlashingEvidencesCalled                     func() ([]*common.SlashingEvidence, error)
+	GetGasProfileCalled                            func() (*common.GasProfile, error)
+	GetAntifloodQuotaInfoCalled                    func() (*common.AntifloodQuotaInfo, error)
+	GetBlacklistedPeersCalled                      func() ([]common.BlacklistedPeerInfo, error)
+	BlacklistPeerManuallyCalled                    func(pid string, reason string, durationInSeconds uint32) error
+	PardonPeerCalled                               func(pid string) error
 	GetUsernameCalled                              func(address string) (string, error)
 	GetESDTDataCalled                              func(address string, key string, nonce uint64) (*esdt.ESDigitalToken, error)
 	GetAllESDTTokensCalled                         func(address string, ctx context.Context) (map[string]*esdt.ESDigitalToken, error)
@@ -49,6 +61,10 @@ type NodeStub struct {
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProofCalled                            func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	VerifyMultiProofCalled                         func(rootHash string, addresses []string, proof [][]byte) (bool, error)
+	GetProofESDTBalanceCalled                      func(rootHash string, address string, tokenIdentifier string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyESDTBalanceProofCalled                   func(rootHash string, address string, tokenIdentifier string, mainProof [][]byte, dataTrieProof [][]byte) (*esdt.ESDigitalToken, bool, error)
 }
 
 // GetProof -
@@ -78,6 +94,42 @@ func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte)
 	return false, nil
 }
 
+// GetMultiProof -
+func (ns *NodeStub) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	if ns.GetMultiProofCalled != nil {
+		return ns.GetMultiProofCalled(rootHash, addresses)
+	}
+
+	return nil, nil
+}
+
+// VerifyMultiProof -
+func (ns *NodeStub) VerifyMultiProof(rootHash string, addresses []string, proof [][]byte) (bool, error) {
+	if ns.VerifyMultiProofCalled != nil {
+		return ns.VerifyMultiProofCalled(rootHash, addresses, proof)
+	}
+
+	return false, nil
+}
+
+// GetProofESDTBalance -
+func (ns *NodeStub) GetProofESDTBalance(rootHash string, address string, tokenIdentifier string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+	if ns.GetProofESDTBalanceCalled != nil {
+		return ns.GetProofESDTBalanceCalled(rootHash, address, tokenIdentifier)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyESDTBalanceProof -
+func (ns *NodeStub) VerifyESDTBalanceProof(rootHash string, address string, tokenIdentifier string, mainProof [][]byte, dataTrieProof [][]byte) (*esdt.ESDigitalToken, bool, error) {
+	if ns.VerifyESDTBalanceProofCalled != nil {
+		return ns.VerifyESDTBalanceProofCalled(rootHash, address, tokenIdentifier, mainProof, dataTrieProof)
+	}
+
+	return nil, false, nil
+}
+
 // GetUsername -
 func (ns *NodeStub) GetUsername(address string) (string, error) {
 	if ns.GetUsernameCalled != nil {
@@ -166,6 +218,24 @@ func (ns *NodeStub) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResp
 	return ns.ValidatorStatisticsApiCalled()
 }
 
+// GetNextEpochShufflingPreview -
+func (ns *NodeStub) GetNextEpochShufflingPreview() (*common.NextEpochShufflingPreview, error) {
+	if ns.GetNextEpochShufflingPreviewCalled != nil {
+		return ns.GetNextEpochShufflingPreviewCalled()
+	}
+
+	return &common.NextEpochShufflingPreview{}, nil
+}
+
+// GetValidatorHistory -
+func (ns *NodeStub) GetValidatorHistory(pubKey string, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error) {
+	if ns.GetValidatorHistoryCalled != nil {
+		return ns.GetValidatorHistoryCalled(pubKey, fromEpoch, toEpoch)
+	}
+
+	return make([]*common.ValidatorEpochHistory, 0), nil
+}
+
 // DirectTrigger -
 func (ns *NodeStub) DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	return ns.DirectTriggerCalled(epoch, withEarlyEndOfEpoch)
@@ -194,6 +264,69 @@ func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return make([]core.QueryP2PPeerInfo, 0), nil
 }
 
+// GetConsensusRoundsTimeline -
+func (ns *NodeStub) GetConsensusRoundsTimeline() ([]*common.ConsensusRoundTimeline, error) {
+	if ns.GetConsensusRoundsTimelineCalled != nil {
+		return ns.GetConsensusRoundsTimelineCalled()
+	}
+
+	return make([]*common.ConsensusRoundTimeline, 0), nil
+}
+
+// GetSlashingEvidences -
+func (ns *NodeStub) GetSlashingEvidences() ([]*common.SlashingEvidence, error) {
+	if ns.GetSlashingEvidencesCalled != nil {
+		return ns.GetSlashingEvidencesCalled()
+	}
+
+	return make([]*common.SlashingEvidence, 0), nil
+}
+
+// GetGasProfile -
+func (ns *NodeStub) GetGasProfile() (*common.GasProfile, error) {
+	if ns.GetGasProfileCalled != nil {
+		return ns.GetGasProfileCalled()
+	}
+
+	return &common.GasProfile{}, nil
+}
+
+// GetAntifloodQuotaInfo -
+func (ns *NodeStub) GetAntifloodQuotaInfo() (*common.AntifloodQuotaInfo, error) {
+	if ns.GetAntifloodQuotaInfoCalled != nil {
+		return ns.GetAntifloodQuotaInfoCalled()
+	}
+
+	return &common.AntifloodQuotaInfo{}, nil
+}
+
+// GetBlacklistedPeers -
+func (ns *NodeStub) GetBlacklistedPeers() ([]common.BlacklistedPeerInfo, error) {
+	if ns.GetBlacklistedPeersCalled != nil {
+		return ns.GetBlacklistedPeersCalled()
+	}
+
+	return make([]common.BlacklistedPeerInfo, 0), nil
+}
+
+// BlacklistPeerManually -
+func (ns *NodeStub) BlacklistPeerManually(pid string, reason string, durationInSeconds uint32) error {
+	if ns.BlacklistPeerManuallyCalled != nil {
+		return ns.BlacklistPeerManuallyCalled(pid, reason, durationInSeconds)
+	}
+
+	return nil
+}
+
+// PardonPeer -
+func (ns *NodeStub) PardonPeer(pid string) error {
+	if ns.PardonPeerCalled != nil {
+		return ns.PardonPeerCalled(pid)
+	}
+
+	return nil
+}
+
 // GetESDTData -
 func (ns *NodeStub) GetESDTData(address, tokenID string, nonce uint64) (*esdt.ESDigitalToken, error) {
 	if ns.GetESDTDataCalled != nil {
@@ -231,7 +364,34 @@ func (ns *NodeStub) GetAllESDTTokens(address string, ctx context.Context) (map[s
 }
 
 // GetTokenSupply -
-func (ns *NodeStub) GetTokenSupply(_ string) (*api.ESDTSupply, error) {
+func (ns *NodeStub) GetTokenSupply(_ string) (*common.ESDTSupply, error) {
+	return nil, nil
+}
+
+// GetESDTHolders -
+func (ns *NodeStub) GetESDTHolders(token string, from uint32, size uint32) (*common.ESDTHolders, error) {
+	if ns.GetESDTHoldersCalled != nil {
+		return ns.GetESDTHoldersCalled(token, from, size)
+	}
+
+	return nil, nil
+}
+
+// GetCollectionNFTs -
+func (ns *NodeStub) GetCollectionNFTs(collection string, from uint32, size uint32) (*common.CollectionNFTs, error) {
+	if ns.GetCollectionNFTsCalled != nil {
+		return ns.GetCollectionNFTsCalled(collection, from, size)
+	}
+
+	return nil, nil
+}
+
+// GetEvents -
+func (ns *NodeStub) GetEvents(query common.EventsQuery) (*common.Events, error) {
+	if ns.GetEventsCalled != nil {
+		return ns.GetEventsCalled(query)
+	}
+
 	return nil, nil
 }
 
@@ -96,6 +96,9 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if arg.WsAntifloodConfig.TrieOperationsDeadlineMilliseconds == 0 {
 		return nil, fmt.Errorf("%w, TrieOperationsDeadlineMilliseconds should not be 0", ErrInvalidValue)
 	}
+	if arg.WsAntifloodConfig.MaxAddressesPerMultiProof == 0 {
+		return nil, fmt.Errorf("%w, MaxAddressesPerMultiProof should not be 0", ErrInvalidValue)
+	}
 	if check.IfNil(arg.AccountsState) {
 		return nil, ErrNilAccountState
 	}
@@ -226,10 +229,25 @@ func (nf *nodeFacade) GetAllESDTTokens(address string) (map[string]*esdt.ESDigit
 }
 
 // GetTokenSupply returns the provided token supply
-func (nf *nodeFacade) GetTokenSupply(token string) (*apiData.ESDTSupply, error) {
+func (nf *nodeFacade) GetTokenSupply(token string) (*common.ESDTSupply, error) {
 	return nf.node.GetTokenSupply(token)
 }
 
+// GetESDTHolders returns a page of the holders of the provided token
+func (nf *nodeFacade) GetESDTHolders(token string, from uint32, size uint32) (*common.ESDTHolders, error) {
+	return nf.node.GetESDTHolders(token, from, size)
+}
+
+// GetCollectionNFTs returns a page of the NFTs of the provided collection
+func (nf *nodeFacade) GetCollectionNFTs(collection string, from uint32, size uint32) (*common.CollectionNFTs, error) {
+	return nf.node.GetCollectionNFTs(collection, from, size)
+}
+
+// GetEvents returns a page of the events matching the provided query
+func (nf *nodeFacade) GetEvents(query common.EventsQuery) (*common.Events, error) {
+	return nf.node.GetEvents(query)
+}
+
 // GetAllIssuedESDTs returns all the issued esdts from the esdt system smart contract
 func (nf *nodeFacade) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	ctx, cancel := nf.getContextForApiTrieRangeOperations()
@@ -279,6 +297,16 @@ func (nf *nodeFacade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiRe
 	return nf.node.ValidatorStatisticsApi()
 }
 
+// GetNextEpochShufflingPreview returns the nodes that will change their position in the next epoch
+func (nf *nodeFacade) GetNextEpochShufflingPreview() (*common.NextEpochShufflingPreview, error) {
+	return nf.node.GetNextEpochShufflingPreview()
+}
+
+// GetValidatorHistory returns the recorded per epoch statistics of the provided validator
+func (nf *nodeFacade) GetValidatorHistory(pubKey string, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error) {
+	return nf.node.GetValidatorHistory(pubKey, fromEpoch, toEpoch)
+}
+
 // SendBulkTransactions will send a bulk of transactions on the topic channel
 func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return nf.node.SendBulkTransactions(txs)
@@ -299,6 +327,12 @@ func (nf *nodeFacade) GetTransactionsPool() (*common.TransactionsPoolAPIResponse
 	return nf.apiResolver.GetTransactionsPool()
 }
 
+// GetTransactionInclusionProof will return the data needed to prove that the transaction with the given hash was
+// included in a block notarized by the metachain
+func (nf *nodeFacade) GetTransactionInclusionProof(hash string) (*common.TransactionInclusionProof, error) {
+	return nf.apiResolver.GetTransactionInclusionProof(hash)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
@@ -401,6 +435,41 @@ func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
+// GetConsensusRoundsTimeline returns the timeline of the consensus events of the last rounds
+func (nf *nodeFacade) GetConsensusRoundsTimeline() ([]*common.ConsensusRoundTimeline, error) {
+	return nf.node.GetConsensusRoundsTimeline()
+}
+
+// GetSlashingEvidences returns the evidences of the validators that signed conflicting consensus messages
+func (nf *nodeFacade) GetSlashingEvidences() ([]*common.SlashingEvidence, error) {
+	return nf.node.GetSlashingEvidences()
+}
+
+// GetGasProfile returns the gas consumption aggregated per contract function and per built-in function
+func (nf *nodeFacade) GetGasProfile() (*common.GasProfile, error) {
+	return nf.node.GetGasProfile()
+}
+
+// GetAntifloodQuotaInfo returns the quota usage measured by the antiflood components
+func (nf *nodeFacade) GetAntifloodQuotaInfo() (*common.AntifloodQuotaInfo, error) {
+	return nf.node.GetAntifloodQuotaInfo()
+}
+
+// GetBlacklistedPeers returns the details of the blacklisted peers
+func (nf *nodeFacade) GetBlacklistedPeers() ([]common.BlacklistedPeerInfo, error) {
+	return nf.node.GetBlacklistedPeers()
+}
+
+// BlacklistPeerManually blacklists the provided peer for the provided duration
+func (nf *nodeFacade) BlacklistPeerManually(pid string, reason string, durationInSeconds uint32) error {
+	return nf.node.BlacklistPeerManually(pid, reason, durationInSeconds)
+}
+
+// PardonPeer removes the provided peer from the black list
+func (nf *nodeFacade) PardonPeer(pid string) error {
+	return nf.node.PardonPeer(pid)
+}
+
 // GetThrottlerForEndpoint returns the throttler for a given endpoint if found
 func (nf *nodeFacade) GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool) {
 	throttlerForEndpoint, ok := nf.endpointsThrottlers[endpoint]
@@ -502,6 +571,52 @@ func (nf *nodeFacade) VerifyProof(rootHash string, address string, proof [][]byt
 	return nf.node.VerifyProof(rootHash, address, proof)
 }
 
+// GetMultiProof returns a single Merkle multi-proof for all the given addresses and root hash
+func (nf *nodeFacade) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	err := nf.checkNumAddressesPerMultiProof(addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	return nf.node.GetMultiProof(rootHash, addresses)
+}
+
+// VerifyMultiProof verifies the given Merkle multi-proof for all the given addresses
+func (nf *nodeFacade) VerifyMultiProof(rootHash string, addresses []string, proof [][]byte) (bool, error) {
+	err := nf.checkNumAddressesPerMultiProof(addresses)
+	if err != nil {
+		return false, err
+	}
+
+	return nf.node.VerifyMultiProof(rootHash, addresses, proof)
+}
+
+func (nf *nodeFacade) checkNumAddressesPerMultiProof(addresses []string) error {
+	if uint32(len(addresses)) > nf.wsAntifloodConfig.MaxAddressesPerMultiProof {
+		return fmt.Errorf("%w, provided %d, maximum %d", ErrTooManyAddressesInMultiProof,
+			len(addresses), nf.wsAntifloodConfig.MaxAddressesPerMultiProof)
+	}
+
+	return nil
+}
+
+// GetProofESDTBalance returns the Merkle Proof for the given address, and another Merkle Proof
+// for the ESDT balance of the given token, as stored in the account's dataTrie
+func (nf *nodeFacade) GetProofESDTBalance(rootHash string, address string, tokenIdentifier string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+	return nf.node.GetProofESDTBalance(rootHash, address, tokenIdentifier)
+}
+
+// VerifyESDTBalanceProof verifies the given ESDT balance proofs and returns the proven ESDT data
+func (nf *nodeFacade) VerifyESDTBalanceProof(
+	rootHash string,
+	address string,
+	tokenIdentifier string,
+	mainProof [][]byte,
+	dataTrieProof [][]byte,
+) (*esdt.ESDigitalToken, bool, error) {
+	return nf.node.VerifyESDTBalanceProof(rootHash, address, tokenIdentifier, mainProof, dataTrieProof)
+}
+
 func (nf *nodeFacade) convertVmOutputToApiResponse(input *vmcommon.VMOutput) *vm.VMOutputApi {
 	outputAccounts := make(map[string]*vm.OutputAccountApi)
 	for key, acc := range input.OutputAccounts {
@@ -594,6 +709,56 @@ func (nf *nodeFacade) GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32]
 	return eligible, waiting, nil
 }
 
+// GetGovernanceProposals will return all the governance proposals
+func (nf *nodeFacade) GetGovernanceProposals() ([]*common.GovernanceProposal, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetGovernanceProposals(ctx)
+}
+
+// GetGovernanceProposal will return the governance proposal with the provided reference, together with its votes
+func (nf *nodeFacade) GetGovernanceProposal(reference string) (*common.GovernanceProposal, error) {
+	return nf.apiResolver.GetGovernanceProposal(reference)
+}
+
+// GetGovernanceVotingPower will return the governance voting power of the provided address
+func (nf *nodeFacade) GetGovernanceVotingPower(address string) (*common.GovernanceVotingPower, error) {
+	return nf.apiResolver.GetGovernanceVotingPower(address)
+}
+
+// GetUserDelegations will return the funds and the rewards of the provided address in all the delegation contracts
+func (nf *nodeFacade) GetUserDelegations(address string) ([]*common.UserDelegation, error) {
+	return nf.apiResolver.GetUserDelegations(address)
+}
+
+// GetDelegationProvider will return the configuration, the nodes and the staked values of a delegation contract
+func (nf *nodeFacade) GetDelegationProvider(contract string) (*common.DelegationProvider, error) {
+	return nf.apiResolver.GetDelegationProvider(contract)
+}
+
+// GetStakingQueue will return a page of the nodes from the staking queue, in the order they will be staked
+func (nf *nodeFacade) GetStakingQueue(from uint32, size uint32) (*common.StakingQueue, error) {
+	return nf.apiResolver.GetStakingQueue(from, size)
+}
+
+// GetStakingNode will return the staking lifecycle view of the node with the provided BLS key
+func (nf *nodeFacade) GetStakingNode(blsKey string) (*common.StakingNode, error) {
+	return nf.apiResolver.GetStakingNode(blsKey)
+}
+
+// DryRunEconomics will evaluate the transactions from the provided block nonces range under both the current and the
+// alternative economics and gas schedule configurations
+func (nf *nodeFacade) DryRunEconomics(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error) {
+	return nf.apiResolver.DryRunEconomics(fromNonce, toNonce)
+}
+
+// GetRewardsPreview will return the rewards per node, per owner and per delegation contract estimated as if the
+// current epoch ended at the current block
+func (nf *nodeFacade) GetRewardsPreview() (*common.RewardsPreview, error) {
+	return nf.apiResolver.GetRewardsPreview()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nf *nodeFacade) IsInterfaceNil() bool {
 	return nf == nil
@@ -47,6 +47,7 @@ func createMockArguments() ArgNodeFacade {
 			SameSourceRequests:                 1,
 			SameSourceResetIntervalInSec:       1,
 			TrieOperationsDeadlineMilliseconds: 1,
+			MaxAddressesPerMultiProof:          10,
 		},
 		FacadeConfig: config.FacadeConfig{
 			RestApiInterface: "127.0.0.1:8080",
@@ -129,6 +130,17 @@ func TestNewNodeFacade_WithInvalidSameSourceRequestsShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, ErrInvalidValue))
 }
 
+func TestNewNodeFacade_WithInvalidMaxAddressesPerMultiProofShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.WsAntifloodConfig.MaxAddressesPerMultiProof = 0
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.True(t, errors.Is(err, ErrInvalidValue))
+}
+
 func TestNewNodeFacade_WithInvalidApiRoutesConfigShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -534,6 +546,23 @@ func TestNodeFacade_Trigger(t *testing.T) {
 	assert.True(t, recoveredWithEarlyEndOfEpoch.IsSet())
 }
 
+func TestNodeFacade_GetGasProfile(t *testing.T) {
+	t.Parallel()
+
+	expectedProfile := &common.GasProfile{WindowInSeconds: 3600}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetGasProfileCalled: func() (*common.GasProfile, error) {
+			return expectedProfile, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	profile, err := nf.GetGasProfile()
+	assert.Nil(t, err)
+	assert.True(t, expectedProfile == profile)
+}
+
 func TestNodeFacade_IsSelfTrigger(t *testing.T) {
 	t.Parallel()
 
@@ -880,6 +909,161 @@ func TestNodeFacade_GetDirectStakedList(t *testing.T) {
 	assert.True(t, called)
 }
 
+func TestNodeFacade_GetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetGovernanceProposalsCalled: func(ctx context.Context) ([]*common.GovernanceProposal, error) {
+			called = true
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.GetGovernanceProposals()
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
+func TestNodeFacade_GetDelegationViews(t *testing.T) {
+	t.Parallel()
+
+	getUserDelegationsCalled := false
+	getDelegationProviderCalled := false
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetUserDelegationsCalled: func(address string) ([]*common.UserDelegation, error) {
+			getUserDelegationsCalled = true
+			return nil, nil
+		},
+		GetDelegationProviderCalled: func(contract string) (*common.DelegationProvider, error) {
+			getDelegationProviderCalled = true
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	_, err := nf.GetUserDelegations("address")
+	assert.Nil(t, err)
+	assert.True(t, getUserDelegationsCalled)
+
+	_, err = nf.GetDelegationProvider("contract")
+	assert.Nil(t, err)
+	assert.True(t, getDelegationProviderCalled)
+}
+
+func TestNodeFacade_GetStakingQueueAndNode(t *testing.T) {
+	t.Parallel()
+
+	expectedQueue := &common.StakingQueue{Size: 1}
+	expectedNode := &common.StakingNode{BLSKey: "abcd"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetStakingQueueCalled: func(from uint32, size uint32) (*common.StakingQueue, error) {
+			assert.Equal(t, uint32(2), from)
+			assert.Equal(t, uint32(5), size)
+			return expectedQueue, nil
+		},
+		GetStakingNodeCalled: func(blsKey string) (*common.StakingNode, error) {
+			assert.Equal(t, "abcd", blsKey)
+			return expectedNode, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	queue, err := nf.GetStakingQueue(2, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedQueue, queue)
+
+	node, err := nf.GetStakingNode("abcd")
+	assert.Nil(t, err)
+	assert.Equal(t, expectedNode, node)
+}
+
+func TestNodeFacade_DryRunEconomics(t *testing.T) {
+	t.Parallel()
+
+	expectedResult := &common.EconomicsDryRunResult{FromNonce: 5, ToNonce: 7}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		DryRunEconomicsCalled: func(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error) {
+			assert.Equal(t, uint64(5), fromNonce)
+			assert.Equal(t, uint64(7), toNonce)
+			return expectedResult, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	result, err := nf.DryRunEconomics(5, 7)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResult, result)
+}
+
+func TestNodeFacade_GetRewardsPreview(t *testing.T) {
+	t.Parallel()
+
+	expectedPreview := &common.RewardsPreview{Epoch: 37}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetRewardsPreviewCalled: func() (*common.RewardsPreview, error) {
+			return expectedPreview, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	preview, err := nf.GetRewardsPreview()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPreview, preview)
+}
+
+func TestNodeFacade_GetESDTHoldersAndCollectionNFTs(t *testing.T) {
+	t.Parallel()
+
+	getESDTHoldersCalled := false
+	getCollectionNFTsCalled := false
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetESDTHoldersCalled: func(token string, from uint32, size uint32) (*common.ESDTHolders, error) {
+			getESDTHoldersCalled = true
+			return nil, nil
+		},
+		GetCollectionNFTsCalled: func(collection string, from uint32, size uint32) (*common.CollectionNFTs, error) {
+			getCollectionNFTsCalled = true
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	_, err := nf.GetESDTHolders("token", 0, 10)
+	assert.Nil(t, err)
+	assert.True(t, getESDTHoldersCalled)
+
+	_, err = nf.GetCollectionNFTs("collection", 0, 10)
+	assert.Nil(t, err)
+	assert.True(t, getCollectionNFTsCalled)
+}
+
+func TestNodeFacade_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	expectedQuery := common.EventsQuery{Identifier: "transfer", ToBlock: 10, Size: 5}
+	expectedEvents := &common.Events{ToBlock: 10}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetEventsCalled: func(query common.EventsQuery) (*common.Events, error) {
+			assert.Equal(t, expectedQuery, query)
+			return expectedEvents, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	events, err := nf.GetEvents(expectedQuery)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedEvents, events)
+}
+
 func TestNodeFacade_GetProofCurrentRootHashIsEmptyShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -917,6 +1101,53 @@ func TestNodeFacade_GetProof(t *testing.T) {
 	assert.Equal(t, expectedResponse, response)
 }
 
+func TestNodeFacade_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.GetMultiProofResponse{
+		Proof:    [][]byte{[]byte("valid"), []byte("proof")},
+		Values:   [][]byte{[]byte("value1"), []byte("value2")},
+		RootHash: "rootHash",
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetMultiProofCalled: func(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetMultiProof("hash", []string{"addr1", "addr2"})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_MultiProofWithTooManyAddressesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.WsAntifloodConfig.MaxAddressesPerMultiProof = 1
+	arg.Node = &mock.NodeStub{
+		GetMultiProofCalled: func(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+			assert.Fail(t, "should have not called the node")
+			return nil, nil
+		},
+		VerifyMultiProofCalled: func(_ string, _ []string, _ [][]byte) (bool, error) {
+			assert.Fail(t, "should have not called the node")
+			return true, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetMultiProof("hash", []string{"addr1", "addr2"})
+	assert.True(t, errors.Is(err, ErrTooManyAddressesInMultiProof))
+	assert.Nil(t, response)
+
+	ok, err := nf.VerifyMultiProof("hash", []string{"addr1", "addr2"}, nil)
+	assert.True(t, errors.Is(err, ErrTooManyAddressesInMultiProof))
+	assert.False(t, ok)
+}
+
 func TestNodeFacade_GetProofCurrentRootHash(t *testing.T) {
 	t.Parallel()
 
@@ -7,12 +7,16 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	metachainEpochStart "github.com/ElrondNetwork/elrond-go/epochStart/metachain"
+	errorsErd "github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/external/blockAPI"
+	"github.com/ElrondNetwork/elrond-go/node/external/economicsDryRun"
 	"github.com/ElrondNetwork/elrond-go/node/external/transactionAPI"
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
 	trieIteratorsFactory "github.com/ElrondNetwork/elrond-go/node/trieIterators/factory"
@@ -28,6 +32,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -180,6 +185,35 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	argsGovernanceProcessor := trieIterators.ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: argsProcessors,
+		Marshalizer:              args.CoreComponents.InternalMarshalizer(),
+		BlockChain:               args.DataComponents.Blockchain(),
+	}
+	governanceHandler, err := trieIteratorsFactory.CreateGovernanceHandler(argsGovernanceProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	argsDelegationViewsProcessor := trieIterators.ArgDelegationViewsProcessor{
+		ArgTrieIteratorProcessor: argsProcessors,
+		BlockChain:               args.DataComponents.Blockchain(),
+	}
+	delegationViewsHandler, err := trieIteratorsFactory.CreateDelegationViewsHandler(argsDelegationViewsProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	argsStakingQueueProcessor := trieIterators.ArgStakingQueueProcessor{
+		ArgTrieIteratorProcessor: argsProcessors,
+		BlockChain:               args.DataComponents.Blockchain(),
+		StakingV2EnableEpoch:     args.Configs.EpochConfig.EnableEpochs.StakingV2EnableEpoch,
+	}
+	stakingQueueHandler, err := trieIteratorsFactory.CreateStakingQueueHandler(argsStakingQueueProcessor)
+	if err != nil {
+		return nil, err
+	}
+
 	argsAPITransactionProc := &transactionAPI.ArgAPITransactionProcessor{
 		RoundDuration:            args.CoreComponents.GenesisNodesSetup().GetRoundDuration(),
 		GenesisTime:              args.CoreComponents.GenesisTime(),
@@ -206,6 +240,16 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	economicsDryRunHandler, err := createEconomicsDryRunHandler(args, apiBlockProcessor, apiInternalBlockProcessor, apiTransactionProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardsPreviewHandler, err := createRewardsPreviewHandler(args, scQueryService)
+	if err != nil {
+		return nil, err
+	}
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:           scQueryService,
 		StatusMetricsHandler:     args.CoreComponents.StatusHandlerUtils().Metrics(),
@@ -213,6 +257,11 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		TotalStakedValueHandler:  totalStakedValueHandler,
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
+		GovernanceHandler:        governanceHandler,
+		DelegationViewsHandler:   delegationViewsHandler,
+		StakingQueueHandler:      stakingQueueHandler,
+		EconomicsDryRunHandler:   economicsDryRunHandler,
+		RewardsPreviewHandler:    rewardsPreviewHandler,
 		APITransactionHandler:    apiTransactionProcessor,
 		APIBlockHandler:          apiBlockProcessor,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
@@ -266,7 +315,42 @@ func createScQueryService(
 		return nil, err
 	}
 
-	return sqQueryDispatcher, nil
+	return createScQueryServiceCache(args, sqQueryDispatcher)
+}
+
+func createScQueryServiceCache(
+	args *scQueryServiceArgs,
+	scQueryService process.SCQueryService,
+) (process.SCQueryService, error) {
+	cacheConfig := args.generalConfig.VirtualMachine.Querying.QueryCache
+	if !cacheConfig.Enabled {
+		return scQueryService, nil
+	}
+
+	excludedContracts := make([][]byte, 0, len(cacheConfig.ExcludedContracts))
+	for _, address := range cacheConfig.ExcludedContracts {
+		decodedAddress, err := args.coreComponents.AddressPubKeyConverter().Decode(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for VirtualMachine.Querying.QueryCache.ExcludedContracts address %s", err, address)
+		}
+
+		excludedContracts = append(excludedContracts, decodedAddress)
+	}
+
+	cacher, err := lrucache.NewCache(cacheConfig.Capacity)
+	if err != nil {
+		return nil, fmt.Errorf("%w for VirtualMachine.Querying.QueryCache.Capacity", err)
+	}
+
+	argsCache := smartContract.ArgsSCQueryServiceCache{
+		SCQueryService:    scQueryService,
+		BlockChain:        args.dataComponents.Blockchain(),
+		Cacher:            cacher,
+		ExcludedContracts: excludedContracts,
+		MetricsRegistry:   args.coreComponents.MetricsRegistry(),
+	}
+
+	return smartContract.NewSCQueryServiceCache(argsCache)
 }
 
 func createScQueryElement(
@@ -405,6 +489,7 @@ func createScQueryElement(
 		Bootstrapper:             args.bootstrapper,
 		AllowExternalQueriesChan: args.allowVMQueriesChan,
 		MaxGasLimitPerQuery:      maxGasForVmQueries,
+		MetricsRegistry:          args.coreComponents.MetricsRegistry(),
 	}
 
 	return smartContract.NewSCQueryService(argsNewSCQueryService)
@@ -460,6 +545,72 @@ func createAPIInternalBlockProcessor(args *ApiResolverArgs, apiTransactionHandle
 	return blockAPI.CreateAPIInternalBlockProcessor(blockApiArgs)
 }
 
+func createEconomicsDryRunHandler(
+	args *ApiResolverArgs,
+	apiBlockHandler blockAPI.APIBlockHandler,
+	apiInternalBlockHandler blockAPI.APIInternalBlockHandler,
+	apiTransactionHandler external.APITransactionHandler,
+) (external.EconomicsDryRunHandler, error) {
+	dryRunConfig := args.Configs.GeneralConfig.EconomicsDryRun
+	if !dryRunConfig.Enabled {
+		return economicsDryRun.NewDisabledEconomicsDryRunProcessor(), nil
+	}
+	// the refunds are read from the stored results of each transaction
+	if !args.ProcessComponents.HistoryRepository().IsEnabled() {
+		return nil, economicsDryRun.ErrTransactionsResultsNotIndexed
+	}
+
+	argsEconomicsDryRun := economicsDryRun.ArgsEconomicsDryRunProcessor{
+		Config:                       dryRunConfig,
+		EconomicsConfig:              args.Configs.EconomicsConfig,
+		GasScheduleConfig:            args.Configs.EpochConfig.GasSchedule,
+		GasScheduleDirectory:         args.Configs.ConfigurationPathsHolder.GasScheduleDirectoryName,
+		EnableEpochs:                 args.Configs.EpochConfig.EnableEpochs,
+		APIBlockHandler:              apiBlockHandler,
+		APIInternalBlockHandler:      apiInternalBlockHandler,
+		APITransactionHandler:        apiTransactionHandler,
+		ContractCallsExecutorCreator: &economicsDryRunExecutorCreator{args: args},
+	}
+
+	return economicsDryRun.NewEconomicsDryRunProcessor(argsEconomicsDryRun)
+}
+
+func createRewardsPreviewHandler(args *ApiResolverArgs, scQueryService process.SCQueryService) (external.RewardsPreviewHandler, error) {
+	if args.BootstrapComponents.ShardCoordinator().SelfId() != core.MetachainShardId {
+		return metachainEpochStart.NewDisabledRewardsPreview(), nil
+	}
+
+	genesisHeader := args.DataComponents.Blockchain().GetGenesisHeader()
+	if check.IfNil(genesisHeader) {
+		return nil, errorsErd.ErrGenesisBlockNotInitialized
+	}
+
+	argsRewardsPreview := metachainEpochStart.ArgsRewardsPreview{
+		Marshalizer:                   args.CoreComponents.InternalMarshalizer(),
+		Hasher:                        args.CoreComponents.Hasher(),
+		Store:                         args.DataComponents.StorageService(),
+		ShardCoordinator:              args.ProcessComponents.ShardCoordinator(),
+		RewardsHandler:                args.CoreComponents.EconomicsData(),
+		RoundTime:                     args.CoreComponents.RoundHandler(),
+		GenesisEpoch:                  genesisHeader.GetEpoch(),
+		GenesisNonce:                  genesisHeader.GetNonce(),
+		GenesisTotalSupply:            args.CoreComponents.EconomicsData().GenesisTotalSupply(),
+		StakingV2EnableEpoch:          args.Configs.EpochConfig.EnableEpochs.StakingV2EnableEpoch,
+		NodesConfigProvider:           args.ProcessComponents.NodesCoordinator(),
+		BlockChain:                    args.DataComponents.Blockchain(),
+		BlockTracker:                  args.ProcessComponents.BlockTracker(),
+		ValidatorsInfoProvider:        args.ProcessComponents.ValidatorsStatistics(),
+		QueryService:                  scQueryService,
+		MinNodePrice:                  args.Configs.SystemSCConfig.StakingSystemSCConfig.GenesisNodePrice,
+		PubkeyConverter:               args.CoreComponents.AddressPubKeyConverter(),
+		DataPool:                      args.DataComponents.Datapool(),
+		UserAccountsDB:                args.StateComponents.AccountsAdapter(),
+		ProtocolSustainabilityAddress: args.CoreComponents.EconomicsData().ProtocolSustainabilityAddress(),
+	}
+
+	return metachainEpochStart.NewRewardsPreview(argsRewardsPreview)
+}
+
 func createAPIBlockProcessorArgs(args *ApiResolverArgs, apiTransactionHandler external.APITransactionHandler) (*blockAPI.ArgAPIBlockProcessor, error) {
 	statusComputer, err := txstatus.NewStatusComputer(
 		args.ProcessComponents.ShardCoordinator().SelfId(),
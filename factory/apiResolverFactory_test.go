@@ -1,21 +1,41 @@
 package factory_test
 
 import (
+	"bytes"
+	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/forking"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/ElrondNetwork/elrond-go/process/sync/disabled"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestCreateApiResolver(t *testing.T) {
-	if testing.Short() {
-		t.Skip("this is not a short test")
-	}
+type blockStateStub struct {
+	header         data.HeaderHandler
+	parentRootHash []byte
+}
+
+// GetHeader -
+func (stub *blockStateStub) GetHeader() (data.HeaderHandler, error) {
+	return stub.header, nil
+}
 
+// GetParentRootHash -
+func (stub *blockStateStub) GetParentRootHash() ([]byte, error) {
+	return stub.parentRootHash, nil
+}
+
+func createMockApiResolverArgs(t *testing.T) *factory.ApiResolverArgs {
 	shardCoordinator := mock.NewMultiShardsCoordinatorMock(1)
 	coreComponents := getCoreComponents()
 	coreComponents.StatusHandlerUtils().Metrics()
@@ -34,7 +54,7 @@ func TestCreateApiResolver(t *testing.T) {
 
 	gasSchedule, _ := common.LoadGasScheduleConfig("../cmd/node/config/gasSchedules/gasScheduleV1.toml")
 	cfg := getGeneralConfig()
-	args := &factory.ApiResolverArgs{
+	return &factory.ApiResolverArgs{
 		Configs: &config.Configs{
 			FlagsConfig: &config.ContextFlagsConfig{
 				WorkingDir: "",
@@ -54,8 +74,48 @@ func TestCreateApiResolver(t *testing.T) {
 		Bootstrapper:       disabled.NewDisabledBootstrapper(),
 		AllowVMQueriesChan: common.GetClosedUnbufferedChannel(),
 	}
+}
+
+func TestCreateApiResolver(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
 
-	apiResolver, err := factory.CreateApiResolver(args)
+	apiResolver, err := factory.CreateApiResolver(createMockApiResolverArgs(t))
 	require.Nil(t, err)
 	require.NotNil(t, apiResolver)
 }
+
+func TestEconomicsDryRunExecutorCreator_CreateContractCallsExecutor(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	args := createMockApiResolverArgs(t)
+	creator := factory.NewEconomicsDryRunExecutorCreator(args)
+	assert.False(t, check.IfNil(creator))
+
+	executor, err := creator.CreateContractCallsExecutor(
+		args.GasScheduleNotifier,
+		args.CoreComponents.EconomicsData(),
+		forking.NewGenericEpochNotifier(),
+	)
+	require.Nil(t, err)
+	require.False(t, check.IfNil(executor))
+
+	rootHash, err := args.StateComponents.AccountsAdapter().RootHash()
+	require.Nil(t, err)
+	blockState := &blockStateStub{header: &block.Header{Nonce: 1}, parentRootHash: rootHash}
+	contractAddress := append(make([]byte, 8), append([]byte{5, 0}, bytes.Repeat([]byte("c"), 22)...)...)
+	tx := &transaction.Transaction{
+		SndAddr: bytes.Repeat([]byte("s"), 32),
+		RcvAddr: contractAddress,
+		Value:   big.NewInt(0),
+		Data:    []byte("add@01"),
+	}
+
+	vmOutput, err := executor.ExecuteContractCall([]byte("hash"), tx, 1000000, blockState)
+	require.Nil(t, err)
+	assert.Equal(t, vmcommon.ContractNotFound, vmOutput.ReturnCode)
+	assert.Nil(t, executor.Close())
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/gasProfiler"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
@@ -223,6 +224,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		BadTxForwarder:      badTxInterim,
 		EpochNotifier:       pcf.epochNotifier,
 		VMOutputCacher:      txcache.NewDisabledCache(),
+		GasProfiler:         pcf.gasProfiler,
 		ArwenChangeLocker:   arwenChangeLocker,
 		EnableEpochs:        enableEpochs,
 	}
@@ -318,6 +320,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		enableEpochs.OptimizeGasUsedInCrossMiniBlocksEnableEpoch,
 		enableEpochs.FrontRunningProtectionEnableEpoch,
 		enableEpochs.ScheduledMiniBlocksEnableEpoch,
+		enableEpochs.ParallelTransactionsExecutionEnableEpoch,
 		txTypeHandler,
 		scheduledTxsExecutionHandler,
 	)
@@ -363,6 +366,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		ScheduledTxsExecutionHandler:      scheduledTxsExecutionHandler,
 		ScheduledMiniBlocksEnableEpoch:    enableEpochs.ScheduledMiniBlocksEnableEpoch,
 		DoubleTransactionsDetector:        doubleTransactionsDetector,
+		Tracer:                            pcf.coreData.Tracer(),
 	}
 	txCoordinator, err := coordinator.NewTransactionCoordinator(argsTransactionCoordinator)
 	if err != nil {
@@ -535,6 +539,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		BadTxForwarder:      badTxForwarder,
 		EpochNotifier:       pcf.epochNotifier,
 		VMOutputCacher:      txcache.NewDisabledCache(),
+		GasProfiler:         pcf.gasProfiler,
 		ArwenChangeLocker:   arwenChangeLocker,
 		EnableEpochs:        enableEpochs,
 	}
@@ -608,6 +613,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		enableEpochs.OptimizeGasUsedInCrossMiniBlocksEnableEpoch,
 		enableEpochs.FrontRunningProtectionEnableEpoch,
 		enableEpochs.ScheduledMiniBlocksEnableEpoch,
+		enableEpochs.ParallelTransactionsExecutionEnableEpoch,
 		txTypeHandler,
 		scheduledTxsExecutionHandler,
 	)
@@ -653,6 +659,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		ScheduledTxsExecutionHandler:      scheduledTxsExecutionHandler,
 		ScheduledMiniBlocksEnableEpoch:    enableEpochs.ScheduledMiniBlocksEnableEpoch,
 		DoubleTransactionsDetector:        doubleTransactionsDetector,
+		Tracer:                            pcf.coreData.Tracer(),
 	}
 	txCoordinator, err := coordinator.NewTransactionCoordinator(argsTransactionCoordinator)
 	if err != nil {
@@ -928,6 +935,7 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 
 	scProcArgs.AccountsDB = readOnlyAccountsDB
 	scProcArgs.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
+	scProcArgs.GasProfiler = gasProfiler.NewDisabledGasProfiler()
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
 	if err != nil {
 		return nil, err
@@ -985,6 +993,7 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 	scProcArgs.TxFeeHandler = &processDisabled.FeeHandler{}
 
 	scProcArgs.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
+	scProcArgs.GasProfiler = gasProfiler.NewDisabledGasProfiler()
 
 	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapterAPI())
 	if err != nil {
@@ -188,6 +188,7 @@ func createAccountAdapter(
 		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
 		ProcessingMode:        common.Normal,
 		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:       &testscommon.MetricsRegistryStub{},
 	}
 	adb, err := state.NewAccountsDB(args)
 	if err != nil {
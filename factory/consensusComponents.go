@@ -13,7 +13,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
+	"github.com/ElrondNetwork/elrond-go/consensus/spos/slashing"
+	disabledSlashing "github.com/ElrondNetwork/elrond-go/consensus/spos/slashing/disabled"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
+	"github.com/ElrondNetwork/elrond-go/consensus/timeline"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
@@ -61,6 +65,8 @@ type consensusComponents struct {
 	broadcastMessenger consensus.BroadcastMessenger
 	worker             ConsensusWorker
 	hardforkTrigger    HardforkTrigger
+	roundTimeline      consensus.RoundTimelineHandler
+	slashingDetector   consensus.SlashingDetector
 	consensusTopic     string
 	consensusGroupSize int
 }
@@ -174,6 +180,20 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
+	cc.roundTimeline, err = timeline.NewRoundTimelineRecorder(timeline.ArgsRoundTimelineRecorder{
+		NumRoundsToKeep:  ccf.config.Consensus.RoundsTimelineSize,
+		RoundHandler:     ccf.processComponents.RoundHandler(),
+		AppStatusHandler: ccf.coreComponents.StatusHandler(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cc.slashingDetector, err = ccf.createSlashingDetector()
+	if err != nil {
+		return nil, err
+	}
+
 	marshalizer := ccf.coreComponents.InternalMarshalizer()
 	sizeCheckDelta := ccf.config.Marshalizer.SizeCheckDelta
 	if sizeCheckDelta > 0 {
@@ -205,6 +225,8 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		PublicKeySize:            ccf.config.ValidatorPubkeyConverter.Length,
 		AppStatusHandler:         ccf.coreComponents.StatusHandler(),
 		NodeRedundancyHandler:    ccf.processComponents.NodeRedundancyHandler(),
+		RoundTimeline:            cc.roundTimeline,
+		SlashingDetector:         cc.slashingDetector,
 	}
 
 	cc.worker, err = spos.NewWorker(workerArgs)
@@ -247,6 +269,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		FallbackHeaderValidator:       ccf.processComponents.FallbackHeaderValidator(),
 		NodeRedundancyHandler:         ccf.processComponents.NodeRedundancyHandler(),
 		ScheduledProcessor:            ccf.scheduledProcessor,
+		RoundTimeline:                 cc.roundTimeline,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -304,6 +327,24 @@ func (cc *consensusComponents) Close() error {
 	return nil
 }
 
+func (ccf *consensusComponentsFactory) createSlashingDetector() (consensus.SlashingDetector, error) {
+	slashingDetectorConfig := ccf.config.Consensus.SlashingDetector
+	if !slashingDetectorConfig.Enabled {
+		return disabledSlashing.NewSlashingDetector(), nil
+	}
+
+	return slashing.NewSlashingDetector(slashing.ArgsSlashingDetector{
+		NumRoundsToKeep:  slashingDetectorConfig.NumRoundsToKeep,
+		MaxNumEvidences:  slashingDetectorConfig.MaxNumEvidences,
+		ShardCoordinator: ccf.processComponents.ShardCoordinator(),
+		Storer:           ccf.dataComponents.StorageService().GetStorer(dataRetriever.SlashingEvidenceUnit),
+		Marshalizer:      ccf.coreComponents.InternalMarshalizer(),
+		Hasher:           ccf.coreComponents.Hasher(),
+		OutportHandler:   ccf.statusComponents.OutportHandler(),
+		AppStatusHandler: ccf.coreComponents.StatusHandler(),
+	})
+}
+
 func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyHandler, error) {
 	wd := ccf.coreComponents.Watchdog()
 	if ccf.statusComponents.OutportHandler().HasDrivers() {
@@ -323,6 +364,9 @@ func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyH
 		SyncTimer:        ccf.coreComponents.SyncTimer(),
 		Watchdog:         wd,
 		AppStatusHandler: ccf.coreComponents.StatusHandler(),
+		MetricsRegistry:  ccf.coreComponents.MetricsRegistry(),
+		Tracer:           ccf.coreComponents.Tracer(),
+		ShardID:          ccf.processComponents.ShardCoordinator().SelfId(),
 	}
 	chronologyHandler, err := chronology.NewChronology(chronologyArg)
 	if err != nil {
@@ -157,6 +157,30 @@ func (mcc *managedConsensusComponents) Bootstrapper() process.Bootstrapper {
 	return mcc.consensusComponents.bootstrapper
 }
 
+// RoundTimeline returns the recorder of the consensus events of the last rounds
+func (mcc *managedConsensusComponents) RoundTimeline() consensus.RoundTimelineHandler {
+	mcc.mutConsensusComponents.RLock()
+	defer mcc.mutConsensusComponents.RUnlock()
+
+	if mcc.consensusComponents == nil {
+		return nil
+	}
+
+	return mcc.consensusComponents.roundTimeline
+}
+
+// SlashingDetector returns the collector of the evidences of conflicting consensus messages
+func (mcc *managedConsensusComponents) SlashingDetector() consensus.SlashingDetector {
+	mcc.mutConsensusComponents.RLock()
+	defer mcc.mutConsensusComponents.RUnlock()
+
+	if mcc.consensusComponents == nil {
+		return nil
+	}
+
+	return mcc.consensusComponents.slashingDetector
+}
+
 // IsInterfaceNil returns true if the underlying object is nil
 func (mcc *managedConsensusComponents) IsInterfaceNil() bool {
 	return mcc == nil
@@ -23,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	commonFactory "github.com/ElrondNetwork/elrond-go/common/factory"
 	"github.com/ElrondNetwork/elrond-go/common/forking"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/round"
@@ -103,6 +104,7 @@ type coreComponents struct {
 	encodedAddressLen             uint32
 	arwenChangeLocker             common.Locker
 	processStatusHandler          common.ProcessStatusHandler
+	tracer                        tracing.Tracer
 }
 
 // NewCoreComponentsFactory initializes the factory which is responsible to creating core components
@@ -332,6 +334,11 @@ func (ccf *coreComponentsFactory) Create() (*coreComponents, error) {
 	// set as observer at first - it will be updated when creating the nodes coordinator
 	nodeTypeProvider := nodetype.NewNodeTypeProvider(core.NodeTypeObserver)
 
+	tracer, err := tracing.CreateTracer(ccf.config.Tracing, ccf.workingDir)
+	if err != nil {
+		return nil, err
+	}
+
 	return &coreComponents{
 		hasher:                        hasher,
 		txSignHasher:                  txSignHasher,
@@ -365,6 +372,7 @@ func (ccf *coreComponentsFactory) Create() (*coreComponents, error) {
 		nodeTypeProvider:              nodeTypeProvider,
 		arwenChangeLocker:             arwenChangeLocker,
 		processStatusHandler:          statusHandler.NewProcessStatusHandler(),
+		tracer:                        tracer,
 	}, nil
 }
 
@@ -382,6 +390,12 @@ func (cc *coreComponents) Close() error {
 			return err
 		}
 	}
+	if !check.IfNil(cc.tracer) {
+		err := cc.tracer.Close()
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -290,6 +291,30 @@ func (mcc *managedCoreComponents) StatusHandler() core.AppStatusHandler {
 	return mcc.coreComponents.statusHandlersUtils.StatusHandler()
 }
 
+// MetricsRegistry returns the registry of the histograms and counters
+func (mcc *managedCoreComponents) MetricsRegistry() common.MetricsRegistry {
+	mcc.mutCoreComponents.RLock()
+	defer mcc.mutCoreComponents.RUnlock()
+
+	if mcc.coreComponents == nil {
+		return nil
+	}
+
+	return mcc.coreComponents.statusHandlersUtils.MetricsRegistry()
+}
+
+// Tracer returns the tracer of the instrumented components
+func (mcc *managedCoreComponents) Tracer() tracing.Tracer {
+	mcc.mutCoreComponents.RLock()
+	defer mcc.mutCoreComponents.RUnlock()
+
+	if mcc.coreComponents == nil {
+		return nil
+	}
+
+	return mcc.coreComponents.tracer
+}
+
 // PathHandler returns the core components path handler
 func (mcc *managedCoreComponents) PathHandler() storage.PathManagerHandler {
 	mcc.mutCoreComponents.RLock()
@@ -0,0 +1,158 @@
+package factory
+
+import (
+	"path/filepath"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/external/economicsDryRun"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
+	"github.com/ElrondNetwork/elrond-go/state"
+	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
+	disabledPruning "github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
+	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	trieFactory "github.com/ElrondNetwork/elrond-go/trie/factory"
+	vmcommonBuiltInFunctions "github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+)
+
+type economicsDryRunExecutorCreator struct {
+	args *ApiResolverArgs
+}
+
+// CreateContractCallsExecutor creates the executor of the contract calls evaluated in an economics dry-run, with a VM
+// built from the provided gas schedule and reading a dedicated accounts adapter, which can be moved to past states
+// without affecting the node. The metachain gets a disabled executor, as no user account exists there
+func (creator *economicsDryRunExecutorCreator) CreateContractCallsExecutor(
+	gasSchedule core.GasScheduleNotifier,
+	economics process.FeeHandler,
+	epochNotifier process.EpochNotifier,
+) (economicsDryRun.ContractCallsExecutor, error) {
+	args := creator.args
+	shardCoordinator := args.ProcessComponents.ShardCoordinator()
+	if shardCoordinator.SelfId() == core.MetachainShardId {
+		return economicsDryRun.NewDisabledContractCallsExecutor(), nil
+	}
+
+	argsAccountsDB := state.ArgsAccountsDB{
+		Trie:                  args.StateComponents.TriesContainer().Get([]byte(trieFactory.UserAccountTrie)),
+		Hasher:                args.CoreComponents.Hasher(),
+		Marshaller:            args.CoreComponents.InternalMarshalizer(),
+		AccountFactory:        factoryState.NewAccountCreator(),
+		StoragePruningManager: disabledPruning.NewDisabledStoragePruningManager(),
+		ProcessingMode:        common.Normal,
+		ProcessStatusHandler:  args.CoreComponents.ProcessStatusHandler(),
+		MetricsRegistry:       args.CoreComponents.MetricsRegistry(),
+	}
+	accounts, err := state.NewAccountsDB(argsAccountsDB)
+	if err != nil {
+		return nil, err
+	}
+
+	readOnlyAccounts, err := txsimulator.NewReadOnlyAccountsDB(accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	enableEpochs := args.Configs.EpochConfig.EnableEpochs
+	builtInFuncs, nftStorageHandler, globalSettingsHandler, err := createBuiltinFuncs(
+		gasSchedule,
+		args.CoreComponents.InternalMarshalizer(),
+		readOnlyAccounts,
+		shardCoordinator,
+		epochNotifier,
+		enableEpochs.ESDTMultiTransferEnableEpoch,
+		enableEpochs.GlobalMintBurnDisableEpoch,
+		enableEpochs.ESDTTransferRoleEnableEpoch,
+		enableEpochs.BuiltInFunctionOnMetaEnableEpoch,
+		enableEpochs.OptimizeNFTStoreEnableEpoch,
+		enableEpochs.CheckCorrectTokenIDForTransferRoleEnableEpoch,
+		enableEpochs.CheckFunctionArgumentEnableEpoch,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	cacherCfg := storageFactory.GetCacherFromConfig(args.Configs.GeneralConfig.SmartContractDataPool)
+	smartContractsCache, err := storageUnit.NewCache(cacherCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	argsHook := hooks.ArgBlockChainHook{
+		Accounts:              readOnlyAccounts,
+		PubkeyConv:            args.CoreComponents.AddressPubKeyConverter(),
+		StorageService:        args.DataComponents.StorageService(),
+		BlockChain:            args.DataComponents.Blockchain(),
+		ShardCoordinator:      shardCoordinator,
+		Marshalizer:           args.CoreComponents.InternalMarshalizer(),
+		Uint64Converter:       args.CoreComponents.Uint64ByteSliceConverter(),
+		BuiltInFunctions:      builtInFuncs,
+		NFTStorageHandler:     nftStorageHandler,
+		GlobalSettingsHandler: globalSettingsHandler,
+		DataPool:              args.DataComponents.Datapool(),
+		ConfigSCStorage:       args.Configs.GeneralConfig.SmartContractsStorageForSCQuery,
+		CompiledSCPool:        smartContractsCache,
+		WorkingDir:            filepath.Join(args.Configs.FlagsConfig.WorkingDir, common.TemporaryPath),
+		EpochNotifier:         epochNotifier,
+		EnableEpochs:          enableEpochs,
+		NilCompiledSCStore:    true,
+	}
+	blockChainHook, err := hooks.NewBlockChainHookImpl(argsHook)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtTransferParser, err := parsers.NewESDTTransferParser(args.CoreComponents.InternalMarshalizer())
+	if err != nil {
+		return nil, err
+	}
+
+	arwenChangeLocker := &sync.RWMutex{}
+	argsNewVMFactory := shard.ArgVMContainerFactory{
+		BlockChainHook:     blockChainHook,
+		BuiltInFunctions:   builtInFuncs,
+		Config:             args.Configs.GeneralConfig.VirtualMachine.Querying.VirtualMachineConfig,
+		BlockGasLimit:      economics.MaxGasLimitPerBlock(shardCoordinator.SelfId()),
+		GasSchedule:        gasSchedule,
+		EpochNotifier:      epochNotifier,
+		EpochConfig:        enableEpochs,
+		ArwenChangeLocker:  arwenChangeLocker,
+		ESDTTransferParser: esdtTransferParser,
+	}
+	vmFactory, err := shard.NewVMContainerFactory(argsNewVMFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	vmContainer, err := vmFactory.Create()
+	if err != nil {
+		return nil, err
+	}
+
+	err = vmcommonBuiltInFunctions.SetPayableHandler(builtInFuncs, blockChainHook)
+	if err != nil {
+		return nil, err
+	}
+
+	argsExecutor := economicsDryRun.ArgsContractCallsExecutor{
+		VMContainer:        vmContainer,
+		BlockChainHook:     blockChainHook,
+		Accounts:           accounts,
+		ShardCoordinator:   shardCoordinator,
+		ESDTTransferParser: esdtTransferParser,
+		ArwenChangeLocker:  arwenChangeLocker,
+	}
+
+	return economicsDryRun.NewContractCallsExecutor(argsExecutor)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (creator *economicsDryRunExecutorCreator) IsInterfaceNil() bool {
+	return creator == nil
+}
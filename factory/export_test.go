@@ -102,3 +102,8 @@ func SetShardCoordinator(shardCoordinator sharding.Coordinator, holder Bootstrap
 func (pcf *processComponentsFactory) IndexGenesisBlocks(genesisBlocks map[uint32]data.HeaderHandler, indexingData map[uint32]*genesis.IndexingData) error {
 	return pcf.indexGenesisBlocks(genesisBlocks, indexingData)
 }
+
+// NewEconomicsDryRunExecutorCreator -
+func NewEconomicsDryRunExecutorCreator(args *ApiResolverArgs) *economicsDryRunExecutorCreator {
+	return &economicsDryRunExecutorCreator{args: args}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/common/statistics"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
@@ -64,7 +65,12 @@ type P2PAntifloodHandler interface {
 	SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error
 	SetTopicsForAll(topics ...string)
 	ApplyConsensusSize(size int)
+	SetQuotaFactor(factor float64)
 	BlacklistPeer(peer core.PeerID, reason string, duration time.Duration)
+	BlacklistPeerManually(peer core.PeerID, reason string, duration time.Duration) error
+	PardonPeer(peer core.PeerID) error
+	GetBlacklistedPeers() []common.BlacklistedPeerInfo
+	GetQuotaInfo() common.AntifloodQuotaInfo
 	IsOriginatorEligibleForTopic(pid core.PeerID, topic string) error
 	Close() error
 	IsInterfaceNil() bool
@@ -106,6 +112,8 @@ type CoreComponentsHolder interface {
 	ValidatorPubKeyConverter() core.PubkeyConverter
 	StatusHandlerUtils() factory.StatusHandlersUtils
 	StatusHandler() core.AppStatusHandler
+	MetricsRegistry() common.MetricsRegistry
+	Tracer() tracing.Tracer
 	PathHandler() storage.PathManagerHandler
 	Watchdog() core.WatchdogTimer
 	AlarmScheduler() core.TimersScheduler
@@ -248,6 +256,9 @@ type ProcessComponentsHolder interface {
 	HeaderIntegrityVerifier() process.HeaderIntegrityVerifier
 	ValidatorsStatistics() process.ValidatorStatisticsProcessor
 	ValidatorsProvider() process.ValidatorsProvider
+	ShufflingPreviewer() process.ShufflingPreviewer
+	ValidatorHistory() process.ValidatorHistoryHandler
+	GasProfiler() process.GasProfilerHandler
 	BlockTracker() process.BlockTracker
 	PendingMiniBlocksHandler() process.PendingMiniBlocksHandler
 	RequestHandler() process.RequestHandler
@@ -393,6 +404,8 @@ type ConsensusComponentsHolder interface {
 	ConsensusGroupSize() (int, error)
 	HardforkTrigger() HardforkTrigger
 	Bootstrapper() process.Bootstrapper
+	RoundTimeline() consensus.RoundTimelineHandler
+	SlashingDetector() consensus.SlashingDetector
 	IsInterfaceNil() bool
 }
 
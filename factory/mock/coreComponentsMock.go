@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -57,6 +58,8 @@ type CoreComponentsMock struct {
 	NodeTypeProviderField        core.NodeTypeProviderHandler
 	ArwenChangeLockerInternal    common.Locker
 	ProcessStatusHandlerInternal common.ProcessStatusHandler
+	MetricsRegistryInternal      common.MetricsRegistry
+	TracerInternal               tracing.Tracer
 }
 
 // InternalMarshalizer -
@@ -243,6 +246,16 @@ func (ccm *CoreComponentsMock) ArwenChangeLocker() common.Locker {
 	return ccm.ArwenChangeLockerInternal
 }
 
+// MetricsRegistry -
+func (ccm *CoreComponentsMock) MetricsRegistry() common.MetricsRegistry {
+	return ccm.MetricsRegistryInternal
+}
+
+// Tracer -
+func (ccm *CoreComponentsMock) Tracer() tracing.Tracer {
+	return ccm.TracerInternal
+}
+
 // ProcessStatusHandler -
 func (ccm *CoreComponentsMock) ProcessStatusHandler() common.ProcessStatusHandler {
 	return ccm.ProcessStatusHandlerInternal
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -13,9 +14,14 @@ type P2PAntifloodHandlerStub struct {
 	CanProcessMessageCalled            func(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
 	CanProcessMessagesOnTopicCalled    func(peer core.PeerID, topic string, numMessages uint32, totalSize uint64, sequence []byte) error
 	ApplyConsensusSizeCalled           func(size int)
+	SetQuotaFactorCalled               func(factor float64)
 	SetDebuggerCalled                  func(debugger process.AntifloodDebugger) error
 	BlacklistPeerCalled                func(peer core.PeerID, reason string, duration time.Duration)
 	IsOriginatorEligibleForTopicCalled func(pid core.PeerID, topic string) error
+	BlacklistPeerManuallyCalled        func(peer core.PeerID, reason string, duration time.Duration) error
+	PardonPeerCalled                   func(peer core.PeerID) error
+	GetBlacklistedPeersCalled          func() []common.BlacklistedPeerInfo
+	GetQuotaInfoCalled                 func() common.AntifloodQuotaInfo
 }
 
 // CanProcessMessage -
@@ -49,6 +55,13 @@ func (p2pahs *P2PAntifloodHandlerStub) ApplyConsensusSize(size int) {
 	}
 }
 
+// SetQuotaFactor -
+func (p2pahs *P2PAntifloodHandlerStub) SetQuotaFactor(factor float64) {
+	if p2pahs.SetQuotaFactorCalled != nil {
+		p2pahs.SetQuotaFactorCalled(factor)
+	}
+}
+
 // SetDebugger -
 func (p2pahs *P2PAntifloodHandlerStub) SetDebugger(debugger process.AntifloodDebugger) error {
 	if p2pahs.SetDebuggerCalled != nil {
@@ -65,6 +78,42 @@ func (p2pahs *P2PAntifloodHandlerStub) BlacklistPeer(peer core.PeerID, reason st
 	}
 }
 
+// BlacklistPeerManually -
+func (p2pahs *P2PAntifloodHandlerStub) BlacklistPeerManually(peer core.PeerID, reason string, duration time.Duration) error {
+	if p2pahs.BlacklistPeerManuallyCalled != nil {
+		return p2pahs.BlacklistPeerManuallyCalled(peer, reason, duration)
+	}
+
+	return nil
+}
+
+// PardonPeer -
+func (p2pahs *P2PAntifloodHandlerStub) PardonPeer(peer core.PeerID) error {
+	if p2pahs.PardonPeerCalled != nil {
+		return p2pahs.PardonPeerCalled(peer)
+	}
+
+	return nil
+}
+
+// GetBlacklistedPeers -
+func (p2pahs *P2PAntifloodHandlerStub) GetBlacklistedPeers() []common.BlacklistedPeerInfo {
+	if p2pahs.GetBlacklistedPeersCalled != nil {
+		return p2pahs.GetBlacklistedPeersCalled()
+	}
+
+	return make([]common.BlacklistedPeerInfo, 0)
+}
+
+// GetQuotaInfo -
+func (p2pahs *P2PAntifloodHandlerStub) GetQuotaInfo() common.AntifloodQuotaInfo {
+	if p2pahs.GetQuotaInfoCalled != nil {
+		return p2pahs.GetQuotaInfoCalled()
+	}
+
+	return common.AntifloodQuotaInfo{}
+}
+
 // ResetForTopic -
 func (p2pahs *P2PAntifloodHandlerStub) ResetForTopic(_ string) {
 
@@ -29,6 +29,9 @@ type ProcessComponentsMock struct {
 	HeaderIntegrVerif                    process.HeaderIntegrityVerifier
 	ValidatorStatistics                  process.ValidatorStatisticsProcessor
 	ValidatorProvider                    process.ValidatorsProvider
+	ShufflingPreviewerField              process.ShufflingPreviewer
+	ValidatorHistoryField                process.ValidatorHistoryHandler
+	GasProfilerField                     process.GasProfilerHandler
 	BlockTrack                           process.BlockTracker
 	PendingMiniBlocksHdl                 process.PendingMiniBlocksHandler
 	ReqHandler                           process.RequestHandler
@@ -138,6 +141,21 @@ func (pcm *ProcessComponentsMock) ValidatorsProvider() process.ValidatorsProvide
 	return pcm.ValidatorProvider
 }
 
+// ShufflingPreviewer -
+func (pcm *ProcessComponentsMock) ShufflingPreviewer() process.ShufflingPreviewer {
+	return pcm.ShufflingPreviewerField
+}
+
+// ValidatorHistory -
+func (pcm *ProcessComponentsMock) ValidatorHistory() process.ValidatorHistoryHandler {
+	return pcm.ValidatorHistoryField
+}
+
+// GasProfiler -
+func (pcm *ProcessComponentsMock) GasProfiler() process.GasProfilerHandler {
+	return pcm.GasProfilerField
+}
+
 // BlockTracker -
 func (pcm *ProcessComponentsMock) BlockTracker() process.BlockTracker {
 	return pcm.BlockTrack
@@ -3,12 +3,14 @@ package factory
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/core/peersholder"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/debug/antiflood"
@@ -19,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/rating/peerHonesty"
 	antifloodFactory "github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/factory"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -30,11 +33,14 @@ type NetworkComponentsFactoryArgs struct {
 	MainConfig          config.Config
 	RatingsConfig       config.RatingsConfig
 	StatusHandler       core.AppStatusHandler
+	MetricsRegistry     common.MetricsRegistry
 	Marshalizer         marshal.Marshalizer
 	Syncer              p2p.SyncTimer
 	PreferredPublicKeys [][]byte
 	BootstrapWaitTime   time.Duration
 	NodeOperationMode   p2p.NodeOperation
+	WorkingDir          string
+	ChainID             string
 }
 
 type networkComponentsFactory struct {
@@ -42,12 +48,15 @@ type networkComponentsFactory struct {
 	mainConfig          config.Config
 	ratingsConfig       config.RatingsConfig
 	statusHandler       core.AppStatusHandler
+	metricsRegistry     common.MetricsRegistry
 	listenAddress       string
 	marshalizer         marshal.Marshalizer
 	syncer              p2p.SyncTimer
 	preferredPublicKeys [][]byte
 	bootstrapWaitTime   time.Duration
 	nodeOperationMode   p2p.NodeOperation
+	workingDir          string
+	chainID             string
 }
 
 // networkComponents struct holds the network components
@@ -63,9 +72,19 @@ type networkComponents struct {
 	peerHonestyHandler     consensus.PeerHonestyHandler
 	peersHolder            PreferredPeersHolderHandler
 	peersRatingHandler     p2p.PeersRatingHandler
+	peersRatingPersister   peersRatingPersister
 	closeFunc              context.CancelFunc
 }
 
+// knownGoodPeersConnectionTimeout bounds the time spent dialing the well rated peers saved on the previous run
+const knownGoodPeersConnectionTimeout = time.Second * 30
+
+type peersRatingPersister interface {
+	ConnectToKnownGoodPeers(ctx context.Context) int
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // NewNetworkComponentsFactory returns a new instance of a network components factory
 func NewNetworkComponentsFactory(
 	args NetworkComponentsFactoryArgs,
@@ -73,6 +92,9 @@ func NewNetworkComponentsFactory(
 	if check.IfNil(args.StatusHandler) {
 		return nil, errors.ErrNilStatusHandler
 	}
+	if check.IfNil(args.MetricsRegistry) {
+		return nil, errors.ErrNilMetricsRegistry
+	}
 	if check.IfNil(args.Marshalizer) {
 		return nil, fmt.Errorf("%w in NewNetworkComponentsFactory", errors.ErrNilMarshalizer)
 	}
@@ -86,11 +108,14 @@ func NewNetworkComponentsFactory(
 		marshalizer:         args.Marshalizer,
 		mainConfig:          args.MainConfig,
 		statusHandler:       args.StatusHandler,
+		metricsRegistry:     args.MetricsRegistry,
 		listenAddress:       libp2p.ListenAddrWithIp4AndTcp,
 		syncer:              args.Syncer,
 		bootstrapWaitTime:   args.BootstrapWaitTime,
 		preferredPublicKeys: args.PreferredPublicKeys,
 		nodeOperationMode:   args.NodeOperationMode,
+		workingDir:          args.WorkingDir,
+		chainID:             args.ChainID,
 	}, nil
 }
 
@@ -122,6 +147,7 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		PreferredPeersHolder: peersHolder,
 		NodeOperationMode:    ncf.nodeOperationMode,
 		PeersRatingHandler:   peersRatingHandler,
+		MetricsRegistry:      ncf.metricsRegistry,
 	}
 
 	netMessenger, err := libp2p.NewNetworkMessenger(arg)
@@ -136,9 +162,23 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		}
 	}()
 
+	var blacklistStorer storage.Storer
+	blacklistStorer, err = ncf.createPeersBlacklistStorer()
+	if err != nil {
+		return nil, err
+	}
+
 	var antiFloodComponents *antifloodFactory.AntiFloodComponents
-	antiFloodComponents, err = antifloodFactory.NewP2PAntiFloodComponents(ctx, ncf.mainConfig, ncf.statusHandler, netMessenger.ID())
+	antiFloodComponents, err = antifloodFactory.NewP2PAntiFloodComponents(
+		ctx,
+		ncf.mainConfig,
+		ncf.statusHandler,
+		netMessenger.ID(),
+		blacklistStorer,
+		ncf.marshalizer,
+	)
 	if err != nil {
+		log.LogIfError(blacklistStorer.Close())
 		return nil, err
 	}
 
@@ -184,6 +224,15 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		return nil, err
 	}
 
+	var ratingPersister peersRatingPersister
+	ratingPersister, err = ncf.createPeersRatingPersister(peersRatingHandler, netMessenger)
+	if err != nil {
+		return nil, err
+	}
+	if !check.IfNil(ratingPersister) {
+		go connectToKnownGoodPeers(ctx, ratingPersister)
+	}
+
 	err = netMessenger.Bootstrap()
 	if err != nil {
 		return nil, err
@@ -203,10 +252,78 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		peerHonestyHandler:     peerHonestyHandler,
 		peersHolder:            peersHolder,
 		peersRatingHandler:     peersRatingHandler,
+		peersRatingPersister:   ratingPersister,
 		closeFunc:              cancelFunc,
 	}, nil
 }
 
+func (ncf *networkComponentsFactory) createPeersRatingPersister(
+	peersRatingHandler p2p.PeersRatingSnapshotHandler,
+	netMessenger p2p.Messenger,
+) (peersRatingPersister, error) {
+	peersRatingConfig := ncf.mainConfig.PeersRatingConfig
+	if !peersRatingConfig.PersistenceEnabled {
+		return nil, nil
+	}
+
+	storer, err := ncf.createStaticStorer(peersRatingConfig.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	args := rating.ArgPeersRatingPersister{
+		PeersRatingHandler:        peersRatingHandler,
+		PeersAddressesHandler:     netMessenger,
+		Storer:                    storer,
+		Marshalizer:               ncf.marshalizer,
+		PersistInterval:           time.Duration(peersRatingConfig.PersistIntervalInSeconds) * time.Second,
+		RatingHalfLife:            time.Duration(peersRatingConfig.RatingHalfLifeInMinutes) * time.Minute,
+		MaxNumOfKnownGoodPeers:    peersRatingConfig.MaxNumOfKnownGoodPeers,
+		MinRatingForKnownGoodPeer: peersRatingConfig.MinRatingForKnownGoodPeer,
+	}
+	ratingPersister, err := rating.NewPeersRatingPersister(args)
+	if err != nil {
+		log.LogIfError(storer.Close())
+		return nil, err
+	}
+
+	return ratingPersister, nil
+}
+
+// connectToKnownGoodPeers dials the well rated peers in parallel with the bootstrap of the messenger, so a slow or
+// unreachable known peer can not delay the node start
+func connectToKnownGoodPeers(ctx context.Context, ratingPersister peersRatingPersister) {
+	ctxConnect, cancel := context.WithTimeout(ctx, knownGoodPeersConnectionTimeout)
+	defer cancel()
+
+	ratingPersister.ConnectToKnownGoodPeers(ctxConnect)
+}
+
+func (ncf *networkComponentsFactory) createPeersBlacklistStorer() (storage.Storer, error) {
+	antifloodConfig := ncf.mainConfig.Antiflood
+	if !antifloodConfig.Enabled || !antifloodConfig.PeersBlacklist.PersistManualDecisions {
+		return storageUnit.NewNilStorer(), nil
+	}
+
+	return ncf.createStaticStorer(antifloodConfig.PeersBlacklist.Storage)
+}
+
+func (ncf *networkComponentsFactory) createStaticStorer(storageConfig config.StorageConfig) (storage.Storer, error) {
+	dbConfig := storageFactory.GetDBFromConfig(storageConfig.DB)
+	dbConfig.FilePath = filepath.Join(
+		ncf.workingDir,
+		common.DefaultDBPath,
+		ncf.chainID,
+		common.DefaultStaticDbString,
+		storageConfig.DB.FilePath,
+	)
+
+	return storageUnit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(storageConfig.Cache),
+		dbConfig,
+	)
+}
+
 func (ncf *networkComponentsFactory) createPeerHonestyHandler(
 	config *config.Config,
 	ratingConfig config.RatingsConfig,
@@ -237,6 +354,9 @@ func (nc *networkComponents) Close() error {
 	if !check.IfNil(nc.peerHonestyHandler) {
 		log.LogIfError(nc.peerHonestyHandler.Close())
 	}
+	if !check.IfNil(nc.peersRatingPersister) {
+		log.LogIfError(nc.peersRatingPersister.Close())
+	}
 
 	if nc.netMessenger != nil {
 		log.Debug("calling close on the network messenger instance...")
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/require"
 )
@@ -27,6 +28,19 @@ func TestNewNetworkComponentsFactory_NilStatusHandlerShouldErr(t *testing.T) {
 	require.Equal(t, errErd.ErrNilStatusHandler, err)
 }
 
+func TestNewNetworkComponentsFactory_NilMetricsRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	args := getNetworkArgs()
+	args.MetricsRegistry = nil
+	ncf, err := factory.NewNetworkComponentsFactory(args)
+	require.Nil(t, ncf)
+	require.Equal(t, errErd.ErrNilMetricsRegistry, err)
+}
+
 func TestNewNetworkComponentsFactory_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 	if testing.Short() {
@@ -152,10 +166,11 @@ func getNetworkArgs() factory.NetworkComponentsFactoryArgs {
 	appStatusHandler := statusHandlerMock.NewAppStatusHandlerMock()
 
 	return factory.NetworkComponentsFactoryArgs{
-		P2pConfig:     p2pConfig,
-		MainConfig:    mainConfig,
-		StatusHandler: appStatusHandler,
-		Marshalizer:   &mock.MarshalizerMock{},
+		P2pConfig:       p2pConfig,
+		MainConfig:      mainConfig,
+		StatusHandler:   appStatusHandler,
+		MetricsRegistry: &testscommon.MetricsRegistryStub{},
+		Marshalizer:     &mock.MarshalizerMock{},
 		RatingsConfig: config.RatingsConfig{
 			General:    config.General{},
 			ShardChain: config.ShardChain{},
@@ -16,6 +16,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/statistics/machine"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -42,10 +43,16 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/poolsCleaner"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
+	"github.com/ElrondNetwork/elrond-go/process/gasProfiler"
 	"github.com/ElrondNetwork/elrond-go/process/headerCheck"
 	"github.com/ElrondNetwork/elrond-go/process/peer"
+	"github.com/ElrondNetwork/elrond-go/process/peer/history"
+	disabledHistory "github.com/ElrondNetwork/elrond-go/process/peer/history/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
+	"github.com/ElrondNetwork/elrond-go/process/throttle"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/adaptive"
+	disabledAntiflood "github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/track"
 	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
 	"github.com/ElrondNetwork/elrond-go/process/txsSender"
@@ -67,6 +74,9 @@ var log = logger.GetOrCreate("factory")
 // timeSpanForBadHeaders is the expiry time for an added block header hash
 var timeSpanForBadHeaders = time.Minute * 2
 
+// numInterceptorsGoRoutines is the maximum number of go routines used by the interceptors
+const numInterceptorsGoRoutines = 100
+
 // processComponents struct holds the process components
 type processComponents struct {
 	nodesCoordinator             nodesCoordinator.NodesCoordinator
@@ -84,6 +94,9 @@ type processComponents struct {
 	headerIntegrityVerifier      factory.HeaderIntegrityVerifierHandler
 	validatorsStatistics         process.ValidatorStatisticsProcessor
 	validatorsProvider           process.ValidatorsProvider
+	shufflingPreviewer           process.ShufflingPreviewer
+	validatorHistory             process.ValidatorHistoryHandler
+	gasProfiler                  process.GasProfilerHandler
 	blockTracker                 process.BlockTracker
 	pendingMiniBlocksHandler     process.PendingMiniBlocksHandler
 	requestHandler               process.RequestHandler
@@ -106,6 +119,7 @@ type processComponents struct {
 	vmFactoryForProcessing       process.VirtualMachinesContainerFactory
 	scheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
 	txsSender                    process.TxsSenderHandler
+	antifloodQuotaController     update.Closer
 }
 
 // ProcessComponentsFactoryArgs holds the arguments needed to create a process components factory
@@ -158,6 +172,7 @@ type processComponentsFactory struct {
 	historyRepo            dblookupext.HistoryRepository
 	epochNotifier          process.EpochNotifier
 	importHandler          update.ImportHandler
+	gasProfiler            process.GasProfilerHandler
 
 	data                DataComponentsHolder
 	coreData            CoreComponentsHolder
@@ -287,6 +302,12 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 	}
 
 	pcf.txLogsProcessor = txLogsProcessor
+
+	pcf.gasProfiler, err = pcf.newGasProfiler()
+	if err != nil {
+		return nil, err
+	}
+
 	genesisBlocks, initialTxs, err := pcf.generateGenesisHeadersAndApplyInitialBalances()
 	if err != nil {
 		return nil, err
@@ -310,7 +331,12 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
-	validatorStatisticsProcessor, err := pcf.newValidatorStatisticsProcessor()
+	validatorHistory, err := pcf.newValidatorHistory()
+	if err != nil {
+		return nil, err
+	}
+
+	validatorStatisticsProcessor, err := pcf.newValidatorStatisticsProcessor(validatorHistory)
 	if err != nil {
 		return nil, err
 	}
@@ -338,6 +364,20 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 
 	requestHandler.SetEpoch(epochStartTrigger.Epoch())
 
+	argsShufflingPreviewer := peer.ArgShufflingPreviewer{
+		NodesCoordinator:    pcf.nodesCoordinator,
+		ValidatorStatistics: validatorStatisticsProcessor,
+		ChainHandler:        pcf.data.Blockchain(),
+		EpochStartTrigger:   epochStartTrigger,
+		ShardCoordinator:    pcf.bootstrapComponents.ShardCoordinator(),
+		PubKeyConverter:     pcf.coreData.ValidatorPubKeyConverter(),
+		RoundsPerEpoch:      uint64(pcf.config.EpochStartConfig.RoundsPerEpoch),
+	}
+	shufflingPreviewer, err := peer.NewShufflingPreviewer(argsShufflingPreviewer)
+	if err != nil {
+		return nil, err
+	}
+
 	err = dataRetriever.SetEpochHandlerToHdrResolver(resolversContainer, epochStartTrigger)
 	if err != nil {
 		return nil, err
@@ -426,12 +466,18 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	interceptorsThrottler, err := throttle.NewInterceptorsThrottler(numInterceptorsGoRoutines)
+	if err != nil {
+		return nil, err
+	}
+
 	interceptorContainerFactory, blackListHandler, err := pcf.newInterceptorContainerFactory(
 		headerSigVerifier,
 		pcf.bootstrapComponents.HeaderIntegrityVerifier(),
 		blockTracker,
 		epochStartTrigger,
 		requestHandler,
+		interceptorsThrottler,
 	)
 	if err != nil {
 		return nil, err
@@ -570,6 +616,11 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	antifloodQuotaController, err := pcf.createAntifloodQuotaController(interceptorsThrottler)
+	if err != nil {
+		return nil, err
+	}
+
 	return &processComponents{
 		nodesCoordinator:             pcf.nodesCoordinator,
 		shardCoordinator:             pcf.bootstrapComponents.ShardCoordinator(),
@@ -585,6 +636,9 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		headerSigVerifier:            headerSigVerifier,
 		validatorsStatistics:         validatorStatisticsProcessor,
 		validatorsProvider:           validatorsProvider,
+		shufflingPreviewer:           shufflingPreviewer,
+		validatorHistory:             validatorHistory,
+		gasProfiler:                  pcf.gasProfiler,
 		blockTracker:                 blockTracker,
 		pendingMiniBlocksHandler:     pendingMiniBlocksHandler,
 		requestHandler:               requestHandler,
@@ -608,10 +662,87 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		vmFactoryForProcessing:       blockProcessorComponents.vmFactoryForProcessing,
 		scheduledTxsExecutionHandler: scheduledTxsExecutionHandler,
 		txsSender:                    txsSenderWithAccumulator,
+		antifloodQuotaController:     antifloodQuotaController,
 	}, nil
 }
 
-func (pcf *processComponentsFactory) newValidatorStatisticsProcessor() (process.ValidatorStatisticsProcessor, error) {
+func (pcf *processComponentsFactory) createAntifloodQuotaController(interceptorsThrottler process.LoadSource) (update.Closer, error) {
+	adaptiveQuotasConfig := pcf.config.Antiflood.AdaptiveQuotas
+	if !pcf.config.Antiflood.Enabled || !adaptiveQuotasConfig.Enabled {
+		return &disabledAntiflood.QuotaController{}, nil
+	}
+
+	txPoolLoadSource, err := adaptive.NewTxPoolLoadSource(
+		pcf.data.Datapool().Transactions(),
+		pcf.config.TxDataPool.Capacity,
+		pcf.config.TxDataPool.SizeInBytes,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	loadSources := []process.LoadSource{interceptorsThrottler, txPoolLoadSource}
+	if adaptiveQuotasConfig.UseCpuLoad {
+		cpuStatistics, errCpu := machine.NewCpuStatistics()
+		if errCpu != nil {
+			return nil, errCpu
+		}
+
+		cpuLoadSource, errCpu := adaptive.NewCpuLoadSource(cpuStatistics)
+		if errCpu != nil {
+			return nil, errCpu
+		}
+
+		loadSources = append(loadSources, cpuLoadSource)
+	}
+
+	return adaptive.NewQuotaController(adaptive.ArgQuotaController{
+		Config:           adaptiveQuotasConfig,
+		LoadSources:      loadSources,
+		AntifloodHandler: pcf.network.InputAntiFloodHandler(),
+		StatusHandler:    pcf.coreData.StatusHandler(),
+	})
+}
+
+func (pcf *processComponentsFactory) newValidatorHistory() (process.ValidatorHistoryHandler, error) {
+	historyConfig := pcf.config.ValidatorStatistics.History
+	if !historyConfig.Enabled {
+		return disabledHistory.NewValidatorHistory(), nil
+	}
+
+	validatorHistory, err := history.NewValidatorHistory(history.ArgsValidatorHistory{
+		Storer:              pcf.data.StorageService().GetStorer(dataRetriever.ValidatorHistoryUnit),
+		Marshalizer:         pcf.coreData.InternalMarshalizer(),
+		MaxRating:           pcf.maxRating,
+		MaxEpochsPerRequest: historyConfig.MaxEpochsPerRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pcf.coreData.EpochStartNotifierWithConfirm().RegisterHandler(validatorHistory)
+
+	return validatorHistory, nil
+}
+
+func (pcf *processComponentsFactory) newGasProfiler() (process.GasProfilerHandler, error) {
+	profilerConfig := pcf.config.Debug.GasProfiler
+	if !profilerConfig.Enabled {
+		return gasProfiler.NewDisabledGasProfiler(), nil
+	}
+
+	return gasProfiler.NewGasProfiler(gasProfiler.ArgsGasProfiler{
+		PubkeyConverter:     pcf.coreData.AddressPubKeyConverter(),
+		WindowInSeconds:     profilerConfig.WindowInSeconds,
+		NumBuckets:          profilerConfig.NumBuckets,
+		MaxEntriesPerBucket: profilerConfig.MaxEntriesPerBucket,
+		MetricsRegistry:     pcf.coreData.MetricsRegistry(),
+	})
+}
+
+func (pcf *processComponentsFactory) newValidatorStatisticsProcessor(
+	historyHandler process.ValidatorHistoryHandler,
+) (process.ValidatorStatisticsProcessor, error) {
 
 	storageService := pcf.data.StorageService()
 
@@ -641,6 +772,7 @@ func (pcf *processComponentsFactory) newValidatorStatisticsProcessor() (process.
 		RatingEnableEpoch:                    ratingEnabledEpoch,
 		GenesisNonce:                         pcf.data.Blockchain().GetGenesisHeader().GetNonce(),
 		EpochNotifier:                        pcf.coreData.EpochNotifier(),
+		HistoryHandler:                       historyHandler,
 		SwitchJailWaitingEnableEpoch:         pcf.epochConfig.EnableEpochs.SwitchJailWaitingEnableEpoch,
 		BelowSignedThresholdEnableEpoch:      pcf.epochConfig.EnableEpochs.BelowSignedThresholdEnableEpoch,
 		StakingV2EnableEpoch:                 pcf.epochConfig.EnableEpochs.StakingV2EnableEpoch,
@@ -1042,6 +1174,7 @@ func (pcf *processComponentsFactory) newShardResolverContainerFactory(
 		ResolverConfig:              pcf.config.Resolvers,
 		PreferredPeersHolder:        pcf.network.PreferredPeersHolderHandler(),
 		PeersRatingHandler:          pcf.network.PeersRatingHandler(),
+		MetricsRegistry:             pcf.coreData.MetricsRegistry(),
 	}
 	resolversContainerFactory, err := resolverscontainer.NewShardResolversContainerFactory(resolversContainerFactoryArgs)
 	if err != nil {
@@ -1078,6 +1211,7 @@ func (pcf *processComponentsFactory) newMetaResolverContainerFactory(
 		ResolverConfig:              pcf.config.Resolvers,
 		PreferredPeersHolder:        pcf.network.PreferredPeersHolderHandler(),
 		PeersRatingHandler:          pcf.network.PeersRatingHandler(),
+		MetricsRegistry:             pcf.coreData.MetricsRegistry(),
 	}
 	resolversContainerFactory, err := resolverscontainer.NewMetaResolversContainerFactory(resolversContainerFactoryArgs)
 	if err != nil {
@@ -1092,6 +1226,7 @@ func (pcf *processComponentsFactory) newInterceptorContainerFactory(
 	validityAttester process.ValidityAttester,
 	epochStartTrigger process.EpochStartTriggerHandler,
 	requestHandler process.RequestHandler,
+	globalThrottler process.InterceptorThrottler,
 ) (process.InterceptorsContainerFactory, process.TimeCacher, error) {
 	if pcf.bootstrapComponents.ShardCoordinator().SelfId() < pcf.bootstrapComponents.ShardCoordinator().NumberOfShards() {
 		return pcf.newShardInterceptorContainerFactory(
@@ -1100,6 +1235,7 @@ func (pcf *processComponentsFactory) newInterceptorContainerFactory(
 			validityAttester,
 			epochStartTrigger,
 			requestHandler,
+			globalThrottler,
 		)
 	}
 	if pcf.bootstrapComponents.ShardCoordinator().SelfId() == core.MetachainShardId {
@@ -1109,6 +1245,7 @@ func (pcf *processComponentsFactory) newInterceptorContainerFactory(
 			validityAttester,
 			epochStartTrigger,
 			requestHandler,
+			globalThrottler,
 		)
 	}
 
@@ -1241,6 +1378,7 @@ func (pcf *processComponentsFactory) newShardInterceptorContainerFactory(
 	validityAttester process.ValidityAttester,
 	epochStartTrigger process.EpochStartTriggerHandler,
 	requestHandler process.RequestHandler,
+	globalThrottler process.InterceptorThrottler,
 ) (process.InterceptorsContainerFactory, process.TimeCacher, error) {
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
 	shardInterceptorsContainerFactoryArgs := interceptorscontainer.CommonInterceptorsContainerFactoryArgs{
@@ -1267,6 +1405,7 @@ func (pcf *processComponentsFactory) newShardInterceptorContainerFactory(
 		EnableSignTxWithHashEpoch: pcf.epochConfig.EnableEpochs.TransactionSignedWithTxHashEnableEpoch,
 		PreferredPeersHolder:      pcf.network.PreferredPeersHolderHandler(),
 		RequestHandler:            requestHandler,
+		GlobalThrottler:           globalThrottler,
 	}
 	log.Debug("shardInterceptor: enable epoch for transaction signed with tx hash", "epoch", shardInterceptorsContainerFactoryArgs.EnableSignTxWithHashEpoch)
 
@@ -1284,6 +1423,7 @@ func (pcf *processComponentsFactory) newMetaInterceptorContainerFactory(
 	validityAttester process.ValidityAttester,
 	epochStartTrigger process.EpochStartTriggerHandler,
 	requestHandler process.RequestHandler,
+	globalThrottler process.InterceptorThrottler,
 ) (process.InterceptorsContainerFactory, process.TimeCacher, error) {
 	headerBlackList := timecache.NewTimeCache(timeSpanForBadHeaders)
 	metaInterceptorsContainerFactoryArgs := interceptorscontainer.CommonInterceptorsContainerFactoryArgs{
@@ -1310,6 +1450,7 @@ func (pcf *processComponentsFactory) newMetaInterceptorContainerFactory(
 		EnableSignTxWithHashEpoch: pcf.epochConfig.EnableEpochs.TransactionSignedWithTxHashEnableEpoch,
 		PreferredPeersHolder:      pcf.network.PreferredPeersHolderHandler(),
 		RequestHandler:            requestHandler,
+		GlobalThrottler:           globalThrottler,
 	}
 	log.Debug("metaInterceptor: enable epoch for transaction signed with tx hash", "epoch", metaInterceptorsContainerFactoryArgs.EnableSignTxWithHashEpoch)
 
@@ -1519,6 +1660,9 @@ func (pc *processComponents) Close() error {
 	if !check.IfNil(pc.txsSender) {
 		log.LogIfError(pc.txsSender.Close())
 	}
+	if !check.IfNil(pc.antifloodQuotaController) {
+		log.LogIfError(pc.antifloodQuotaController.Close())
+	}
 
 	return nil
 }
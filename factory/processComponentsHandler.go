@@ -122,6 +122,15 @@ func (m *managedProcessComponents) CheckSubcomponents() error {
 	if check.IfNil(m.processComponents.validatorsProvider) {
 		return errors.ErrNilValidatorsProvider
 	}
+	if check.IfNil(m.processComponents.shufflingPreviewer) {
+		return errors.ErrNilShufflingPreviewer
+	}
+	if check.IfNil(m.processComponents.validatorHistory) {
+		return errors.ErrNilValidatorHistory
+	}
+	if check.IfNil(m.processComponents.gasProfiler) {
+		return errors.ErrNilGasProfiler
+	}
 	if check.IfNil(m.processComponents.blockTracker) {
 		return errors.ErrNilBlockTracker
 	}
@@ -338,6 +347,42 @@ func (m *managedProcessComponents) ValidatorsProvider() process.ValidatorsProvid
 	return m.processComponents.validatorsProvider
 }
 
+// ShufflingPreviewer returns the next epoch shuffling previewer
+func (m *managedProcessComponents) ShufflingPreviewer() process.ShufflingPreviewer {
+	m.mutProcessComponents.RLock()
+	defer m.mutProcessComponents.RUnlock()
+
+	if m.processComponents == nil {
+		return nil
+	}
+
+	return m.processComponents.shufflingPreviewer
+}
+
+// ValidatorHistory returns the validator rating and signing history handler
+func (m *managedProcessComponents) ValidatorHistory() process.ValidatorHistoryHandler {
+	m.mutProcessComponents.RLock()
+	defer m.mutProcessComponents.RUnlock()
+
+	if m.processComponents == nil {
+		return nil
+	}
+
+	return m.processComponents.validatorHistory
+}
+
+// GasProfiler returns the gas profiler
+func (m *managedProcessComponents) GasProfiler() process.GasProfilerHandler {
+	m.mutProcessComponents.RLock()
+	defer m.mutProcessComponents.RUnlock()
+
+	if m.processComponents == nil {
+		return nil
+	}
+
+	return m.processComponents.gasProfiler
+}
+
 // BlockTracker returns the block tracker
 func (m *managedProcessComponents) BlockTracker() process.BlockTracker {
 	m.mutProcessComponents.RLock()
@@ -105,7 +105,8 @@ func startNodesWithCommitBlock(nodes []*testNode, mutex *sync.Mutex, nonceForRou
 		consensusArgs := factory.ConsensusComponentsFactoryArgs{
 			Config: config.Config{
 				Consensus: config.ConsensusConfig{
-					Type: blsConsensusType,
+					Type:               blsConsensusType,
+					RoundsTimelineSize: 10,
 				},
 				ValidatorPubkeyConverter: config.PubkeyConfig{
 					Length:          96,
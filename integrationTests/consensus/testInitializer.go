@@ -154,6 +154,8 @@ func createTestStore() dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.BootstrapUnit, createMemUnit())
 	store.AddStorer(dataRetriever.ReceiptsUnit, createMemUnit())
 	store.AddStorer(dataRetriever.ScheduledSCRsUnit, createMemUnit())
+	store.AddStorer(dataRetriever.SlashingEvidenceUnit, createMemUnit())
+	store.AddStorer(dataRetriever.ValidatorHistoryUnit, createMemUnit())
 	return store
 }
 
@@ -177,6 +179,7 @@ func createAccountsDB(marshaller marshal.Marshalizer) state.AccountsAdapter {
 		GeneralConfig:          generalCfg,
 		CheckpointHashesHolder: hashesHolder.NewCheckpointHashesHolder(10000000, uint64(hasher.Size())),
 		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:        &testscommon.MetricsRegistryStub{},
 	}
 	trieStorage, _ := trie.NewTrieStorageManager(args)
 
@@ -199,6 +202,7 @@ func createAccountsDB(marshaller marshal.Marshalizer) state.AccountsAdapter {
 		StoragePruningManager: storagePruning,
 		ProcessingMode:        common.Normal,
 		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:       &testscommon.MetricsRegistryStub{},
 	}
 
 	adb, _ := state.NewAccountsDB(argsAccountsDB)
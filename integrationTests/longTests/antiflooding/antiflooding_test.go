@@ -8,6 +8,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/display"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/integrationTests"
@@ -15,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/factory"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
@@ -123,13 +125,27 @@ func createProcessors(peers []p2p.Messenger, topic string, idxBadPeers []int, id
 		var err error
 
 		if intInSlice(i, idxBadPeers) {
-			antifloodComponents, err = factory.NewP2PAntiFloodComponents(ctx, createDisabledConfig(), &statusHandlerMock.AppStatusHandlerStub{}, peers[i].ID())
+			antifloodComponents, err = factory.NewP2PAntiFloodComponents(
+				ctx,
+				createDisabledConfig(),
+				&statusHandlerMock.AppStatusHandlerStub{},
+				peers[i].ID(),
+				storageUnit.NewNilStorer(),
+				&marshal.GogoProtoMarshalizer{},
+			)
 			log.LogIfError(err)
 		}
 
 		if intInSlice(i, idxGoodPeers) {
 			statusHandler := &statusHandlerMock.AppStatusHandlerStub{}
-			antifloodComponents, err = factory.NewP2PAntiFloodComponents(ctx, createWorkableConfig(), statusHandler, peers[i].ID())
+			antifloodComponents, err = factory.NewP2PAntiFloodComponents(
+				ctx,
+				createWorkableConfig(),
+				statusHandler,
+				peers[i].ID(),
+				storageUnit.NewNilStorer(),
+				&marshal.GogoProtoMarshalizer{},
+			)
 			log.LogIfError(err)
 		}
 
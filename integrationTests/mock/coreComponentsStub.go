@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	"github.com/ElrondNetwork/elrond-go/ntp"
@@ -53,6 +54,8 @@ type CoreComponentsStub struct {
 	NodeTypeProviderField              core.NodeTypeProviderHandler
 	ArwenChangeLockerInternal          common.Locker
 	ProcessStatusHandlerInternal       common.ProcessStatusHandler
+	MetricsRegistryInternal            common.MetricsRegistry
+	TracerInternal                     tracing.Tracer
 }
 
 // Create -
@@ -243,6 +246,16 @@ func (ccs *CoreComponentsStub) ArwenChangeLocker() common.Locker {
 	return ccs.ArwenChangeLockerInternal
 }
 
+// MetricsRegistry -
+func (ccs *CoreComponentsStub) MetricsRegistry() common.MetricsRegistry {
+	return ccs.MetricsRegistryInternal
+}
+
+// Tracer -
+func (ccs *CoreComponentsStub) Tracer() tracing.Tracer {
+	return ccs.TracerInternal
+}
+
 // ProcessStatusHandler -
 func (ccs *CoreComponentsStub) ProcessStatusHandler() common.ProcessStatusHandler {
 	return ccs.ProcessStatusHandlerInternal
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -40,10 +41,34 @@ func (nah *NilAntifloodHandler) SetDebugger(_ process.AntifloodDebugger) error {
 func (nah *NilAntifloodHandler) ApplyConsensusSize(_ int) {
 }
 
+// SetQuotaFactor does nothing
+func (nah *NilAntifloodHandler) SetQuotaFactor(_ float64) {
+}
+
 // BlacklistPeer does nothing
 func (nah *NilAntifloodHandler) BlacklistPeer(_ core.PeerID, _ string, _ time.Duration) {
 }
 
+// BlacklistPeerManually returns nil
+func (nah *NilAntifloodHandler) BlacklistPeerManually(_ core.PeerID, _ string, _ time.Duration) error {
+	return nil
+}
+
+// PardonPeer returns nil
+func (nah *NilAntifloodHandler) PardonPeer(_ core.PeerID) error {
+	return nil
+}
+
+// GetBlacklistedPeers returns an empty slice
+func (nah *NilAntifloodHandler) GetBlacklistedPeers() []common.BlacklistedPeerInfo {
+	return make([]common.BlacklistedPeerInfo, 0)
+}
+
+// GetQuotaInfo returns an empty quota info
+func (nah *NilAntifloodHandler) GetQuotaInfo() common.AntifloodQuotaInfo {
+	return common.AntifloodQuotaInfo{}
+}
+
 // SetPeerValidatorMapper -
 func (nah *NilAntifloodHandler) SetPeerValidatorMapper(_ process.PeerValidatorMapper) error {
 	return nil
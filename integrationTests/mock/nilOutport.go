@@ -3,6 +3,7 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/outport"
 )
 
@@ -41,6 +42,10 @@ func (n *nilOutport) SaveAccounts(_ uint64, _ []data.UserAccountHandler) {
 func (n *nilOutport) FinalizedBlock(_ []byte) {
 }
 
+// SaveSlashingEvidence -
+func (n *nilOutport) SaveSlashingEvidence(_ *common.SlashingEvidence) {
+}
+
 // Close -
 func (n *nilOutport) Close() error {
 	return nil
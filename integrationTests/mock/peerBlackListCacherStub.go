@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 // PeerBlackListCacherStub -
@@ -12,6 +13,10 @@ type PeerBlackListCacherStub struct {
 	UpsertCalled func(pid core.PeerID, span time.Duration) error
 	HasCalled    func(pid core.PeerID) bool
 	SweepCalled  func()
+
+	UpsertWithReasonCalled    func(pid core.PeerID, span time.Duration, reason string, isManual bool) error
+	RemoveCalled              func(pid core.PeerID) error
+	GetBlacklistedPeersCalled func() []common.BlacklistedPeerInfo
 }
 
 // Add -
@@ -50,6 +55,38 @@ func (pblhs *PeerBlackListCacherStub) Sweep() {
 	pblhs.SweepCalled()
 }
 
+// UpsertWithReason -
+func (pblhs *PeerBlackListCacherStub) UpsertWithReason(pid core.PeerID, span time.Duration, reason string, isManual bool) error {
+	if pblhs.UpsertWithReasonCalled == nil {
+		return nil
+	}
+
+	return pblhs.UpsertWithReasonCalled(pid, span, reason, isManual)
+}
+
+// Remove -
+func (pblhs *PeerBlackListCacherStub) Remove(pid core.PeerID) error {
+	if pblhs.RemoveCalled == nil {
+		return nil
+	}
+
+	return pblhs.RemoveCalled(pid)
+}
+
+// GetBlacklistedPeers -
+func (pblhs *PeerBlackListCacherStub) GetBlacklistedPeers() []common.BlacklistedPeerInfo {
+	if pblhs.GetBlacklistedPeersCalled == nil {
+		return make([]common.BlacklistedPeerInfo, 0)
+	}
+
+	return pblhs.GetBlacklistedPeersCalled()
+}
+
+// Close -
+func (pblhs *PeerBlackListCacherStub) Close() error {
+	return nil
+}
+
 // IsInterfaceNil -
 func (pblhs *PeerBlackListCacherStub) IsInterfaceNil() bool {
 	return pblhs == nil
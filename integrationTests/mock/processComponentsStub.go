@@ -29,6 +29,9 @@ type ProcessComponentsStub struct {
 	HeaderIntegrVerif                    process.HeaderIntegrityVerifier
 	ValidatorStatistics                  process.ValidatorStatisticsProcessor
 	ValidatorProvider                    process.ValidatorsProvider
+	ShufflingPreviewerField              process.ShufflingPreviewer
+	ValidatorHistoryField                process.ValidatorHistoryHandler
+	GasProfilerField                     process.GasProfilerHandler
 	BlockTrack                           process.BlockTracker
 	PendingMiniBlocksHdl                 process.PendingMiniBlocksHandler
 	ReqHandler                           process.RequestHandler
@@ -138,6 +141,21 @@ func (pcs *ProcessComponentsStub) ValidatorsProvider() process.ValidatorsProvide
 	return pcs.ValidatorProvider
 }
 
+// ShufflingPreviewer -
+func (pcs *ProcessComponentsStub) ShufflingPreviewer() process.ShufflingPreviewer {
+	return pcs.ShufflingPreviewerField
+}
+
+// ValidatorHistory -
+func (pcs *ProcessComponentsStub) ValidatorHistory() process.ValidatorHistoryHandler {
+	return pcs.ValidatorHistoryField
+}
+
+// GasProfiler -
+func (pcs *ProcessComponentsStub) GasProfiler() process.GasProfilerHandler {
+	return pcs.GasProfilerField
+}
+
 // BlockTracker -
 func (pcs *ProcessComponentsStub) BlockTracker() process.BlockTracker {
 	return pcs.BlockTrack
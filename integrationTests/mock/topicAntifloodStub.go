@@ -1,6 +1,9 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go-core/core"
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
 
 // TopicAntiFloodStub -
 type TopicAntiFloodStub struct {
@@ -28,6 +31,15 @@ func (t *TopicAntiFloodStub) ResetForNotRegisteredTopics() {
 func (t *TopicAntiFloodStub) SetMaxMessagesForTopic(_ string, _ uint32) {
 }
 
+// SetQuotaFactor -
+func (t *TopicAntiFloodStub) SetQuotaFactor(_ float64) {
+}
+
+// GetTopicsQuotaInfo -
+func (t *TopicAntiFloodStub) GetTopicsQuotaInfo() []common.TopicQuotaInfo {
+	return make([]common.TopicQuotaInfo, 0)
+}
+
 // IsInterfaceNil -
 func (t *TopicAntiFloodStub) IsInterfaceNil() bool {
 	return t == nil
@@ -69,6 +69,7 @@ func testPeerDisconnectionWithOneAdvertiser(t *testing.T, p2pConfig config.P2PCo
 		Marshalizer:          &testscommon.MarshalizerMock{},
 		SyncTimer:            &testscommon.SyncTimerStub{},
 		PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 	// Step 1. Create advertiser
 	advertiser, err := libp2p.NewMockMessenger(argSeeder, netw)
@@ -86,6 +87,7 @@ func testPeerDisconnectionWithOneAdvertiser(t *testing.T, p2pConfig config.P2PCo
 			Marshalizer:          &testscommon.MarshalizerMock{},
 			SyncTimer:            &testscommon.SyncTimerStub{},
 			PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+			MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 		}
 		node, errCreate := libp2p.NewMockMessenger(arg, netw)
 		require.Nil(t, errCreate)
@@ -57,6 +57,7 @@ func TestSeedersDisconnectionWith2AdvertiserAnd3Peers(t *testing.T) {
 			Marshalizer:          &testscommon.MarshalizerMock{},
 			SyncTimer:            &testscommon.SyncTimerStub{},
 			PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+			MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 		}
 		node, err := libp2p.NewMockMessenger(arg, netw)
 		require.Nil(t, err)
@@ -130,6 +131,7 @@ func createBootstrappedSeeders(baseP2PConfig config.P2PConfig, numSeeders int, n
 		Marshalizer:          &testscommon.MarshalizerMock{},
 		SyncTimer:            &testscommon.SyncTimerStub{},
 		PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 	seeders[0], _ = libp2p.NewMockMessenger(argSeeder, netw)
 	_ = seeders[0].Bootstrap()
@@ -146,6 +148,7 @@ func createBootstrappedSeeders(baseP2PConfig config.P2PConfig, numSeeders int, n
 			Marshalizer:          &testscommon.MarshalizerMock{},
 			SyncTimer:            &testscommon.SyncTimerStub{},
 			PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+			MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 		}
 		seeders[i], _ = libp2p.NewMockMessenger(argSeeder, netw)
 		_ = netw.LinkAll()
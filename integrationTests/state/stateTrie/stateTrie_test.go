@@ -55,6 +55,7 @@ func getNewTrieStorageManagerArgs() trie.NewTrieStorageManagerArgs {
 		GeneralConfig:          config.TrieStorageManagerConfig{SnapshotsGoroutineNum: 1},
 		CheckpointHashesHolder: &trieMock.CheckpointHashesHolderStub{},
 		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:        &testscommon.MetricsRegistryStub{},
 	}
 }
 
@@ -1065,6 +1066,7 @@ func createAccounts(
 		StoragePruningManager: spm,
 		ProcessingMode:        common.Normal,
 		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:       &testscommon.MetricsRegistryStub{},
 	}
 	adb, _ := state.NewAccountsDB(argsAccountsDB)
 
@@ -2408,6 +2410,7 @@ func createAccountsDBTestSetup() *state.AccountsDB {
 		StoragePruningManager: spm,
 		ProcessingMode:        common.Normal,
 		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:       &testscommon.MetricsRegistryStub{},
 	}
 	adb, _ := state.NewAccountsDB(argsAccountsDB)
 
@@ -98,6 +98,7 @@ func TestTrieStorageManager_Close(t *testing.T) {
 		GeneralConfig:          config.TrieStorageManagerConfig{SnapshotsGoroutineNum: 1},
 		CheckpointHashesHolder: hashesHolder.NewCheckpointHashesHolder(10, 32),
 		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:        &testscommon.MetricsRegistryStub{},
 	}
 
 	gc := goroutines.NewGoCounter(goroutines.TestsRelevantGoRoutines)
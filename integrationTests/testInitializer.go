@@ -160,6 +160,7 @@ func CreateMessengerWithKadDht(initialAddr string) p2p.Messenger {
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
 		NodeOperationMode:    p2p.NormalOperation,
 		PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 
 	libP2PMes, err := libp2p.NewNetworkMessenger(arg)
@@ -184,6 +185,7 @@ func CreateMessengerWithKadDhtAndProtocolID(initialAddr string, protocolID strin
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
 		NodeOperationMode:    p2p.NormalOperation,
 		PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 
 	libP2PMes, err := libp2p.NewNetworkMessenger(arg)
@@ -202,6 +204,7 @@ func CreateMessengerFromConfig(p2pConfig config.P2PConfig) p2p.Messenger {
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
 		NodeOperationMode:    p2p.NormalOperation,
 		PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 
 	if p2pConfig.Sharding.AdditionalConnections.MaxFullHistoryObservers > 0 {
@@ -225,6 +228,7 @@ func CreateMessengerFromConfigWithPeersRatingHandler(p2pConfig config.P2PConfig,
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
 		NodeOperationMode:    p2p.NormalOperation,
 		PeersRatingHandler:   peersRatingHandler,
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 
 	if p2pConfig.Sharding.AdditionalConnections.MaxFullHistoryObservers > 0 {
@@ -396,6 +400,8 @@ func CreateStore(numOfShards uint32) dataRetriever.StorageService {
 	store.AddStorer(dataRetriever.StatusMetricsUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.ReceiptsUnit, CreateMemUnit())
 	store.AddStorer(dataRetriever.ScheduledSCRsUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.SlashingEvidenceUnit, CreateMemUnit())
+	store.AddStorer(dataRetriever.ValidatorHistoryUnit, CreateMemUnit())
 
 	for i := uint32(0); i < numOfShards; i++ {
 		hdrNonceHashDataUnit := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(i)
@@ -429,6 +435,7 @@ func CreateTrieStorageManagerWithPruningStorer(coordinator sharding.Coordinator,
 		GeneralConfig:          generalCfg,
 		CheckpointHashesHolder: hashesHolder.NewCheckpointHashesHolder(10000000, uint64(TestHasher.Size())),
 		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:        &testscommon.MetricsRegistryStub{},
 	}
 	trieStorageManager, _ := trie.NewTrieStorageManager(args)
 
@@ -450,6 +457,7 @@ func CreateTrieStorageManager(store storage.Storer) (common.StorageManager, stor
 		GeneralConfig:          generalCfg,
 		CheckpointHashesHolder: hashesHolder.NewCheckpointHashesHolder(10000000, uint64(TestHasher.Size())),
 		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:        &testscommon.MetricsRegistryStub{},
 	}
 	trieStorageManager, _ := trie.NewTrieStorageManager(args)
 
@@ -529,6 +537,7 @@ func CreateAccountsDB(
 		StoragePruningManager: spm,
 		ProcessingMode:        common.Normal,
 		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:       &testscommon.MetricsRegistryStub{},
 	}
 	adb, _ := state.NewAccountsDB(args)
 
@@ -1101,6 +1110,7 @@ func CreateNewDefaultTrie() common.Trie {
 		GeneralConfig:          generalCfg,
 		CheckpointHashesHolder: hashesHolder.NewCheckpointHashesHolder(10000000, uint64(TestHasher.Size())),
 		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:        &testscommon.MetricsRegistryStub{},
 	}
 	trieStorage, _ := trie.NewTrieStorageManager(args)
 
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/core/partitioning"
 	"github.com/ElrondNetwork/elrond-go-core/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go-core/core/throttler"
 	"github.com/ElrondNetwork/elrond-go-core/core/versioning"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	dataBlock "github.com/ElrondNetwork/elrond-go-core/data/block"
@@ -33,6 +34,7 @@ import (
 	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/common/forking"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
@@ -67,9 +69,11 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/factory/interceptorscontainer"
 	metaProcess "github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/gasProfiler"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	processMock "github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/peer"
+	disabledHistory "github.com/ElrondNetwork/elrond-go/process/peer/history/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/rating"
 	"github.com/ElrondNetwork/elrond-go/process/rewardTransaction"
 	"github.com/ElrondNetwork/elrond-go/process/scToProtocol"
@@ -200,6 +204,9 @@ var DelegationManagerConfigChangeAddress = "erd1vxy22x0fj4zv6hktmydg8vpfh6euv02c
 // sizeCheckDelta the maximum allowed bufer overhead (p2p unmarshalling)
 const sizeCheckDelta = 100
 
+// numInterceptorsGoRoutines the maximum number of go routines used by the interceptors
+const numInterceptorsGoRoutines = 100
+
 const stateCheckpointModulus = 100
 
 // StakingV2Epoch defines the epoch for integration tests when stakingV2 is enabled
@@ -561,6 +568,7 @@ func NewTestProcessorNodeWithFullGenesis(
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -720,6 +728,7 @@ func (tpn *TestProcessorNode) initValidatorStatistics() {
 		NodesSetup:                           tpn.NodesSetup,
 		GenesisNonce:                         tpn.BlockChain.GetGenesisHeader().GetNonce(),
 		EpochNotifier:                        &epochNotifier.EpochNotifierStub{},
+		HistoryHandler:                       disabledHistory.NewValidatorHistory(),
 		StakingV2EnableEpoch:                 StakingV2Epoch,
 	}
 
@@ -768,6 +777,7 @@ func (tpn *TestProcessorNode) initTestNode() {
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -977,6 +987,7 @@ func (tpn *TestProcessorNode) createFullSCQueryService() {
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 }
@@ -994,6 +1005,7 @@ func (tpn *TestProcessorNode) InitializeProcessors(gasMap map[string]map[string]
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -1235,6 +1247,7 @@ func (tpn *TestProcessorNode) initInterceptors() {
 		tpn.EpochStartTrigger = &metachain.TestTrigger{}
 		tpn.EpochStartTrigger.SetTrigger(epochStartTrigger)
 
+		globalThrottler, _ := throttler.NewNumGoRoutinesThrottler(numInterceptorsGoRoutines)
 		metaInterceptorContainerFactoryArgs := interceptorscontainer.CommonInterceptorsContainerFactoryArgs{
 			CoreComponents:          coreComponents,
 			CryptoComponents:        cryptoComponents,
@@ -1258,6 +1271,7 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			ArgumentsParser:         smartContract.NewArgumentParser(),
 			PreferredPeersHolder:    &p2pmocks.PeersHolderStub{},
 			RequestHandler:          tpn.RequestHandler,
+			GlobalThrottler:         globalThrottler,
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewMetaInterceptorsContainerFactory(metaInterceptorContainerFactoryArgs)
 
@@ -1291,6 +1305,7 @@ func (tpn *TestProcessorNode) initInterceptors() {
 		tpn.EpochStartTrigger = &shardchain.TestTrigger{}
 		tpn.EpochStartTrigger.SetTrigger(epochStartTrigger)
 
+		globalThrottler, _ := throttler.NewNumGoRoutinesThrottler(numInterceptorsGoRoutines)
 		shardIntereptorContainerFactoryArgs := interceptorscontainer.CommonInterceptorsContainerFactoryArgs{
 			CoreComponents:          coreComponents,
 			CryptoComponents:        cryptoComponents,
@@ -1314,6 +1329,7 @@ func (tpn *TestProcessorNode) initInterceptors() {
 			ArgumentsParser:         smartContract.NewArgumentParser(),
 			PreferredPeersHolder:    &p2pmocks.PeersHolderStub{},
 			RequestHandler:          tpn.RequestHandler,
+			GlobalThrottler:         globalThrottler,
 		}
 		interceptorContainerFactory, _ := interceptorscontainer.NewShardInterceptorsContainerFactory(shardIntereptorContainerFactoryArgs)
 
@@ -1344,6 +1360,7 @@ func (tpn *TestProcessorNode) initResolvers() {
 		NumConcurrentResolvingJobs:  10,
 		CurrentNetworkEpochProvider: &mock.CurrentNetworkEpochProviderStub{},
 		PreferredPeersHolder:        &p2pmocks.PeersHolderStub{},
+		MetricsRegistry:             &testscommon.MetricsRegistryStub{},
 		ResolverConfig: config.ResolverConfig{
 			NumCrossShardPeers:  2,
 			NumIntraShardPeers:  1,
@@ -1534,6 +1551,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		BadTxForwarder:    badBlocksHandler,
 		EpochNotifier:     tpn.EpochNotifier,
 		VMOutputCacher:    txcache.NewDisabledCache(),
+		GasProfiler:       gasProfiler.NewDisabledGasProfiler(),
 		ArwenChangeLocker: tpn.ArwenChangeLocker,
 		EnableEpochs:      tpn.EnableEpochs,
 	}
@@ -1592,6 +1610,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		tpn.EnableEpochs.OptimizeGasUsedInCrossMiniBlocksEnableEpoch,
 		tpn.EnableEpochs.FrontRunningProtectionEnableEpoch,
 		tpn.ScheduledMiniBlocksEnableEpoch,
+		tpn.EnableEpochs.ParallelTransactionsExecutionEnableEpoch,
 		txTypeHandler,
 		scheduledTxsExecutionHandler,
 	)
@@ -1618,6 +1637,7 @@ func (tpn *TestProcessorNode) initInnerProcessors(gasMap map[string]map[string]u
 		ScheduledTxsExecutionHandler:      scheduledTxsExecutionHandler,
 		ScheduledMiniBlocksEnableEpoch:    tpn.ScheduledMiniBlocksEnableEpoch,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tpn.TxCoordinator, _ = coordinator.NewTransactionCoordinator(argsTransactionCoordinator)
 	scheduledTxsExecutionHandler.SetTransactionCoordinator(tpn.TxCoordinator)
@@ -1785,6 +1805,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		BadTxForwarder:    badBlocksHandler,
 		EpochNotifier:     tpn.EpochNotifier,
 		VMOutputCacher:    txcache.NewDisabledCache(),
+		GasProfiler:       gasProfiler.NewDisabledGasProfiler(),
 		ArwenChangeLocker: tpn.ArwenChangeLocker,
 		EnableEpochs:      tpn.EnableEpochs,
 	}
@@ -1832,6 +1853,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		tpn.EnableEpochs.OptimizeGasUsedInCrossMiniBlocksEnableEpoch,
 		tpn.EnableEpochs.FrontRunningProtectionEnableEpoch,
 		tpn.ScheduledMiniBlocksEnableEpoch,
+		tpn.EnableEpochs.ParallelTransactionsExecutionEnableEpoch,
 		txTypeHandler,
 		scheduledTxsExecutionHandler,
 	)
@@ -1858,6 +1880,7 @@ func (tpn *TestProcessorNode) initMetaInnerProcessors() {
 		ScheduledTxsExecutionHandler:      scheduledTxsExecutionHandler,
 		ScheduledMiniBlocksEnableEpoch:    tpn.ScheduledMiniBlocksEnableEpoch,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tpn.TxCoordinator, _ = coordinator.NewTransactionCoordinator(argsTransactionCoordinator)
 	scheduledTxsExecutionHandler.SetTransactionCoordinator(tpn.TxCoordinator)
@@ -2952,6 +2975,8 @@ func GetDefaultCoreComponents() *mock.CoreComponentsStub {
 		RoundNotifierField:           &processMock.RoundNotifierStub{},
 		TxVersionCheckField:          versioning.NewTxVersionChecker(MinTransactionVersion),
 		ProcessStatusHandlerInternal: &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistryInternal:      &testscommon.MetricsRegistryStub{},
+		TracerInternal:               tracing.NewDisabledTracer(),
 	}
 }
 
@@ -139,6 +139,7 @@ func NewTestProcessorNodeWithStateCheckpointModulus(
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.initBlockProcessor(stateCheckpointModulus)
@@ -10,10 +10,12 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/epochStart/metachain"
 	nodeFacade "github.com/ElrondNetwork/elrond-go/facade"
 	"github.com/ElrondNetwork/elrond-go/integrationTests/mock"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/external/blockAPI"
+	"github.com/ElrondNetwork/elrond-go/node/external/economicsDryRun"
 	"github.com/ElrondNetwork/elrond-go/node/external/transactionAPI"
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
 	"github.com/ElrondNetwork/elrond-go/node/trieIterators/factory"
@@ -86,6 +88,7 @@ func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
 			SameSourceRequests:                 1000,
 			SameSourceResetIntervalInSec:       1,
 			TrieOperationsDeadlineMilliseconds: 1,
+			MaxAddressesPerMultiProof:          10,
 			EndpointsThrottlers:                []config.EndpointsThrottlersConfig{},
 		},
 		FacadeConfig:    config.FacadeConfig{},
@@ -190,6 +193,29 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	delegatedListHandler, err := factory.CreateDelegatedListHandler(args)
 	log.LogIfError(err)
 
+	argsGovernance := trieIterators.ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: args,
+		Marshalizer:              TestMarshalizer,
+		BlockChain:               tpn.BlockChain,
+	}
+	governanceHandler, err := factory.CreateGovernanceHandler(argsGovernance)
+	log.LogIfError(err)
+
+	argsDelegationViews := trieIterators.ArgDelegationViewsProcessor{
+		ArgTrieIteratorProcessor: args,
+		BlockChain:               tpn.BlockChain,
+	}
+	delegationViewsHandler, err := factory.CreateDelegationViewsHandler(argsDelegationViews)
+	log.LogIfError(err)
+
+	argsStakingQueue := trieIterators.ArgStakingQueueProcessor{
+		ArgTrieIteratorProcessor: args,
+		BlockChain:               tpn.BlockChain,
+		StakingV2EnableEpoch:     tpn.EnableEpochs.StakingV2EnableEpoch,
+	}
+	stakingQueueHandler, err := factory.CreateStakingQueueHandler(argsStakingQueue)
+	log.LogIfError(err)
+
 	argsApiTransactionProc := &transactionAPI.ArgAPITransactionProcessor{
 		Marshalizer:              TestMarshalizer,
 		AddressPubKeyConverter:   TestAddressPubkeyConverter,
@@ -229,6 +255,11 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		TotalStakedValueHandler:  totalStakedValueHandler,
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
+		GovernanceHandler:        governanceHandler,
+		DelegationViewsHandler:   delegationViewsHandler,
+		StakingQueueHandler:      stakingQueueHandler,
+		EconomicsDryRunHandler:   economicsDryRun.NewDisabledEconomicsDryRunProcessor(),
+		RewardsPreviewHandler:    metachain.NewDisabledRewardsPreview(),
 		APITransactionHandler:    apiTransactionHandler,
 		APIBlockHandler:          blockAPIHandler,
 		APIInternalBlockHandler:  apiInternalBlockProcessor,
@@ -158,6 +158,7 @@ func (tpn *TestProcessorNode) initTestNodeWithSync() {
 		ArwenChangeLocker:        tpn.ArwenChangeLocker,
 		Bootstrapper:             tpn.Bootstrapper,
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 	}
 	tpn.SCQueryService, _ = smartContract.NewSCQueryService(argsNewScQueryService)
 	tpn.addHandlersForCounters()
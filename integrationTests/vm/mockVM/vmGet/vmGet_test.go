@@ -44,6 +44,7 @@ func TestVmGetShouldReturnValue(t *testing.T) {
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             disabled.NewDisabledBootstrapper(),
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 	}
 	service, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -38,6 +38,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/economics"
 	"github.com/ElrondNetwork/elrond-go/process/factory/metachain"
 	"github.com/ElrondNetwork/elrond-go/process/factory/shard"
+	"github.com/ElrondNetwork/elrond-go/process/gasProfiler"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/builtInFunctions"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract/hooks"
@@ -236,6 +237,7 @@ func (vmTestContext *VMTestContext) GetVMOutputWithTransientVM(funcName string,
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             syncDisabled.NewDisabledBootstrapper(),
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -301,6 +303,7 @@ func CreateInMemoryShardAccountsDB() *state.AccountsDB {
 		GeneralConfig:          generalCfg,
 		CheckpointHashesHolder: hashesHolder.NewCheckpointHashesHolder(10000000, uint64(testHasher.Size())),
 		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:        &testscommon.MetricsRegistryStub{},
 	}
 	trieStorage, _ := trie.NewTrieStorageManager(args)
 
@@ -315,6 +318,7 @@ func CreateInMemoryShardAccountsDB() *state.AccountsDB {
 		StoragePruningManager: spm,
 		ProcessingMode:        common.Normal,
 		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:       &testscommon.MetricsRegistryStub{},
 	}
 	adb, _ := state.NewAccountsDB(argsAccountsDB)
 
@@ -480,6 +484,7 @@ func CreateTxProcessorWithOneSCExecutorMockVM(
 		EpochNotifier:     forking.NewGenericEpochNotifier(),
 		EnableEpochs:      enableEpochs,
 		VMOutputCacher:    txcache.NewDisabledCache(),
+		GasProfiler:       gasProfiler.NewDisabledGasProfiler(),
 		ArwenChangeLocker: arwenChangeLocker,
 	}
 	scProcessor, _ := smartContract.NewSmartContractProcessor(argsNewSCProcessor)
@@ -831,6 +836,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		EpochNotifier:     epochNotifierInstance,
 		ArwenChangeLocker: arwenChangeLocker,
 		VMOutputCacher:    txcache.NewDisabledCache(),
+		GasProfiler:       gasProfiler.NewDisabledGasProfiler(),
 		EnableEpochs:      enableEpochs,
 	}
 
@@ -1372,6 +1378,7 @@ func GetVmOutput(gasSchedule map[string]map[string]uint64, accnts state.Accounts
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             syncDisabled.NewDisabledBootstrapper(),
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -1420,6 +1427,7 @@ func ComputeGasLimit(gasSchedule map[string]map[string]uint64, testContext *VMTe
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             syncDisabled.NewDisabledBootstrapper(),
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
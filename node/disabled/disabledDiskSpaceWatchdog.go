@@ -0,0 +1,18 @@
+package disabled
+
+type disabledDiskSpaceWatchdog struct{}
+
+// NewDisabledDiskSpaceWatchdog returns a new instance of disabledDiskSpaceWatchdog
+func NewDisabledDiskSpaceWatchdog() *disabledDiskSpaceWatchdog {
+	return &disabledDiskSpaceWatchdog{}
+}
+
+// Close returns nil as this is a disabled component
+func (d *disabledDiskSpaceWatchdog) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if the value under interface is nil
+func (d *disabledDiskSpaceWatchdog) IsInterfaceNil() bool {
+	return d == nil
+}
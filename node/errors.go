@@ -132,3 +132,24 @@ var ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler = errors.New("ca
 
 // ErrTrieOperationsTimeout signals that a trie operation took too long
 var ErrTrieOperationsTimeout = errors.New("trie operations timeout")
+
+// ErrEmptyAddressesList signals that an empty list of addresses has been provided
+var ErrEmptyAddressesList = errors.New("empty addresses list")
+
+// ErrNilRoundTimelineHandler signals that a nil round timeline handler has been provided
+var ErrNilRoundTimelineHandler = errors.New("nil round timeline handler")
+
+// ErrCannotBlacklistSelf signals that the current node can not be blacklisted
+var ErrCannotBlacklistSelf = errors.New("the current node can not be blacklisted")
+
+// ErrNilSlashingDetector signals that a nil slashing detector has been provided
+var ErrNilSlashingDetector = errors.New("nil slashing detector")
+
+// ErrNilGasProfiler signals that a nil gas profiler has been provided
+var ErrNilGasProfiler = errors.New("nil gas profiler")
+
+// ErrNilShufflingPreviewer signals that a nil shuffling previewer has been provided
+var ErrNilShufflingPreviewer = errors.New("nil shuffling previewer")
+
+// ErrNilValidatorHistory signals that a nil validator history handler has been provided
+var ErrNilValidatorHistory = errors.New("nil validator history")
@@ -0,0 +1,242 @@
+package economicsDryRun
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+)
+
+// ArgsContractCallsExecutor holds the arguments needed to create a new contract calls executor
+type ArgsContractCallsExecutor struct {
+	VMContainer    process.VirtualMachinesContainer
+	BlockChainHook process.BlockChainHookHandler
+	// Accounts is the adapter read by the blockchain hook, recreated at the state root hash of the evaluated blocks
+	// parents. It should not be the adapter used by the node, as it is moved to past states
+	Accounts           state.AccountsAdapter
+	ShardCoordinator   sharding.Coordinator
+	ESDTTransferParser vmcommon.ESDTTransferParser
+	ArwenChangeLocker  common.Locker
+}
+
+type contractCallsExecutor struct {
+	vmContainer        process.VirtualMachinesContainer
+	blockChainHook     process.BlockChainHookHandler
+	accounts           state.AccountsAdapter
+	shardCoordinator   sharding.Coordinator
+	esdtTransferParser vmcommon.ESDTTransferParser
+	callArgsParser     process.CallArgumentsParser
+	deployArgsParser   process.DeployArgumentsParser
+	arwenChangeLocker  common.Locker
+	lastRootHash       []byte
+}
+
+// NewContractCallsExecutor creates an executor running the contract calls and deploys of the evaluated transactions
+// against the state at the end of the parent block of each one, as the state changes made by the previous transactions
+// of the same block are not saved
+func NewContractCallsExecutor(args ArgsContractCallsExecutor) (*contractCallsExecutor, error) {
+	if check.IfNil(args.VMContainer) {
+		return nil, process.ErrNoVM
+	}
+	if check.IfNil(args.BlockChainHook) {
+		return nil, process.ErrNilBlockChainHook
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.ESDTTransferParser) {
+		return nil, process.ErrNilESDTTransferParser
+	}
+	if check.IfNilReflect(args.ArwenChangeLocker) {
+		return nil, process.ErrNilLocker
+	}
+
+	return &contractCallsExecutor{
+		vmContainer:        args.VMContainer,
+		blockChainHook:     args.BlockChainHook,
+		accounts:           args.Accounts,
+		shardCoordinator:   args.ShardCoordinator,
+		esdtTransferParser: args.ESDTTransferParser,
+		callArgsParser:     parsers.NewCallArgsParser(),
+		deployArgsParser:   parsers.NewDeployArgsParser(),
+		arwenChangeLocker:  args.ArwenChangeLocker,
+	}, nil
+}
+
+// ExecuteContractCall executes the contract code called or deployed by a transaction, providing the VM with the given
+// gas. ErrNotAContractCall is returned for the transactions which do not call contract code and ErrContractNotInShard
+// for the ones calling a contract from another shard
+func (cce *contractCallsExecutor) ExecuteContractCall(
+	txHash []byte,
+	tx *transaction.Transaction,
+	gasProvided uint64,
+	blockState BlockStateHandler,
+) (*vmcommon.VMOutput, error) {
+	if len(tx.Data) == 0 {
+		return nil, ErrNotAContractCall
+	}
+
+	cce.arwenChangeLocker.RLock()
+	defer cce.arwenChangeLocker.RUnlock()
+
+	isDeploy := core.IsSmartContractAddress(tx.RcvAddr) && core.IsEmptyAddress(tx.RcvAddr)
+	if isDeploy {
+		return cce.executeDeploy(txHash, tx, gasProvided, blockState)
+	}
+
+	return cce.executeCall(txHash, tx, gasProvided, blockState)
+}
+
+func (cce *contractCallsExecutor) executeDeploy(
+	txHash []byte,
+	tx *transaction.Transaction,
+	gasProvided uint64,
+	blockState BlockStateHandler,
+) (*vmcommon.VMOutput, error) {
+	deployArgs, err := cce.deployArgsParser.ParseData(string(tx.Data))
+	if err != nil {
+		return nil, ErrNotAContractCall
+	}
+
+	codeMetadata := cce.blockChainHook.ApplyFiltersOnCodeMetadata(deployArgs.CodeMetadata)
+	createInput := &vmcommon.ContractCreateInput{
+		VMInput:              createVMInput(txHash, tx, gasProvided, deployArgs.Arguments),
+		ContractCode:         deployArgs.Code,
+		ContractCodeMetadata: codeMetadata.ToBytes(),
+	}
+
+	vm, err := cce.prepareVM(deployArgs.VMType, blockState)
+	if err != nil {
+		return nil, err
+	}
+
+	return vm.RunSmartContractCreate(createInput)
+}
+
+func (cce *contractCallsExecutor) executeCall(
+	txHash []byte,
+	tx *transaction.Transaction,
+	gasProvided uint64,
+	blockState BlockStateHandler,
+) (*vmcommon.VMOutput, error) {
+	callInput, err := cce.createCallInput(txHash, tx, gasProvided)
+	if err != nil {
+		return nil, err
+	}
+
+	vmType := callInput.RecipientAddr[core.NumInitCharactersForScAddress-core.VMTypeLen : core.NumInitCharactersForScAddress]
+	vm, err := cce.prepareVM(vmType, blockState)
+	if err != nil {
+		return nil, err
+	}
+
+	return vm.RunSmartContractCall(callInput)
+}
+
+func (cce *contractCallsExecutor) createCallInput(
+	txHash []byte,
+	tx *transaction.Transaction,
+	gasProvided uint64,
+) (*vmcommon.ContractCallInput, error) {
+	function, arguments, err := cce.callArgsParser.ParseData(string(tx.Data))
+	if err != nil {
+		return nil, ErrNotAContractCall
+	}
+
+	callInput := &vmcommon.ContractCallInput{
+		VMInput:       createVMInput(txHash, tx, gasProvided, arguments),
+		RecipientAddr: tx.RcvAddr,
+		Function:      function,
+	}
+
+	parsedTransfers, err := cce.esdtTransferParser.ParseESDTTransfers(tx.SndAddr, tx.RcvAddr, function, arguments)
+	if err == nil {
+		// the tokens transferred to a contract can be followed by the call of one of its functions
+		if len(parsedTransfers.CallFunction) == 0 {
+			return nil, ErrNotAContractCall
+		}
+
+		callInput.RecipientAddr = parsedTransfers.RcvAddr
+		callInput.Function = parsedTransfers.CallFunction
+		callInput.Arguments = parsedTransfers.CallArgs
+		callInput.ESDTTransfers = parsedTransfers.ESDTTransfers
+		callInput.CallValue = big.NewInt(0)
+	} else {
+		_, isBuiltInFunction := cce.blockChainHook.GetBuiltinFunctionNames()[function]
+		if isBuiltInFunction {
+			return nil, ErrNotAContractCall
+		}
+	}
+
+	if !core.IsSmartContractAddress(callInput.RecipientAddr) {
+		return nil, ErrNotAContractCall
+	}
+	if cce.shardCoordinator.ComputeId(callInput.RecipientAddr) != cce.shardCoordinator.SelfId() {
+		return nil, ErrContractNotInShard
+	}
+
+	return callInput, nil
+}
+
+func createVMInput(txHash []byte, tx *transaction.Transaction, gasProvided uint64, arguments [][]byte) vmcommon.VMInput {
+	return vmcommon.VMInput{
+		CallerAddr:     tx.SndAddr,
+		Arguments:      arguments,
+		CallValue:      big.NewInt(0).Set(tx.Value),
+		CallType:       vmData.DirectCall,
+		GasPrice:       tx.GasPrice,
+		GasProvided:    gasProvided,
+		OriginalTxHash: txHash,
+		CurrentTxHash:  txHash,
+		PrevTxHash:     txHash,
+	}
+}
+
+// prepareVM moves the accounts to the state at the end of the parent block, if not already there, and sets the header
+// of the evaluated block as the current one
+func (cce *contractCallsExecutor) prepareVM(vmType []byte, blockState BlockStateHandler) (vmcommon.VMExecutionHandler, error) {
+	rootHash, err := blockState.GetParentRootHash()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(rootHash, cce.lastRootHash) {
+		err = cce.accounts.RecreateTrie(rootHash)
+		if err != nil {
+			return nil, fmt.Errorf("%w while recreating the state at root hash %s", err, hex.EncodeToString(rootHash))
+		}
+
+		cce.lastRootHash = rootHash
+	}
+
+	header, err := blockState.GetHeader()
+	if err != nil {
+		return nil, err
+	}
+	cce.blockChainHook.SetCurrentHeader(header)
+
+	return cce.vmContainer.Get(vmType)
+}
+
+// Close closes the virtual machines
+func (cce *contractCallsExecutor) Close() error {
+	return cce.vmContainer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cce *contractCallsExecutor) IsInterfaceNil() bool {
+	return cce == nil
+}
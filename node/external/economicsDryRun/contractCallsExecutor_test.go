@@ -0,0 +1,328 @@
+package economicsDryRun
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blockStateStub struct {
+	header         data.HeaderHandler
+	parentRootHash []byte
+}
+
+func (stub *blockStateStub) GetHeader() (data.HeaderHandler, error) {
+	return stub.header, nil
+}
+
+func (stub *blockStateStub) GetParentRootHash() ([]byte, error) {
+	return stub.parentRootHash, nil
+}
+
+func createMockArgsContractCallsExecutor() ArgsContractCallsExecutor {
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(&testscommon.MarshalizerMock{})
+	shardCoordinator := mock.NewMultiShardsCoordinatorMock(2)
+	shardCoordinator.ComputeIdCalled = func(address []byte) uint32 {
+		if bytes.Equal(address, otherShardContractAddress) {
+			return 1
+		}
+
+		return 0
+	}
+
+	return ArgsContractCallsExecutor{
+		VMContainer: &mock.VMContainerMock{
+			GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+				return nil, errors.New("the VM should not be used")
+			},
+		},
+		BlockChainHook:     &testscommon.BlockChainHookStub{},
+		Accounts:           &stateMock.AccountsStub{},
+		ShardCoordinator:   shardCoordinator,
+		ESDTTransferParser: esdtTransferParser,
+		ArwenChangeLocker:  &sync.RWMutex{},
+	}
+}
+
+func TestNewContractCallsExecutor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil vm container should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractCallsExecutor()
+		args.VMContainer = nil
+
+		cce, err := NewContractCallsExecutor(args)
+		assert.Equal(t, process.ErrNoVM, err)
+		assert.True(t, check.IfNil(cce))
+	})
+	t.Run("nil blockchain hook should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractCallsExecutor()
+		args.BlockChainHook = nil
+
+		cce, err := NewContractCallsExecutor(args)
+		assert.Equal(t, process.ErrNilBlockChainHook, err)
+		assert.True(t, check.IfNil(cce))
+	})
+	t.Run("nil accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractCallsExecutor()
+		args.Accounts = nil
+
+		cce, err := NewContractCallsExecutor(args)
+		assert.Equal(t, process.ErrNilAccountsAdapter, err)
+		assert.True(t, check.IfNil(cce))
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractCallsExecutor()
+		args.ShardCoordinator = nil
+
+		cce, err := NewContractCallsExecutor(args)
+		assert.Equal(t, process.ErrNilShardCoordinator, err)
+		assert.True(t, check.IfNil(cce))
+	})
+	t.Run("nil esdt transfer parser should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractCallsExecutor()
+		args.ESDTTransferParser = nil
+
+		cce, err := NewContractCallsExecutor(args)
+		assert.Equal(t, process.ErrNilESDTTransferParser, err)
+		assert.True(t, check.IfNil(cce))
+	})
+	t.Run("nil arwen change locker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractCallsExecutor()
+		args.ArwenChangeLocker = nil
+
+		cce, err := NewContractCallsExecutor(args)
+		assert.Equal(t, process.ErrNilLocker, err)
+		assert.True(t, check.IfNil(cce))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cce, err := NewContractCallsExecutor(createMockArgsContractCallsExecutor())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(cce))
+	})
+}
+
+func TestContractCallsExecutor_ExecuteContractCallShouldNotExecuteTheTransactionsNotCallingContracts(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsContractCallsExecutor()
+	args.BlockChainHook = &testscommon.BlockChainHookStub{
+		GetBuiltinFunctionNamesCalled: func() vmcommon.FunctionNames {
+			return map[string]struct{}{core.BuiltInFunctionClaimDeveloperRewards: {}}
+		},
+	}
+	cce, _ := NewContractCallsExecutor(args)
+
+	transactions := map[string]*transaction.Transaction{
+		"no data":                         {RcvAddr: contractAddress, Value: big.NewInt(1)},
+		"user receiver":                   {RcvAddr: userAddress, Value: big.NewInt(0), Data: []byte("hello")},
+		"built-in function":               {RcvAddr: contractAddress, Value: big.NewInt(0), Data: []byte("ClaimDeveloperRewards")},
+		"tokens transfer to a user":       {RcvAddr: userAddress, Value: big.NewInt(0), Data: []byte("ESDTTransfer@544f4b454e@0a@616464")},
+		"tokens transfer to a contract":   {RcvAddr: contractAddress, Value: big.NewInt(0), Data: []byte("ESDTTransfer@544f4b454e@0a")},
+		"deploy with invalid arguments":   {RcvAddr: make([]byte, 32), Value: big.NewInt(0), Data: []byte("abba")},
+		"transfer to an unknown function": {RcvAddr: userAddress, Value: big.NewInt(0), Data: []byte("add@01")},
+	}
+	for name, tx := range transactions {
+		tx.SndAddr = senderAddress
+
+		vmOutput, err := cce.ExecuteContractCall([]byte("hash"), tx, 1000, &blockStateStub{})
+		assert.Nil(t, vmOutput, name)
+		assert.Equal(t, ErrNotAContractCall, err, name)
+	}
+}
+
+func TestContractCallsExecutor_ExecuteContractCallShouldNotExecuteTheCallsOfContractsFromOtherShards(t *testing.T) {
+	t.Parallel()
+
+	cce, _ := NewContractCallsExecutor(createMockArgsContractCallsExecutor())
+
+	tx := &transaction.Transaction{
+		SndAddr: senderAddress,
+		RcvAddr: otherShardContractAddress,
+		Value:   big.NewInt(0),
+		Data:    []byte("add@01"),
+	}
+	vmOutput, err := cce.ExecuteContractCall([]byte("hash"), tx, 1000, &blockStateStub{})
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, ErrContractNotInShard, err)
+}
+
+func TestContractCallsExecutor_ExecuteContractCallShouldExecuteTheCallAgainstTheStateOfTheParentBlock(t *testing.T) {
+	t.Parallel()
+
+	header := &block.Header{Nonce: 5}
+	expectedVMOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 400}
+	recreatedRootHashes := make([][]byte, 0)
+	var callInput *vmcommon.ContractCallInput
+	args := createMockArgsContractCallsExecutor()
+	args.Accounts = &stateMock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			recreatedRootHashes = append(recreatedRootHashes, rootHash)
+			return nil
+		},
+	}
+	args.BlockChainHook = &testscommon.BlockChainHookStub{
+		SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+			assert.Equal(t, header, hdr)
+		},
+	}
+	args.VMContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			assert.Equal(t, contractAddress[core.NumInitCharactersForScAddress-core.VMTypeLen:core.NumInitCharactersForScAddress], key)
+
+			return &mock.VMExecutionHandlerStub{
+				RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+					callInput = input
+					return expectedVMOutput, nil
+				},
+			}, nil
+		},
+	}
+	cce, _ := NewContractCallsExecutor(args)
+
+	blockState := &blockStateStub{header: header, parentRootHash: []byte("rootHash4")}
+	t.Run("direct call", func(t *testing.T) {
+		tx := &transaction.Transaction{
+			SndAddr:  senderAddress,
+			RcvAddr:  contractAddress,
+			Value:    big.NewInt(7),
+			GasPrice: 1000000000,
+			Data:     []byte("add@01@02"),
+		}
+		vmOutput, err := cce.ExecuteContractCall([]byte("hash"), tx, 1000, blockState)
+		require.Nil(t, err)
+		assert.Equal(t, expectedVMOutput, vmOutput)
+
+		assert.Equal(t, contractAddress, callInput.RecipientAddr)
+		assert.Equal(t, "add", callInput.Function)
+		assert.Equal(t, [][]byte{{1}, {2}}, callInput.Arguments)
+		assert.Equal(t, senderAddress, callInput.CallerAddr)
+		assert.Equal(t, big.NewInt(7), callInput.CallValue)
+		assert.Equal(t, uint64(1000), callInput.GasProvided)
+		assert.Equal(t, uint64(1000000000), callInput.GasPrice)
+		assert.Equal(t, []byte("hash"), callInput.OriginalTxHash)
+	})
+	t.Run("tokens transfer and call", func(t *testing.T) {
+		tx := &transaction.Transaction{
+			SndAddr: senderAddress,
+			RcvAddr: contractAddress,
+			Value:   big.NewInt(0),
+			Data:    []byte("ESDTTransfer@544f4b454e@0a@616464@01"),
+		}
+		vmOutput, err := cce.ExecuteContractCall([]byte("hash"), tx, 2000, blockState)
+		require.Nil(t, err)
+		assert.Equal(t, expectedVMOutput, vmOutput)
+
+		assert.Equal(t, contractAddress, callInput.RecipientAddr)
+		assert.Equal(t, "add", callInput.Function)
+		assert.Equal(t, [][]byte{{1}}, callInput.Arguments)
+		assert.Equal(t, big.NewInt(0), callInput.CallValue)
+		assert.Equal(t, uint64(2000), callInput.GasProvided)
+		require.Equal(t, 1, len(callInput.ESDTTransfers))
+		assert.Equal(t, []byte("TOKEN"), callInput.ESDTTransfers[0].ESDTTokenName)
+		assert.Equal(t, big.NewInt(10), callInput.ESDTTransfers[0].ESDTValue)
+	})
+
+	// the state is recreated only once for the transactions of the same block
+	assert.Equal(t, [][]byte{[]byte("rootHash4")}, recreatedRootHashes)
+}
+
+func TestContractCallsExecutor_ExecuteContractCallShouldExecuteTheDeploy(t *testing.T) {
+	t.Parallel()
+
+	expectedVMOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 400}
+	var createInput *vmcommon.ContractCreateInput
+	args := createMockArgsContractCallsExecutor()
+	args.VMContainer = &mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			assert.Equal(t, []byte{5, 0}, key)
+
+			return &mock.VMExecutionHandlerStub{
+				RunSmartContractCreateCalled: func(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+					createInput = input
+					return expectedVMOutput, nil
+				},
+			}, nil
+		},
+	}
+	cce, _ := NewContractCallsExecutor(args)
+
+	tx := &transaction.Transaction{
+		SndAddr: senderAddress,
+		RcvAddr: make([]byte, 32),
+		Value:   big.NewInt(0),
+		Data:    []byte("abba@0500@0100@01"),
+	}
+	vmOutput, err := cce.ExecuteContractCall([]byte("hash"), tx, 1000, &blockStateStub{})
+	require.Nil(t, err)
+	assert.Equal(t, expectedVMOutput, vmOutput)
+	assert.Equal(t, []byte{0xab, 0xba}, createInput.ContractCode)
+	assert.Equal(t, []byte{1, 0}, createInput.ContractCodeMetadata)
+	assert.Equal(t, [][]byte{{1}}, createInput.Arguments)
+	assert.Equal(t, uint64(1000), createInput.GasProvided)
+}
+
+func TestContractCallsExecutor_ExecuteContractCallShouldErrIfTheStateCanNotBeRecreated(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsContractCallsExecutor()
+	args.Accounts = &stateMock.AccountsStub{
+		RecreateTrieCalled: func(rootHash []byte) error {
+			return expectedErr
+		},
+	}
+	cce, _ := NewContractCallsExecutor(args)
+
+	tx := &transaction.Transaction{
+		SndAddr: senderAddress,
+		RcvAddr: contractAddress,
+		Value:   big.NewInt(0),
+		Data:    []byte("add@01"),
+	}
+	vmOutput, err := cce.ExecuteContractCall([]byte("hash"), tx, 1000, &blockStateStub{parentRootHash: []byte("rootHash")})
+	assert.Nil(t, vmOutput)
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestDisabledContractCallsExecutor(t *testing.T) {
+	t.Parallel()
+
+	dcce := NewDisabledContractCallsExecutor()
+	assert.False(t, check.IfNil(dcce))
+
+	vmOutput, err := dcce.ExecuteContractCall([]byte("hash"), &transaction.Transaction{}, 1000, &blockStateStub{})
+	assert.Nil(t, vmOutput)
+	assert.Equal(t, ErrNotAContractCall, err)
+	assert.Nil(t, dcce.Close())
+}
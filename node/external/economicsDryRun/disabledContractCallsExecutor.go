@@ -0,0 +1,35 @@
+package economicsDryRun
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+type disabledContractCallsExecutor struct {
+}
+
+// NewDisabledContractCallsExecutor returns a contract calls executor which executes no transaction, used on the
+// metachain, where no user account exists
+func NewDisabledContractCallsExecutor() *disabledContractCallsExecutor {
+	return &disabledContractCallsExecutor{}
+}
+
+// ExecuteContractCall returns ErrNotAContractCall
+func (dcce *disabledContractCallsExecutor) ExecuteContractCall(
+	_ []byte,
+	_ *transaction.Transaction,
+	_ uint64,
+	_ BlockStateHandler,
+) (*vmcommon.VMOutput, error) {
+	return nil, ErrNotAContractCall
+}
+
+// Close returns nil
+func (dcce *disabledContractCallsExecutor) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dcce *disabledContractCallsExecutor) IsInterfaceNil() bool {
+	return dcce == nil
+}
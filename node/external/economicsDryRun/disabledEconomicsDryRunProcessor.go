@@ -0,0 +1,21 @@
+package economicsDryRun
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+type disabledEconomicsDryRunProcessor struct {
+}
+
+// NewDisabledEconomicsDryRunProcessor returns an economics dry-run processor that rejects all the runs
+func NewDisabledEconomicsDryRunProcessor() *disabledEconomicsDryRunProcessor {
+	return &disabledEconomicsDryRunProcessor{}
+}
+
+// DryRun returns ErrEconomicsDryRunDisabled
+func (dedr *disabledEconomicsDryRunProcessor) DryRun(_ uint64, _ uint64) (*common.EconomicsDryRunResult, error) {
+	return nil, ErrEconomicsDryRunDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dedr *disabledEconomicsDryRunProcessor) IsInterfaceNil() bool {
+	return dedr == nil
+}
@@ -0,0 +1,614 @@
+package economicsDryRun
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/forking"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/external/blockAPI"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	processTransaction "github.com/ElrondNetwork/elrond-go/process/transaction"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const relayerRefundReturnMessage = "gas refund for relayer"
+
+var log = logger.GetOrCreate("node/economicsDryRun")
+
+var okReturnData = "@" + hex.EncodeToString([]byte(vmcommon.Ok.String()))
+var outOfGasReturnData = "@" + hex.EncodeToString([]byte(vmcommon.OutOfGas.String()))
+
+// ArgsEconomicsDryRunProcessor holds the arguments needed to create a new economics dry-run processor
+type ArgsEconomicsDryRunProcessor struct {
+	Config                       config.EconomicsDryRunConfig
+	EconomicsConfig              *config.EconomicsConfig
+	GasScheduleConfig            config.GasScheduleConfig
+	GasScheduleDirectory         string
+	EnableEpochs                 config.EnableEpochs
+	APIBlockHandler              blockAPI.APIBlockHandler
+	APIInternalBlockHandler      blockAPI.APIInternalBlockHandler
+	APITransactionHandler        external.APITransactionHandler
+	ContractCallsExecutorCreator ContractCallsExecutorCreator
+}
+
+type economicsDryRunProcessor struct {
+	economicsConfig                *config.EconomicsConfig
+	gasScheduleConfig              config.GasScheduleConfig
+	gasScheduleDirectory           string
+	alternativeEconomicsFile       string
+	alternativeGasScheduleFile     string
+	maxBlocksPerRun                uint64
+	penalizedTooMuchGasEnableEpoch uint32
+	gasPriceModifierEnableEpoch    uint32
+	apiBlockHandler                blockAPI.APIBlockHandler
+	apiInternalBlockHandler        blockAPI.APIInternalBlockHandler
+	apiTransactionHandler          external.APITransactionHandler
+	contractCallsExecutorCreator   ContractCallsExecutorCreator
+	mutRun                         sync.Mutex
+}
+
+type feeEvaluator struct {
+	economics            process.FeeHandler
+	builtInFunctionsCost economics.BuiltInFunctionsCostHandler
+	gasSchedule          core.GasScheduleNotifier
+}
+
+type dryRunContext struct {
+	epochNotifier process.EpochNotifier
+	current       *feeEvaluator
+	alternative   *feeEvaluator
+	executor      ContractCallsExecutor
+}
+
+// blockState lazily loads the header of an evaluated block and the state root hash of its parent, which are needed
+// only for executing the contract calls
+type blockState struct {
+	nonce                   uint64
+	apiInternalBlockHandler blockAPI.APIInternalBlockHandler
+	header                  data.HeaderHandler
+	parentRootHash          []byte
+}
+
+type executionResults struct {
+	refund   *big.Int
+	outOfGas bool
+}
+
+type outcome struct {
+	gasUsed  uint64
+	fee      *big.Int
+	refund   *big.Int
+	outOfGas bool
+	rejected bool
+}
+
+type totals struct {
+	gasUsed     uint64
+	fees        *big.Int
+	refunds     *big.Int
+	numOutOfGas uint64
+	numRejected uint64
+}
+
+// NewEconomicsDryRunProcessor creates a processor able to evaluate how the transactions of a range of stored blocks
+// would have been charged under an alternative economics config and gas schedule. The contract calls are executed
+// again, read-only, by a VM using the alternative gas schedule
+func NewEconomicsDryRunProcessor(args ArgsEconomicsDryRunProcessor) (*economicsDryRunProcessor, error) {
+	if args.EconomicsConfig == nil {
+		return nil, ErrNilEconomicsConfig
+	}
+	if len(args.GasScheduleConfig.GasScheduleByEpochs) == 0 {
+		return nil, process.ErrNilGasSchedule
+	}
+	if args.Config.MaxBlocksPerRun == 0 {
+		return nil, ErrInvalidMaxBlocksPerRun
+	}
+	if check.IfNil(args.APIBlockHandler) {
+		return nil, ErrNilAPIBlockHandler
+	}
+	if check.IfNil(args.APIInternalBlockHandler) {
+		return nil, ErrNilAPIInternalBlockHandler
+	}
+	if check.IfNil(args.APITransactionHandler) {
+		return nil, ErrNilAPITransactionHandler
+	}
+	if check.IfNil(args.ContractCallsExecutorCreator) {
+		return nil, ErrNilContractCallsExecutorCreator
+	}
+
+	// the gas schedule notifier sorts the provided versions in place
+	gasScheduleByEpochs := make([]config.GasScheduleByEpochs, len(args.GasScheduleConfig.GasScheduleByEpochs))
+	copy(gasScheduleByEpochs, args.GasScheduleConfig.GasScheduleByEpochs)
+
+	return &economicsDryRunProcessor{
+		economicsConfig:                args.EconomicsConfig,
+		gasScheduleConfig:              config.GasScheduleConfig{GasScheduleByEpochs: gasScheduleByEpochs},
+		gasScheduleDirectory:           args.GasScheduleDirectory,
+		alternativeEconomicsFile:       args.Config.AlternativeEconomicsFile,
+		alternativeGasScheduleFile:     args.Config.AlternativeGasScheduleFile,
+		maxBlocksPerRun:                args.Config.MaxBlocksPerRun,
+		penalizedTooMuchGasEnableEpoch: args.EnableEpochs.PenalizedTooMuchGasEnableEpoch,
+		gasPriceModifierEnableEpoch:    args.EnableEpochs.GasPriceModifierEnableEpoch,
+		apiBlockHandler:                args.APIBlockHandler,
+		apiInternalBlockHandler:        args.APIInternalBlockHandler,
+		apiTransactionHandler:          args.APITransactionHandler,
+		contractCallsExecutorCreator:   args.ContractCallsExecutorCreator,
+	}, nil
+}
+
+// DryRun evaluates the transactions originating in the blocks with the nonces in the provided range, both inclusive,
+// under the current and under the alternative economics config and gas schedule. The alternative files are read on
+// each run. The calls of contracts from other shards are only counted as skipped. The runs are executed one at a time
+func (edr *economicsDryRunProcessor) DryRun(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error) {
+	if fromNonce > toNonce {
+		return nil, ErrInvalidNonceRange
+	}
+	if toNonce-fromNonce >= edr.maxBlocksPerRun {
+		return nil, fmt.Errorf("%w, at most %d blocks can be evaluated in a run", ErrTooManyBlocks, edr.maxBlocksPerRun)
+	}
+
+	edr.mutRun.Lock()
+	defer edr.mutRun.Unlock()
+
+	ctx, err := edr.createDryRunContext()
+	if err != nil {
+		return nil, err
+	}
+	defer ctx.close()
+
+	blocks := make([]*api.Block, 0, toNonce-fromNonce+1)
+	for i := uint64(0); i <= toNonce-fromNonce; i++ {
+		apiBlock, errGet := edr.apiBlockHandler.GetBlockByNonce(fromNonce+i, true)
+		if errGet != nil {
+			return nil, fmt.Errorf("%w for block with nonce %d", errGet, fromNonce+i)
+		}
+
+		blocks = append(blocks, apiBlock)
+	}
+
+	result := &common.EconomicsDryRunResult{
+		FromNonce:    fromNonce,
+		ToNonce:      toNonce,
+		Transactions: make([]*common.EconomicsDryRunTransaction, 0),
+	}
+	currentTotals := newTotals()
+	alternativeTotals := newTotals()
+	for _, apiBlock := range blocks {
+		ctx.epochNotifier.CheckEpoch(&block.Header{Epoch: apiBlock.Epoch})
+		state := &blockState{
+			nonce:                   apiBlock.Nonce,
+			apiInternalBlockHandler: edr.apiInternalBlockHandler,
+		}
+
+		for _, miniBlock := range apiBlock.MiniBlocks {
+			// the transactions are evaluated only in their source shard, where the fees are charged
+			if miniBlock.SourceShard != apiBlock.Shard {
+				continue
+			}
+
+			for _, apiTx := range miniBlock.Transactions {
+				current, alternative, evaluated, errEvaluate := edr.evaluateTransaction(ctx, state, apiTx)
+				if errEvaluate != nil {
+					return nil, errEvaluate
+				}
+				if !evaluated {
+					continue
+				}
+				if current == nil {
+					result.NumSkipped++
+					continue
+				}
+
+				currentTotals.add(current)
+				alternativeTotals.add(alternative)
+				result.Transactions = append(result.Transactions, &common.EconomicsDryRunTransaction{
+					Hash:        apiTx.Hash,
+					BlockNonce:  apiBlock.Nonce,
+					Current:     current.toDryRunOutcome(),
+					Alternative: alternative.toDryRunOutcome(),
+				})
+			}
+		}
+	}
+
+	result.NumTransactions = uint64(len(result.Transactions))
+	result.Current = currentTotals.toDryRunTotals()
+	result.Alternative = alternativeTotals.toDryRunTotals()
+
+	log.Debug("economicsDryRunProcessor.DryRun",
+		"from nonce", fromNonce,
+		"to nonce", toNonce,
+		"num transactions", result.NumTransactions,
+		"num skipped", result.NumSkipped)
+
+	return result, nil
+}
+
+func (edr *economicsDryRunProcessor) createDryRunContext() (*dryRunContext, error) {
+	alternativeEconomicsConfig, err := common.LoadEconomicsConfig(edr.alternativeEconomicsFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the alternative economics config", err)
+	}
+
+	epochNotifier := forking.NewGenericEpochNotifier()
+	current, err := edr.createFeeEvaluator(edr.economicsConfig, edr.gasScheduleConfig, edr.gasScheduleDirectory, epochNotifier)
+	if err != nil {
+		return nil, err
+	}
+
+	alternativeGasScheduleConfig := config.GasScheduleConfig{
+		GasScheduleByEpochs: []config.GasScheduleByEpochs{
+			{
+				StartEpoch: 0,
+				FileName:   filepath.Base(edr.alternativeGasScheduleFile),
+			},
+		},
+	}
+	alternativeGasScheduleDirectory := filepath.Dir(edr.alternativeGasScheduleFile)
+	alternative, err := edr.createFeeEvaluator(alternativeEconomicsConfig, alternativeGasScheduleConfig, alternativeGasScheduleDirectory, epochNotifier)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the alternative economics", err)
+	}
+
+	executor, err := edr.contractCallsExecutorCreator.CreateContractCallsExecutor(alternative.gasSchedule, alternative.economics, epochNotifier)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the contract calls executor", err)
+	}
+
+	return &dryRunContext{
+		epochNotifier: epochNotifier,
+		current:       current,
+		alternative:   alternative,
+		executor:      executor,
+	}, nil
+}
+
+func (ctx *dryRunContext) close() {
+	err := ctx.executor.Close()
+	if err != nil {
+		log.Warn("economicsDryRunProcessor: error closing the contract calls executor", "error", err)
+	}
+}
+
+func (edr *economicsDryRunProcessor) createFeeEvaluator(
+	economicsConfig *config.EconomicsConfig,
+	gasScheduleConfig config.GasScheduleConfig,
+	gasScheduleDirectory string,
+	epochNotifier process.EpochNotifier,
+) (*feeEvaluator, error) {
+	gasScheduleNotifier, err := forking.NewGasScheduleNotifier(forking.ArgsNewGasScheduleNotifier{
+		GasScheduleConfig: gasScheduleConfig,
+		ConfigDir:         gasScheduleDirectory,
+		EpochNotifier:     epochNotifier,
+		ArwenChangeLocker: &sync.RWMutex{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	builtInFunctionsCost, err := economics.NewBuiltInFunctionsCost(&economics.ArgsBuiltInFunctionCost{
+		GasSchedule: gasScheduleNotifier,
+		ArgsParser:  smartContract.NewArgumentParser(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	economicsData, err := economics.NewEconomicsData(economics.ArgsNewEconomicsData{
+		BuiltInFunctionsCostHandler:    builtInFunctionsCost,
+		Economics:                      economicsConfig,
+		EpochNotifier:                  epochNotifier,
+		PenalizedTooMuchGasEnableEpoch: edr.penalizedTooMuchGasEnableEpoch,
+		GasPriceModifierEnableEpoch:    edr.gasPriceModifierEnableEpoch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &feeEvaluator{
+		economics:            economicsData,
+		builtInFunctionsCost: builtInFunctionsCost,
+		gasSchedule:          gasScheduleNotifier,
+	}, nil
+}
+
+// evaluateTransaction returns the current and the alternative outcomes of a transaction. The returned flag is false for
+// the transactions that are not charged by the economics config, while nil outcomes mark a skipped transaction
+func (edr *economicsDryRunProcessor) evaluateTransaction(
+	ctx *dryRunContext,
+	state *blockState,
+	apiTx *transaction.ApiTransactionResult,
+) (*outcome, *outcome, bool, error) {
+	tx, ok := apiTx.Tx.(*transaction.Transaction)
+	if !ok {
+		return nil, nil, false, nil
+	}
+
+	switch apiTx.Type {
+	case string(transaction.TxTypeNormal):
+		results, err := edr.getExecutionResults(apiTx.Hash)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("%w for transaction with hash %s", err, apiTx.Hash)
+		}
+
+		txHash, err := hex.DecodeString(apiTx.Hash)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("%w for transaction with hash %s", err, apiTx.Hash)
+		}
+
+		current, alternative, err := ctx.evaluateExecutedTransaction(txHash, tx, results, state)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("%w for transaction with hash %s", err, apiTx.Hash)
+		}
+		return current, alternative, true, nil
+	case string(transaction.TxTypeInvalid):
+		current := ctx.current.computeInvalidOutcome(tx)
+		alternative := ctx.alternative.computeInvalidOutcome(tx)
+		if ctx.alternative.economics.CheckValidityTxValues(tx) != nil {
+			alternative = newRejectedOutcome()
+		}
+		return current, alternative, true, nil
+	default:
+		return nil, nil, false, nil
+	}
+}
+
+// getExecutionResults returns the refund and the out of gas outcome of a transaction from its stored results, which
+// are not bound to the evaluated blocks range
+func (edr *economicsDryRunProcessor) getExecutionResults(txHash string) (*executionResults, error) {
+	txWithResults, err := edr.apiTransactionHandler.GetTransaction(txHash, true)
+	if err != nil {
+		return nil, err
+	}
+
+	results := &executionResults{refund: big.NewInt(0)}
+	receipt := txWithResults.Receipt
+	if receipt != nil && receipt.Data == processTransaction.RefundGasMessage && receipt.Value != nil {
+		results.refund.Add(results.refund, receipt.Value)
+	}
+
+	for _, scr := range txWithResults.SmartContractResults {
+		if strings.Contains(scr.Data, outOfGasReturnData) {
+			results.outOfGas = true
+		}
+		if isRefundForSender(scr, txWithResults) {
+			results.refund.Add(results.refund, scr.Value)
+		}
+	}
+
+	return results, nil
+}
+
+func isRefundForSender(scr *transaction.ApiSmartContractResult, tx *transaction.ApiTransactionResult) bool {
+	if scr.Value == nil || scr.Value.Sign() <= 0 || scr.RcvAddr != tx.Sender {
+		return false
+	}
+	if scr.ReturnMessage == relayerRefundReturnMessage {
+		return true
+	}
+
+	return scr.Nonce == tx.Nonce+1 && strings.HasPrefix(scr.Data, okReturnData)
+}
+
+// evaluateExecutedTransaction returns the current outcome of a transaction, computed from its stored results, and its
+// alternative outcome. The contract calls are executed again with the gas left after the alternative move balance and
+// built-in function costs. Nil outcomes are returned for the calls of contracts from other shards and for the
+// transactions which executed contract code without calling it directly, such as the relayed ones
+func (ctx *dryRunContext) evaluateExecutedTransaction(
+	txHash []byte,
+	tx *transaction.Transaction,
+	results *executionResults,
+	state *blockState,
+) (*outcome, *outcome, error) {
+	current := ctx.current.computeExecutedOutcome(tx, results)
+	if ctx.alternative.economics.CheckValidityTxValues(tx) != nil {
+		return current, newRejectedOutcome(), nil
+	}
+
+	staticGas := ctx.alternative.computeStaticGas(tx)
+	if staticGas > tx.GasLimit {
+		return current, ctx.alternative.computeOutOfGasOutcome(tx), nil
+	}
+
+	gasProvided := tx.GasLimit - staticGas
+	vmOutput, err := ctx.executor.ExecuteContractCall(txHash, tx, gasProvided, state)
+	switch {
+	case err == nil:
+		return current, ctx.alternative.computeContractCallOutcome(tx, staticGas, gasProvided, vmOutput), nil
+	case errors.Is(err, ErrContractNotInShard):
+		return nil, nil, nil
+	case !errors.Is(err, ErrNotAContractCall):
+		return nil, nil, err
+	}
+
+	if current.outOfGas || current.gasUsed > ctx.current.computeStaticGas(tx) {
+		return nil, nil, nil
+	}
+
+	return current, ctx.alternative.computeStaticOutcome(tx, staticGas), nil
+}
+
+func (fe *feeEvaluator) computeExecutedOutcome(tx *transaction.Transaction, results *executionResults) *outcome {
+	gasUsed, fee := fe.economics.ComputeGasUsedAndFeeBasedOnRefundValue(tx, results.refund)
+
+	return &outcome{
+		gasUsed:  gasUsed,
+		fee:      fee,
+		refund:   fe.computeRefund(tx, fee),
+		outOfGas: results.outOfGas,
+	}
+}
+
+func (fe *feeEvaluator) computeStaticOutcome(tx *transaction.Transaction, gasUsed uint64) *outcome {
+	fee := fe.economics.ComputeTxFeeBasedOnGasUsed(tx, gasUsed)
+	return &outcome{
+		gasUsed: gasUsed,
+		fee:     fee,
+		refund:  fe.computeRefund(tx, fee),
+	}
+}
+
+// computeContractCallOutcome returns the outcome of an executed contract call. The failed calls consume all the gas
+func (fe *feeEvaluator) computeContractCallOutcome(
+	tx *transaction.Transaction,
+	staticGas uint64,
+	gasProvided uint64,
+	vmOutput *vmcommon.VMOutput,
+) *outcome {
+	switch vmOutput.ReturnCode {
+	case vmcommon.Ok:
+		return fe.computeStaticOutcome(tx, staticGas+gasProvided-vmOutput.GasRemaining)
+	case vmcommon.OutOfGas:
+		return fe.computeOutOfGasOutcome(tx)
+	default:
+		return fe.computeInvalidOutcome(tx)
+	}
+}
+
+// computeStaticGas returns the gas a transaction uses without executing contract code
+func (fe *feeEvaluator) computeStaticGas(tx *transaction.Transaction) uint64 {
+	return fe.economics.ComputeGasLimit(tx) + fe.builtInFunctionsCost.ComputeBuiltInCost(tx)
+}
+
+// newRejectedOutcome returns the outcome of a transaction that would not be accepted in a block, so it pays no fee
+func newRejectedOutcome() *outcome {
+	return &outcome{
+		fee:      big.NewInt(0),
+		refund:   big.NewInt(0),
+		rejected: true,
+	}
+}
+
+func (fe *feeEvaluator) computeOutOfGasOutcome(tx *transaction.Transaction) *outcome {
+	return &outcome{
+		gasUsed:  tx.GasLimit,
+		fee:      fe.economics.ComputeTxFee(tx),
+		refund:   big.NewInt(0),
+		outOfGas: true,
+	}
+}
+
+func (fe *feeEvaluator) computeInvalidOutcome(tx *transaction.Transaction) *outcome {
+	return &outcome{
+		gasUsed: tx.GasLimit,
+		fee:     fe.economics.ComputeTxFee(tx),
+		refund:  big.NewInt(0),
+	}
+}
+
+// computeRefund returns the part of the fee paid upfront that is returned to the sender
+func (fe *feeEvaluator) computeRefund(tx *transaction.Transaction, fee *big.Int) *big.Int {
+	refund := big.NewInt(0).Sub(fe.economics.ComputeTxFee(tx), fee)
+	if refund.Sign() < 0 {
+		return big.NewInt(0)
+	}
+
+	return refund
+}
+
+func (o *outcome) toDryRunOutcome() *common.EconomicsDryRunOutcome {
+	return &common.EconomicsDryRunOutcome{
+		GasUsed:  o.gasUsed,
+		Fee:      o.fee.String(),
+		Refund:   o.refund.String(),
+		OutOfGas: o.outOfGas,
+		Rejected: o.rejected,
+	}
+}
+
+func newTotals() *totals {
+	return &totals{
+		fees:    big.NewInt(0),
+		refunds: big.NewInt(0),
+	}
+}
+
+func (t *totals) add(o *outcome) {
+	t.gasUsed += o.gasUsed
+	t.fees.Add(t.fees, o.fee)
+	t.refunds.Add(t.refunds, o.refund)
+	if o.outOfGas {
+		t.numOutOfGas++
+	}
+	if o.rejected {
+		t.numRejected++
+	}
+}
+
+func (t *totals) toDryRunTotals() *common.EconomicsDryRunTotals {
+	return &common.EconomicsDryRunTotals{
+		GasUsed:     t.gasUsed,
+		Fees:        t.fees.String(),
+		Refunds:     t.refunds.String(),
+		NumOutOfGas: t.numOutOfGas,
+		NumRejected: t.numRejected,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (edr *economicsDryRunProcessor) IsInterfaceNil() bool {
+	return edr == nil
+}
+
+// GetHeader returns the header of the evaluated block
+func (bs *blockState) GetHeader() (data.HeaderHandler, error) {
+	if check.IfNil(bs.header) {
+		header, err := bs.getHeaderByNonce(bs.nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		bs.header = header
+	}
+
+	return bs.header, nil
+}
+
+// GetParentRootHash returns the state root hash of the parent of the evaluated block
+func (bs *blockState) GetParentRootHash() ([]byte, error) {
+	if bs.parentRootHash == nil {
+		if bs.nonce == 0 {
+			return nil, fmt.Errorf("%w, the genesis block has no parent", ErrInvalidNonceRange)
+		}
+
+		parentHeader, err := bs.getHeaderByNonce(bs.nonce - 1)
+		if err != nil {
+			return nil, err
+		}
+
+		bs.parentRootHash = parentHeader.GetRootHash()
+	}
+
+	return bs.parentRootHash, nil
+}
+
+func (bs *blockState) getHeaderByNonce(nonce uint64) (data.HeaderHandler, error) {
+	internalBlock, err := bs.apiInternalBlockHandler.GetInternalShardBlockByNonce(common.ApiOutputFormatJSON, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w for block with nonce %d", err, nonce)
+	}
+
+	header, ok := internalBlock.(data.HeaderHandler)
+	if !ok || check.IfNil(header) {
+		return nil, fmt.Errorf("%w for block with nonce %d", process.ErrWrongTypeAssertion, nonce)
+	}
+
+	return header, nil
+}
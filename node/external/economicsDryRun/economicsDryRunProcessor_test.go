@@ -0,0 +1,621 @@
+package economicsDryRun
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	processTransaction "github.com/ElrondNetwork/elrond-go/process/transaction"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	economicsConfigFile   = "../../../cmd/node/config/economics.toml"
+	gasSchedulesDirectory = "../../../cmd/node/config/gasSchedules"
+)
+
+const senderBech32Address = "erd1sender"
+
+var senderAddress = bytes.Repeat([]byte("s"), 32)
+var userAddress = bytes.Repeat([]byte("u"), 32)
+var contractAddress = append(make([]byte, 10), bytes.Repeat([]byte("c"), 22)...)
+var otherShardContractAddress = append(make([]byte, 10), bytes.Repeat([]byte("o"), 22)...)
+
+type contractCallsExecutorStub struct {
+	ExecuteContractCallCalled func(txHash []byte, tx *transaction.Transaction, gasProvided uint64, blockState BlockStateHandler) (*vmcommon.VMOutput, error)
+	CloseCalled               func() error
+}
+
+func (stub *contractCallsExecutorStub) ExecuteContractCall(
+	txHash []byte,
+	tx *transaction.Transaction,
+	gasProvided uint64,
+	blockState BlockStateHandler,
+) (*vmcommon.VMOutput, error) {
+	if stub.ExecuteContractCallCalled != nil {
+		return stub.ExecuteContractCallCalled(txHash, tx, gasProvided, blockState)
+	}
+
+	return nil, ErrNotAContractCall
+}
+
+func (stub *contractCallsExecutorStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+func (stub *contractCallsExecutorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+type contractCallsExecutorCreatorStub struct {
+	CreateContractCallsExecutorCalled func(gasSchedule core.GasScheduleNotifier, economics process.FeeHandler, epochNotifier process.EpochNotifier) (ContractCallsExecutor, error)
+}
+
+func (stub *contractCallsExecutorCreatorStub) CreateContractCallsExecutor(
+	gasSchedule core.GasScheduleNotifier,
+	economics process.FeeHandler,
+	epochNotifier process.EpochNotifier,
+) (ContractCallsExecutor, error) {
+	if stub.CreateContractCallsExecutorCalled != nil {
+		return stub.CreateContractCallsExecutorCalled(gasSchedule, economics, epochNotifier)
+	}
+
+	return &contractCallsExecutorStub{}, nil
+}
+
+func (stub *contractCallsExecutorCreatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createMockArgsEconomicsDryRunProcessor(t *testing.T) ArgsEconomicsDryRunProcessor {
+	economicsConfig, err := common.LoadEconomicsConfig(economicsConfigFile)
+	require.Nil(t, err)
+
+	// the alternative config doubles the gas per data byte
+	economicsFileContent, err := ioutil.ReadFile(economicsConfigFile)
+	require.Nil(t, err)
+	alternativeEconomicsContent := strings.Replace(string(economicsFileContent), `GasPerDataByte          = "1500"`, `GasPerDataByte          = "3000"`, 1)
+	require.NotEqual(t, string(economicsFileContent), alternativeEconomicsContent)
+	alternativeEconomicsFile := filepath.Join(t.TempDir(), "economics.toml")
+	err = ioutil.WriteFile(alternativeEconomicsFile, []byte(alternativeEconomicsContent), 0644)
+	require.Nil(t, err)
+
+	return ArgsEconomicsDryRunProcessor{
+		Config: config.EconomicsDryRunConfig{
+			Enabled:                    true,
+			AlternativeEconomicsFile:   alternativeEconomicsFile,
+			AlternativeGasScheduleFile: filepath.Join(gasSchedulesDirectory, "gasScheduleV6.toml"),
+			MaxBlocksPerRun:            10,
+		},
+		EconomicsConfig: economicsConfig,
+		GasScheduleConfig: config.GasScheduleConfig{
+			GasScheduleByEpochs: []config.GasScheduleByEpochs{
+				{StartEpoch: 0, FileName: "gasScheduleV1.toml"},
+			},
+		},
+		GasScheduleDirectory:         gasSchedulesDirectory,
+		EnableEpochs:                 config.EnableEpochs{},
+		APIBlockHandler:              &mock.BlockAPIHandlerStub{},
+		APIInternalBlockHandler:      &mock.InternalBlockApiHandlerStub{},
+		APITransactionHandler:        &mock.TransactionAPIHandlerStub{},
+		ContractCallsExecutorCreator: &contractCallsExecutorCreatorStub{},
+	}
+}
+
+func createAPITransaction(name string, txType transaction.TxType, tx *transaction.Transaction) *transaction.ApiTransactionResult {
+	return &transaction.ApiTransactionResult{
+		Tx:   tx,
+		Type: string(txType),
+		Hash: hex.EncodeToString([]byte(name)),
+	}
+}
+
+func createAPITransactionWithResults(
+	nonce uint64,
+	receipt *transaction.ApiReceipt,
+	scrs ...*transaction.ApiSmartContractResult,
+) *transaction.ApiTransactionResult {
+	return &transaction.ApiTransactionResult{
+		Nonce:                nonce,
+		Sender:               senderBech32Address,
+		Receipt:              receipt,
+		SmartContractResults: scrs,
+	}
+}
+
+// createTestResults returns the stored results of the test transactions, keyed by the hex encoded transaction hash
+func createTestResults() map[string]*transaction.ApiTransactionResult {
+	return map[string]*transaction.ApiTransactionResult{
+		hex.EncodeToString([]byte("moveBalanceWithRefund")): createAPITransactionWithResults(0, &transaction.ApiReceipt{
+			Value: big.NewInt(425000000000),
+			Data:  processTransaction.RefundGasMessage,
+		}),
+		hex.EncodeToString([]byte("scCall")): createAPITransactionWithResults(7, nil, &transaction.ApiSmartContractResult{
+			Nonce:   8,
+			Value:   big.NewInt(8000000000000),
+			RcvAddr: senderBech32Address,
+			Data:    okReturnData,
+		}),
+		hex.EncodeToString([]byte("outOfGas")): createAPITransactionWithResults(8, nil, &transaction.ApiSmartContractResult{
+			Nonce:   8,
+			Value:   big.NewInt(0),
+			RcvAddr: senderBech32Address,
+			Data:    outOfGasReturnData + "@" + hex.EncodeToString([]byte("outOfGas")),
+		}),
+	}
+}
+
+func createTestBlocks() map[uint64]*api.Block {
+	moveBalanceTx := &transaction.Transaction{
+		Value:    big.NewInt(1),
+		SndAddr:  senderAddress,
+		RcvAddr:  userAddress,
+		GasPrice: 1000000000,
+		GasLimit: 57500,
+		Data:     []byte("hello"),
+	}
+	moveBalanceWithRefundTx := &transaction.Transaction{
+		Value:    big.NewInt(1),
+		SndAddr:  senderAddress,
+		RcvAddr:  userAddress,
+		GasPrice: 1000000000,
+		GasLimit: 100000,
+		Data:     []byte("hello"),
+	}
+	esdtTransferTx := &transaction.Transaction{
+		Value:    big.NewInt(0),
+		SndAddr:  senderAddress,
+		RcvAddr:  userAddress,
+		GasPrice: 1000000000,
+		GasLimit: 500000,
+		Data:     []byte("ESDTTransfer@544f4b454e@0a"),
+	}
+	scCallTx := &transaction.Transaction{
+		Nonce:    7,
+		Value:    big.NewInt(0),
+		SndAddr:  senderAddress,
+		RcvAddr:  contractAddress,
+		GasPrice: 1000000000,
+		GasLimit: 1000000,
+		Data:     []byte("add@01"),
+	}
+	outOfGasTx := &transaction.Transaction{
+		Nonce:    8,
+		Value:    big.NewInt(0),
+		SndAddr:  senderAddress,
+		RcvAddr:  contractAddress,
+		GasPrice: 1000000000,
+		GasLimit: 100000,
+		Data:     []byte("add@01"),
+	}
+	crossShardSCCallTx := &transaction.Transaction{
+		Nonce:    9,
+		Value:    big.NewInt(0),
+		SndAddr:  senderAddress,
+		RcvAddr:  otherShardContractAddress,
+		GasPrice: 1000000000,
+		GasLimit: 1000000,
+		Data:     []byte("add@01"),
+	}
+	crossShardTx := &transaction.Transaction{
+		Value:    big.NewInt(0),
+		SndAddr:  userAddress,
+		RcvAddr:  senderAddress,
+		GasPrice: 1000000000,
+		GasLimit: 50000,
+	}
+	invalidTx := &transaction.Transaction{
+		Value:    big.NewInt(1000),
+		SndAddr:  userAddress,
+		RcvAddr:  senderAddress,
+		GasPrice: 1000000000,
+		GasLimit: 50000,
+	}
+
+	return map[uint64]*api.Block{
+		1: {
+			Nonce: 1,
+			Shard: 0,
+			MiniBlocks: []*api.MiniBlock{
+				{
+					SourceShard:      0,
+					DestinationShard: 0,
+					Transactions: []*transaction.ApiTransactionResult{
+						createAPITransaction("moveBalance", transaction.TxTypeNormal, moveBalanceTx),
+						createAPITransaction("moveBalanceWithRefund", transaction.TxTypeNormal, moveBalanceWithRefundTx),
+						createAPITransaction("esdtTransfer", transaction.TxTypeNormal, esdtTransferTx),
+						createAPITransaction("scCall", transaction.TxTypeNormal, scCallTx),
+						createAPITransaction("outOfGas", transaction.TxTypeNormal, outOfGasTx),
+						createAPITransaction("crossShardSCCall", transaction.TxTypeNormal, crossShardSCCallTx),
+					},
+				},
+				{
+					SourceShard:      1,
+					DestinationShard: 0,
+					Transactions: []*transaction.ApiTransactionResult{
+						createAPITransaction("crossShard", transaction.TxTypeNormal, crossShardTx),
+					},
+				},
+			},
+		},
+		2: {
+			Nonce: 2,
+			Shard: 0,
+			MiniBlocks: []*api.MiniBlock{
+				{
+					SourceShard:      0,
+					DestinationShard: 0,
+					Transactions: []*transaction.ApiTransactionResult{
+						createAPITransaction("invalid", transaction.TxTypeInvalid, invalidTx),
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewEconomicsDryRunProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil economics config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEconomicsDryRunProcessor(t)
+		args.EconomicsConfig = nil
+
+		edr, err := NewEconomicsDryRunProcessor(args)
+		assert.Equal(t, ErrNilEconomicsConfig, err)
+		assert.True(t, check.IfNil(edr))
+	})
+	t.Run("empty gas schedule config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEconomicsDryRunProcessor(t)
+		args.GasScheduleConfig = config.GasScheduleConfig{}
+
+		edr, err := NewEconomicsDryRunProcessor(args)
+		assert.Equal(t, process.ErrNilGasSchedule, err)
+		assert.True(t, check.IfNil(edr))
+	})
+	t.Run("invalid max blocks per run should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEconomicsDryRunProcessor(t)
+		args.Config.MaxBlocksPerRun = 0
+
+		edr, err := NewEconomicsDryRunProcessor(args)
+		assert.Equal(t, ErrInvalidMaxBlocksPerRun, err)
+		assert.True(t, check.IfNil(edr))
+	})
+	t.Run("nil api block handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEconomicsDryRunProcessor(t)
+		args.APIBlockHandler = nil
+
+		edr, err := NewEconomicsDryRunProcessor(args)
+		assert.Equal(t, ErrNilAPIBlockHandler, err)
+		assert.True(t, check.IfNil(edr))
+	})
+	t.Run("nil api internal block handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEconomicsDryRunProcessor(t)
+		args.APIInternalBlockHandler = nil
+
+		edr, err := NewEconomicsDryRunProcessor(args)
+		assert.Equal(t, ErrNilAPIInternalBlockHandler, err)
+		assert.True(t, check.IfNil(edr))
+	})
+	t.Run("nil contract calls executor creator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEconomicsDryRunProcessor(t)
+		args.ContractCallsExecutorCreator = nil
+
+		edr, err := NewEconomicsDryRunProcessor(args)
+		assert.Equal(t, ErrNilContractCallsExecutorCreator, err)
+		assert.True(t, check.IfNil(edr))
+	})
+	t.Run("nil api transaction handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEconomicsDryRunProcessor(t)
+		args.APITransactionHandler = nil
+
+		edr, err := NewEconomicsDryRunProcessor(args)
+		assert.Equal(t, ErrNilAPITransactionHandler, err)
+		assert.True(t, check.IfNil(edr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		edr, err := NewEconomicsDryRunProcessor(createMockArgsEconomicsDryRunProcessor(t))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(edr))
+	})
+}
+
+func TestEconomicsDryRunProcessor_DryRunInvalidRangesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	edr, _ := NewEconomicsDryRunProcessor(createMockArgsEconomicsDryRunProcessor(t))
+
+	result, err := edr.DryRun(5, 4)
+	assert.Nil(t, result)
+	assert.Equal(t, ErrInvalidNonceRange, err)
+
+	result, err = edr.DryRun(5, 15)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, ErrTooManyBlocks))
+
+	result, err = edr.DryRun(1, ^uint64(0))
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, ErrTooManyBlocks))
+}
+
+func TestEconomicsDryRunProcessor_DryRunShouldErrIfTheAlternativeConfigCanNotBeLoaded(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsEconomicsDryRunProcessor(t)
+	args.Config.AlternativeEconomicsFile = filepath.Join(t.TempDir(), "missing.toml")
+	edr, _ := NewEconomicsDryRunProcessor(args)
+
+	result, err := edr.DryRun(1, 2)
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+
+	args = createMockArgsEconomicsDryRunProcessor(t)
+	args.Config.AlternativeGasScheduleFile = filepath.Join(t.TempDir(), "missing.toml")
+	edr, _ = NewEconomicsDryRunProcessor(args)
+
+	result, err = edr.DryRun(1, 2)
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+}
+
+func TestEconomicsDryRunProcessor_DryRunShouldErrIfABlockIsMissing(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsEconomicsDryRunProcessor(t)
+	args.APIBlockHandler = &mock.BlockAPIHandlerStub{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			return nil, expectedErr
+		},
+	}
+	edr, _ := NewEconomicsDryRunProcessor(args)
+
+	result, err := edr.DryRun(1, 2)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestEconomicsDryRunProcessor_DryRunShouldErrIfTheTransactionResultsCanNotBeRead(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	blocks := createTestBlocks()
+	args := createMockArgsEconomicsDryRunProcessor(t)
+	args.APIBlockHandler = &mock.BlockAPIHandlerStub{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			return blocks[nonce], nil
+		},
+	}
+	args.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			return nil, expectedErr
+		},
+	}
+	edr, _ := NewEconomicsDryRunProcessor(args)
+
+	result, err := edr.DryRun(1, 2)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestEconomicsDryRunProcessor_DryRunShouldErrIfAContractCallCanNotBeExecuted(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	blocks := createTestBlocks()
+	args := createMockArgsEconomicsDryRunProcessor(t)
+	args.APIBlockHandler = &mock.BlockAPIHandlerStub{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			return blocks[nonce], nil
+		},
+	}
+	args.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			return createAPITransactionWithResults(0, nil), nil
+		},
+	}
+	args.APIInternalBlockHandler = &mock.InternalBlockApiHandlerStub{
+		GetInternalShardBlockByNonceCalled: func(format common.ApiOutputFormat, nonce uint64) (interface{}, error) {
+			return nil, expectedErr
+		},
+	}
+	args.ContractCallsExecutorCreator = &contractCallsExecutorCreatorStub{
+		CreateContractCallsExecutorCalled: func(gasSchedule core.GasScheduleNotifier, economics process.FeeHandler, epochNotifier process.EpochNotifier) (ContractCallsExecutor, error) {
+			return &contractCallsExecutorStub{
+				ExecuteContractCallCalled: func(txHash []byte, tx *transaction.Transaction, gasProvided uint64, blockState BlockStateHandler) (*vmcommon.VMOutput, error) {
+					// the state of the parent block can not be loaded
+					_, err := blockState.GetParentRootHash()
+					return nil, err
+				},
+			}, nil
+		},
+	}
+	edr, _ := NewEconomicsDryRunProcessor(args)
+
+	result, err := edr.DryRun(1, 2)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, expectedErr))
+
+	args.ContractCallsExecutorCreator = &contractCallsExecutorCreatorStub{
+		CreateContractCallsExecutorCalled: func(gasSchedule core.GasScheduleNotifier, economics process.FeeHandler, epochNotifier process.EpochNotifier) (ContractCallsExecutor, error) {
+			return nil, expectedErr
+		},
+	}
+	edr, _ = NewEconomicsDryRunProcessor(args)
+
+	result, err = edr.DryRun(1, 2)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, expectedErr))
+}
+
+func TestEconomicsDryRunProcessor_DryRunShouldEvaluateTheTransactions(t *testing.T) {
+	t.Parallel()
+
+	blocks := createTestBlocks()
+	results := createTestResults()
+	args := createMockArgsEconomicsDryRunProcessor(t)
+	args.APIBlockHandler = &mock.BlockAPIHandlerStub{
+		GetBlockByNonceCalled: func(nonce uint64, withTxs bool) (*api.Block, error) {
+			assert.True(t, withTxs)
+			return blocks[nonce], nil
+		},
+	}
+	args.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+			assert.True(t, withResults)
+			txWithResults, found := results[hash]
+			if !found {
+				return createAPITransactionWithResults(0, nil), nil
+			}
+
+			return txWithResults, nil
+		},
+	}
+	args.APIInternalBlockHandler = &mock.InternalBlockApiHandlerStub{
+		GetInternalShardBlockByNonceCalled: func(format common.ApiOutputFormat, nonce uint64) (interface{}, error) {
+			return &block.Header{Nonce: nonce, RootHash: []byte(fmt.Sprintf("rootHash%d", nonce))}, nil
+		},
+	}
+	executorClosed := false
+	executor := &contractCallsExecutorStub{
+		ExecuteContractCallCalled: func(txHash []byte, tx *transaction.Transaction, gasProvided uint64, blockState BlockStateHandler) (*vmcommon.VMOutput, error) {
+			switch string(txHash) {
+			case "scCall":
+				header, err := blockState.GetHeader()
+				require.Nil(t, err)
+				assert.Equal(t, uint64(1), header.GetNonce())
+				rootHash, err := blockState.GetParentRootHash()
+				require.Nil(t, err)
+				assert.Equal(t, []byte("rootHash0"), rootHash)
+
+				// the alternative move balance cost of the 6 data bytes is deducted from the gas limit
+				assert.Equal(t, uint64(1000000-68000), gasProvided)
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: gasProvided - 100000}, nil
+			case "outOfGas":
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.OutOfGas}, nil
+			case "crossShardSCCall":
+				return nil, ErrContractNotInShard
+			default:
+				return nil, ErrNotAContractCall
+			}
+		},
+		CloseCalled: func() error {
+			executorClosed = true
+			return nil
+		},
+	}
+	args.ContractCallsExecutorCreator = &contractCallsExecutorCreatorStub{
+		CreateContractCallsExecutorCalled: func(gasSchedule core.GasScheduleNotifier, economics process.FeeHandler, epochNotifier process.EpochNotifier) (ContractCallsExecutor, error) {
+			// the VM uses the alternative gas schedule
+			assert.Equal(t, uint64(200000), gasSchedule.LatestGasSchedule()[common.BuiltInCost][core.BuiltInFunctionESDTTransfer])
+			assert.Equal(t, uint64(3000), economics.GasPerDataByte())
+			return executor, nil
+		},
+	}
+	edr, _ := NewEconomicsDryRunProcessor(args)
+
+	result, err := edr.DryRun(1, 2)
+	require.Nil(t, err)
+	assert.True(t, executorClosed)
+	require.Equal(t, uint64(6), result.NumTransactions)
+	assert.Equal(t, uint64(1), result.NumSkipped, "the calls of contracts from other shards should be skipped")
+
+	transactions := make(map[string]*common.EconomicsDryRunTransaction)
+	for _, tx := range result.Transactions {
+		transactions[tx.Hash] = tx
+	}
+	getTransaction := func(name string) *common.EconomicsDryRunTransaction {
+		tx, found := transactions[hex.EncodeToString([]byte(name))]
+		require.True(t, found, name)
+
+		return tx
+	}
+
+	// the alternative gas per data byte makes the provided gas limit insufficient
+	moveBalance := getTransaction("moveBalance")
+	assert.Equal(t, &common.EconomicsDryRunOutcome{GasUsed: 57500, Fee: "57500000000000", Refund: "0"}, moveBalance.Current)
+	assert.Equal(t, &common.EconomicsDryRunOutcome{Fee: "0", Refund: "0", Rejected: true}, moveBalance.Alternative)
+
+	// the refund is read from the stored results of the transaction
+	moveBalanceWithRefund := getTransaction("moveBalanceWithRefund")
+	assert.Equal(t, &common.EconomicsDryRunOutcome{GasUsed: 57500, Fee: "57500000000000", Refund: "425000000000"}, moveBalanceWithRefund.Current)
+	assert.Equal(t, &common.EconomicsDryRunOutcome{GasUsed: 65000, Fee: "65000000000000", Refund: "350000000000"}, moveBalanceWithRefund.Alternative)
+
+	// both the data and the built-in function cost change
+	esdtTransfer := getTransaction("esdtTransfer")
+	assert.Equal(t, &common.EconomicsDryRunOutcome{GasUsed: 339000, Fee: "91500000000000", Refund: "1610000000000"}, esdtTransfer.Current)
+	assert.Equal(t, &common.EconomicsDryRunOutcome{GasUsed: 328000, Fee: "130000000000000", Refund: "1720000000000"}, esdtTransfer.Alternative)
+
+	invalid := getTransaction("invalid")
+	assert.Equal(t, uint64(2), invalid.BlockNonce)
+	assert.Equal(t, &common.EconomicsDryRunOutcome{GasUsed: 50000, Fee: "50000000000000", Refund: "0"}, invalid.Alternative)
+
+	// the contract call executed again consumes 100000 gas in the VM, on top of the alternative move balance cost
+	scCall := getTransaction("scCall")
+	assert.Equal(t, &common.EconomicsDryRunOutcome{GasUsed: 200000, Fee: "60410000000000", Refund: "8000000000000"}, scCall.Current)
+	assert.Equal(t, &common.EconomicsDryRunOutcome{GasUsed: 168000, Fee: "69000000000000", Refund: "8320000000000"}, scCall.Alternative)
+
+	outOfGas := getTransaction("outOfGas")
+	assert.Equal(t, &common.EconomicsDryRunOutcome{GasUsed: 100000, Fee: "59410000000000", Refund: "0", OutOfGas: true}, outOfGas.Current)
+	assert.Equal(t, &common.EconomicsDryRunOutcome{GasUsed: 100000, Fee: "68320000000000", Refund: "0", OutOfGas: true}, outOfGas.Alternative)
+
+	for _, name := range []string{"crossShardSCCall", "crossShard"} {
+		_, found := transactions[hex.EncodeToString([]byte(name))]
+		assert.False(t, found, name)
+	}
+
+	assert.Equal(t, uint64(1), result.Current.NumOutOfGas)
+	assert.Equal(t, uint64(0), result.Current.NumRejected)
+	assert.Equal(t, uint64(1), result.Alternative.NumOutOfGas)
+	assert.Equal(t, uint64(1), result.Alternative.NumRejected)
+	assert.Equal(t, uint64(57500+57500+339000+200000+100000+50000), result.Current.GasUsed)
+	assert.Equal(t, uint64(65000+328000+168000+100000+50000), result.Alternative.GasUsed)
+}
+
+func TestDisabledEconomicsDryRunProcessor(t *testing.T) {
+	t.Parallel()
+
+	dedr := NewDisabledEconomicsDryRunProcessor()
+	assert.False(t, check.IfNil(dedr))
+
+	result, err := dedr.DryRun(1, 2)
+	assert.Nil(t, result)
+	assert.Equal(t, ErrEconomicsDryRunDisabled, err)
+}
@@ -0,0 +1,40 @@
+package economicsDryRun
+
+import "errors"
+
+// ErrNilEconomicsConfig signals that a nil economics config has been provided
+var ErrNilEconomicsConfig = errors.New("nil economics config")
+
+// ErrNilAPIBlockHandler signals that a nil api block handler has been provided
+var ErrNilAPIBlockHandler = errors.New("nil api block handler")
+
+// ErrInvalidMaxBlocksPerRun signals that an invalid maximum number of blocks per run has been provided
+var ErrInvalidMaxBlocksPerRun = errors.New("invalid maximum number of blocks per run")
+
+// ErrInvalidNonceRange signals that the start nonce is higher than the end nonce
+var ErrInvalidNonceRange = errors.New("invalid nonce range")
+
+// ErrTooManyBlocks signals that the requested nonce range holds more blocks than allowed for a run
+var ErrTooManyBlocks = errors.New("too many blocks requested")
+
+// ErrEconomicsDryRunDisabled signals that the economics dry-run is disabled
+var ErrEconomicsDryRunDisabled = errors.New("economics dry-run is disabled")
+
+// ErrNilAPITransactionHandler signals that a nil api transaction handler has been provided
+var ErrNilAPITransactionHandler = errors.New("nil api transaction handler")
+
+// ErrTransactionsResultsNotIndexed signals that the transactions results can not be read as the DbLookupExtensions
+// are disabled
+var ErrTransactionsResultsNotIndexed = errors.New("the transactions results are not indexed, the DbLookupExtensions should be enabled")
+
+// ErrNilAPIInternalBlockHandler signals that a nil api internal block handler has been provided
+var ErrNilAPIInternalBlockHandler = errors.New("nil api internal block handler")
+
+// ErrNilContractCallsExecutorCreator signals that a nil contract calls executor creator has been provided
+var ErrNilContractCallsExecutorCreator = errors.New("nil contract calls executor creator")
+
+// ErrNotAContractCall signals that the transaction does not call contract code
+var ErrNotAContractCall = errors.New("the transaction does not call contract code")
+
+// ErrContractNotInShard signals that the called contract is in another shard, whose state is not available
+var ErrContractNotInShard = errors.New("the called contract is in another shard")
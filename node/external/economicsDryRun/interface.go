@@ -0,0 +1,37 @@
+package economicsDryRun
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ContractCallsExecutor executes again the contract code called by the transactions, without saving the state changes
+type ContractCallsExecutor interface {
+	ExecuteContractCall(
+		txHash []byte,
+		tx *transaction.Transaction,
+		gasProvided uint64,
+		blockState BlockStateHandler,
+	) (*vmcommon.VMOutput, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// ContractCallsExecutorCreator creates, for each run, the contract calls executor using the alternative gas schedule
+type ContractCallsExecutorCreator interface {
+	CreateContractCallsExecutor(
+		gasSchedule core.GasScheduleNotifier,
+		economics process.FeeHandler,
+		epochNotifier process.EpochNotifier,
+	) (ContractCallsExecutor, error)
+	IsInterfaceNil() bool
+}
+
+// BlockStateHandler provides the header of an evaluated block and the state root hash of its parent block
+type BlockStateHandler interface {
+	GetHeader() (data.HeaderHandler, error)
+	GetParentRootHash() ([]byte, error)
+}
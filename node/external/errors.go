@@ -20,6 +20,21 @@ var ErrNilDirectStakeListHandler = errors.New("nil direct stake list handler")
 // ErrNilDelegatedListHandler signals that a nil delegated list handler has been provided
 var ErrNilDelegatedListHandler = errors.New("nil delegated list handler")
 
+// ErrNilGovernanceHandler signals that a nil governance handler has been provided
+var ErrNilGovernanceHandler = errors.New("nil governance handler")
+
+// ErrNilDelegationViewsHandler signals that a nil delegation views handler has been provided
+var ErrNilDelegationViewsHandler = errors.New("nil delegation views handler")
+
+// ErrNilStakingQueueHandler signals that a nil staking queue handler has been provided
+var ErrNilStakingQueueHandler = errors.New("nil staking queue handler")
+
+// ErrNilEconomicsDryRunHandler signals that a nil economics dry-run handler has been provided
+var ErrNilEconomicsDryRunHandler = errors.New("nil economics dry-run handler")
+
+// ErrNilRewardsPreviewHandler signals that a nil rewards preview handler has been provided
+var ErrNilRewardsPreviewHandler = errors.New("nil rewards preview handler")
+
 // ErrNilVmContainer signals that a nil vm container has been provided
 var ErrNilVmContainer = errors.New("nil vm container")
 
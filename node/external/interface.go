@@ -55,10 +55,49 @@ type DelegatedListHandler interface {
 	IsInterfaceNil() bool
 }
 
+// GovernanceHandler defines the behavior of a component able to return the governance proposals, their votes and
+// the voting power of an address
+type GovernanceHandler interface {
+	GetProposals(ctx context.Context) ([]*common.GovernanceProposal, error)
+	GetProposal(reference string) (*common.GovernanceProposal, error)
+	GetVotingPower(address string) (*common.GovernanceVotingPower, error)
+	IsInterfaceNil() bool
+}
+
+// DelegationViewsHandler defines the behavior of a component able to return the aggregated delegation data of a user
+// or of a delegation contract
+type DelegationViewsHandler interface {
+	GetUserDelegations(address string) ([]*common.UserDelegation, error)
+	GetDelegationProvider(contract string) (*common.DelegationProvider, error)
+	IsInterfaceNil() bool
+}
+
+// StakingQueueHandler defines the behavior of a component able to return the staking queue and the staking lifecycle
+// view of a node
+type StakingQueueHandler interface {
+	GetStakingQueue(from uint32, size uint32) (*common.StakingQueue, error)
+	GetStakingNode(blsKey string) (*common.StakingNode, error)
+	IsInterfaceNil() bool
+}
+
+// EconomicsDryRunHandler defines the behavior of a component able to evaluate the already executed transactions
+// under an alternative economics and gas schedule configuration
+type EconomicsDryRunHandler interface {
+	DryRun(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error)
+	IsInterfaceNil() bool
+}
+
+// RewardsPreviewHandler defines the behavior of a component able to estimate the rewards of the in-progress epoch
+type RewardsPreviewHandler interface {
+	ComputeRewardsPreview() (*common.RewardsPreview, error)
+	IsInterfaceNil() bool
+}
+
 // APITransactionHandler defines what an API transaction handler should be able to do
 type APITransactionHandler interface {
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPool() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionInclusionProof(txHash string) (*common.TransactionInclusionProof, error)
 	UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	UnmarshalReceipt(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	IsInterfaceNil() bool
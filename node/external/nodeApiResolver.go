@@ -24,6 +24,11 @@ type ArgNodeApiResolver struct {
 	TotalStakedValueHandler  TotalStakedValueHandler
 	DirectStakedListHandler  DirectStakedListHandler
 	DelegatedListHandler     DelegatedListHandler
+	GovernanceHandler        GovernanceHandler
+	DelegationViewsHandler   DelegationViewsHandler
+	StakingQueueHandler      StakingQueueHandler
+	EconomicsDryRunHandler   EconomicsDryRunHandler
+	RewardsPreviewHandler    RewardsPreviewHandler
 	APITransactionHandler    APITransactionHandler
 	APIBlockHandler          blockAPI.APIBlockHandler
 	APIInternalBlockHandler  blockAPI.APIInternalBlockHandler
@@ -39,6 +44,11 @@ type nodeApiResolver struct {
 	totalStakedValueHandler  TotalStakedValueHandler
 	directStakedListHandler  DirectStakedListHandler
 	delegatedListHandler     DelegatedListHandler
+	governanceHandler        GovernanceHandler
+	delegationViewsHandler   DelegationViewsHandler
+	stakingQueueHandler      StakingQueueHandler
+	economicsDryRunHandler   EconomicsDryRunHandler
+	rewardsPreviewHandler    RewardsPreviewHandler
 	apiTransactionHandler    APITransactionHandler
 	apiBlockHandler          blockAPI.APIBlockHandler
 	apiInternalBlockHandler  blockAPI.APIInternalBlockHandler
@@ -66,6 +76,21 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.DelegatedListHandler) {
 		return nil, ErrNilDelegatedListHandler
 	}
+	if check.IfNil(arg.GovernanceHandler) {
+		return nil, ErrNilGovernanceHandler
+	}
+	if check.IfNil(arg.DelegationViewsHandler) {
+		return nil, ErrNilDelegationViewsHandler
+	}
+	if check.IfNil(arg.StakingQueueHandler) {
+		return nil, ErrNilStakingQueueHandler
+	}
+	if check.IfNil(arg.EconomicsDryRunHandler) {
+		return nil, ErrNilEconomicsDryRunHandler
+	}
+	if check.IfNil(arg.RewardsPreviewHandler) {
+		return nil, ErrNilRewardsPreviewHandler
+	}
 	if check.IfNil(arg.APITransactionHandler) {
 		return nil, ErrNilAPITransactionHandler
 	}
@@ -89,6 +114,11 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		totalStakedValueHandler:  arg.TotalStakedValueHandler,
 		directStakedListHandler:  arg.DirectStakedListHandler,
 		delegatedListHandler:     arg.DelegatedListHandler,
+		governanceHandler:        arg.GovernanceHandler,
+		delegationViewsHandler:   arg.DelegationViewsHandler,
+		stakingQueueHandler:      arg.StakingQueueHandler,
+		economicsDryRunHandler:   arg.EconomicsDryRunHandler,
+		rewardsPreviewHandler:    arg.RewardsPreviewHandler,
 		apiBlockHandler:          arg.APIBlockHandler,
 		apiTransactionHandler:    arg.APITransactionHandler,
 		apiInternalBlockHandler:  arg.APIInternalBlockHandler,
@@ -132,6 +162,53 @@ func (nar *nodeApiResolver) GetDelegatorsList(ctx context.Context) ([]*api.Deleg
 	return nar.delegatedListHandler.GetDelegatorsList(ctx)
 }
 
+// GetGovernanceProposals will return all the governance proposals
+func (nar *nodeApiResolver) GetGovernanceProposals(ctx context.Context) ([]*common.GovernanceProposal, error) {
+	return nar.governanceHandler.GetProposals(ctx)
+}
+
+// GetGovernanceProposal will return the governance proposal with the provided reference, together with its votes
+func (nar *nodeApiResolver) GetGovernanceProposal(reference string) (*common.GovernanceProposal, error) {
+	return nar.governanceHandler.GetProposal(reference)
+}
+
+// GetGovernanceVotingPower will return the governance voting power of the provided address
+func (nar *nodeApiResolver) GetGovernanceVotingPower(address string) (*common.GovernanceVotingPower, error) {
+	return nar.governanceHandler.GetVotingPower(address)
+}
+
+// GetUserDelegations will return the funds and the rewards of the provided address in all the delegation contracts
+func (nar *nodeApiResolver) GetUserDelegations(address string) ([]*common.UserDelegation, error) {
+	return nar.delegationViewsHandler.GetUserDelegations(address)
+}
+
+// GetDelegationProvider will return the configuration, the nodes and the staked values of a delegation contract
+func (nar *nodeApiResolver) GetDelegationProvider(contract string) (*common.DelegationProvider, error) {
+	return nar.delegationViewsHandler.GetDelegationProvider(contract)
+}
+
+// GetStakingQueue will return a page of the nodes from the staking queue, in the order they will be staked
+func (nar *nodeApiResolver) GetStakingQueue(from uint32, size uint32) (*common.StakingQueue, error) {
+	return nar.stakingQueueHandler.GetStakingQueue(from, size)
+}
+
+// GetStakingNode will return the staking lifecycle view of the node with the provided BLS key
+func (nar *nodeApiResolver) GetStakingNode(blsKey string) (*common.StakingNode, error) {
+	return nar.stakingQueueHandler.GetStakingNode(blsKey)
+}
+
+// DryRunEconomics will evaluate the transactions from the provided block nonces range under both the current and the
+// alternative economics and gas schedule configurations
+func (nar *nodeApiResolver) DryRunEconomics(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error) {
+	return nar.economicsDryRunHandler.DryRun(fromNonce, toNonce)
+}
+
+// GetRewardsPreview will return the rewards per node, per owner and per delegation contract estimated as if the
+// current epoch ended at the current block
+func (nar *nodeApiResolver) GetRewardsPreview() (*common.RewardsPreview, error) {
+	return nar.rewardsPreviewHandler.ComputeRewardsPreview()
+}
+
 // GetTransaction will return the transaction with the given hash and optionally with results
 func (nar *nodeApiResolver) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nar.apiTransactionHandler.GetTransaction(hash, withResults)
@@ -142,6 +219,12 @@ func (nar *nodeApiResolver) GetTransactionsPool() (*common.TransactionsPoolAPIRe
 	return nar.apiTransactionHandler.GetTransactionsPool()
 }
 
+// GetTransactionInclusionProof will return the data needed to prove that the transaction with the given hash was
+// included in a block notarized by the metachain
+func (nar *nodeApiResolver) GetTransactionInclusionProof(hash string) (*common.TransactionInclusionProof, error) {
+	return nar.apiTransactionHandler.GetTransactionInclusionProof(hash)
+}
+
 // GetBlockByHash will return the block with the given hash and optionally with transactions
 func (nar *nodeApiResolver) GetBlockByHash(hash string, withTxs bool) (*api.Block, error) {
 	decodedHash, err := hex.DecodeString(hash)
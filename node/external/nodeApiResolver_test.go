@@ -29,6 +29,11 @@ func createMockArgs() external.ArgNodeApiResolver {
 		TotalStakedValueHandler:  &mock.StakeValuesProcessorStub{},
 		DirectStakedListHandler:  &mock.DirectStakedListProcessorStub{},
 		DelegatedListHandler:     &mock.DelegatedListProcessorStub{},
+		GovernanceHandler:        &mock.GovernanceProcessorStub{},
+		DelegationViewsHandler:   &mock.DelegationViewsProcessorStub{},
+		StakingQueueHandler:      &mock.StakingQueueProcessorStub{},
+		EconomicsDryRunHandler:   &mock.EconomicsDryRunHandlerStub{},
+		RewardsPreviewHandler:    &mock.RewardsPreviewHandlerStub{},
 		APIBlockHandler:          &mock.BlockAPIHandlerStub{},
 		APITransactionHandler:    &mock.TransactionAPIHandlerStub{},
 		APIInternalBlockHandler:  &mock.InternalBlockApiHandlerStub{},
@@ -244,6 +249,187 @@ func TestNodeApiResolver_GetDelegatorsList(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNewNodeApiResolver_NilGovernanceHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.GovernanceHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilGovernanceHandler, err)
+}
+
+func TestNewNodeApiResolver_NilDelegationViewsHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.DelegationViewsHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilDelegationViewsHandler, err)
+}
+
+func TestNodeApiResolver_GetDelegationViews(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	delegations := make([]*common.UserDelegation, 1)
+	provider := &common.DelegationProvider{DelegationScAddress: "contract"}
+	arg.DelegationViewsHandler = &mock.DelegationViewsProcessorStub{
+		GetUserDelegationsCalled: func(address string) ([]*common.UserDelegation, error) {
+			assert.Equal(t, "address", address)
+			return delegations, nil
+		},
+		GetDelegationProviderCalled: func(contract string) (*common.DelegationProvider, error) {
+			assert.Equal(t, "contract", contract)
+			return provider, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredDelegations, err := nar.GetUserDelegations("address")
+	assert.Nil(t, err)
+	assert.Equal(t, delegations, recoveredDelegations)
+
+	recoveredProvider, err := nar.GetDelegationProvider("contract")
+	assert.Nil(t, err)
+	assert.Equal(t, provider, recoveredProvider)
+}
+
+func TestNewNodeApiResolver_NilStakingQueueHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.StakingQueueHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilStakingQueueHandler, err)
+}
+
+func TestNodeApiResolver_GetStakingQueueAndNode(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	queue := &common.StakingQueue{Size: 1}
+	node := &common.StakingNode{BLSKey: "abcd"}
+	arg.StakingQueueHandler = &mock.StakingQueueProcessorStub{
+		GetStakingQueueCalled: func(from uint32, size uint32) (*common.StakingQueue, error) {
+			assert.Equal(t, uint32(2), from)
+			assert.Equal(t, uint32(5), size)
+			return queue, nil
+		},
+		GetStakingNodeCalled: func(blsKey string) (*common.StakingNode, error) {
+			assert.Equal(t, "abcd", blsKey)
+			return node, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredQueue, err := nar.GetStakingQueue(2, 5)
+	assert.Nil(t, err)
+	assert.Equal(t, queue, recoveredQueue)
+
+	recoveredNode, err := nar.GetStakingNode("abcd")
+	assert.Nil(t, err)
+	assert.Equal(t, node, recoveredNode)
+}
+
+func TestNewNodeApiResolver_NilEconomicsDryRunHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.EconomicsDryRunHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilEconomicsDryRunHandler, err)
+}
+
+func TestNodeApiResolver_DryRunEconomics(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	result := &common.EconomicsDryRunResult{FromNonce: 5, ToNonce: 7}
+	arg.EconomicsDryRunHandler = &mock.EconomicsDryRunHandlerStub{
+		DryRunCalled: func(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error) {
+			assert.Equal(t, uint64(5), fromNonce)
+			assert.Equal(t, uint64(7), toNonce)
+			return result, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredResult, err := nar.DryRunEconomics(5, 7)
+	assert.Nil(t, err)
+	assert.Equal(t, result, recoveredResult)
+}
+
+func TestNewNodeApiResolver_NilRewardsPreviewHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.RewardsPreviewHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilRewardsPreviewHandler, err)
+}
+
+func TestNodeApiResolver_GetRewardsPreview(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	preview := &common.RewardsPreview{Epoch: 37}
+	arg.RewardsPreviewHandler = &mock.RewardsPreviewHandlerStub{
+		ComputeRewardsPreviewCalled: func() (*common.RewardsPreview, error) {
+			return preview, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredPreview, err := nar.GetRewardsPreview()
+	assert.Nil(t, err)
+	assert.Equal(t, preview, recoveredPreview)
+}
+
+func TestNodeApiResolver_GetGovernanceProposals(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	proposals := make([]*common.GovernanceProposal, 1)
+	proposal := &common.GovernanceProposal{Reference: "reference"}
+	votingPower := &common.GovernanceVotingPower{Address: "address"}
+	arg.GovernanceHandler = &mock.GovernanceProcessorStub{
+		GetProposalsCalled: func(_ context.Context) ([]*common.GovernanceProposal, error) {
+			return proposals, nil
+		},
+		GetProposalCalled: func(reference string) (*common.GovernanceProposal, error) {
+			assert.Equal(t, "reference", reference)
+			return proposal, nil
+		},
+		GetVotingPowerCalled: func(address string) (*common.GovernanceVotingPower, error) {
+			assert.Equal(t, "address", address)
+			return votingPower, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	recoveredProposals, err := nar.GetGovernanceProposals(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, proposals, recoveredProposals)
+
+	recoveredProposal, err := nar.GetGovernanceProposal("reference")
+	assert.Nil(t, err)
+	assert.Equal(t, proposal, recoveredProposal)
+
+	recoveredVotingPower, err := nar.GetGovernanceVotingPower("address")
+	assert.Nil(t, err)
+	assert.Equal(t, votingPower, recoveredVotingPower)
+}
+
 func TestNodeApiResolver_GetDirectStakedList(t *testing.T) {
 	t.Parallel()
 
@@ -13,3 +13,18 @@ var ErrNilStatusComputer = errors.New("nil transaction status computer")
 
 // ErrNilAPITransactionProcessorArg signals that a nil arguments structure has been provided
 var ErrNilAPITransactionProcessorArg = errors.New("nil api transaction processor arg")
+
+// ErrDBLookupExtensionIsNotEnabled signals that the db lookup extension is not enabled
+var ErrDBLookupExtensionIsNotEnabled = errors.New("db lookup extension is not enabled")
+
+// ErrTransactionNotFoundInMiniBlock signals that a transaction was not found in its miniblock
+var ErrTransactionNotFoundInMiniBlock = errors.New("transaction not found in miniblock")
+
+// ErrBlockNotYetNotarized signals that the block containing the transaction was not yet notarized by the metachain
+var ErrBlockNotYetNotarized = errors.New("block not yet notarized by the metachain")
+
+// ErrNilInclusionProof signals that a nil inclusion proof has been provided
+var ErrNilInclusionProof = errors.New("nil inclusion proof")
+
+// ErrInvalidInclusionProof signals that an invalid inclusion proof has been provided
+var ErrInvalidInclusionProof = errors.New("invalid inclusion proof")
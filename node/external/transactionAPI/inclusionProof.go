@@ -0,0 +1,116 @@
+package transactionAPI
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+)
+
+// GetTransactionInclusionProof will return the data needed to prove that the transaction with the provided hash
+// was included in a block notarized by the metachain. It requires the history repository (dblookupext) to be enabled
+func (atp *apiTransactionProcessor) GetTransactionInclusionProof(txHash string) (*common.TransactionInclusionProof, error) {
+	if !atp.historyRepository.IsEnabled() {
+		return nil, ErrDBLookupExtensionIsNotEnabled
+	}
+
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	miniblockMetadata, err := atp.historyRepository.GetMiniblockMetadataByTxHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrTransactionNotFound.Error(), err)
+	}
+
+	miniBlockBytes, err := atp.getFromStorerByEpoch(dataRetriever.MiniBlockUnit, miniblockMetadata.MiniblockHash, miniblockMetadata.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w for miniblock %s", err, hex.EncodeToString(miniblockMetadata.MiniblockHash))
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = atp.marshalizer.Unmarshal(miniBlock, miniBlockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	txIndex, found := getTxIndexInMiniBlock(hash, miniBlock)
+	if !found {
+		return nil, ErrTransactionNotFoundInMiniBlock
+	}
+
+	selfShardID := atp.shardCoordinator.SelfId()
+	headerUnit := dataRetriever.BlockHeaderUnit
+	if selfShardID == core.MetachainShardId {
+		headerUnit = dataRetriever.MetaBlockUnit
+	}
+
+	headerBytes, err := atp.getFromStorerByEpoch(headerUnit, miniblockMetadata.HeaderHash, miniblockMetadata.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w for header %s", err, hex.EncodeToString(miniblockMetadata.HeaderHash))
+	}
+
+	proof := &common.TransactionInclusionProof{
+		TxHash:        hash,
+		TxIndex:       txIndex,
+		MiniBlock:     miniBlockBytes,
+		MiniBlockHash: miniblockMetadata.MiniblockHash,
+		HeaderShardID: selfShardID,
+		Header:        headerBytes,
+		HeaderHash:    miniblockMetadata.HeaderHash,
+	}
+	if selfShardID == core.MetachainShardId {
+		return proof, nil
+	}
+
+	metaBlockNonce, metaBlockHash := getNotarizingMetaBlock(miniblockMetadata, selfShardID)
+	if len(metaBlockHash) == 0 {
+		return nil, ErrBlockNotYetNotarized
+	}
+
+	metaBlockBytes, err := atp.storageService.GetStorer(dataRetriever.MetaBlockUnit).SearchFirst(metaBlockHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for metablock %s", err, hex.EncodeToString(metaBlockHash))
+	}
+
+	proof.MetaBlock = metaBlockBytes
+	proof.MetaBlockHash = metaBlockHash
+	proof.MetaBlockNonce = metaBlockNonce
+
+	return proof, nil
+}
+
+func (atp *apiTransactionProcessor) getFromStorerByEpoch(unit dataRetriever.UnitType, key []byte, epoch uint32) ([]byte, error) {
+	storer := atp.storageService.GetStorer(unit)
+	buff, err := storer.GetFromEpoch(key, epoch)
+	if err == nil {
+		return buff, nil
+	}
+
+	return storer.SearchFirst(key)
+}
+
+func getTxIndexInMiniBlock(txHash []byte, miniBlock *block.MiniBlock) (uint32, bool) {
+	for index, hash := range miniBlock.TxHashes {
+		if bytes.Equal(hash, txHash) {
+			return uint32(index), true
+		}
+	}
+
+	return 0, false
+}
+
+// getNotarizingMetaBlock returns the metablock that notarized the block of the self shard which contains the miniblock
+func getNotarizingMetaBlock(miniblockMetadata *dblookupext.MiniblockMetadata, selfShardID uint32) (uint64, []byte) {
+	if miniblockMetadata.DestinationShardID == selfShardID {
+		return miniblockMetadata.NotarizedAtDestinationInMetaNonce, miniblockMetadata.NotarizedAtDestinationInMetaHash
+	}
+
+	return miniblockMetadata.NotarizedAtSourceInMetaNonce, miniblockMetadata.NotarizedAtSourceInMetaHash
+}
@@ -0,0 +1,138 @@
+package transactionAPI
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+// VerifyTransactionInclusionProof verifies the provided transaction inclusion proof. It only relies on the data
+// contained in the proof, so the caller only has to trust the hash of the notarizing metablock (or of the header,
+// for metachain transactions), which can be checked against an independently synchronized chain of metablocks
+func VerifyTransactionInclusionProof(
+	proof *common.TransactionInclusionProof,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) error {
+	if proof == nil {
+		return ErrNilInclusionProof
+	}
+	if check.IfNil(marshalizer) {
+		return process.ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return process.ErrNilHasher
+	}
+
+	err := verifyTxInMiniBlock(proof, marshalizer, hasher)
+	if err != nil {
+		return err
+	}
+
+	header, err := verifyMiniBlockInHeader(proof, marshalizer, hasher)
+	if err != nil {
+		return err
+	}
+	if proof.HeaderShardID == core.MetachainShardId {
+		return nil
+	}
+
+	return verifyHeaderInMetaBlock(proof, header, marshalizer, hasher)
+}
+
+func verifyTxInMiniBlock(proof *common.TransactionInclusionProof, marshalizer marshal.Marshalizer, hasher hashing.Hasher) error {
+	if !bytes.Equal(hasher.Compute(string(proof.MiniBlock)), proof.MiniBlockHash) {
+		return fmt.Errorf("%w: miniblock hash mismatch", ErrInvalidInclusionProof)
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err := marshalizer.Unmarshal(miniBlock, proof.MiniBlock)
+	if err != nil {
+		return err
+	}
+
+	if int(proof.TxIndex) >= len(miniBlock.TxHashes) {
+		return fmt.Errorf("%w: transaction index out of range", ErrInvalidInclusionProof)
+	}
+	if !bytes.Equal(miniBlock.TxHashes[proof.TxIndex], proof.TxHash) {
+		return fmt.Errorf("%w: transaction not found in miniblock", ErrInvalidInclusionProof)
+	}
+
+	return nil
+}
+
+func verifyMiniBlockInHeader(
+	proof *common.TransactionInclusionProof,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (data.HeaderHandler, error) {
+	if !bytes.Equal(hasher.Compute(string(proof.Header)), proof.HeaderHash) {
+		return nil, fmt.Errorf("%w: header hash mismatch", ErrInvalidInclusionProof)
+	}
+
+	header, err := unmarshalHeader(proof.HeaderShardID, proof.Header, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, miniBlockHash := range header.GetMiniBlockHeadersHashes() {
+		if bytes.Equal(miniBlockHash, proof.MiniBlockHash) {
+			return header, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: miniblock not found in header", ErrInvalidInclusionProof)
+}
+
+func verifyHeaderInMetaBlock(
+	proof *common.TransactionInclusionProof,
+	header data.HeaderHandler,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) error {
+	if !bytes.Equal(hasher.Compute(string(proof.MetaBlock)), proof.MetaBlockHash) {
+		return fmt.Errorf("%w: metablock hash mismatch", ErrInvalidInclusionProof)
+	}
+
+	metaBlock := &block.MetaBlock{}
+	err := marshalizer.Unmarshal(metaBlock, proof.MetaBlock)
+	if err != nil {
+		return err
+	}
+	if metaBlock.GetNonce() != proof.MetaBlockNonce {
+		return fmt.Errorf("%w: metablock nonce mismatch", ErrInvalidInclusionProof)
+	}
+
+	for _, shardData := range metaBlock.GetShardInfoHandlers() {
+		isNotarizedHeader := shardData.GetShardID() == header.GetShardID() &&
+			shardData.GetNonce() == header.GetNonce() &&
+			bytes.Equal(shardData.GetHeaderHash(), proof.HeaderHash)
+		if isNotarizedHeader {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: header not notarized in metablock", ErrInvalidInclusionProof)
+}
+
+func unmarshalHeader(shardID uint32, headerBytes []byte, marshalizer marshal.Marshalizer) (data.HeaderHandler, error) {
+	if shardID == core.MetachainShardId {
+		metaBlock := &block.MetaBlock{}
+		err := marshalizer.Unmarshal(metaBlock, headerBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return metaBlock, nil
+	}
+
+	return process.CreateShardHeader(marshalizer, headerBytes)
+}
@@ -0,0 +1,283 @@
+package transactionAPI
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	dblookupextMock "github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type inclusionProofTestData struct {
+	txHash         []byte
+	miniBlockHash  []byte
+	headerHash     []byte
+	metaBlockHash  []byte
+	metaBlockNonce uint64
+	storers        map[dataRetriever.UnitType]storage.Storer
+}
+
+func createInclusionProofTestData(t *testing.T, marshalizer marshal.Marshalizer) *inclusionProofTestData {
+	hasher := sha256.NewSha256()
+	epoch := uint32(3)
+	storers := map[dataRetriever.UnitType]storage.Storer{
+		dataRetriever.MiniBlockUnit:   genericMocks.NewStorerMock("MiniBlock", epoch),
+		dataRetriever.BlockHeaderUnit: genericMocks.NewStorerMock("BlockHeader", epoch),
+		dataRetriever.MetaBlockUnit:   genericMocks.NewStorerMock("MetaBlock", epoch),
+	}
+
+	txHash := []byte("tx hash 2")
+	miniBlock := &block.MiniBlock{
+		TxHashes:        [][]byte{[]byte("tx hash 1"), txHash, []byte("tx hash 3")},
+		SenderShardID:   1,
+		ReceiverShardID: 1,
+	}
+	miniBlockBytes, _ := marshalizer.Marshal(miniBlock)
+	miniBlockHash := hasher.Compute(string(miniBlockBytes))
+	require.Nil(t, storers[dataRetriever.MiniBlockUnit].Put(miniBlockHash, miniBlockBytes))
+
+	header := &block.Header{
+		Nonce:   10,
+		ShardID: 1,
+		Epoch:   epoch,
+		MiniBlockHeaders: []block.MiniBlockHeader{
+			{Hash: []byte("other miniblock")},
+			{Hash: miniBlockHash, SenderShardID: 1, ReceiverShardID: 1, TxCount: 3},
+		},
+	}
+	headerBytes, _ := marshalizer.Marshal(header)
+	headerHash := hasher.Compute(string(headerBytes))
+	require.Nil(t, storers[dataRetriever.BlockHeaderUnit].Put(headerHash, headerBytes))
+
+	metaBlock := &block.MetaBlock{
+		Nonce: 11,
+		Epoch: epoch,
+		ShardInfo: []block.ShardData{
+			{ShardID: 0, HeaderHash: []byte("other header"), Nonce: 10},
+			{ShardID: 1, HeaderHash: headerHash, Nonce: 10},
+		},
+	}
+	metaBlockBytes, _ := marshalizer.Marshal(metaBlock)
+	metaBlockHash := hasher.Compute(string(metaBlockBytes))
+	require.Nil(t, storers[dataRetriever.MetaBlockUnit].Put(metaBlockHash, metaBlockBytes))
+
+	return &inclusionProofTestData{
+		txHash:         txHash,
+		miniBlockHash:  miniBlockHash,
+		headerHash:     headerHash,
+		metaBlockHash:  metaBlockHash,
+		metaBlockNonce: metaBlock.Nonce,
+		storers:        storers,
+	}
+}
+
+func createInclusionProofAPITransactionProc(
+	t *testing.T,
+	marshalizer marshal.Marshalizer,
+	testData *inclusionProofTestData,
+	historyRepo *dblookupextMock.HistoryRepositoryStub,
+) *apiTransactionProcessor {
+	args := &ArgAPITransactionProcessor{
+		RoundDuration:          0,
+		GenesisTime:            time.Time{},
+		Marshalizer:            marshalizer,
+		AddressPubKeyConverter: &mock.PubkeyConverterMock{},
+		ShardCoordinator:       createShardCoordinator(),
+		HistoryRepository:      historyRepo,
+		StorageService: &mock.ChainStorerMock{
+			GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+				return testData.storers[unitType]
+			},
+		},
+		DataPool:                 dataRetrieverMock.NewPoolsHolderMock(),
+		Uint64ByteSliceConverter: mock.NewNonceHashConverterMock(),
+	}
+	apiTransactionProc, err := NewAPITransactionProcessor(args)
+	require.Nil(t, err)
+
+	return apiTransactionProc
+}
+
+func createHistoryRepoForInclusionProof(testData *inclusionProofTestData, notarized bool) *dblookupextMock.HistoryRepositoryStub {
+	return &dblookupextMock.HistoryRepositoryStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+			metadata := &dblookupext.MiniblockMetadata{
+				SourceShardID:      1,
+				DestinationShardID: 1,
+				HeaderNonce:        10,
+				HeaderHash:         testData.headerHash,
+				MiniblockHash:      testData.miniBlockHash,
+				Epoch:              3,
+			}
+			if notarized {
+				metadata.NotarizedAtDestinationInMetaNonce = testData.metaBlockNonce
+				metadata.NotarizedAtDestinationInMetaHash = testData.metaBlockHash
+			}
+
+			return metadata, nil
+		},
+	}
+}
+
+func TestApiTransactionProcessor_GetTransactionInclusionProof(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+
+	t.Run("db lookup extension disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		testData := createInclusionProofTestData(t, marshalizer)
+		historyRepo := &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}
+		atp := createInclusionProofAPITransactionProc(t, marshalizer, testData, historyRepo)
+
+		proof, err := atp.GetTransactionInclusionProof("aa")
+		assert.Nil(t, proof)
+		assert.Equal(t, ErrDBLookupExtensionIsNotEnabled, err)
+	})
+	t.Run("block not notarized should error", func(t *testing.T) {
+		t.Parallel()
+
+		testData := createInclusionProofTestData(t, marshalizer)
+		atp := createInclusionProofAPITransactionProc(t, marshalizer, testData, createHistoryRepoForInclusionProof(testData, false))
+
+		proof, err := atp.GetTransactionInclusionProof("747820686173682032")
+		assert.Nil(t, proof)
+		assert.Equal(t, ErrBlockNotYetNotarized, err)
+	})
+	t.Run("transaction not in miniblock should error", func(t *testing.T) {
+		t.Parallel()
+
+		testData := createInclusionProofTestData(t, marshalizer)
+		atp := createInclusionProofAPITransactionProc(t, marshalizer, testData, createHistoryRepoForInclusionProof(testData, true))
+
+		proof, err := atp.GetTransactionInclusionProof("aa")
+		assert.Nil(t, proof)
+		assert.Equal(t, ErrTransactionNotFoundInMiniBlock, err)
+	})
+	t.Run("should work and verify", func(t *testing.T) {
+		t.Parallel()
+
+		testData := createInclusionProofTestData(t, marshalizer)
+		atp := createInclusionProofAPITransactionProc(t, marshalizer, testData, createHistoryRepoForInclusionProof(testData, true))
+
+		proof, err := atp.GetTransactionInclusionProof("747820686173682032")
+		require.Nil(t, err)
+		assert.Equal(t, testData.txHash, proof.TxHash)
+		assert.Equal(t, uint32(1), proof.TxIndex)
+		assert.Equal(t, testData.headerHash, proof.HeaderHash)
+		assert.Equal(t, testData.metaBlockHash, proof.MetaBlockHash)
+		assert.Equal(t, testData.metaBlockNonce, proof.MetaBlockNonce)
+
+		err = VerifyTransactionInclusionProof(proof, marshalizer, sha256.NewSha256())
+		assert.Nil(t, err)
+	})
+}
+
+func TestVerifyTransactionInclusionProof(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := sha256.NewSha256()
+	createValidProof := func(t *testing.T) *common.TransactionInclusionProof {
+		testData := createInclusionProofTestData(t, marshalizer)
+		atp := createInclusionProofAPITransactionProc(t, marshalizer, testData, createHistoryRepoForInclusionProof(testData, true))
+		proof, err := atp.GetTransactionInclusionProof("747820686173682032")
+		require.Nil(t, err)
+
+		return proof
+	}
+
+	t.Run("nil proof should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := VerifyTransactionInclusionProof(nil, marshalizer, hasher)
+		assert.Equal(t, ErrNilInclusionProof, err)
+	})
+	t.Run("different transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		proof := createValidProof(t)
+		proof.TxHash = []byte("tx hash 3")
+
+		err := VerifyTransactionInclusionProof(proof, marshalizer, hasher)
+		assert.True(t, errors.Is(err, ErrInvalidInclusionProof))
+	})
+	t.Run("index out of range should error", func(t *testing.T) {
+		t.Parallel()
+
+		proof := createValidProof(t)
+		proof.TxIndex = 3
+
+		err := VerifyTransactionInclusionProof(proof, marshalizer, hasher)
+		assert.True(t, errors.Is(err, ErrInvalidInclusionProof))
+	})
+	t.Run("tampered miniblock should error", func(t *testing.T) {
+		t.Parallel()
+
+		proof := createValidProof(t)
+		proof.MiniBlockHash = []byte("other miniblock")
+
+		err := VerifyTransactionInclusionProof(proof, marshalizer, hasher)
+		assert.True(t, errors.Is(err, ErrInvalidInclusionProof))
+	})
+	t.Run("header from another chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		proof := createValidProof(t)
+		proof.HeaderHash = []byte("other header")
+
+		err := VerifyTransactionInclusionProof(proof, marshalizer, hasher)
+		assert.True(t, errors.Is(err, ErrInvalidInclusionProof))
+	})
+	t.Run("wrong metablock nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		proof := createValidProof(t)
+		proof.MetaBlockNonce++
+
+		err := VerifyTransactionInclusionProof(proof, marshalizer, hasher)
+		assert.True(t, errors.Is(err, ErrInvalidInclusionProof))
+	})
+	t.Run("metachain header without metablock should work", func(t *testing.T) {
+		t.Parallel()
+
+		miniBlockBytes, _ := marshalizer.Marshal(&block.MiniBlock{TxHashes: [][]byte{[]byte("tx")}})
+		miniBlockHash := hasher.Compute(string(miniBlockBytes))
+		metaBlockBytes, _ := marshalizer.Marshal(&block.MetaBlock{
+			Nonce:            5,
+			MiniBlockHeaders: []block.MiniBlockHeader{{Hash: miniBlockHash}},
+		})
+		proof := &common.TransactionInclusionProof{
+			TxHash:        []byte("tx"),
+			MiniBlock:     miniBlockBytes,
+			MiniBlockHash: miniBlockHash,
+			HeaderShardID: core.MetachainShardId,
+			Header:        metaBlockBytes,
+			HeaderHash:    hasher.Compute(string(metaBlockBytes)),
+		}
+
+		err := VerifyTransactionInclusionProof(proof, marshalizer, hasher)
+		assert.Nil(t, err)
+	})
+}
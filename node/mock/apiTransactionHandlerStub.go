@@ -7,10 +7,11 @@ import (
 
 // TransactionAPIHandlerStub -
 type TransactionAPIHandlerStub struct {
-	GetTransactionCalled       func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPoolCalled  func() (*common.TransactionsPoolAPIResponse, error)
-	UnmarshalTransactionCalled func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
-	UnmarshalReceiptCalled     func(receiptBytes []byte) (*transaction.ApiReceipt, error)
+	GetTransactionCalled               func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionsPoolCalled          func() (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionInclusionProofCalled func(hash string) (*common.TransactionInclusionProof, error)
+	UnmarshalTransactionCalled         func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
+	UnmarshalReceiptCalled             func(receiptBytes []byte) (*transaction.ApiReceipt, error)
 }
 
 // GetTransaction -
@@ -31,6 +32,15 @@ func (tas *TransactionAPIHandlerStub) GetTransactionsPool() (*common.Transaction
 	return nil, nil
 }
 
+// GetTransactionInclusionProof -
+func (tas *TransactionAPIHandlerStub) GetTransactionInclusionProof(hash string) (*common.TransactionInclusionProof, error) {
+	if tas.GetTransactionInclusionProofCalled != nil {
+		return tas.GetTransactionInclusionProofCalled(hash)
+	}
+
+	return nil, nil
+}
+
 // UnmarshalTransaction -
 func (tas *TransactionAPIHandlerStub) UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error) {
 	if tas.UnmarshalTransactionCalled != nil {
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// DelegationViewsProcessorStub -
+type DelegationViewsProcessorStub struct {
+	GetUserDelegationsCalled    func(address string) ([]*common.UserDelegation, error)
+	GetDelegationProviderCalled func(contract string) (*common.DelegationProvider, error)
+}
+
+// GetUserDelegations -
+func (dvps *DelegationViewsProcessorStub) GetUserDelegations(address string) ([]*common.UserDelegation, error) {
+	if dvps.GetUserDelegationsCalled != nil {
+		return dvps.GetUserDelegationsCalled(address)
+	}
+
+	return nil, nil
+}
+
+// GetDelegationProvider -
+func (dvps *DelegationViewsProcessorStub) GetDelegationProvider(contract string) (*common.DelegationProvider, error) {
+	if dvps.GetDelegationProviderCalled != nil {
+		return dvps.GetDelegationProviderCalled(contract)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (dvps *DelegationViewsProcessorStub) IsInterfaceNil() bool {
+	return dvps == nil
+}
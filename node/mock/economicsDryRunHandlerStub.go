@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// EconomicsDryRunHandlerStub -
+type EconomicsDryRunHandlerStub struct {
+	DryRunCalled func(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error)
+}
+
+// DryRun -
+func (edrhs *EconomicsDryRunHandlerStub) DryRun(fromNonce uint64, toNonce uint64) (*common.EconomicsDryRunResult, error) {
+	if edrhs.DryRunCalled != nil {
+		return edrhs.DryRunCalled(fromNonce, toNonce)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (edrhs *EconomicsDryRunHandlerStub) IsInterfaceNil() bool {
+	return edrhs == nil
+}
@@ -0,0 +1,46 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// GovernanceProcessorStub -
+type GovernanceProcessorStub struct {
+	GetProposalsCalled   func(ctx context.Context) ([]*common.GovernanceProposal, error)
+	GetProposalCalled    func(reference string) (*common.GovernanceProposal, error)
+	GetVotingPowerCalled func(address string) (*common.GovernanceVotingPower, error)
+}
+
+// GetProposals -
+func (gps *GovernanceProcessorStub) GetProposals(ctx context.Context) ([]*common.GovernanceProposal, error) {
+	if gps.GetProposalsCalled != nil {
+		return gps.GetProposalsCalled(ctx)
+	}
+
+	return nil, nil
+}
+
+// GetProposal -
+func (gps *GovernanceProcessorStub) GetProposal(reference string) (*common.GovernanceProposal, error) {
+	if gps.GetProposalCalled != nil {
+		return gps.GetProposalCalled(reference)
+	}
+
+	return nil, nil
+}
+
+// GetVotingPower -
+func (gps *GovernanceProcessorStub) GetVotingPower(address string) (*common.GovernanceVotingPower, error) {
+	if gps.GetVotingPowerCalled != nil {
+		return gps.GetVotingPowerCalled(address)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (gps *GovernanceProcessorStub) IsInterfaceNil() bool {
+	return gps == nil
+}
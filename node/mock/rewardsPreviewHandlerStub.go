@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// RewardsPreviewHandlerStub -
+type RewardsPreviewHandlerStub struct {
+	ComputeRewardsPreviewCalled func() (*common.RewardsPreview, error)
+}
+
+// ComputeRewardsPreview -
+func (rphs *RewardsPreviewHandlerStub) ComputeRewardsPreview() (*common.RewardsPreview, error) {
+	if rphs.ComputeRewardsPreviewCalled != nil {
+		return rphs.ComputeRewardsPreviewCalled()
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (rphs *RewardsPreviewHandlerStub) IsInterfaceNil() bool {
+	return rphs == nil
+}
@@ -0,0 +1,32 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// StakingQueueProcessorStub -
+type StakingQueueProcessorStub struct {
+	GetStakingQueueCalled func(from uint32, size uint32) (*common.StakingQueue, error)
+	GetStakingNodeCalled  func(blsKey string) (*common.StakingNode, error)
+}
+
+// GetStakingQueue -
+func (sqps *StakingQueueProcessorStub) GetStakingQueue(from uint32, size uint32) (*common.StakingQueue, error) {
+	if sqps.GetStakingQueueCalled != nil {
+		return sqps.GetStakingQueueCalled(from, size)
+	}
+
+	return nil, nil
+}
+
+// GetStakingNode -
+func (sqps *StakingQueueProcessorStub) GetStakingNode(blsKey string) (*common.StakingNode, error) {
+	if sqps.GetStakingNodeCalled != nil {
+		return sqps.GetStakingNodeCalled(blsKey)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (sqps *StakingQueueProcessorStub) IsInterfaceNil() bool {
+	return sqps == nil
+}
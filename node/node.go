@@ -22,6 +22,8 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/eventsIndex"
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/facade"
 	mainFactory "github.com/ElrondNetwork/elrond-go/factory"
@@ -42,6 +44,8 @@ import (
 const (
 	// esdtTickerNumChars represents the number of hex-encoded characters of a ticker
 	esdtTickerNumChars = 6
+
+	defaultManualBlacklistReason = "manually blacklisted"
 )
 
 var log = logger.GetOrCreate("node")
@@ -443,19 +447,116 @@ func (n *Node) GetESDTsRoles(address string, ctx context.Context) (map[string][]
 }
 
 // GetTokenSupply returns the provided token supply from current shard
-func (n *Node) GetTokenSupply(token string) (*api.ESDTSupply, error) {
+func (n *Node) GetTokenSupply(token string) (*common.ESDTSupply, error) {
 	esdtSupply, err := n.processComponents.HistoryRepository().GetESDTSupply(token)
 	if err != nil {
 		return nil, err
 	}
 
-	return &api.ESDTSupply{
-		Supply: bigToString(esdtSupply.Supply),
-		Burned: bigToString(esdtSupply.Burned),
-		Minted: bigToString(esdtSupply.Minted),
+	return &common.ESDTSupply{
+		Supply:  bigToString(esdtSupply.Supply),
+		Burned:  bigToString(esdtSupply.Burned),
+		Minted:  bigToString(esdtSupply.Minted),
+		Partial: esdtSupply.Partial,
+	}, nil
+}
+
+// GetESDTHolders returns the requested page of the holders of the provided token from current shard
+func (n *Node) GetESDTHolders(token string, from uint32, size uint32) (*common.ESDTHolders, error) {
+	holders, numHolders, err := n.processComponents.HistoryRepository().GetESDTHolders(token, from, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.ESDTHolders{
+		Token:      token,
+		NumHolders: numHolders,
+		Holders:    n.convertHolders(holders),
 	}, nil
 }
 
+// GetCollectionNFTs returns the requested page of the NFTs of the provided collection held in current shard
+func (n *Node) GetCollectionNFTs(collection string, from uint32, size uint32) (*common.CollectionNFTs, error) {
+	nfts, numNFTs, err := n.processComponents.HistoryRepository().GetCollectionNFTs(collection, from, size)
+	if err != nil {
+		return nil, err
+	}
+
+	collectionNFTs := &common.CollectionNFTs{
+		Collection: collection,
+		NumNFTs:    numNFTs,
+		NFTs:       make([]*common.CollectionNFT, 0, len(nfts)),
+	}
+	for _, nft := range nfts {
+		collectionNFTs.NFTs = append(collectionNFTs.NFTs, &common.CollectionNFT{
+			Identifier: nft.Identifier,
+			Nonce:      nft.Nonce,
+			Owners:     n.convertHolders(nft.Holders),
+		})
+	}
+
+	return collectionNFTs, nil
+}
+
+// GetEvents returns the requested page of the events from current shard matching the provided query
+func (n *Node) GetEvents(query common.EventsQuery) (*common.Events, error) {
+	indexQuery := eventsIndex.EventsQuery{
+		Identifier:       []byte(query.Identifier),
+		FromBlock:        query.FromBlock,
+		ToBlock:          query.ToBlock,
+		DefaultFromBlock: query.DefaultFromBlock,
+	}
+
+	var err error
+	if len(query.Address) > 0 {
+		indexQuery.Address, err = n.coreComponents.AddressPubKeyConverter().Decode(query.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for address %s", err, query.Address)
+		}
+	}
+	indexQuery.Topic0, err = hex.DecodeString(query.Topic0)
+	if err != nil {
+		return nil, fmt.Errorf("%w for topic0 %s", err, query.Topic0)
+	}
+
+	page, err := n.processComponents.HistoryRepository().GetEvents(indexQuery, query.From, query.Size)
+	if err != nil {
+		return nil, err
+	}
+
+	events := &common.Events{
+		FromBlock: page.FromBlock,
+		ToBlock:   page.ToBlock,
+		HasMore:   page.HasMore,
+		Events:    make([]*common.Event, 0, len(page.Events)),
+	}
+	for _, event := range page.Events {
+		events.Events = append(events.Events, &common.Event{
+			BlockNonce: event.BlockNonce,
+			TxHash:     hex.EncodeToString(event.TxHash),
+			Index:      event.Index,
+			Address:    n.coreComponents.AddressPubKeyConverter().Encode(event.Address),
+			Identifier: string(event.Identifier),
+			Topics:     event.Topics,
+			Data:       event.Data,
+		})
+	}
+
+	return events, nil
+}
+
+func (n *Node) convertHolders(holders []*esdtSupply.HolderBalance) []*common.ESDTHolder {
+	converted := make([]*common.ESDTHolder, 0, len(holders))
+	for _, holder := range holders {
+		converted = append(converted, &common.ESDTHolder{
+			Address: n.coreComponents.AddressPubKeyConverter().Encode(holder.Address),
+			Balance: bigToString(holder.Balance),
+		})
+	}
+
+	return converted
+}
+
 func bigToString(bigValue *big.Int) string {
 	if bigValue == nil {
 		return "0"
@@ -864,6 +965,31 @@ func (n *Node) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse,
 	return n.processComponents.ValidatorsProvider().GetLatestValidators(), nil
 }
 
+// GetNextEpochShufflingPreview returns the nodes that will change their position in the next epoch together with the
+// positions of the node's own keys
+func (n *Node) GetNextEpochShufflingPreview() (*common.NextEpochShufflingPreview, error) {
+	if check.IfNil(n.processComponents.ShufflingPreviewer()) {
+		return nil, ErrNilShufflingPreviewer
+	}
+
+	return n.processComponents.ShufflingPreviewer().PreviewNextEpochShuffling()
+}
+
+// GetValidatorHistory returns the recorded rating and signing statistics of the provided validator in the provided
+// epochs range
+func (n *Node) GetValidatorHistory(pubKey string, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error) {
+	if check.IfNil(n.processComponents.ValidatorHistory()) {
+		return nil, ErrNilValidatorHistory
+	}
+
+	pubKeyBytes, err := n.coreComponents.ValidatorPubKeyConverter().Decode(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the validator public key", err)
+	}
+
+	return n.processComponents.ValidatorHistory().GetHistory(pubKeyBytes, fromEpoch, toEpoch)
+}
+
 // DirectTrigger will start the hardfork trigger
 func (n *Node) DirectTrigger(epoch uint32, withEarlyEndOfEpoch bool) error {
 	return n.hardforkTrigger.Trigger(epoch, withEarlyEndOfEpoch)
@@ -954,6 +1080,73 @@ func (n *Node) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 	return peerInfoSlice, nil
 }
 
+// GetConsensusRoundsTimeline returns the timeline of the consensus events of the last rounds
+func (n *Node) GetConsensusRoundsTimeline() ([]*common.ConsensusRoundTimeline, error) {
+	if check.IfNil(n.consensusComponents) || check.IfNil(n.consensusComponents.RoundTimeline()) {
+		return nil, ErrNilRoundTimelineHandler
+	}
+
+	return n.consensusComponents.RoundTimeline().GetRounds(), nil
+}
+
+// GetSlashingEvidences returns the evidences of the validators that signed conflicting consensus messages
+func (n *Node) GetSlashingEvidences() ([]*common.SlashingEvidence, error) {
+	if check.IfNil(n.consensusComponents) || check.IfNil(n.consensusComponents.SlashingDetector()) {
+		return nil, ErrNilSlashingDetector
+	}
+
+	return n.consensusComponents.SlashingDetector().GetEvidences(), nil
+}
+
+// GetGasProfile returns the gas consumption aggregated per contract function and per built-in function
+func (n *Node) GetGasProfile() (*common.GasProfile, error) {
+	if check.IfNil(n.processComponents) || check.IfNil(n.processComponents.GasProfiler()) {
+		return nil, ErrNilGasProfiler
+	}
+
+	return n.processComponents.GasProfiler().GetGasProfile()
+}
+
+// GetAntifloodQuotaInfo returns the quota usage measured by the input antiflood components
+func (n *Node) GetAntifloodQuotaInfo() (*common.AntifloodQuotaInfo, error) {
+	quotaInfo := n.networkComponents.InputAntiFloodHandler().GetQuotaInfo()
+
+	return &quotaInfo, nil
+}
+
+// GetBlacklistedPeers returns the details of the blacklisted peers
+func (n *Node) GetBlacklistedPeers() ([]common.BlacklistedPeerInfo, error) {
+	return n.networkComponents.InputAntiFloodHandler().GetBlacklistedPeers(), nil
+}
+
+// BlacklistPeerManually blacklists the provided peer for the provided duration, as an operator's decision
+func (n *Node) BlacklistPeerManually(pid string, reason string, durationInSeconds uint32) error {
+	peerID, err := p2p.NewPeerIDFromPretty(pid)
+	if err != nil {
+		return err
+	}
+	if peerID == n.networkComponents.NetworkMessenger().ID() {
+		return ErrCannotBlacklistSelf
+	}
+	if len(reason) == 0 {
+		reason = defaultManualBlacklistReason
+	}
+
+	duration := time.Duration(durationInSeconds) * time.Second
+
+	return n.networkComponents.InputAntiFloodHandler().BlacklistPeerManually(peerID, reason, duration)
+}
+
+// PardonPeer removes the provided peer from the black list
+func (n *Node) PardonPeer(pid string) error {
+	peerID, err := p2p.NewPeerIDFromPretty(pid)
+	if err != nil {
+		return err
+	}
+
+	return n.networkComponents.InputAntiFloodHandler().PardonPeer(peerID)
+}
+
 // GetHardforkTrigger returns the hardfork trigger
 func (n *Node) GetHardforkTrigger() HardforkTrigger {
 	return n.hardforkTrigger
@@ -1091,6 +1284,23 @@ func (n *Node) GetProofDataTrie(rootHash string, address string, key string) (*c
 		return nil, nil, err
 	}
 
+	return n.getProofDataTrie(rootHashBytes, addressBytes, keyBytes)
+}
+
+// GetProofESDTBalance returns the Merkle Proof for the given address, and another Merkle Proof for the ESDT
+// key of the given token, as it is stored in the account's dataTrie
+func (n *Node) GetProofESDTBalance(rootHash string, address string, tokenIdentifier string) (*common.GetProofResponse, *common.GetProofResponse, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenIdentifier)
+
+	return n.getProofDataTrie(rootHashBytes, addressBytes, esdtTokenKey)
+}
+
+func (n *Node) getProofDataTrie(rootHashBytes []byte, addressBytes []byte, keyBytes []byte) (*common.GetProofResponse, *common.GetProofResponse, error) {
 	mainProofResponse, err := n.getProof(rootHashBytes, addressBytes)
 	if err != nil {
 		return nil, nil, err
@@ -1131,6 +1341,132 @@ func (n *Node) VerifyProof(rootHash string, address string, proof [][]byte) (boo
 	return mpv.VerifyProof(rootHashBytes, key, proof)
 }
 
+// GetMultiProof returns a single Merkle multi-proof for all the given addresses. The trie nodes shared between
+// the individual proofs are only included once
+func (n *Node) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	if len(addresses) == 0 {
+		return nil, ErrEmptyAddressesList
+	}
+
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHashBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	proofs := make([][][]byte, 0, len(addresses))
+	values := make([][]byte, 0, len(addresses))
+	for _, address := range addresses {
+		addressBytes, errDecode := n.getKeyBytes(address)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		proof, value, errProof := tr.GetProof(addressBytes)
+		if errProof != nil {
+			return nil, errProof
+		}
+
+		proofs = append(proofs, proof)
+		values = append(values, value)
+	}
+
+	return &common.GetMultiProofResponse{
+		Proof:    trie.MergeProofs(proofs...),
+		Values:   values,
+		RootHash: rootHash,
+	}, nil
+}
+
+// VerifyMultiProof verifies the given Merkle multi-proof against all the provided addresses
+func (n *Node) VerifyMultiProof(rootHash string, addresses []string, proof [][]byte) (bool, error) {
+	if len(addresses) == 0 {
+		return false, ErrEmptyAddressesList
+	}
+
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return false, err
+	}
+
+	mpv, err := trie.NewMerkleProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return false, err
+	}
+
+	keys := make([][]byte, 0, len(addresses))
+	for _, address := range addresses {
+		key, errDecode := n.getKeyBytes(address)
+		if errDecode != nil {
+			return false, errDecode
+		}
+
+		keys = append(keys, key)
+	}
+
+	return mpv.VerifyMultiProof(rootHashBytes, keys, proof)
+}
+
+// VerifyESDTBalanceProof verifies, end to end, the proofs returned by GetProofESDTBalance: the main trie proof
+// is verified against the given root hash, the account's dataTrie root hash is extracted from the proven account
+// and the dataTrie proof is verified against it. If both proofs are valid, the proven ESDT data is returned
+func (n *Node) VerifyESDTBalanceProof(
+	rootHash string,
+	address string,
+	tokenIdentifier string,
+	mainProof [][]byte,
+	dataTrieProof [][]byte,
+) (*esdt.ESDigitalToken, bool, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
+	if err != nil {
+		return nil, false, err
+	}
+
+	marshalizer := n.coreComponents.InternalMarshalizer()
+	mpv, err := trie.NewMerkleProofVerifier(marshalizer, n.coreComponents.Hasher())
+	if err != nil {
+		return nil, false, err
+	}
+
+	accountBytes, ok, err := mpv.VerifyProofAndGetValue(rootHashBytes, addressBytes, mainProof)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	accountData := &state.UserAccountData{}
+	err = marshalizer.Unmarshal(accountData, accountBytes)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(accountData.RootHash) == 0 {
+		return nil, false, nil
+	}
+
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenIdentifier)
+	leafValue, ok, err := mpv.VerifyProofAndGetValue(accountData.RootHash, esdtTokenKey, dataTrieProof)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	// the values saved in the dataTrie have the key and the account's address appended
+	tailLength := len(esdtTokenKey) + len(addressBytes)
+	if len(leafValue) < tailLength {
+		return nil, false, nil
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err = marshalizer.Unmarshal(esdtToken, leafValue[:len(leafValue)-tailLength])
+	if err != nil {
+		return nil, false, err
+	}
+
+	return esdtToken, true, nil
+}
+
 func (n *Node) getRootHashAndAddressAsBytes(rootHash string, address string) ([]byte, []byte, error) {
 	rootHashBytes, err := hex.DecodeString(rootHash)
 	if err != nil {
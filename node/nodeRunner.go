@@ -35,12 +35,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis"
 	"github.com/ElrondNetwork/elrond-go/genesis/parsing"
 	"github.com/ElrondNetwork/elrond-go/health"
+	"github.com/ElrondNetwork/elrond-go/node/disabled"
 	"github.com/ElrondNetwork/elrond-go/node/metrics"
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/interceptors"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/storage/clean"
+	"github.com/ElrondNetwork/elrond-go/storage/diskspace"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
@@ -181,6 +184,7 @@ func printEnableEpochs(configs *config.Configs) {
 	log.Debug(readEpochFor("fail execution on every wrong API call"), "epoch", enableEpochs.FailExecutionOnEveryAPIErrorEnableEpoch)
 	log.Debug(readEpochFor("managed crypto API in wasm vm"), "epoch", enableEpochs.ManagedCryptoAPIsEnableEpoch)
 	log.Debug(readEpochFor("refactor contexts"), "epoch", enableEpochs.RefactorContextEnableEpoch)
+	log.Debug(readEpochFor("parallel transactions execution"), "epoch", enableEpochs.ParallelTransactionsExecutionEnableEpoch)
 
 	gasSchedule := configs.EpochConfig.GasSchedule
 
@@ -271,7 +275,7 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	}
 
 	log.Debug("creating disabled API services")
-	webServerHandler, err := nr.createHttpServer()
+	webServerHandler, err := nr.createHttpServer(managedCoreComponents)
 	if err != nil {
 		return true, err
 	}
@@ -310,6 +314,15 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 	log.Debug("registering components in healthService")
 	nr.registerDataComponentsInHealthService(healthService, managedDataComponents)
 
+	log.Debug("creating disk space watchdog")
+	diskSpaceWatchdog, err := nr.createDiskSpaceWatchdog(managedCoreComponents, managedDataComponents)
+	if err != nil {
+		return true, err
+	}
+	defer func() {
+		log.LogIfError(diskSpaceWatchdog.Close())
+	}()
+
 	nodesShufflerOut, err := mainFactory.CreateNodesShuffleOut(
 		managedCoreComponents.GenesisNodesSetup(),
 		configs.GeneralConfig.EpochStartConfig,
@@ -476,6 +489,7 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		sigs,
 		managedCoreComponents.ChanStopNodeProcess(),
 		healthService,
+		diskSpaceWatchdog,
 		ef,
 		webServerHandler,
 		currentNode,
@@ -555,11 +569,13 @@ func (nr *nodeRunner) createApiFacade(
 	return ef, nil
 }
 
-func (nr *nodeRunner) createHttpServer() (shared.UpgradeableHttpServerHandler, error) {
+func (nr *nodeRunner) createHttpServer(coreComponents mainFactory.CoreComponentsHolder) (shared.UpgradeableHttpServerHandler, error) {
 	httpServerArgs := gin.ArgsNewWebServer{
 		Facade:          initial.NewInitialNodeFacade(nr.configs.FlagsConfig.RestApiInterface, nr.configs.FlagsConfig.EnablePprof),
 		ApiConfig:       *nr.configs.ApiRoutesConfig,
 		AntiFloodConfig: nr.configs.GeneralConfig.Antiflood.WebServer,
+		MetricsRegistry: coreComponents.MetricsRegistry(),
+		Tracer:          coreComponents.Tracer(),
 	}
 
 	httpServerWrapper, err := gin.NewGinWebServerHandler(httpServerArgs)
@@ -625,6 +641,50 @@ func (nr *nodeRunner) registerDataComponentsInHealthService(healthService Health
 	healthService.RegisterComponent(dataComponents.Datapool().RewardTransactions())
 }
 
+func (nr *nodeRunner) createDiskSpaceWatchdog(
+	coreComponents mainFactory.CoreComponentsHolder,
+	dataComponents mainFactory.DataComponentsHolder,
+) (io.Closer, error) {
+	watchdogConfig := nr.configs.GeneralConfig.Health.DiskSpaceWatchdog
+	if !watchdogConfig.Enabled {
+		return disabled.NewDisabledDiskSpaceWatchdog(), nil
+	}
+
+	oldDataCleanerProvider, err := clean.NewOldDataCleanerProvider(
+		coreComponents.NodeTypeProvider(),
+		nr.configs.GeneralConfig.StoragePruning,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	earlyDatabaseCleaner, err := clean.NewEarlyDatabaseCleaner(clean.ArgsEarlyDatabaseCleaner{
+		StorageListProvider:    dataComponents.StorageService(),
+		OldDataCleanerProvider: oldDataCleanerProvider,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	diskSpaceWatchdog, err := diskspace.NewDiskSpaceWatchdog(diskspace.ArgsDiskSpaceWatchdog{
+		Config:              watchdogConfig,
+		WorkingDir:          nr.configs.FlagsConfig.WorkingDir,
+		NumActivePersisters: uint32(nr.configs.GeneralConfig.StoragePruning.NumActivePersisters),
+		IsFullArchive:       nr.configs.PreferencesConfig.Preferences.FullArchive,
+		StatusHandler:       coreComponents.StatusHandler(),
+		EpochProvider:       coreComponents.EpochNotifier(),
+		OldEpochsCleaner:    earlyDatabaseCleaner,
+		ChanStopNodeProcess: coreComponents.ChanStopNodeProcess(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	diskSpaceWatchdog.StartWatching()
+
+	return diskSpaceWatchdog, nil
+}
+
 // CreateManagedConsensusComponents is the managed consensus components factory
 func (nr *nodeRunner) CreateManagedConsensusComponents(
 	coreComponents mainFactory.CoreComponentsHolder,
@@ -748,6 +808,7 @@ func waitForSignal(
 	sigs chan os.Signal,
 	chanStopNodeProcess chan endProcess.ArgEndProcess,
 	healthService closing.Closer,
+	diskSpaceWatchdog io.Closer,
 	ef closing.Closer,
 	httpServer shared.UpgradeableHttpServerHandler,
 	currentNode *Node,
@@ -757,6 +818,8 @@ func waitForSignal(
 	reshuffled := false
 	wrongConfig := false
 	wrongConfigDescription := ""
+	diskSpaceExhausted := false
+	diskSpaceExhaustedDescription := ""
 
 	select {
 	case <-sigs:
@@ -770,11 +833,15 @@ func waitForSignal(
 			wrongConfig = true
 			wrongConfigDescription = sig.Description
 		}
+		if sig.Reason == common.DiskSpaceExhausted {
+			diskSpaceExhausted = true
+			diskSpaceExhaustedDescription = sig.Description
+		}
 	}
 
 	chanCloseComponents := make(chan struct{})
 	go func() {
-		closeAllComponents(healthService, ef, httpServer, currentNode, chanCloseComponents)
+		closeAllComponents(healthService, diskSpaceWatchdog, ef, httpServer, currentNode, chanCloseComponents)
 	}()
 
 	select {
@@ -794,6 +861,14 @@ func waitForSignal(
 		}
 	}
 
+	if diskSpaceExhausted {
+		// hang the node's process as a restart would fail on the first database write, possibly corrupting the data
+		for {
+			log.Error("not enough disk space. stopped processing", "description", diskSpaceExhaustedDescription)
+			time.Sleep(1 * time.Minute)
+		}
+	}
+
 	if reshuffled {
 		log.Info("=============================" + SoftRestartMessage + "==================================")
 		core.DumpGoRoutinesToLog(goRoutinesNumberStart, log)
@@ -980,6 +1055,7 @@ func (nr *nodeRunner) CreateManagedProcessComponents(
 		Marshalizer:              coreComponents.InternalMarshalizer(),
 		Store:                    dataComponents.StorageService(),
 		Uint64ByteSliceConverter: coreComponents.Uint64ByteSliceConverter(),
+		ShardCoordinator:         bootstrapComponents.ShardCoordinator(),
 	}
 	historyRepositoryFactory, err := dbLookupFactory.NewHistoryRepositoryFactory(historyRepoFactoryArgs)
 	if err != nil {
@@ -1189,11 +1265,14 @@ func (nr *nodeRunner) CreateManagedNetworkComponents(
 		MainConfig:          *nr.configs.GeneralConfig,
 		RatingsConfig:       *nr.configs.RatingsConfig,
 		StatusHandler:       coreComponents.StatusHandler(),
+		MetricsRegistry:     coreComponents.MetricsRegistry(),
 		Marshalizer:         coreComponents.InternalMarshalizer(),
 		Syncer:              coreComponents.SyncTimer(),
 		PreferredPublicKeys: decodedPreferredPubKeys,
 		BootstrapWaitTime:   common.TimeToWaitForP2PBootstrap,
 		NodeOperationMode:   p2p.NormalOperation,
+		WorkingDir:          nr.configs.FlagsConfig.WorkingDir,
+		ChainID:             coreComponents.ChainID(),
 	}
 	if nr.configs.ImportDbConfig.IsImportDBMode {
 		networkComponentsFactoryArgs.BootstrapWaitTime = 0
@@ -1295,6 +1374,7 @@ func (nr *nodeRunner) CreateManagedCryptoComponents(
 
 func closeAllComponents(
 	healthService io.Closer,
+	diskSpaceWatchdog io.Closer,
 	facade mainFactory.Closer,
 	httpServer shared.UpgradeableHttpServerHandler,
 	node *Node,
@@ -1304,6 +1384,9 @@ func closeAllComponents(
 	err := healthService.Close()
 	log.LogIfError(err)
 
+	log.Debug("closing disk space watchdog")
+	log.LogIfError(diskSpaceWatchdog.Close())
+
 	log.Debug("closing http server")
 	log.LogIfError(httpServer.Close())
 
@@ -20,7 +20,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
 	"github.com/ElrondNetwork/elrond-go-core/core/versioning"
 	"github.com/ElrondNetwork/elrond-go-core/data"
-	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -29,14 +28,18 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	crypto "github.com/ElrondNetwork/elrond-go-crypto"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/eventsIndex"
 	"github.com/ElrondNetwork/elrond-go/factory"
 	factoryMock "github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/ElrondNetwork/elrond-go/node"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	nodeMockFactory "github.com/ElrondNetwork/elrond-go/node/mock/factory"
+	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/gasProfiler"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/bootstrapMocks"
@@ -52,6 +55,8 @@ import (
 	statusHandlerMock "github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txsSenderMock"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/ElrondNetwork/elrond-go/trie/hashesHolder"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
@@ -3083,6 +3088,112 @@ func TestNode_GetPeerInfoUnknownPeerShouldErr(t *testing.T) {
 	assert.True(t, errors.Is(err, node.ErrUnknownPeerID))
 }
 
+func createPeerID(t *testing.T, prettyPid string) core.PeerID {
+	pid, err := p2p.NewPeerIDFromPretty(prettyPid)
+	require.Nil(t, err)
+
+	return pid
+}
+
+func TestNode_BlacklistPeerManually(t *testing.T) {
+	t.Parallel()
+
+	selfPid := createPeerID(t, "16Uiu2HAmH3ptbAiRfejMPj73YEt6zCA1CwnVKAAcMdefb8dCctiT")
+	otherPid := createPeerID(t, "16Uiu2HAmSSuucbRbYFcNsHWjHKMr9ak1vfeC8mBdvw5Y4qwBJ1LA")
+
+	t.Run("invalid pid should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithNetworkComponents(getDefaultNetworkComponents()),
+		)
+
+		err := n.BlacklistPeerManually("not a base58 pid 0OIl", "", 10)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidPeerID))
+	})
+	t.Run("self pid should error", func(t *testing.T) {
+		t.Parallel()
+
+		networkComponents := getDefaultNetworkComponents()
+		networkComponents.Messenger = &p2pmocks.MessengerStub{
+			IDCalled: func() core.PeerID {
+				return selfPid
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithNetworkComponents(networkComponents),
+		)
+
+		err := n.BlacklistPeerManually(selfPid.Pretty(), "", 10)
+		assert.Equal(t, node.ErrCannotBlacklistSelf, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var blacklistedPid core.PeerID
+		var blacklistedReason string
+		var blacklistedDuration time.Duration
+		networkComponents := getDefaultNetworkComponents()
+		networkComponents.Messenger = &p2pmocks.MessengerStub{
+			IDCalled: func() core.PeerID {
+				return selfPid
+			},
+		}
+		networkComponents.InputAntiFlood = &mock.P2PAntifloodHandlerStub{
+			BlacklistPeerManuallyCalled: func(peer core.PeerID, reason string, duration time.Duration) error {
+				blacklistedPid = peer
+				blacklistedReason = reason
+				blacklistedDuration = duration
+				return nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithNetworkComponents(networkComponents),
+		)
+
+		err := n.BlacklistPeerManually(otherPid.Pretty(), "", 10)
+		assert.Nil(t, err)
+		assert.Equal(t, otherPid, blacklistedPid)
+		assert.Equal(t, "manually blacklisted", blacklistedReason)
+		assert.Equal(t, 10*time.Second, blacklistedDuration)
+	})
+}
+
+func TestNode_PardonPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid pid should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithNetworkComponents(getDefaultNetworkComponents()),
+		)
+
+		err := n.PardonPeer("not a base58 pid 0OIl")
+		assert.True(t, errors.Is(err, p2p.ErrInvalidPeerID))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pid := createPeerID(t, "16Uiu2HAmH3ptbAiRfejMPj73YEt6zCA1CwnVKAAcMdefb8dCctiT")
+		var pardonedPid core.PeerID
+		networkComponents := getDefaultNetworkComponents()
+		networkComponents.InputAntiFlood = &mock.P2PAntifloodHandlerStub{
+			PardonPeerCalled: func(peer core.PeerID) error {
+				pardonedPid = peer
+				return nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithNetworkComponents(networkComponents),
+		)
+
+		err := n.PardonPeer(pid.Pretty())
+		assert.Nil(t, err)
+		assert.Equal(t, pid, pardonedPid)
+	})
+}
+
 func TestNode_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -3553,6 +3664,208 @@ func TestNode_VerifyProof(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestNode_GetMultiProofEmptyAddressesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+	response, err := n.GetMultiProof("deadbeef", nil)
+	assert.Nil(t, response)
+	assert.Equal(t, node.ErrEmptyAddressesList, err)
+}
+
+func TestNode_GetMultiProofInvalidRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+	response, err := n.GetMultiProof("invalidRootHash", []string{"0123"})
+	assert.Nil(t, response)
+	assert.NotNil(t, err)
+}
+
+func TestNode_GetMultiProofShouldWork(t *testing.T) {
+	t.Parallel()
+
+	proofs := map[string][][]byte{
+		"0123": {[]byte("root"), []byte("branch"), []byte("leaf1")},
+		"4567": {[]byte("root"), []byte("branch"), []byte("leaf2")},
+	}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return &trieMock.TrieStub{
+				GetProofCalled: func(key []byte) ([][]byte, []byte, error) {
+					return proofs[hex.EncodeToString(key)], key, nil
+				},
+			}, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	rootHash := "deadbeef"
+	response, err := n.GetMultiProof(rootHash, []string{"0123", "4567"})
+	assert.Nil(t, err)
+	expectedProof := [][]byte{[]byte("root"), []byte("branch"), []byte("leaf1"), []byte("leaf2")}
+	assert.Equal(t, expectedProof, response.Proof)
+	assert.Equal(t, [][]byte{{0x01, 0x23}, {0x45, 0x67}}, response.Values)
+	assert.Equal(t, rootHash, response.RootHash)
+}
+
+func TestNode_VerifyMultiProofEmptyAddressesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+	response, err := n.VerifyMultiProof("deadbeef", nil, [][]byte{})
+	assert.False(t, response)
+	assert.Equal(t, node.ErrEmptyAddressesList, err)
+}
+
+func TestNode_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.Hash = sha256.NewSha256()
+	coreComponents.IntMarsh = &marshal.GogoProtoMarshalizer{}
+	n, _ := node.NewNode(
+		node.WithStateComponents(getDefaultStateComponents()),
+		node.WithCoreComponents(coreComponents),
+	)
+
+	rootHash := "bc2e549d98c31ffe6e9419b933d03b37e84f74c42601412302799d277651a6d8"
+	address := "bf42213747697e9dec4211ef50ba6061b54729b53ba0c4994948cab478af8854"
+	p, _ := hex.DecodeString("0a41040508080f0a0807040b0a0c080409040909040c000a0b03050b09020704050b010600060a0b00050f0e010102040c0e0d090e07090607040703010202040f0b10124c1202000022206182d14320be95434f5508acad9478d3b6cf837bfce7ebfe47c2e860d1b98ca72a20bf42213747697e9dec4211ef50ba6061b54729b53ba0c4994948cab478af88543202000001")
+	proof := [][]byte{p}
+
+	response, err := n.VerifyMultiProof(rootHash, []string{address}, proof)
+	assert.True(t, response)
+	assert.Nil(t, err)
+
+	response, err = n.VerifyMultiProof(rootHash, []string{address, "0123"}, proof)
+	assert.False(t, response)
+	assert.Nil(t, err)
+}
+
+func TestNode_GetProofESDTBalanceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mainTrieKey := "0123"
+	tokenIdentifier := "TKN-010101"
+	esdtKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenIdentifier)
+	dataTrieProof := [][]byte{[]byte("valid"), []byte("proof"), []byte("dataTrie")}
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return &trieMock.TrieStub{
+				GetProofCalled: func(key []byte) ([][]byte, []byte, error) {
+					if bytes.Equal(key, esdtKey) {
+						return dataTrieProof, nil, nil
+					}
+
+					return [][]byte{[]byte("main")}, []byte("account"), nil
+				},
+			}, nil
+		},
+		GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+			acc := &mock.AccountWrapMock{}
+			acc.SetTrackableDataTrie(&trieMock.DataTrieTrackerStub{
+				RetrieveValueCalled: func(key []byte) ([]byte, error) {
+					assert.Equal(t, esdtKey, key)
+					return []byte("esdt"), nil
+				},
+			})
+			acc.SetRootHash([]byte("dataTrieRoot"))
+			return acc, nil
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	mainTrieResponse, dataTrieResponse, err := n.GetProofESDTBalance("deadbeef", mainTrieKey, tokenIdentifier)
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("main")}, mainTrieResponse.Proof)
+	assert.Equal(t, dataTrieProof, dataTrieResponse.Proof)
+	assert.Equal(t, []byte("esdt"), dataTrieResponse.Value)
+}
+
+func TestNode_VerifyESDTBalanceProof(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := sha256.NewSha256()
+	address := bytes.Repeat([]byte{1}, 32)
+	tokenIdentifier := "TKN-010101"
+	esdtKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokenIdentifier)
+
+	esdtTokenBytes, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(37)})
+	dataTrie := createTestTrie(marshalizer, hasher)
+	_ = dataTrie.Update(esdtKey, append(append(esdtTokenBytes, esdtKey...), address...))
+	_ = dataTrie.Update([]byte("other key"), []byte("other value"))
+	dataTrieRootHash, _ := dataTrie.RootHash()
+	dataTrieProof, _, _ := dataTrie.GetProof(esdtKey)
+
+	accountBytes, _ := marshalizer.Marshal(&state.UserAccountData{Address: address, RootHash: dataTrieRootHash})
+	mainTrie := createTestTrie(marshalizer, hasher)
+	_ = mainTrie.Update(address, accountBytes)
+	_ = mainTrie.Update(bytes.Repeat([]byte{2}, 32), []byte("other account"))
+	rootHash, _ := mainTrie.RootHash()
+	mainProof, _, _ := mainTrie.GetProof(address)
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.Hash = hasher
+	coreComponents.IntMarsh = marshalizer
+	n, _ := node.NewNode(
+		node.WithStateComponents(getDefaultStateComponents()),
+		node.WithCoreComponents(coreComponents),
+	)
+
+	t.Run("valid proofs should work", func(t *testing.T) {
+		esdtToken, ok, err := n.VerifyESDTBalanceProof(hex.EncodeToString(rootHash), hex.EncodeToString(address), tokenIdentifier, mainProof, dataTrieProof)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, big.NewInt(37), esdtToken.Value)
+	})
+	t.Run("other token should not verify", func(t *testing.T) {
+		esdtToken, ok, err := n.VerifyESDTBalanceProof(hex.EncodeToString(rootHash), hex.EncodeToString(address), "OTHER-010101", mainProof, dataTrieProof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, esdtToken)
+	})
+	t.Run("data trie proof swapped with main proof should not verify", func(t *testing.T) {
+		esdtToken, ok, err := n.VerifyESDTBalanceProof(hex.EncodeToString(rootHash), hex.EncodeToString(address), tokenIdentifier, mainProof, mainProof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+		assert.Nil(t, esdtToken)
+	})
+}
+
+func createTestTrie(marshalizer marshal.Marshalizer, hasher hashing.Hasher) common.Trie {
+	args := trie.NewTrieStorageManagerArgs{
+		MainStorer:        testscommon.NewSnapshotPruningStorerMock(),
+		CheckpointsStorer: testscommon.NewSnapshotPruningStorerMock(),
+		Marshalizer:       marshalizer,
+		Hasher:            hasher,
+		GeneralConfig: config.TrieStorageManagerConfig{
+			PruningBufferLen:      1000,
+			SnapshotsBufferLen:    10,
+			SnapshotsGoroutineNum: 1,
+		},
+		CheckpointHashesHolder: hashesHolder.NewCheckpointHashesHolder(10000000, uint64(hasher.Size())),
+		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:        &testscommon.MetricsRegistryStub{},
+	}
+	trieStorageManager, _ := trie.NewTrieStorageManager(args)
+	tr, _ := trie.NewTrie(trieStorageManager, marshalizer, hasher, 5)
+
+	return tr
+}
+
 func TestGetESDTSupplyError(t *testing.T) {
 	t.Parallel()
 
@@ -3579,8 +3892,9 @@ func TestGetESDTSupply(t *testing.T) {
 	historyProc := &dblookupext.HistoryRepositoryStub{
 		GetESDTSupplyCalled: func(token string) (*esdtSupply.SupplyESDT, error) {
 			return &esdtSupply.SupplyESDT{
-				Supply: big.NewInt(100),
-				Minted: big.NewInt(15),
+				Supply:  big.NewInt(100),
+				Minted:  big.NewInt(15),
+				Partial: true,
 			}, nil
 		},
 	}
@@ -3594,13 +3908,272 @@ func TestGetESDTSupply(t *testing.T) {
 	supply, err := n.GetTokenSupply("my-token")
 	require.Nil(t, err)
 
-	require.Equal(t, &api.ESDTSupply{
-		Supply: "100",
-		Burned: "0",
-		Minted: "15",
+	require.Equal(t, &common.ESDTSupply{
+		Supply:  "100",
+		Burned:  "0",
+		Minted:  "15",
+		Partial: true,
 	}, supply)
 }
 
+func TestNode_GetESDTHolders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		localErr := errors.New("local error")
+		processComponentsMock := getDefaultProcessComponents()
+		processComponentsMock.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+			GetESDTHoldersCalled: func(token string, from uint32, size uint32) ([]*esdtSupply.HolderBalance, uint32, error) {
+				return nil, 0, localErr
+			},
+		}
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithProcessComponents(processComponentsMock),
+		)
+
+		holders, err := n.GetESDTHolders("my-token", 0, 10)
+		require.Equal(t, localErr, err)
+		require.Nil(t, holders)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		holderAddress := bytes.Repeat([]byte("a"), 32)
+		processComponentsMock := getDefaultProcessComponents()
+		processComponentsMock.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+			GetESDTHoldersCalled: func(token string, from uint32, size uint32) ([]*esdtSupply.HolderBalance, uint32, error) {
+				require.Equal(t, "my-token", token)
+				require.Equal(t, uint32(5), from)
+				require.Equal(t, uint32(10), size)
+
+				return []*esdtSupply.HolderBalance{{Address: holderAddress, Balance: big.NewInt(37)}}, 6, nil
+			},
+		}
+		coreComponentsMock := getDefaultCoreComponents()
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponentsMock),
+			node.WithProcessComponents(processComponentsMock),
+		)
+
+		holders, err := n.GetESDTHolders("my-token", 5, 10)
+		require.Nil(t, err)
+		require.Equal(t, &common.ESDTHolders{
+			Token:      "my-token",
+			NumHolders: 6,
+			Holders: []*common.ESDTHolder{
+				{Address: coreComponentsMock.AddrPubKeyConv.Encode(holderAddress), Balance: "37"},
+			},
+		}, holders)
+	})
+}
+
+func TestNode_GetCollectionNFTs(t *testing.T) {
+	t.Parallel()
+
+	holderAddress := bytes.Repeat([]byte("a"), 32)
+	processComponentsMock := getDefaultProcessComponents()
+	processComponentsMock.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+		GetCollectionNFTsCalled: func(collection string, from uint32, size uint32) ([]*esdtSupply.NFTHolders, uint32, error) {
+			return []*esdtSupply.NFTHolders{
+				{
+					Identifier: "NFT-abcdef-02",
+					Nonce:      2,
+					Holders:    []*esdtSupply.HolderBalance{{Address: holderAddress, Balance: big.NewInt(1)}},
+				},
+			}, 1, nil
+		},
+	}
+	coreComponentsMock := getDefaultCoreComponents()
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponentsMock),
+		node.WithProcessComponents(processComponentsMock),
+	)
+
+	nfts, err := n.GetCollectionNFTs("NFT-abcdef", 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, &common.CollectionNFTs{
+		Collection: "NFT-abcdef",
+		NumNFTs:    1,
+		NFTs: []*common.CollectionNFT{
+			{
+				Identifier: "NFT-abcdef-02",
+				Nonce:      2,
+				Owners: []*common.ESDTHolder{
+					{Address: coreComponentsMock.AddrPubKeyConv.Encode(holderAddress), Balance: "1"},
+				},
+			},
+		},
+	}, nfts)
+}
+
+func TestNode_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid topic should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithProcessComponents(getDefaultProcessComponents()),
+		)
+
+		events, err := n.GetEvents(common.EventsQuery{Topic0: "not hex"})
+		require.NotNil(t, err)
+		require.Nil(t, events)
+	})
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		localErr := errors.New("local error")
+		processComponentsMock := getDefaultProcessComponents()
+		processComponentsMock.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+			GetEventsCalled: func(query eventsIndex.EventsQuery, from uint32, size uint32) (*eventsIndex.EventsPage, error) {
+				return nil, localErr
+			},
+		}
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(getDefaultCoreComponents()),
+			node.WithProcessComponents(processComponentsMock),
+		)
+
+		events, err := n.GetEvents(common.EventsQuery{})
+		require.Equal(t, localErr, err)
+		require.Nil(t, events)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		emitter := bytes.Repeat([]byte("a"), 32)
+		coreComponentsMock := getDefaultCoreComponents()
+		processComponentsMock := getDefaultProcessComponents()
+		processComponentsMock.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+			GetEventsCalled: func(query eventsIndex.EventsQuery, from uint32, size uint32) (*eventsIndex.EventsPage, error) {
+				require.Equal(t, eventsIndex.EventsQuery{
+					Address:    emitter,
+					Identifier: []byte("transfer"),
+					Topic0:     []byte("topic"),
+					FromBlock:  5,
+					ToBlock:    15,
+				}, query)
+				require.Equal(t, uint32(2), from)
+				require.Equal(t, uint32(10), size)
+
+				return &eventsIndex.EventsPage{
+					Events: []*eventsIndex.IndexedEvent{
+						{
+							BlockNonce: 7,
+							TxHash:     []byte("hash"),
+							Index:      1,
+							Address:    emitter,
+							Identifier: []byte("transfer"),
+							Topics:     [][]byte{[]byte("topic")},
+							Data:       []byte("data"),
+						},
+					},
+					FromBlock: 5,
+					ToBlock:   12,
+					HasMore:   true,
+				}, nil
+			},
+		}
+
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponentsMock),
+			node.WithProcessComponents(processComponentsMock),
+		)
+
+		encodedEmitter := coreComponentsMock.AddrPubKeyConv.Encode(emitter)
+		events, err := n.GetEvents(common.EventsQuery{
+			Address:    encodedEmitter,
+			Identifier: "transfer",
+			Topic0:     hex.EncodeToString([]byte("topic")),
+			FromBlock:  5,
+			ToBlock:    15,
+			From:       2,
+			Size:       10,
+		})
+		require.Nil(t, err)
+		require.Equal(t, &common.Events{
+			FromBlock: 5,
+			ToBlock:   12,
+			HasMore:   true,
+			Events: []*common.Event{
+				{
+					BlockNonce: 7,
+					TxHash:     hex.EncodeToString([]byte("hash")),
+					Index:      1,
+					Address:    encodedEmitter,
+					Identifier: "transfer",
+					Topics:     [][]byte{[]byte("topic")},
+					Data:       []byte("data"),
+				},
+			},
+		}, events)
+	})
+}
+
+func TestNode_GetGasProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil gas profiler should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithProcessComponents(getDefaultProcessComponents()),
+		)
+
+		profile, err := n.GetGasProfile()
+		assert.Nil(t, profile)
+		assert.Equal(t, node.ErrNilGasProfiler, err)
+	})
+	t.Run("disabled gas profiler should error", func(t *testing.T) {
+		t.Parallel()
+
+		processComponentsMock := getDefaultProcessComponents()
+		processComponentsMock.GasProfilerField = gasProfiler.NewDisabledGasProfiler()
+		n, _ := node.NewNode(
+			node.WithProcessComponents(processComponentsMock),
+		)
+
+		profile, err := n.GetGasProfile()
+		assert.Nil(t, profile)
+		assert.Equal(t, gasProfiler.ErrGasProfilerDisabled, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		coreComponentsMock := getDefaultCoreComponents()
+		profiler, _ := gasProfiler.NewGasProfiler(gasProfiler.ArgsGasProfiler{
+			PubkeyConverter:     coreComponentsMock.AddrPubKeyConv,
+			WindowInSeconds:     60,
+			NumBuckets:          6,
+			MaxEntriesPerBucket: 10,
+			MetricsRegistry:     &testscommon.MetricsRegistryStub{},
+		})
+		contract := bytes.Repeat([]byte("a"), 32)
+		profiler.RecordContractExecution(contract, "swap", 1000, false)
+		processComponentsMock := getDefaultProcessComponents()
+		processComponentsMock.GasProfilerField = profiler
+		n, _ := node.NewNode(
+			node.WithCoreComponents(coreComponentsMock),
+			node.WithProcessComponents(processComponentsMock),
+		)
+
+		profile, err := n.GetGasProfile()
+		require.Nil(t, err)
+		require.Equal(t, 1, len(profile.Contracts))
+		assert.Equal(t, coreComponentsMock.AddrPubKeyConv.Encode(contract), profile.Contracts[0].Contract)
+		assert.Equal(t, uint64(1000), profile.Contracts[0].GasUsed)
+	})
+}
+
 func TestNode_SendBulkTransactions(t *testing.T) {
 	t.Parallel()
 
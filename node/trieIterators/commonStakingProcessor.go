@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -14,6 +16,7 @@ import (
 type stakingValidatorInfo struct {
 	totalStakedValue *big.Int
 	topUpValue       *big.Int
+	numActiveNodes   uint64
 }
 
 type commonStakingProcessor struct {
@@ -46,10 +49,31 @@ func (csp *commonStakingProcessor) getValidatorInfoFromSC(validatorAddress []byt
 
 	info.topUpValue = big.NewInt(0).SetBytes(vmOutput.ReturnData[0])
 	info.totalStakedValue = big.NewInt(0).SetBytes(vmOutput.ReturnData[1])
+	info.numActiveNodes = big.NewInt(0).SetBytes(vmOutput.ReturnData[2]).Uint64()
 
 	return info, nil
 }
 
+func (csp *commonStakingProcessor) getAllDelegationContractAddresses() ([][]byte, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  vm.DelegationManagerSCAddress,
+		FuncName:   "getAllContractAddresses",
+		CallerAddr: vm.DelegationManagerSCAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  make([][]byte, 0),
+	}
+
+	vmOutput, err := csp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
 func (csp *commonStakingProcessor) getAccount(scAddress []byte) (state.UserAccountHandler, error) {
 	accountHandler, err := csp.accounts.GetExistingAccount(scAddress)
 	if err != nil {
@@ -63,3 +87,12 @@ func (csp *commonStakingProcessor) getAccount(scAddress []byte) (state.UserAccou
 
 	return account, nil
 }
+
+func getCurrentEpoch(blockChain data.ChainHandler) uint32 {
+	currentHeader := blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return 0
+	}
+
+	return currentHeader.GetEpoch()
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/epochStart"
 	"github.com/ElrondNetwork/elrond-go/process"
-	"github.com/ElrondNetwork/elrond-go/vm"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -59,26 +58,6 @@ func (dlp *delegatedListProcessor) GetDelegatorsList(ctx context.Context) ([]*ap
 	return dlp.mapToSlice(delegatorsInfo), nil
 }
 
-func (dlp *delegatedListProcessor) getAllDelegationContractAddresses() ([][]byte, error) {
-	scQuery := &process.SCQuery{
-		ScAddress:  vm.DelegationManagerSCAddress,
-		FuncName:   "getAllContractAddresses",
-		CallerAddr: vm.DelegationManagerSCAddress,
-		CallValue:  big.NewInt(0),
-		Arguments:  make([][]byte, 0),
-	}
-
-	vmOutput, err := dlp.queryService.ExecuteQuery(scQuery)
-	if err != nil {
-		return nil, err
-	}
-	if vmOutput.ReturnCode != vmcommon.Ok {
-		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
-	}
-
-	return vmOutput.ReturnData, nil
-}
-
 func (dlp *delegatedListProcessor) getDelegatorsInfo(delegationSC []byte, delegatorsMap map[string]*api.Delegator, ctx context.Context) error {
 	delegatorsList, err := dlp.getDelegatorsList(delegationSC, ctx)
 	if err != nil {
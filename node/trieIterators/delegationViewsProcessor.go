@@ -0,0 +1,294 @@
+package trieIterators
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/process"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const (
+	numContractConfigValues = 10
+	numMetaDataValues       = 3
+)
+
+var nodeStatesMarkers = map[string]struct{}{
+	"staked":    {},
+	"notStaked": {},
+	"unStaked":  {},
+}
+
+// ArgDelegationViewsProcessor represents the arguments DTO used in the delegation views processor constructor
+type ArgDelegationViewsProcessor struct {
+	ArgTrieIteratorProcessor
+	BlockChain data.ChainHandler
+}
+
+type delegationViewsProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter core.PubkeyConverter
+	blockChain         data.ChainHandler
+}
+
+// NewDelegationViewsProcessor will create a new instance of delegationViewsProcessor
+func NewDelegationViewsProcessor(arg ArgDelegationViewsProcessor) (*delegationViewsProcessor, error) {
+	err := checkArguments(arg.ArgTrieIteratorProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.BlockChain) {
+		return nil, ErrNilBlockChain
+	}
+
+	return &delegationViewsProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter: arg.PublicKeyConverter,
+		blockChain:         arg.BlockChain,
+	}, nil
+}
+
+// GetUserDelegations will return the funds and the rewards of the provided address in every delegation contract it
+// delegated to
+func (dvp *delegationViewsProcessor) GetUserDelegations(address string) ([]*common.UserDelegation, error) {
+	addressBytes, err := dvp.publicKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	dvp.accounts.Lock()
+	defer dvp.accounts.Unlock()
+
+	delegationScAddresses, err := dvp.getAllDelegationContractAddresses()
+	if err != nil {
+		return nil, err
+	}
+
+	storageGetter := &accountsStorageGetter{commonStakingProcessor: dvp.commonStakingProcessor}
+	currentEpoch := getCurrentEpoch(dvp.blockChain)
+	delegations := make([]*common.UserDelegation, 0)
+	for _, delegationSC := range delegationScAddresses {
+		// the delegator data is saved under the delegator's address, this is how the contract also decides
+		// if an address is one of its delegators
+		if len(storageGetter.GetStorageFromAddress(delegationSC, addressBytes)) == 0 {
+			continue
+		}
+
+		delegation, errGet := dvp.getUserDelegation(delegationSC, addressBytes, currentEpoch)
+		if errGet != nil {
+			return nil, fmt.Errorf("%w for delegationSC %s", errGet, dvp.publicKeyConverter.Encode(delegationSC))
+		}
+
+		delegations = append(delegations, delegation)
+	}
+
+	return delegations, nil
+}
+
+func (dvp *delegationViewsProcessor) getUserDelegation(delegationSC []byte, address []byte, currentEpoch uint32) (*common.UserDelegation, error) {
+	activeStake, err := dvp.executeSingleValueQuery(delegationSC, "getUserActiveStake", address)
+	if err != nil {
+		return nil, err
+	}
+
+	claimableRewards, err := dvp.executeSingleValueQuery(delegationSC, "getClaimableRewards", address)
+	if err != nil {
+		return nil, err
+	}
+
+	totalCumulatedRewards, err := dvp.executeSingleValueQuery(delegationSC, "getTotalCumulatedRewardsForUser", address)
+	if err != nil {
+		return nil, err
+	}
+
+	unDelegatedList, err := dvp.executeQuery(delegationSC, "getUserUnDelegatedList", address)
+	if err != nil {
+		return nil, err
+	}
+	if len(unDelegatedList)%2 != 0 {
+		return nil, fmt.Errorf("%w, getUserUnDelegatedList function should have returned pairs of values", epochStart.ErrExecutingSystemScCode)
+	}
+
+	unStakedFunds := make([]*common.DelegationUnStakedFund, 0, len(unDelegatedList)/2)
+	for i := 0; i < len(unDelegatedList); i += 2 {
+		remainingEpochs := uint32(big.NewInt(0).SetBytes(unDelegatedList[i+1]).Uint64())
+		unStakedFunds = append(unStakedFunds, &common.DelegationUnStakedFund{
+			Value:           big.NewInt(0).SetBytes(unDelegatedList[i]).String(),
+			RemainingEpochs: remainingEpochs,
+			UnBondEpoch:     currentEpoch + remainingEpochs,
+		})
+	}
+
+	return &common.UserDelegation{
+		DelegationScAddress:   dvp.publicKeyConverter.Encode(delegationSC),
+		ActiveStake:           activeStake.String(),
+		UnStakedFunds:         unStakedFunds,
+		ClaimableRewards:      claimableRewards.String(),
+		TotalCumulatedRewards: totalCumulatedRewards.String(),
+	}, nil
+}
+
+// GetDelegationProvider will return the configuration, the nodes states and the staked values of the provided
+// delegation contract
+func (dvp *delegationViewsProcessor) GetDelegationProvider(contract string) (*common.DelegationProvider, error) {
+	delegationSC, err := dvp.publicKeyConverter.Decode(contract)
+	if err != nil {
+		return nil, err
+	}
+
+	dvp.accounts.Lock()
+	defer dvp.accounts.Unlock()
+
+	provider, err := dvp.getContractConfig(delegationSC)
+	if err != nil {
+		return nil, err
+	}
+
+	dvp.setMetaData(delegationSC, provider)
+
+	numUsers, err := dvp.executeSingleValueQuery(delegationSC, "getNumUsers")
+	if err != nil {
+		return nil, err
+	}
+	provider.NumUsers = numUsers.Uint64()
+
+	totalActiveStake, err := dvp.executeSingleValueQuery(delegationSC, "getTotalActiveStake")
+	if err != nil {
+		return nil, err
+	}
+	provider.TotalActiveStake = totalActiveStake.String()
+
+	totalUnStaked, err := dvp.executeSingleValueQuery(delegationSC, "getTotalUnStaked")
+	if err != nil {
+		return nil, err
+	}
+	provider.TotalUnStaked = totalUnStaked.String()
+
+	provider.Nodes, err = dvp.getNodesStates(delegationSC)
+	if err != nil {
+		return nil, err
+	}
+
+	provider.TotalStaked = "0"
+	provider.TopUp = "0"
+	info, err := dvp.getValidatorInfoFromSC(delegationSC)
+	if err != nil {
+		// the contract did not stake anything yet
+		log.Debug("delegationViewsProcessor.GetDelegationProvider: cannot get validator info", "error", err)
+		return provider, nil
+	}
+
+	provider.TotalStaked = info.totalStakedValue.String()
+	provider.TopUp = info.topUpValue.String()
+
+	return provider, nil
+}
+
+func (dvp *delegationViewsProcessor) getContractConfig(delegationSC []byte) (*common.DelegationProvider, error) {
+	values, err := dvp.executeQuery(delegationSC, "getContractConfig")
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != numContractConfigValues {
+		return nil, fmt.Errorf("%w, getContractConfig function should have returned %d values", epochStart.ErrExecutingSystemScCode, numContractConfigValues)
+	}
+
+	return &common.DelegationProvider{
+		DelegationScAddress:         dvp.publicKeyConverter.Encode(delegationSC),
+		OwnerAddress:                dvp.publicKeyConverter.Encode(values[0]),
+		ServiceFee:                  big.NewInt(0).SetBytes(values[1]).String(),
+		MaxDelegationCap:            big.NewInt(0).SetBytes(values[2]).String(),
+		InitialOwnerFunds:           big.NewInt(0).SetBytes(values[3]).String(),
+		AutomaticActivation:         string(values[4]) == "true",
+		WithDelegationCap:           string(values[5]) == "true",
+		ChangeableServiceFee:        string(values[6]) == "true",
+		CheckCapOnReDelegateRewards: string(values[7]) == "true",
+		CreatedNonce:                big.NewInt(0).SetBytes(values[8]).Uint64(),
+		UnBondPeriodInEpochs:        uint32(big.NewInt(0).SetBytes(values[9]).Uint64()),
+	}, nil
+}
+
+func (dvp *delegationViewsProcessor) setMetaData(delegationSC []byte, provider *common.DelegationProvider) {
+	values, err := dvp.executeQuery(delegationSC, "getMetaData")
+	if err != nil || len(values) != numMetaDataValues {
+		// the meta data is optional
+		return
+	}
+
+	provider.Name = string(values[0])
+	provider.Website = string(values[1])
+	provider.Identifier = string(values[2])
+}
+
+func (dvp *delegationViewsProcessor) getNodesStates(delegationSC []byte) ([]*common.DelegationProviderNode, error) {
+	values, err := dvp.executeQuery(delegationSC, "getAllNodeStates")
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*common.DelegationProviderNode, 0, len(values))
+	state := ""
+	for _, value := range values {
+		_, isMarker := nodeStatesMarkers[string(value)]
+		if isMarker {
+			state = string(value)
+			continue
+		}
+
+		nodes = append(nodes, &common.DelegationProviderNode{
+			BLSKey: hex.EncodeToString(value),
+			State:  state,
+		})
+	}
+
+	return nodes, nil
+}
+
+func (dvp *delegationViewsProcessor) executeSingleValueQuery(scAddress []byte, funcName string, args ...[]byte) (*big.Int, error) {
+	values, err := dvp.executeQuery(scAddress, funcName, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 1 {
+		return nil, fmt.Errorf("%w, %s function should have returned one value", epochStart.ErrExecutingSystemScCode, funcName)
+	}
+
+	return big.NewInt(0).SetBytes(values[0]), nil
+}
+
+func (dvp *delegationViewsProcessor) executeQuery(scAddress []byte, funcName string, args ...[]byte) ([][]byte, error) {
+	scQuery := &process.SCQuery{
+		ScAddress:  scAddress,
+		FuncName:   funcName,
+		CallerAddr: scAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  args,
+	}
+	if scQuery.Arguments == nil {
+		scQuery.Arguments = make([][]byte, 0)
+	}
+
+	vmOutput, err := dvp.queryService.ExecuteQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dvp *delegationViewsProcessor) IsInterfaceNil() bool {
+	return dvp == nil
+}
@@ -0,0 +1,242 @@
+package trieIterators
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testDelegator      = bytes.Repeat([]byte("u"), 32)
+	testOtherDelegator = bytes.Repeat([]byte("o"), 32)
+	testOtherContract  = bytes.Repeat([]byte("e"), 32)
+	testOwner          = bytes.Repeat([]byte("w"), 32)
+)
+
+func createMockArgDelegationViewsProcessor() ArgDelegationViewsProcessor {
+	arg := ArgDelegationViewsProcessor{
+		ArgTrieIteratorProcessor: createMockArgs(),
+		BlockChain: &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.MetaBlock{Epoch: 10}
+			},
+		},
+	}
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(32)
+
+	return arg
+}
+
+func createDelegationQueryService(responses map[string][][]byte) *mock.SCQueryServiceStub {
+	return &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			returnData, ok := responses[query.FuncName]
+			if !ok {
+				return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError}, nil
+			}
+
+			return &vmcommon.VMOutput{
+				ReturnCode: vmcommon.Ok,
+				ReturnData: returnData,
+			}, nil
+		},
+	}
+}
+
+func TestNewDelegationViewsProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgDelegationViewsProcessor()
+		arg.Accounts = nil
+
+		dvp, err := NewDelegationViewsProcessor(arg)
+		assert.Equal(t, ErrNilAccountsAdapter, err)
+		assert.True(t, check.IfNil(dvp))
+	})
+	t.Run("nil block chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgDelegationViewsProcessor()
+		arg.BlockChain = nil
+
+		dvp, err := NewDelegationViewsProcessor(arg)
+		assert.Equal(t, ErrNilBlockChain, err)
+		assert.True(t, check.IfNil(dvp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dvp, err := NewDelegationViewsProcessor(createMockArgDelegationViewsProcessor())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(dvp))
+	})
+}
+
+func TestDelegationViewsProcessor_GetUserDelegationsShouldReturnOnlyTheContractsOfTheDelegator(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgDelegationViewsProcessor()
+	arg.QueryService = createDelegationQueryService(map[string][][]byte{
+		"getAllContractAddresses":         {testDelegation, testOtherContract},
+		"getUserActiveStake":              {big.NewInt(1000).Bytes()},
+		"getClaimableRewards":             {big.NewInt(5).Bytes()},
+		"getTotalCumulatedRewardsForUser": {big.NewInt(7).Bytes()},
+		"getUserUnDelegatedList":          {big.NewInt(10).Bytes(), big.NewInt(2).Bytes(), big.NewInt(20).Bytes(), {}},
+	})
+	setAccountsStorage(arg.Accounts, map[string]map[string][]byte{
+		string(testDelegation): {
+			string(testDelegator): []byte("delegator data"),
+		},
+		string(testOtherContract): {
+			string(testOtherDelegator): []byte("delegator data"),
+		},
+	})
+	dvp, _ := NewDelegationViewsProcessor(arg)
+
+	delegations, err := dvp.GetUserDelegations(arg.PublicKeyConverter.Encode(testDelegator))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(delegations))
+	assert.Equal(t, &common.UserDelegation{
+		DelegationScAddress: arg.PublicKeyConverter.Encode(testDelegation),
+		ActiveStake:         "1000",
+		UnStakedFunds: []*common.DelegationUnStakedFund{
+			{Value: "10", RemainingEpochs: 2, UnBondEpoch: 12},
+			{Value: "20", RemainingEpochs: 0, UnBondEpoch: 10},
+		},
+		ClaimableRewards:      "5",
+		TotalCumulatedRewards: "7",
+	}, delegations[0])
+}
+
+func TestDelegationViewsProcessor_GetUserDelegationsQueryErrorShouldError(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgDelegationViewsProcessor()
+	arg.QueryService = createDelegationQueryService(map[string][][]byte{
+		"getAllContractAddresses": {testDelegation},
+	})
+	setAccountsStorage(arg.Accounts, map[string]map[string][]byte{
+		string(testDelegation): {
+			string(testDelegator): []byte("delegator data"),
+		},
+	})
+	dvp, _ := NewDelegationViewsProcessor(arg)
+
+	delegations, err := dvp.GetUserDelegations(arg.PublicKeyConverter.Encode(testDelegator))
+	assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+	assert.Nil(t, delegations)
+}
+
+func TestDelegationViewsProcessor_GetDelegationProvider(t *testing.T) {
+	t.Parallel()
+
+	responses := map[string][][]byte{
+		"getContractConfig": {
+			testOwner,
+			big.NewInt(1000).Bytes(),
+			big.NewInt(5000).Bytes(),
+			big.NewInt(2500).Bytes(),
+			[]byte("true"),
+			[]byte("true"),
+			[]byte("false"),
+			[]byte("false"),
+			big.NewInt(37).Bytes(),
+			big.NewInt(10).Bytes(),
+		},
+		"getMetaData":         {[]byte("provider"), []byte("provider.com"), []byte("identity")},
+		"getNumUsers":         {big.NewInt(3).Bytes()},
+		"getTotalActiveStake": {big.NewInt(2500).Bytes()},
+		"getTotalUnStaked":    {big.NewInt(100).Bytes()},
+		"getAllNodeStates":    {[]byte("staked"), []byte("key1"), []byte("notStaked"), []byte("key2"), []byte("key3")},
+	}
+
+	t.Run("missing config should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgDelegationViewsProcessor()
+		arg.QueryService = createDelegationQueryService(map[string][][]byte{})
+		dvp, _ := NewDelegationViewsProcessor(arg)
+
+		provider, err := dvp.GetDelegationProvider(arg.PublicKeyConverter.Encode(testDelegation))
+		assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+		assert.Nil(t, provider)
+	})
+	t.Run("should return the provider with its nodes and top up", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgDelegationViewsProcessor()
+		queryService := createDelegationQueryService(responses)
+		arg.QueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				if bytes.Equal(query.ScAddress, vm.ValidatorSCAddress) {
+					assert.Equal(t, [][]byte{testDelegation}, query.Arguments)
+					return &vmcommon.VMOutput{
+						ReturnCode: vmcommon.Ok,
+						ReturnData: [][]byte{big.NewInt(500).Bytes(), big.NewInt(7500).Bytes(), big.NewInt(3).Bytes()},
+					}, nil
+				}
+
+				return queryService.ExecuteQuery(query)
+			},
+		}
+		dvp, _ := NewDelegationViewsProcessor(arg)
+
+		provider, err := dvp.GetDelegationProvider(arg.PublicKeyConverter.Encode(testDelegation))
+		require.Nil(t, err)
+		assert.Equal(t, &common.DelegationProvider{
+			DelegationScAddress:         arg.PublicKeyConverter.Encode(testDelegation),
+			OwnerAddress:                arg.PublicKeyConverter.Encode(testOwner),
+			ServiceFee:                  "1000",
+			MaxDelegationCap:            "5000",
+			InitialOwnerFunds:           "2500",
+			AutomaticActivation:         true,
+			WithDelegationCap:           true,
+			ChangeableServiceFee:        false,
+			CheckCapOnReDelegateRewards: false,
+			CreatedNonce:                37,
+			UnBondPeriodInEpochs:        10,
+			Name:                        "provider",
+			Website:                     "provider.com",
+			Identifier:                  "identity",
+			NumUsers:                    3,
+			TotalActiveStake:            "2500",
+			TotalUnStaked:               "100",
+			TotalStaked:                 "7500",
+			TopUp:                       "500",
+			Nodes: []*common.DelegationProviderNode{
+				{BLSKey: "6b657931", State: "staked"},
+				{BLSKey: "6b657932", State: "notStaked"},
+				{BLSKey: "6b657933", State: "notStaked"},
+			},
+		}, provider)
+	})
+	t.Run("not staked contract should return zero top up", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgDelegationViewsProcessor()
+		arg.QueryService = createDelegationQueryService(responses)
+		dvp, _ := NewDelegationViewsProcessor(arg)
+
+		provider, err := dvp.GetDelegationProvider(arg.PublicKeyConverter.Encode(testDelegation))
+		require.Nil(t, err)
+		assert.Equal(t, "0", provider.TotalStaked)
+		assert.Equal(t, "0", provider.TopUp)
+	})
+}
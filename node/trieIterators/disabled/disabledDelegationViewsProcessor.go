@@ -0,0 +1,31 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errCannotReturnDelegationViewsFromShardNode = errors.New("delegation views cannot be returned by a shard node")
+
+type delegationViewsProcessor struct{}
+
+// NewDisabledDelegationViewsProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledDelegationViewsProcessor() *delegationViewsProcessor {
+	return &delegationViewsProcessor{}
+}
+
+// GetUserDelegations returns the errCannotReturnDelegationViewsFromShardNode error
+func (dvp *delegationViewsProcessor) GetUserDelegations(_ string) ([]*common.UserDelegation, error) {
+	return nil, errCannotReturnDelegationViewsFromShardNode
+}
+
+// GetDelegationProvider returns the errCannotReturnDelegationViewsFromShardNode error
+func (dvp *delegationViewsProcessor) GetDelegationProvider(_ string) (*common.DelegationProvider, error) {
+	return nil, errCannotReturnDelegationViewsFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dvp *delegationViewsProcessor) IsInterfaceNil() bool {
+	return dvp == nil
+}
@@ -0,0 +1,37 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errCannotReturnGovernanceDataFromShardNode = errors.New("governance data cannot be returned by a shard node")
+
+type governanceProcessor struct{}
+
+// NewDisabledGovernanceProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledGovernanceProcessor() *governanceProcessor {
+	return &governanceProcessor{}
+}
+
+// GetProposals returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetProposals(_ context.Context) ([]*common.GovernanceProposal, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// GetProposal returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetProposal(_ string) (*common.GovernanceProposal, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// GetVotingPower returns the errCannotReturnGovernanceDataFromShardNode error
+func (gp *governanceProcessor) GetVotingPower(_ string) (*common.GovernanceVotingPower, error) {
+	return nil, errCannotReturnGovernanceDataFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
@@ -0,0 +1,31 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errCannotReturnStakingQueueFromShardNode = errors.New("staking queue cannot be returned by a shard node")
+
+type stakingQueueProcessor struct{}
+
+// NewDisabledStakingQueueProcessor returns a disabled implementation to be used on shard nodes
+func NewDisabledStakingQueueProcessor() *stakingQueueProcessor {
+	return &stakingQueueProcessor{}
+}
+
+// GetStakingQueue returns the errCannotReturnStakingQueueFromShardNode error
+func (sqp *stakingQueueProcessor) GetStakingQueue(from uint32, size uint32) (*common.StakingQueue, error) {
+	return nil, errCannotReturnStakingQueueFromShardNode
+}
+
+// GetStakingNode returns the errCannotReturnStakingQueueFromShardNode error
+func (sqp *stakingQueueProcessor) GetStakingNode(_ string) (*common.StakingNode, error) {
+	return nil, errCannotReturnStakingQueueFromShardNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sqp *stakingQueueProcessor) IsInterfaceNil() bool {
+	return sqp == nil
+}
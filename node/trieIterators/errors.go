@@ -19,3 +19,18 @@ var ErrNilMutex = errors.New("nil mutex")
 
 // ErrTrieOperationsTimeout signals a timeout during trie operations
 var ErrTrieOperationsTimeout = errors.New("trie operations timeout")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilBlockChain signals that a nil block chain has been provided
+var ErrNilBlockChain = errors.New("nil block chain")
+
+// ErrProposalNotFound signals that the requested governance proposal does not exist
+var ErrProposalNotFound = errors.New("proposal not found")
+
+// ErrInvalidProposalReference signals that an invalid governance proposal reference has been provided
+var ErrInvalidProposalReference = errors.New("invalid proposal reference")
+
+// ErrNodeNotRegistered signals that the requested BLS key is not registered in the staking contract
+var ErrNodeNotRegistered = errors.New("node not registered in the staking contract")
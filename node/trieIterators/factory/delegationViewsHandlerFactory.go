@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators/disabled"
+)
+
+// CreateDelegationViewsHandler will create a new instance of DelegationViewsHandler
+func CreateDelegationViewsHandler(args trieIterators.ArgDelegationViewsProcessor) (external.DelegationViewsHandler, error) {
+	if args.ShardID != core.MetachainShardId {
+		return disabled.NewDisabledDelegationViewsProcessor(), nil
+	}
+
+	return trieIterators.NewDelegationViewsProcessor(args)
+}
@@ -0,0 +1,50 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateDelegationViewsHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgDelegationViewsProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: 0,
+		},
+	}
+
+	delegationViewsHandler, err := CreateDelegationViewsHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.delegationViewsProcessor", fmt.Sprintf("%T", delegationViewsHandler))
+}
+
+func TestCreateDelegationViewsHandler_DelegationViewsProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgDelegationViewsProcessor{
+		ArgTrieIteratorProcessor: trieIterators.ArgTrieIteratorProcessor{
+			ShardID: core.MetachainShardId,
+			Accounts: &trieIterators.AccountsWrapper{
+				Mutex:           &sync.Mutex{},
+				AccountsAdapter: &stateMock.AccountsStub{},
+			},
+			PublicKeyConverter: &mock.PubkeyConverterMock{},
+			QueryService:       &mock.SCQueryServiceStub{},
+		},
+		BlockChain: &testscommon.ChainHandlerStub{},
+	}
+
+	delegationViewsHandler, err := CreateDelegationViewsHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.delegationViewsProcessor", fmt.Sprintf("%T", delegationViewsHandler))
+}
@@ -0,0 +1,359 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+)
+
+const (
+	governanceProposalPrefix = "proposal_"
+	commitHashLength         = 40
+
+	// ProposalStatusPending marks a proposal whose voting period did not start yet
+	ProposalStatusPending = "pending"
+	// ProposalStatusActive marks a proposal that can be voted
+	ProposalStatusActive = "active"
+	// ProposalStatusEnded marks a proposal whose voting period ended but was not closed yet
+	ProposalStatusEnded = "ended"
+	// ProposalStatusClosed marks a closed proposal, for which the end results were computed
+	ProposalStatusClosed = "closed"
+)
+
+var log = logger.GetOrCreate("node/trieIterators")
+
+// ArgGovernanceProcessor represents the arguments DTO used in the governance processor constructor
+type ArgGovernanceProcessor struct {
+	ArgTrieIteratorProcessor
+	Marshalizer marshal.Marshalizer
+	BlockChain  data.ChainHandler
+}
+
+type governanceProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter core.PubkeyConverter
+	marshalizer        marshal.Marshalizer
+	blockChain         data.ChainHandler
+}
+
+// NewGovernanceProcessor will create a new instance of governanceProcessor
+func NewGovernanceProcessor(arg ArgGovernanceProcessor) (*governanceProcessor, error) {
+	err := checkArguments(arg.ArgTrieIteratorProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(arg.BlockChain) {
+		return nil, ErrNilBlockChain
+	}
+
+	return &governanceProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter: arg.PublicKeyConverter,
+		marshalizer:        arg.Marshalizer,
+		blockChain:         arg.BlockChain,
+	}, nil
+}
+
+// GetProposals will return all the governance proposals, without their votes
+func (gp *governanceProcessor) GetProposals(ctx context.Context) ([]*common.GovernanceProposal, error) {
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	governanceAccount, err := gp.getAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash, err := governanceAccount.DataTrie().RootHash()
+	if err != nil {
+		return nil, err
+	}
+
+	chLeaves := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+	err = governanceAccount.DataTrie().GetAllLeavesOnChannel(chLeaves, ctx, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	currentNonce := gp.getCurrentNonce()
+	proposals := make([]*common.GovernanceProposal, 0)
+	for leaf := range chLeaves {
+		reference, isProposal := gp.getProposalReference(leaf.Key())
+		if !isProposal {
+			continue
+		}
+
+		suffix := append(leaf.Key(), governanceAccount.AddressBytes()...)
+		value, errVal := leaf.ValueWithoutSuffix(suffix)
+		if errVal != nil {
+			log.Warn("cannot get value without suffix", "error", errVal, "key", leaf.Key())
+			continue
+		}
+
+		generalProposal := &systemSmartContracts.GeneralProposal{}
+		errVal = gp.marshalizer.Unmarshal(generalProposal, value)
+		if errVal != nil {
+			continue
+		}
+
+		proposals = append(proposals, gp.createProposal(reference, generalProposal, currentNonce))
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+
+	sort.SliceStable(proposals, func(i, j int) bool {
+		return proposals[i].StartVoteNonce < proposals[j].StartVoteNonce
+	})
+
+	return proposals, nil
+}
+
+// getProposalReference returns the reference of the proposal saved under the provided key. The general proposals are
+// saved under their commit hash while the white list proposals are saved under the proposer's address
+func (gp *governanceProcessor) getProposalReference(key []byte) ([]byte, bool) {
+	if !bytes.HasPrefix(key, []byte(governanceProposalPrefix)) {
+		return nil, false
+	}
+
+	reference := key[len(governanceProposalPrefix):]
+	switch len(reference) {
+	case commitHashLength, gp.publicKeyConverter.Len():
+		return reference, true
+	default:
+		return nil, false
+	}
+}
+
+// GetProposal will return the governance proposal with the provided reference, together with its votes
+func (gp *governanceProcessor) GetProposal(reference string) (*common.GovernanceProposal, error) {
+	referenceBytes, err := gp.decodeReference(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	governanceAccount, err := gp.getAccount(vm.GovernanceSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	generalProposal := &systemSmartContracts.GeneralProposal{}
+	err = gp.getStorageValue(governanceAccount.RetrieveValueFromDataTrieTracker, createGovernanceKey(referenceBytes), generalProposal)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrProposalNotFound, reference)
+	}
+
+	proposal := gp.createProposal(referenceBytes, generalProposal, gp.getCurrentNonce())
+	proposal.Votes = make([]*common.GovernanceVote, 0, len(generalProposal.Votes))
+	for _, voter := range generalProposal.Votes {
+		voteSet := &systemSmartContracts.VoteSet{}
+		err = gp.getStorageValue(governanceAccount.RetrieveValueFromDataTrieTracker, createGovernanceKey(generalProposal.CommitHash, voter), voteSet)
+		if err != nil {
+			log.Debug("governanceProcessor.GetProposal: cannot get vote set", "voter", voter, "error", err)
+			continue
+		}
+
+		proposal.Votes = append(proposal.Votes, gp.createVote(voter, voteSet))
+	}
+
+	return proposal, nil
+}
+
+func (gp *governanceProcessor) decodeReference(reference string) ([]byte, error) {
+	if len(reference) == commitHashLength {
+		return []byte(reference), nil
+	}
+
+	address, err := gp.publicKeyConverter.Decode(reference)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidProposalReference, err.Error())
+	}
+
+	return address, nil
+}
+
+// GetVotingPower will return the governance voting power of the provided address, computed the same way the
+// governance contract does when the address votes
+func (gp *governanceProcessor) GetVotingPower(address string) (*common.GovernanceVotingPower, error) {
+	addressBytes, err := gp.publicKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	gp.accounts.Lock()
+	defer gp.accounts.Unlock()
+
+	argsVotingPower := systemSmartContracts.ArgsVotingPower{
+		Storage:                &accountsStorageGetter{commonStakingProcessor: gp.commonStakingProcessor},
+		Marshalizer:            gp.marshalizer,
+		ValidatorSCAddress:     vm.ValidatorSCAddress,
+		DelegationMgrSCAddress: vm.DelegationManagerSCAddress,
+	}
+	details, err := systemSmartContracts.ComputeVotingPowerDetails(argsVotingPower, addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorVotingPower, err := systemSmartContracts.ComputeVotingPower(details.StakedValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.GovernanceVotingPower{
+		Address:              address,
+		StakedValue:          details.StakedValue.String(),
+		DelegatedValue:       details.DelegatedValue.String(),
+		ValidatorVotingPower: validatorVotingPower.String(),
+		VotingPower:          details.VotingPower.String(),
+	}, nil
+}
+
+func (gp *governanceProcessor) createProposal(
+	reference []byte,
+	generalProposal *systemSmartContracts.GeneralProposal,
+	currentNonce uint64,
+) *common.GovernanceProposal {
+	encodedReference := string(reference)
+	if len(reference) != commitHashLength {
+		encodedReference = gp.publicKeyConverter.Encode(reference)
+	}
+
+	return &common.GovernanceProposal{
+		Reference:      encodedReference,
+		CommitHash:     string(generalProposal.CommitHash),
+		Issuer:         gp.publicKeyConverter.Encode(generalProposal.IssuerAddress),
+		StartVoteNonce: generalProposal.StartVoteNonce,
+		EndVoteNonce:   generalProposal.EndVoteNonce,
+		Status:         computeProposalStatus(generalProposal, currentNonce),
+		Yes:            bigIntToString(generalProposal.Yes),
+		No:             bigIntToString(generalProposal.No),
+		Veto:           bigIntToString(generalProposal.Veto),
+		Passed:         generalProposal.Passed,
+		NumVoters:      len(generalProposal.Votes),
+	}
+}
+
+func (gp *governanceProcessor) createVote(voter []byte, voteSet *systemSmartContracts.VoteSet) *common.GovernanceVote {
+	vote := &common.GovernanceVote{
+		Voter:       gp.publicKeyConverter.Encode(voter),
+		UsedPower:   bigIntToString(voteSet.UsedPower),
+		UsedBalance: bigIntToString(voteSet.UsedBalance),
+		TotalYes:    bigIntToString(voteSet.TotalYes),
+		TotalNo:     bigIntToString(voteSet.TotalNo),
+		TotalVeto:   bigIntToString(voteSet.TotalVeto),
+		Items:       make([]*common.GovernanceVoteItem, 0, len(voteSet.VoteItems)),
+	}
+
+	for _, voteItem := range voteSet.VoteItems {
+		item := &common.GovernanceVoteItem{
+			Value:   voteItem.Value.String(),
+			Power:   bigIntToString(voteItem.Power),
+			Balance: bigIntToString(voteItem.Balance),
+		}
+		if len(voteItem.DelegatedTo) > 0 {
+			item.DelegatedTo = gp.publicKeyConverter.Encode(voteItem.DelegatedTo)
+		}
+
+		vote.Items = append(vote.Items, item)
+	}
+
+	return vote
+}
+
+func (gp *governanceProcessor) getStorageValue(retrieveValue func(key []byte) ([]byte, error), key []byte, value interface{}) error {
+	buff, err := retrieveValue(key)
+	if err != nil {
+		return err
+	}
+	if len(buff) == 0 {
+		return vm.ErrEmptyStorage
+	}
+
+	return gp.marshalizer.Unmarshal(value, buff)
+}
+
+func (gp *governanceProcessor) getCurrentNonce() uint64 {
+	currentHeader := gp.blockChain.GetCurrentBlockHeader()
+	if check.IfNil(currentHeader) {
+		return 0
+	}
+
+	return currentHeader.GetNonce()
+}
+
+func computeProposalStatus(generalProposal *systemSmartContracts.GeneralProposal, currentNonce uint64) string {
+	switch {
+	case generalProposal.Closed:
+		return ProposalStatusClosed
+	case currentNonce < generalProposal.StartVoteNonce:
+		return ProposalStatusPending
+	case currentNonce <= generalProposal.EndVoteNonce:
+		return ProposalStatusActive
+	default:
+		return ProposalStatusEnded
+	}
+}
+
+func createGovernanceKey(parts ...[]byte) []byte {
+	key := []byte(governanceProposalPrefix)
+	for _, part := range parts {
+		key = append(key, part...)
+	}
+
+	return key
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *governanceProcessor) IsInterfaceNil() bool {
+	return gp == nil
+}
+
+// accountsStorageGetter reads the system smart contracts storage directly from the accounts adapter, the same way
+// the system VM reads it while executing a transaction
+type accountsStorageGetter struct {
+	*commonStakingProcessor
+}
+
+// GetStorageFromAddress returns the value saved under the provided key in the provided account's storage
+func (asg *accountsStorageGetter) GetStorageFromAddress(address []byte, key []byte) []byte {
+	account, err := asg.getAccount(address)
+	if err != nil {
+		return nil
+	}
+
+	value, err := account.RetrieveValueFromDataTrieTracker(key)
+	if err != nil {
+		return nil
+	}
+
+	return value
+}
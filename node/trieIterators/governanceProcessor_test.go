@@ -0,0 +1,316 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testCommitHash  = []byte("0123456789012345678901234567890123456789")
+	testVoter       = bytes.Repeat([]byte("v"), 32)
+	testIssuer      = bytes.Repeat([]byte("i"), 32)
+	testDelegation  = bytes.Repeat([]byte("d"), 32)
+	testMarshalizer = &marshal.GogoProtoMarshalizer{}
+)
+
+func createMockArgGovernanceProcessor() ArgGovernanceProcessor {
+	arg := ArgGovernanceProcessor{
+		ArgTrieIteratorProcessor: createMockArgs(),
+		Marshalizer:              testMarshalizer,
+		BlockChain: &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.MetaBlock{Nonce: 15}
+			},
+		},
+	}
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(32)
+
+	return arg
+}
+
+func createAccountWithStorage(address []byte, storage map[string][]byte) state.UserAccountHandler {
+	acc, _ := state.NewUserAccount(address)
+	acc.SetDataTrie(&trieMock.TrieStub{
+		RootCalled: func() ([]byte, error) {
+			return []byte("root hash"), nil
+		},
+		GetCalled: func(key []byte) ([]byte, error) {
+			value, ok := storage[string(key)]
+			if !ok {
+				return nil, nil
+			}
+
+			return createLeafValue(value, key, address), nil
+		},
+		GetAllLeavesOnChannelCalled: func(ch chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error {
+			go func() {
+				for key, value := range storage {
+					ch <- keyValStorage.NewKeyValStorage([]byte(key), createLeafValue(value, []byte(key), address))
+				}
+
+				close(ch)
+			}()
+
+			return nil
+		},
+	})
+
+	return acc
+}
+
+func createLeafValue(value []byte, key []byte, address []byte) []byte {
+	leafValue := make([]byte, 0, len(value)+len(key)+len(address))
+	leafValue = append(leafValue, value...)
+	leafValue = append(leafValue, key...)
+
+	return append(leafValue, address...)
+}
+
+func setAccountsStorage(accounts *AccountsWrapper, storage map[string]map[string][]byte) {
+	accounts.AccountsAdapter = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			accountStorage, ok := storage[string(address)]
+			if !ok {
+				return nil, errors.New("account not found")
+			}
+
+			return createAccountWithStorage(address, accountStorage), nil
+		},
+	}
+}
+
+func marshalForTest(obj interface{}) []byte {
+	buff, _ := testMarshalizer.Marshal(obj)
+	return buff
+}
+
+func createGovernanceStorage() map[string][]byte {
+	activeProposal := &systemSmartContracts.GeneralProposal{
+		IssuerAddress:  testIssuer,
+		CommitHash:     testCommitHash,
+		StartVoteNonce: 10,
+		EndVoteNonce:   20,
+		Yes:            big.NewInt(7),
+		No:             big.NewInt(2),
+		Veto:           big.NewInt(0),
+		Votes:          [][]byte{testVoter},
+	}
+	closedProposal := &systemSmartContracts.GeneralProposal{
+		IssuerAddress:  testIssuer,
+		CommitHash:     bytes.Repeat([]byte("c"), commitHashLength),
+		StartVoteNonce: 1,
+		EndVoteNonce:   5,
+		Yes:            big.NewInt(10),
+		No:             big.NewInt(0),
+		Veto:           big.NewInt(0),
+		Passed:         true,
+		Closed:         true,
+	}
+	voteSet := &systemSmartContracts.VoteSet{
+		UsedPower:   big.NewInt(9),
+		UsedBalance: big.NewInt(81),
+		TotalYes:    big.NewInt(7),
+		TotalNo:     big.NewInt(2),
+		TotalVeto:   big.NewInt(0),
+		VoteItems: []*systemSmartContracts.VoteDetails{
+			{Value: systemSmartContracts.Yes, Power: big.NewInt(7), Balance: big.NewInt(49)},
+			{Value: systemSmartContracts.No, Power: big.NewInt(2), Balance: big.NewInt(32), DelegatedTo: testDelegation},
+		},
+	}
+
+	return map[string][]byte{
+		string(createGovernanceKey(testCommitHash)):            marshalForTest(activeProposal),
+		string(createGovernanceKey(testIssuer)):                marshalForTest(closedProposal),
+		string(createGovernanceKey(testCommitHash, testVoter)): marshalForTest(voteSet),
+		"governanceConfig": []byte("config"),
+	}
+}
+
+func TestNewGovernanceProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgGovernanceProcessor()
+		arg.Accounts = nil
+
+		gp, err := NewGovernanceProcessor(arg)
+		assert.Equal(t, ErrNilAccountsAdapter, err)
+		assert.True(t, check.IfNil(gp))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgGovernanceProcessor()
+		arg.Marshalizer = nil
+
+		gp, err := NewGovernanceProcessor(arg)
+		assert.Equal(t, ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(gp))
+	})
+	t.Run("nil block chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgGovernanceProcessor()
+		arg.BlockChain = nil
+
+		gp, err := NewGovernanceProcessor(arg)
+		assert.Equal(t, ErrNilBlockChain, err)
+		assert.True(t, check.IfNil(gp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gp, err := NewGovernanceProcessor(createMockArgGovernanceProcessor())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(gp))
+	})
+}
+
+func TestGovernanceProcessor_GetProposalsShouldReturnTheProposalsWithTheirStatus(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGovernanceProcessor()
+	setAccountsStorage(arg.Accounts, map[string]map[string][]byte{
+		string(vm.GovernanceSCAddress): createGovernanceStorage(),
+	})
+	gp, _ := NewGovernanceProcessor(arg)
+
+	proposals, err := gp.GetProposals(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 2, len(proposals))
+
+	assert.Equal(t, &common.GovernanceProposal{
+		Reference:      arg.PublicKeyConverter.Encode(testIssuer),
+		CommitHash:     string(bytes.Repeat([]byte("c"), commitHashLength)),
+		Issuer:         arg.PublicKeyConverter.Encode(testIssuer),
+		StartVoteNonce: 1,
+		EndVoteNonce:   5,
+		Status:         ProposalStatusClosed,
+		Yes:            "10",
+		No:             "0",
+		Veto:           "0",
+		Passed:         true,
+	}, proposals[0])
+	assert.Equal(t, &common.GovernanceProposal{
+		Reference:      string(testCommitHash),
+		CommitHash:     string(testCommitHash),
+		Issuer:         arg.PublicKeyConverter.Encode(testIssuer),
+		StartVoteNonce: 10,
+		EndVoteNonce:   20,
+		Status:         ProposalStatusActive,
+		Yes:            "7",
+		No:             "2",
+		Veto:           "0",
+		NumVoters:      1,
+	}, proposals[1])
+}
+
+func TestGovernanceProcessor_GetProposal(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgGovernanceProcessor()
+	setAccountsStorage(arg.Accounts, map[string]map[string][]byte{
+		string(vm.GovernanceSCAddress): createGovernanceStorage(),
+	})
+	gp, _ := NewGovernanceProcessor(arg)
+
+	t.Run("invalid reference should error", func(t *testing.T) {
+		t.Parallel()
+
+		proposal, err := gp.GetProposal("not a hex reference")
+		assert.True(t, errors.Is(err, ErrInvalidProposalReference))
+		assert.Nil(t, proposal)
+	})
+	t.Run("missing proposal should error", func(t *testing.T) {
+		t.Parallel()
+
+		proposal, err := gp.GetProposal(string(bytes.Repeat([]byte("m"), commitHashLength)))
+		assert.True(t, errors.Is(err, ErrProposalNotFound))
+		assert.Nil(t, proposal)
+	})
+	t.Run("should return the proposal with its votes", func(t *testing.T) {
+		t.Parallel()
+
+		proposal, err := gp.GetProposal(string(testCommitHash))
+		require.Nil(t, err)
+		assert.Equal(t, ProposalStatusActive, proposal.Status)
+		require.Equal(t, 1, len(proposal.Votes))
+		assert.Equal(t, &common.GovernanceVote{
+			Voter:       arg.PublicKeyConverter.Encode(testVoter),
+			UsedPower:   "9",
+			UsedBalance: "81",
+			TotalYes:    "7",
+			TotalNo:     "2",
+			TotalVeto:   "0",
+			Items: []*common.GovernanceVoteItem{
+				{Value: "Yes", Power: "7", Balance: "49"},
+				{Value: "No", Power: "2", Balance: "32", DelegatedTo: arg.PublicKeyConverter.Encode(testDelegation)},
+			},
+		}, proposal.Votes[0])
+	})
+}
+
+func TestGovernanceProcessor_GetVotingPowerShouldAddTheStakedAndTheDelegatedValues(t *testing.T) {
+	t.Parallel()
+
+	validatorData := &systemSmartContracts.ValidatorDataV2{
+		TotalStakeValue: big.NewInt(100),
+		LockedStake:     big.NewInt(0),
+		MaxStakePerNode: big.NewInt(0),
+		TotalUnstaked:   big.NewInt(0),
+		TotalSlashed:    big.NewInt(0),
+	}
+	delegatorData := &systemSmartContracts.DelegatorData{
+		ActiveFund:            []byte("active fund"),
+		UnClaimedRewards:      big.NewInt(0),
+		TotalCumulatedRewards: big.NewInt(0),
+	}
+
+	arg := createMockArgGovernanceProcessor()
+	setAccountsStorage(arg.Accounts, map[string]map[string][]byte{
+		string(vm.ValidatorSCAddress): {
+			string(testVoter): marshalForTest(validatorData),
+		},
+		string(vm.DelegationManagerSCAddress): {
+			"delegationContracts": marshalForTest(&systemSmartContracts.DelegationContractList{Addresses: [][]byte{testDelegation}}),
+		},
+		string(testDelegation): {
+			string(testVoter): marshalForTest(delegatorData),
+			"active fund":     marshalForTest(&systemSmartContracts.Fund{Value: big.NewInt(21)}),
+		},
+	})
+	gp, _ := NewGovernanceProcessor(arg)
+
+	votingPower, err := gp.GetVotingPower(arg.PublicKeyConverter.Encode(testVoter))
+	require.Nil(t, err)
+	assert.Equal(t, &common.GovernanceVotingPower{
+		Address:              arg.PublicKeyConverter.Encode(testVoter),
+		StakedValue:          "100",
+		DelegatedValue:       "21",
+		ValidatorVotingPower: "10",
+		VotingPower:          "11",
+	}, votingPower)
+}
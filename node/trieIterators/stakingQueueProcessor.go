@@ -0,0 +1,328 @@
+package trieIterators
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const (
+	numValuesPerQueueEntry = 3
+	jailedStatus           = "jailed"
+	queuedStatus           = "queued"
+)
+
+// ArgStakingQueueProcessor represents the arguments DTO used in the staking queue processor constructor
+type ArgStakingQueueProcessor struct {
+	ArgTrieIteratorProcessor
+	BlockChain           data.ChainHandler
+	StakingV2EnableEpoch uint32
+}
+
+type stakingQueueProcessor struct {
+	*commonStakingProcessor
+	publicKeyConverter   core.PubkeyConverter
+	blockChain           data.ChainHandler
+	stakingV2EnableEpoch uint32
+}
+
+// NewStakingQueueProcessor will create a new instance of stakingQueueProcessor
+func NewStakingQueueProcessor(arg ArgStakingQueueProcessor) (*stakingQueueProcessor, error) {
+	err := checkArguments(arg.ArgTrieIteratorProcessor)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.BlockChain) {
+		return nil, ErrNilBlockChain
+	}
+
+	return &stakingQueueProcessor{
+		commonStakingProcessor: &commonStakingProcessor{
+			queryService: arg.QueryService,
+			accounts:     arg.Accounts,
+		},
+		publicKeyConverter:   arg.PublicKeyConverter,
+		blockChain:           arg.BlockChain,
+		stakingV2EnableEpoch: arg.StakingV2EnableEpoch,
+	}, nil
+}
+
+// GetStakingQueue will return the nodes from the staking queue found at the 0 based positions starting with from, at
+// most size of them, in the order they will be staked. The size of the queue is the total number of queued nodes
+func (sqp *stakingQueueProcessor) GetStakingQueue(from uint32, size uint32) (*common.StakingQueue, error) {
+	sqp.accounts.Lock()
+	defer sqp.accounts.Unlock()
+
+	queueSize, err := sqp.getQueueSize()
+	if err != nil {
+		return nil, err
+	}
+
+	queue := &common.StakingQueue{
+		Size:  queueSize,
+		Nodes: make([]*common.StakingQueueEntry, 0),
+	}
+	if queueSize == 0 || from >= queueSize {
+		return queue, nil
+	}
+
+	values, err := sqp.executeQuery("getQueueRegisterNonceAndRewardAddress")
+	if err != nil {
+		return nil, err
+	}
+	if len(values)%numValuesPerQueueEntry != 0 {
+		return nil, fmt.Errorf("%w, getQueueRegisterNonceAndRewardAddress function should have returned triplets of values", epochStart.ErrExecutingSystemScCode)
+	}
+
+	// the owners are read with one query per node, so they are read only for the requested page
+	numEntries := uint64(len(values) / numValuesPerQueueEntry)
+	end := uint64(from) + uint64(size)
+	if end > numEntries {
+		end = numEntries
+	}
+	for position := uint64(from); position < end; position++ {
+		i := position * numValuesPerQueueEntry
+		owner, errOwner := sqp.getOwner(values[i])
+		if errOwner != nil {
+			return nil, errOwner
+		}
+
+		queue.Nodes = append(queue.Nodes, &common.StakingQueueEntry{
+			Position:      uint32(position + 1),
+			BLSKey:        hex.EncodeToString(values[i]),
+			OwnerAddress:  sqp.encodeAddress(owner),
+			RewardAddress: sqp.publicKeyConverter.Encode(values[i+1]),
+			RegisterNonce: big.NewInt(0).SetBytes(values[i+2]).Uint64(),
+		})
+	}
+
+	return queue, nil
+}
+
+// GetStakingNode will return the staking lifecycle view of the node with the provided BLS key
+func (sqp *stakingQueueProcessor) GetStakingNode(blsKey string) (*common.StakingNode, error) {
+	blsKeyBytes, err := hex.DecodeString(blsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sqp.accounts.Lock()
+	defer sqp.accounts.Unlock()
+
+	statusValues, err := sqp.executeOptionalQuery("getBLSKeyStatus", blsKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(statusValues) != 1 {
+		return nil, ErrNodeNotRegistered
+	}
+
+	node := &common.StakingNode{
+		BLSKey:      hex.EncodeToString(blsKeyBytes),
+		Status:      string(statusValues[0]),
+		Jailed:      string(statusValues[0]) == jailedStatus,
+		TotalStaked: "0",
+		TopUp:       "0",
+	}
+
+	node.RewardAddress, err = sqp.getRewardAddress(blsKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sqp.getOwner(blsKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+	node.OwnerAddress = sqp.encodeAddress(owner)
+
+	node.QueueSize, err = sqp.getQueueSize()
+	if err != nil {
+		return nil, err
+	}
+
+	if node.Status == queuedStatus {
+		node.QueuePosition, err = sqp.executeNumericStringQuery("getQueueIndex", blsKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = sqp.setUnBondPeriod(blsKeyBytes, node)
+	if err != nil {
+		return nil, err
+	}
+
+	sqp.setStakedValues(owner, node)
+
+	return node, nil
+}
+
+func (sqp *stakingQueueProcessor) getQueueSize() (uint32, error) {
+	return sqp.executeNumericStringQuery("getQueueSize")
+}
+
+// getOwner returns an empty owner if it was not yet set in the staking contract
+func (sqp *stakingQueueProcessor) getOwner(blsKey []byte) ([]byte, error) {
+	values, err := sqp.executeOptionalQuery("getOwner", blsKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 1 {
+		return nil, nil
+	}
+
+	return values[0], nil
+}
+
+func (sqp *stakingQueueProcessor) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return sqp.publicKeyConverter.Encode(address)
+}
+
+func (sqp *stakingQueueProcessor) getRewardAddress(blsKey []byte) (string, error) {
+	values, err := sqp.executeQuery("getRewardAddress", blsKey)
+	if err != nil {
+		return "", err
+	}
+	if len(values) != 1 {
+		return "", fmt.Errorf("%w, getRewardAddress function should have returned one value", epochStart.ErrExecutingSystemScCode)
+	}
+
+	// the staking contract returns the reward address hex encoded
+	rewardAddress, err := hex.DecodeString(string(values[0]))
+	if err != nil {
+		return "", err
+	}
+
+	return sqp.publicKeyConverter.Encode(rewardAddress), nil
+}
+
+func (sqp *stakingQueueProcessor) setUnBondPeriod(blsKey []byte, node *common.StakingNode) error {
+	// the staking contract returns an error if the node is not in the unbond period
+	values, err := sqp.executeOptionalQuery("getRemainingUnBondPeriod", blsKey)
+	if err != nil {
+		return err
+	}
+	if len(values) != 1 {
+		return nil
+	}
+
+	remainingUnBondPeriod, err := sqp.parseRemainingUnBondPeriod(values[0])
+	if err != nil {
+		return err
+	}
+
+	node.UnBonding = true
+	node.RemainingUnBondPeriod = remainingUnBondPeriod
+
+	return nil
+}
+
+// parseRemainingUnBondPeriod decodes the value returned by the staking contract, which is a decimal string before
+// the staking v2 activation and a big endian number afterwards
+func (sqp *stakingQueueProcessor) parseRemainingUnBondPeriod(value []byte) (uint64, error) {
+	if getCurrentEpoch(sqp.blockChain) >= sqp.stakingV2EnableEpoch {
+		return big.NewInt(0).SetBytes(value).Uint64(), nil
+	}
+
+	remainingUnBondPeriod, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w, getRemainingUnBondPeriod function returned an invalid value: %s", epochStart.ErrExecutingSystemScCode, err.Error())
+	}
+
+	return remainingUnBondPeriod, nil
+}
+
+func (sqp *stakingQueueProcessor) setStakedValues(owner []byte, node *common.StakingNode) {
+	if len(owner) == 0 {
+		return
+	}
+
+	info, err := sqp.getValidatorInfoFromSC(owner)
+	if err != nil {
+		// the owner has no active nodes left or the top up is not yet enabled
+		log.Debug("stakingQueueProcessor.GetStakingNode: cannot get validator info", "error", err)
+		return
+	}
+
+	node.TotalStaked = info.totalStakedValue.String()
+	node.TopUp = info.topUpValue.String()
+	node.NumActiveNodes = info.numActiveNodes
+}
+
+func (sqp *stakingQueueProcessor) executeNumericStringQuery(funcName string, args ...[]byte) (uint32, error) {
+	values, err := sqp.executeQuery(funcName, args...)
+	if err != nil {
+		return 0, err
+	}
+	if len(values) != 1 {
+		return 0, fmt.Errorf("%w, %s function should have returned one value", epochStart.ErrExecutingSystemScCode, funcName)
+	}
+
+	value, err := strconv.ParseUint(string(values[0]), 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w, %s function returned an invalid value: %s", epochStart.ErrExecutingSystemScCode, funcName, err.Error())
+	}
+
+	return uint32(value), nil
+}
+
+func (sqp *stakingQueueProcessor) executeQuery(funcName string, args ...[]byte) ([][]byte, error) {
+	vmOutput, err := sqp.executeStakingQuery(funcName, args...)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, fmt.Errorf("%w, return code: %v, message: %s", epochStart.ErrExecutingSystemScCode, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+// executeOptionalQuery returns no values if the staking contract did not finish the call successfully
+func (sqp *stakingQueueProcessor) executeOptionalQuery(funcName string, args ...[]byte) ([][]byte, error) {
+	vmOutput, err := sqp.executeStakingQuery(funcName, args...)
+	if err != nil {
+		return nil, err
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return nil, nil
+	}
+
+	return vmOutput.ReturnData, nil
+}
+
+func (sqp *stakingQueueProcessor) executeStakingQuery(funcName string, args ...[]byte) (*vmcommon.VMOutput, error) {
+	// some of the staking contract view functions can only be called by the validator contract
+	scQuery := &process.SCQuery{
+		ScAddress:  vm.StakingSCAddress,
+		FuncName:   funcName,
+		CallerAddr: vm.ValidatorSCAddress,
+		CallValue:  big.NewInt(0),
+		Arguments:  args,
+	}
+	if scQuery.Arguments == nil {
+		scQuery.Arguments = make([][]byte, 0)
+	}
+
+	return sqp.queryService.ExecuteQuery(scQuery)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sqp *stakingQueueProcessor) IsInterfaceNil() bool {
+	return sqp == nil
+}
@@ -0,0 +1,332 @@
+package trieIterators
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/epochStart"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testBLSKey1        = bytes.Repeat([]byte("1"), 96)
+	testBLSKey2        = bytes.Repeat([]byte("2"), 96)
+	testNodeOwner      = bytes.Repeat([]byte("n"), 32)
+	testRewardAddress1 = bytes.Repeat([]byte("r"), 32)
+	testRewardAddress2 = bytes.Repeat([]byte("s"), 32)
+)
+
+func createMockArgStakingQueueProcessorWithoutResponses() ArgStakingQueueProcessor {
+	return ArgStakingQueueProcessor{
+		ArgTrieIteratorProcessor: createMockArgs(),
+		BlockChain: &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.MetaBlock{Epoch: 10}
+			},
+		},
+		StakingV2EnableEpoch: 5,
+	}
+}
+
+func createMockArgStakingQueueProcessor(responses map[string][][]byte) ArgStakingQueueProcessor {
+	arg := createMockArgStakingQueueProcessorWithoutResponses()
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(32)
+	queryService := createDelegationQueryService(responses)
+	arg.QueryService = &mock.SCQueryServiceStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			if query.FuncName != "getTotalStakedTopUpStakedBlsKeys" {
+				if !bytes.Equal(vm.StakingSCAddress, query.ScAddress) || !bytes.Equal(vm.ValidatorSCAddress, query.CallerAddr) {
+					return nil, errors.New("the staking contract should have been called by the validator contract")
+				}
+			}
+
+			return queryService.ExecuteQuery(query)
+		},
+	}
+
+	return arg
+}
+
+func TestNewStakingQueueProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgStakingQueueProcessorWithoutResponses()
+		arg.Accounts = nil
+
+		sqp, err := NewStakingQueueProcessor(arg)
+		assert.Equal(t, ErrNilAccountsAdapter, err)
+		assert.True(t, check.IfNil(sqp))
+	})
+	t.Run("nil query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgStakingQueueProcessorWithoutResponses()
+		arg.QueryService = nil
+
+		sqp, err := NewStakingQueueProcessor(arg)
+		assert.Equal(t, ErrNilQueryService, err)
+		assert.True(t, check.IfNil(sqp))
+	})
+	t.Run("nil block chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgStakingQueueProcessorWithoutResponses()
+		arg.BlockChain = nil
+
+		sqp, err := NewStakingQueueProcessor(arg)
+		assert.Equal(t, ErrNilBlockChain, err)
+		assert.True(t, check.IfNil(sqp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sqp, err := NewStakingQueueProcessor(createMockArgStakingQueueProcessorWithoutResponses())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(sqp))
+	})
+}
+
+func TestStakingQueueProcessor_GetStakingQueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty queue should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgStakingQueueProcessor(map[string][][]byte{
+			"getQueueSize": {[]byte("0")},
+		})
+		sqp, _ := NewStakingQueueProcessor(arg)
+
+		queue, err := sqp.GetStakingQueue(0, 10)
+		require.Nil(t, err)
+		assert.Equal(t, &common.StakingQueue{Size: 0, Nodes: make([]*common.StakingQueueEntry, 0)}, queue)
+	})
+	t.Run("query service error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		arg := createMockArgStakingQueueProcessorWithoutResponses()
+		arg.QueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				return nil, expectedErr
+			},
+		}
+		sqp, _ := NewStakingQueueProcessor(arg)
+
+		queue, err := sqp.GetStakingQueue(0, 10)
+		assert.Nil(t, queue)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("invalid queue values should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgStakingQueueProcessor(map[string][][]byte{
+			"getQueueSize":                          {[]byte("1")},
+			"getQueueRegisterNonceAndRewardAddress": {testBLSKey1, testRewardAddress1},
+		})
+		sqp, _ := NewStakingQueueProcessor(arg)
+
+		queue, err := sqp.GetStakingQueue(0, 10)
+		assert.Nil(t, queue)
+		assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgStakingQueueProcessor(map[string][][]byte{
+			"getQueueSize": {[]byte("2")},
+			"getQueueRegisterNonceAndRewardAddress": {
+				testBLSKey1, testRewardAddress1, big.NewInt(5).Bytes(),
+				testBLSKey2, testRewardAddress2, big.NewInt(7).Bytes(),
+			},
+			"getOwner": {testNodeOwner},
+		})
+		sqp, _ := NewStakingQueueProcessor(arg)
+
+		queue, err := sqp.GetStakingQueue(0, 10)
+		require.Nil(t, err)
+		assert.Equal(t, &common.StakingQueue{
+			Size: 2,
+			Nodes: []*common.StakingQueueEntry{
+				{
+					Position:      1,
+					BLSKey:        hex.EncodeToString(testBLSKey1),
+					OwnerAddress:  arg.PublicKeyConverter.Encode(testNodeOwner),
+					RewardAddress: arg.PublicKeyConverter.Encode(testRewardAddress1),
+					RegisterNonce: 5,
+				},
+				{
+					Position:      2,
+					BLSKey:        hex.EncodeToString(testBLSKey2),
+					OwnerAddress:  arg.PublicKeyConverter.Encode(testNodeOwner),
+					RewardAddress: arg.PublicKeyConverter.Encode(testRewardAddress2),
+					RegisterNonce: 7,
+				},
+			},
+		}, queue)
+	})
+	t.Run("should read the owners only for the requested page", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgStakingQueueProcessor(map[string][][]byte{
+			"getQueueSize": {[]byte("2")},
+			"getQueueRegisterNonceAndRewardAddress": {
+				testBLSKey1, testRewardAddress1, big.NewInt(5).Bytes(),
+				testBLSKey2, testRewardAddress2, big.NewInt(7).Bytes(),
+			},
+			"getOwner": {testNodeOwner},
+		})
+		queryService := arg.QueryService
+		numOwnerQueries := 0
+		arg.QueryService = &mock.SCQueryServiceStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				if query.FuncName == "getOwner" {
+					numOwnerQueries++
+					assert.Equal(t, testBLSKey2, query.Arguments[0])
+				}
+
+				return queryService.ExecuteQuery(query)
+			},
+		}
+		sqp, _ := NewStakingQueueProcessor(arg)
+
+		queue, err := sqp.GetStakingQueue(1, 10)
+		require.Nil(t, err)
+		assert.Equal(t, 1, numOwnerQueries)
+		assert.Equal(t, uint32(2), queue.Size)
+		require.Equal(t, 1, len(queue.Nodes))
+		assert.Equal(t, uint32(2), queue.Nodes[0].Position)
+		assert.Equal(t, hex.EncodeToString(testBLSKey2), queue.Nodes[0].BLSKey)
+
+		queue, err = sqp.GetStakingQueue(2, 10)
+		require.Nil(t, err)
+		assert.Equal(t, 1, numOwnerQueries)
+		assert.Equal(t, uint32(2), queue.Size)
+		assert.Equal(t, 0, len(queue.Nodes))
+	})
+}
+
+func TestStakingQueueProcessor_GetStakingNode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid BLS key should error", func(t *testing.T) {
+		t.Parallel()
+
+		sqp, _ := NewStakingQueueProcessor(createMockArgStakingQueueProcessor(map[string][][]byte{}))
+
+		node, err := sqp.GetStakingNode("not hex")
+		assert.Nil(t, node)
+		assert.NotNil(t, err)
+	})
+	t.Run("not registered node should error", func(t *testing.T) {
+		t.Parallel()
+
+		sqp, _ := NewStakingQueueProcessor(createMockArgStakingQueueProcessor(map[string][][]byte{}))
+
+		node, err := sqp.GetStakingNode(hex.EncodeToString(testBLSKey1))
+		assert.Nil(t, node)
+		assert.Equal(t, ErrNodeNotRegistered, err)
+	})
+	t.Run("queued node should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgStakingQueueProcessor(map[string][][]byte{
+			"getBLSKeyStatus":  {[]byte("queued")},
+			"getRewardAddress": {[]byte(hex.EncodeToString(testRewardAddress1))},
+			"getOwner":         {testNodeOwner},
+			"getQueueSize":     {[]byte("3")},
+			"getQueueIndex":    {[]byte("2")},
+			"getTotalStakedTopUpStakedBlsKeys": {
+				big.NewInt(100).Bytes(), big.NewInt(5100).Bytes(), big.NewInt(2).Bytes(), testBLSKey2,
+			},
+		})
+		sqp, _ := NewStakingQueueProcessor(arg)
+
+		node, err := sqp.GetStakingNode(hex.EncodeToString(testBLSKey1))
+		require.Nil(t, err)
+		assert.Equal(t, &common.StakingNode{
+			BLSKey:         hex.EncodeToString(testBLSKey1),
+			OwnerAddress:   arg.PublicKeyConverter.Encode(testNodeOwner),
+			RewardAddress:  arg.PublicKeyConverter.Encode(testRewardAddress1),
+			Status:         "queued",
+			QueuePosition:  2,
+			QueueSize:      3,
+			TotalStaked:    "5100",
+			TopUp:          "100",
+			NumActiveNodes: 2,
+		}, node)
+	})
+	t.Run("unbonding jailed node without owner should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgStakingQueueProcessor(map[string][][]byte{
+			"getBLSKeyStatus":          {[]byte("jailed")},
+			"getRewardAddress":         {[]byte(hex.EncodeToString(testRewardAddress1))},
+			"getQueueSize":             {[]byte("0")},
+			"getRemainingUnBondPeriod": {big.NewInt(20).Bytes()},
+		})
+		sqp, _ := NewStakingQueueProcessor(arg)
+
+		node, err := sqp.GetStakingNode(hex.EncodeToString(testBLSKey1))
+		require.Nil(t, err)
+		assert.Equal(t, &common.StakingNode{
+			BLSKey:                hex.EncodeToString(testBLSKey1),
+			RewardAddress:         arg.PublicKeyConverter.Encode(testRewardAddress1),
+			Status:                "jailed",
+			Jailed:                true,
+			UnBonding:             true,
+			RemainingUnBondPeriod: 20,
+			TotalStaked:           "0",
+			TopUp:                 "0",
+		}, node)
+	})
+	t.Run("unbonding node before the staking v2 activation should work", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgStakingQueueProcessor(map[string][][]byte{
+			"getBLSKeyStatus":          {[]byte("unStaked")},
+			"getRewardAddress":         {[]byte(hex.EncodeToString(testRewardAddress1))},
+			"getQueueSize":             {[]byte("0")},
+			"getRemainingUnBondPeriod": {[]byte("20")},
+		})
+		arg.StakingV2EnableEpoch = 11
+		sqp, _ := NewStakingQueueProcessor(arg)
+
+		node, err := sqp.GetStakingNode(hex.EncodeToString(testBLSKey1))
+		require.Nil(t, err)
+		assert.True(t, node.UnBonding)
+		assert.Equal(t, uint64(20), node.RemainingUnBondPeriod)
+	})
+	t.Run("invalid unbond period before the staking v2 activation should error", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgStakingQueueProcessor(map[string][][]byte{
+			"getBLSKeyStatus":          {[]byte("unStaked")},
+			"getRewardAddress":         {[]byte(hex.EncodeToString(testRewardAddress1))},
+			"getQueueSize":             {[]byte("0")},
+			"getRemainingUnBondPeriod": {big.NewInt(20).Bytes()},
+		})
+		arg.StakingV2EnableEpoch = 11
+		sqp, _ := NewStakingQueueProcessor(arg)
+
+		node, err := sqp.GetStakingNode(hex.EncodeToString(testBLSKey1))
+		assert.Nil(t, node)
+		assert.True(t, errors.Is(err, epochStart.ErrExecutingSystemScCode))
+	})
+}
@@ -3,6 +3,7 @@ package disabled
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/outport"
 )
 
@@ -41,6 +42,10 @@ func (n *disabledOutport) SaveAccounts(_ uint64, _ []data.UserAccountHandler) {
 func (n *disabledOutport) FinalizedBlock(_ []byte) {
 }
 
+// SaveSlashingEvidence does nothing
+func (n *disabledOutport) SaveSlashingEvidence(_ *common.SlashingEvidence) {
+}
+
 // Close does nothing
 func (n *disabledOutport) Close() error {
 	return nil
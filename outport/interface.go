@@ -3,6 +3,7 @@ package outport
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 // Driver is an interface for saving node specific data to other storage.
@@ -19,6 +20,12 @@ type Driver interface {
 	IsInterfaceNil() bool
 }
 
+// SlashingEvidenceDriver is the optional interface implemented by the drivers which want to receive the slashing
+// evidences collected by the node
+type SlashingEvidenceDriver interface {
+	SaveSlashingEvidence(evidence *common.SlashingEvidence) error
+}
+
 // OutportHandler is interface that defines what a proxy implementation should be able to do
 // The node is able to talk only with this interface
 type OutportHandler interface {
@@ -29,6 +36,7 @@ type OutportHandler interface {
 	SaveValidatorsRating(indexID string, infoRating []*indexer.ValidatorRatingInfo)
 	SaveAccounts(blockTimestamp uint64, acc []data.UserAccountHandler)
 	FinalizedBlock(headerHash []byte)
+	SaveSlashingEvidence(evidence *common.SlashingEvidence)
 	SubscribeDriver(driver Driver) error
 	HasDrivers() bool
 	Close() error
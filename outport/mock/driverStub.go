@@ -3,6 +3,7 @@ package mock
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 // DriverStub -
@@ -14,6 +15,7 @@ type DriverStub struct {
 	SaveValidatorsRatingCalled  func(indexID string, infoRating []*indexer.ValidatorRatingInfo) error
 	SaveAccountsCalled          func(timestamp uint64, acc []data.UserAccountHandler) error
 	FinalizedBlockCalled        func(headerHash []byte) error
+	SaveSlashingEvidenceCalled  func(evidence *common.SlashingEvidence) error
 	CloseCalled                 func() error
 }
 
@@ -80,6 +82,15 @@ func (d *DriverStub) FinalizedBlock(headerHash []byte) error {
 	return nil
 }
 
+// SaveSlashingEvidence -
+func (d *DriverStub) SaveSlashingEvidence(evidence *common.SlashingEvidence) error {
+	if d.SaveSlashingEvidenceCalled != nil {
+		return d.SaveSlashingEvidenceCalled(evidence)
+	}
+
+	return nil
+}
+
 // Close -
 func (d *DriverStub) Close() error {
 	if d.CloseCalled != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 var log = logger.GetOrCreate("outport/eventNotifier")
@@ -19,6 +20,7 @@ const (
 	pushEventEndpoint       = "/events/push"
 	revertEventsEndpoint    = "/events/revert"
 	finalizedEventsEndpoint = "/events/finalized"
+	slashingEventsEndpoint  = "/events/slashing"
 )
 
 // SaveBlockData holds the data that will be sent to notifier instance
@@ -180,6 +182,16 @@ func (en *eventNotifier) FinalizedBlock(headerHash []byte) error {
 	return nil
 }
 
+// SaveSlashingEvidence pushes the slashing evidence to subscribers
+func (en *eventNotifier) SaveSlashingEvidence(evidence *common.SlashingEvidence) error {
+	err := en.httpClient.Post(slashingEventsEndpoint, evidence, nil)
+	if err != nil {
+		return fmt.Errorf("%w in eventNotifier.SaveSlashingEvidence while posting event data", err)
+	}
+
+	return nil
+}
+
 // SaveRoundsInfo returns nil
 func (en *eventNotifier) SaveRoundsInfo(_ []*indexer.RoundInfo) error {
 	return nil
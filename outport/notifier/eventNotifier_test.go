@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/outport/mock"
 	"github.com/ElrondNetwork/elrond-go/outport/notifier"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -114,6 +115,30 @@ func TestFinalizedBlock(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestSaveSlashingEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEventNotifierArgs()
+
+	evidence := &common.SlashingEvidence{Type: "doubleSigning"}
+	wasCalled := false
+	args.HttpClient = &mock.HTTPClientStub{
+		PostCalled: func(route string, payload, response interface{}) error {
+			wasCalled = true
+			require.Equal(t, "/events/slashing", route)
+			require.Equal(t, evidence, payload)
+			return nil
+		},
+	}
+
+	en, _ := notifier.NewEventNotifier(args)
+
+	err := en.SaveSlashingEvidence(evidence)
+	require.Nil(t, err)
+
+	require.True(t, wasCalled)
+}
+
 func TestMockFunctions(t *testing.T) {
 	t.Parallel()
 
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 var log = logger.GetOrCreate("outport")
@@ -241,6 +242,43 @@ func (o *outport) finalizedBlockBlocking(headerHash []byte, driver Driver) {
 	}
 }
 
+// SaveSlashingEvidence will save the slashing evidence for every driver which accepts it
+func (o *outport) SaveSlashingEvidence(evidence *common.SlashingEvidence) {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	for _, driver := range o.drivers {
+		slashingEvidenceDriver, ok := driver.(SlashingEvidenceDriver)
+		if !ok {
+			continue
+		}
+
+		o.saveSlashingEvidenceBlocking(evidence, slashingEvidenceDriver, driver)
+	}
+}
+
+func (o *outport) saveSlashingEvidenceBlocking(
+	evidence *common.SlashingEvidence,
+	slashingEvidenceDriver SlashingEvidenceDriver,
+	driver Driver,
+) {
+	for {
+		err := slashingEvidenceDriver.SaveSlashingEvidence(evidence)
+		if err == nil {
+			return
+		}
+
+		log.Error("error calling SaveSlashingEvidence, will retry",
+			"driver", driverString(driver),
+			"retrial in", o.retrialInterval,
+			"error", err)
+
+		if o.shouldTerminate() {
+			return
+		}
+	}
+}
+
 // Close will close all the drivers that are in outport
 func (o *outport) Close() error {
 	close(o.chanClose)
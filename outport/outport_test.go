@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/outport/mock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -255,6 +256,44 @@ func TestOutport_FinalizedBlock(t *testing.T) {
 	assert.Equal(t, 1, numCalled2)
 }
 
+func TestOutport_SaveSlashingEvidence(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("expected error")
+	numCalled1 := 0
+	numCalled2 := 0
+	driver1 := &mock.DriverStub{
+		SaveSlashingEvidenceCalled: func(evidence *common.SlashingEvidence) error {
+			numCalled1++
+			if numCalled1 < 10 {
+				return expectedError
+			}
+
+			return nil
+		},
+	}
+	driver2 := &mock.DriverStub{
+		SaveSlashingEvidenceCalled: func(evidence *common.SlashingEvidence) error {
+			numCalled2++
+			return nil
+		},
+	}
+	driverWithoutSlashingEvidence := &struct {
+		Driver
+	}{
+		Driver: &mock.DriverStub{},
+	}
+	outportHandler, _ := NewOutport(minimumRetrialInterval)
+	outportHandler.SaveSlashingEvidence(&common.SlashingEvidence{})
+	_ = outportHandler.SubscribeDriver(driver1)
+	_ = outportHandler.SubscribeDriver(driverWithoutSlashingEvidence)
+	_ = outportHandler.SubscribeDriver(driver2)
+
+	outportHandler.SaveSlashingEvidence(&common.SlashingEvidence{})
+	assert.Equal(t, 10, numCalled1)
+	assert.Equal(t, 1, numCalled2)
+}
+
 func TestOutport_SubscribeDriver(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,68 @@
+package compression
+
+import (
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+type snappyCodec struct {
+}
+
+func (sc *snappyCodec) compress(data []byte) ([]byte, error) {
+	return snappy.Encode(nil, data), nil
+}
+
+func (sc *snappyCodec) decompress(data []byte, maxDecompressedSize int) ([]byte, error) {
+	decompressedSize, err := snappy.DecodedLen(data)
+	if err != nil {
+		return nil, err
+	}
+	if decompressedSize > maxDecompressedSize {
+		return nil, fmt.Errorf("%w, decompressed size: %d, maximum: %d",
+			p2p.ErrMessageTooLarge, decompressedSize, maxDecompressedSize)
+	}
+
+	return snappy.Decode(nil, data)
+}
+
+type zstdCodec struct {
+	encoder *zstd.Encoder
+	decoder *zstd.Decoder
+}
+
+func newZstdCodec(maxDecompressedSize int) (*zstdCodec, error) {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	if err != nil {
+		return nil, err
+	}
+
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderMaxMemory(uint64(maxDecompressedSize)))
+	if err != nil {
+		return nil, err
+	}
+
+	return &zstdCodec{
+		encoder: encoder,
+		decoder: decoder,
+	}, nil
+}
+
+func (zc *zstdCodec) compress(data []byte) ([]byte, error) {
+	return zc.encoder.EncodeAll(data, nil), nil
+}
+
+func (zc *zstdCodec) decompress(data []byte, maxDecompressedSize int) ([]byte, error) {
+	decompressed, err := zc.decoder.DecodeAll(data, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(decompressed) > maxDecompressedSize {
+		return nil, fmt.Errorf("%w, decompressed size: %d, maximum: %d",
+			p2p.ErrMessageTooLarge, len(decompressed), maxDecompressedSize)
+	}
+
+	return decompressed, nil
+}
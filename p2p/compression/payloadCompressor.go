@@ -0,0 +1,154 @@
+package compression
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
+)
+
+var log = logger.GetOrCreate("p2p/compression")
+
+const (
+	// NoCompression marks an uncompressed payload
+	NoCompression = uint32(0)
+	// SnappyCompression marks a payload compressed with snappy
+	SnappyCompression = uint32(1)
+	// ZstdCompression marks a payload compressed with zstd
+	ZstdCompression = uint32(2)
+)
+
+const (
+	// SnappyType is the configuration name of the snappy compression
+	SnappyType = "snappy"
+	// ZstdType is the configuration name of the zstd compression
+	ZstdType = "zstd"
+)
+
+type codec interface {
+	compress(data []byte) ([]byte, error)
+	decompress(data []byte, maxDecompressedSize int) ([]byte, error)
+}
+
+// ArgsPayloadCompressor is the DTO used to create a new payload compressor
+type ArgsPayloadCompressor struct {
+	Config              config.PayloadCompressionConfig
+	MaxDecompressedSize int
+	MetricsRegistry     common.MetricsRegistry
+}
+
+type payloadCompressor struct {
+	isEnabled           bool
+	compressionType     uint32
+	minPayloadSize      int
+	topics              []string
+	maxDecompressedSize int
+	codecs              map[uint32]codec
+	metricsRegistry     common.MetricsRegistry
+}
+
+// NewPayloadCompressor creates a new payload compressor. Regardless of the provided configuration, the returned
+// instance is able to decompress all the supported compression types
+func NewPayloadCompressor(args ArgsPayloadCompressor) (*payloadCompressor, error) {
+	if args.MaxDecompressedSize <= 0 {
+		return nil, fmt.Errorf("%w for MaxDecompressedSize, provided %d", p2p.ErrInvalidValue, args.MaxDecompressedSize)
+	}
+	if check.IfNil(args.MetricsRegistry) {
+		return nil, p2p.ErrNilMetricsRegistry
+	}
+
+	zstdCodecInstance, err := newZstdCodec(args.MaxDecompressedSize)
+	if err != nil {
+		return nil, err
+	}
+
+	pc := &payloadCompressor{
+		isEnabled:           args.Config.Enabled,
+		minPayloadSize:      int(args.Config.MinPayloadSizeInBytes),
+		topics:              args.Config.Topics,
+		maxDecompressedSize: args.MaxDecompressedSize,
+		metricsRegistry:     args.MetricsRegistry,
+		codecs: map[uint32]codec{
+			SnappyCompression: &snappyCodec{},
+			ZstdCompression:   zstdCodecInstance,
+		},
+	}
+
+	if !pc.isEnabled {
+		return pc, nil
+	}
+
+	switch args.Config.Type {
+	case SnappyType:
+		pc.compressionType = SnappyCompression
+	case ZstdType:
+		pc.compressionType = ZstdCompression
+	default:
+		return nil, fmt.Errorf("%w: %s", p2p.ErrUnknownCompressionType, args.Config.Type)
+	}
+
+	log.Debug("p2p payload compression enabled", "type", args.Config.Type,
+		"min payload size", pc.minPayloadSize, "topics", strings.Join(pc.topics, ", "))
+
+	return pc, nil
+}
+
+// CompressPayload compresses the provided payload if the compression is enabled for the provided topic and the
+// payload is large enough. Returns the payload to be sent and the compression type that was applied
+func (pc *payloadCompressor) CompressPayload(topic string, payload []byte) ([]byte, uint32) {
+	if !pc.shouldCompress(topic, payload) {
+		return payload, NoCompression
+	}
+
+	compressed, err := pc.codecs[pc.compressionType].compress(payload)
+	if err != nil {
+		log.Trace("payloadCompressor.CompressPayload", "topic", topic, "error", err)
+		return payload, NoCompression
+	}
+	if len(compressed) >= len(payload) {
+		return payload, NoCompression
+	}
+
+	pc.metricsRegistry.IncrementCounter(prometheus.P2PCompressedMessages, topic)
+	pc.metricsRegistry.AddToCounter(prometheus.P2PCompressionSavedBytes, uint64(len(payload)-len(compressed)), topic)
+
+	return compressed, pc.compressionType
+}
+
+func (pc *payloadCompressor) shouldCompress(topic string, payload []byte) bool {
+	if !pc.isEnabled || len(payload) < pc.minPayloadSize {
+		return false
+	}
+
+	for _, topicPrefix := range pc.topics {
+		if strings.HasPrefix(topic, topicPrefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DecompressPayload decompresses the provided payload based on the provided compression type
+func (pc *payloadCompressor) DecompressPayload(payload []byte, compressionType uint32) ([]byte, error) {
+	if compressionType == NoCompression {
+		return payload, nil
+	}
+
+	codecInstance, ok := pc.codecs[compressionType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", p2p.ErrUnknownCompressionType, compressionType)
+	}
+
+	return codecInstance.decompress(payload, pc.maxDecompressedSize)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pc *payloadCompressor) IsInterfaceNil() bool {
+	return pc == nil
+}
@@ -0,0 +1,210 @@
+package compression
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const maxDecompressedSize = 1 << 20
+
+func createMockArgsPayloadCompressor(compressionType string) ArgsPayloadCompressor {
+	return ArgsPayloadCompressor{
+		Config: config.PayloadCompressionConfig{
+			Enabled:               true,
+			Type:                  compressionType,
+			MinPayloadSizeInBytes: 100,
+			Topics:                []string{"transactions", "txBlockBodies"},
+		},
+		MaxDecompressedSize: maxDecompressedSize,
+		MetricsRegistry:     &testscommon.MetricsRegistryStub{},
+	}
+}
+
+func createCompressiblePayload(size int) []byte {
+	return bytes.Repeat([]byte("a"), size)
+}
+
+func TestNewPayloadCompressor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max decompressed size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPayloadCompressor(SnappyType)
+		args.MaxDecompressedSize = 0
+
+		pc, err := NewPayloadCompressor(args)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+		assert.True(t, check.IfNil(pc))
+	})
+	t.Run("unknown compression type should error", func(t *testing.T) {
+		t.Parallel()
+
+		pc, err := NewPayloadCompressor(createMockArgsPayloadCompressor("gzip"))
+		assert.True(t, errors.Is(err, p2p.ErrUnknownCompressionType))
+		assert.True(t, check.IfNil(pc))
+	})
+	t.Run("unknown compression type on disabled config should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPayloadCompressor("gzip")
+		args.Config.Enabled = false
+
+		pc, err := NewPayloadCompressor(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(pc))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pc, err := NewPayloadCompressor(createMockArgsPayloadCompressor(ZstdType))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(pc))
+		assert.Equal(t, ZstdCompression, pc.compressionType)
+	})
+}
+
+func TestPayloadCompressor_CompressPayload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should not compress", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPayloadCompressor(SnappyType)
+		args.Config.Enabled = false
+		pc, _ := NewPayloadCompressor(args)
+
+		payload := createCompressiblePayload(1000)
+		result, compressionType := pc.CompressPayload("transactions_0", payload)
+		assert.Equal(t, NoCompression, compressionType)
+		assert.Equal(t, payload, result)
+	})
+	t.Run("topic not configured should not compress", func(t *testing.T) {
+		t.Parallel()
+
+		pc, _ := NewPayloadCompressor(createMockArgsPayloadCompressor(SnappyType))
+
+		payload := createCompressiblePayload(1000)
+		result, compressionType := pc.CompressPayload("heartbeat_0", payload)
+		assert.Equal(t, NoCompression, compressionType)
+		assert.Equal(t, payload, result)
+	})
+	t.Run("small payload should not compress", func(t *testing.T) {
+		t.Parallel()
+
+		pc, _ := NewPayloadCompressor(createMockArgsPayloadCompressor(SnappyType))
+
+		payload := createCompressiblePayload(99)
+		result, compressionType := pc.CompressPayload("transactions_0", payload)
+		assert.Equal(t, NoCompression, compressionType)
+		assert.Equal(t, payload, result)
+	})
+	t.Run("incompressible payload should not compress", func(t *testing.T) {
+		t.Parallel()
+
+		pc, _ := NewPayloadCompressor(createMockArgsPayloadCompressor(SnappyType))
+
+		payload := make([]byte, 1000)
+		_, _ = rand.Read(payload)
+
+		result, compressionType := pc.CompressPayload("transactions_0", payload)
+		assert.Equal(t, NoCompression, compressionType)
+		assert.Equal(t, payload, result)
+	})
+}
+
+func TestPayloadCompressor_CompressDecompressShouldWork(t *testing.T) {
+	t.Parallel()
+
+	for _, compressionType := range []string{SnappyType, ZstdType} {
+		pc, _ := NewPayloadCompressor(createMockArgsPayloadCompressor(compressionType))
+
+		payload := createCompressiblePayload(1000)
+		compressed, appliedType := pc.CompressPayload("txBlockBodies_0_1", payload)
+		assert.NotEqual(t, NoCompression, appliedType)
+		assert.True(t, len(compressed) < len(payload))
+
+		decompressed, err := pc.DecompressPayload(compressed, appliedType)
+		assert.Nil(t, err)
+		assert.Equal(t, payload, decompressed)
+	}
+}
+
+func TestPayloadCompressor_DecompressPayload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no compression should return the same payload", func(t *testing.T) {
+		t.Parallel()
+
+		pc, _ := NewPayloadCompressor(createMockArgsPayloadCompressor(SnappyType))
+
+		payload := []byte("payload")
+		result, err := pc.DecompressPayload(payload, NoCompression)
+		assert.Nil(t, err)
+		assert.Equal(t, payload, result)
+	})
+	t.Run("unknown compression type should error", func(t *testing.T) {
+		t.Parallel()
+
+		pc, _ := NewPayloadCompressor(createMockArgsPayloadCompressor(SnappyType))
+
+		result, err := pc.DecompressPayload([]byte("payload"), 1000)
+		assert.True(t, errors.Is(err, p2p.ErrUnknownCompressionType))
+		assert.Nil(t, result)
+	})
+	t.Run("disabled compressor should still decompress", func(t *testing.T) {
+		t.Parallel()
+
+		sender, _ := NewPayloadCompressor(createMockArgsPayloadCompressor(ZstdType))
+		args := createMockArgsPayloadCompressor(SnappyType)
+		args.Config.Enabled = false
+		receiver, _ := NewPayloadCompressor(args)
+
+		payload := createCompressiblePayload(1000)
+		compressed, compressionType := sender.CompressPayload("transactions_0", payload)
+		require.Equal(t, ZstdCompression, compressionType)
+
+		decompressed, err := receiver.DecompressPayload(compressed, compressionType)
+		assert.Nil(t, err)
+		assert.Equal(t, payload, decompressed)
+	})
+	t.Run("too large decompressed payload should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, compressionType := range []string{SnappyType, ZstdType} {
+			sender, _ := NewPayloadCompressor(createMockArgsPayloadCompressor(compressionType))
+			args := createMockArgsPayloadCompressor(compressionType)
+			args.MaxDecompressedSize = 500
+			receiver, _ := NewPayloadCompressor(args)
+
+			compressed, appliedType := sender.CompressPayload("transactions_0", createCompressiblePayload(1000))
+			require.NotEqual(t, NoCompression, appliedType)
+
+			result, err := receiver.DecompressPayload(compressed, appliedType)
+			assert.NotNil(t, err)
+			assert.Nil(t, result)
+		}
+	})
+	t.Run("corrupted payload should error", func(t *testing.T) {
+		t.Parallel()
+
+		pc, _ := NewPayloadCompressor(createMockArgsPayloadCompressor(SnappyType))
+
+		result, err := pc.DecompressPayload([]byte("corrupted"), SnappyCompression)
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+
+		result, err = pc.DecompressPayload([]byte("corrupted"), ZstdCompression)
+		assert.NotNil(t, err)
+		assert.Nil(t, result)
+	})
+}
@@ -158,3 +158,24 @@ var ErrNilPeersRatingHandler = errors.New("nil peers rating handler")
 
 // ErrNilCacher signals that a nil cacher has been provided
 var ErrNilCacher = errors.New("nil cacher")
+
+// ErrNilPeersRatingSnapshotHandler signals that a nil peers rating snapshot handler has been provided
+var ErrNilPeersRatingSnapshotHandler = errors.New("nil peers rating snapshot handler")
+
+// ErrNilPeersAddressesHandler signals that a nil peers addresses handler has been provided
+var ErrNilPeersAddressesHandler = errors.New("nil peers addresses handler")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrUnknownCompressionType signals that an unknown compression type has been provided
+var ErrUnknownCompressionType = errors.New("unknown compression type")
+
+// ErrNilPayloadCompressor signals that a nil payload compressor has been provided
+var ErrNilPayloadCompressor = errors.New("nil payload compressor")
+
+// ErrInvalidPeerID signals that an invalid peer ID has been provided
+var ErrInvalidPeerID = errors.New("invalid peer ID")
+
+// ErrNilMetricsRegistry signals that a nil metrics registry has been provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry")
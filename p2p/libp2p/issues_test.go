@@ -37,6 +37,7 @@ func createMessenger() p2p.Messenger {
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
 		NodeOperationMode:    p2p.NormalOperation,
 		PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 
 	libP2PMes, err := libp2p.NewNetworkMessenger(args)
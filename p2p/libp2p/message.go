@@ -15,10 +15,13 @@ import (
 const currentTopicMessageVersion = uint32(1)
 
 // NewMessage returns a new instance of a Message object
-func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Message, error) {
+func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer, payloadCompressor p2p.PayloadCompressor) (*message.Message, error) {
 	if check.IfNil(marshalizer) {
 		return nil, p2p.ErrNilMarshalizer
 	}
+	if check.IfNil(payloadCompressor) {
+		return nil, p2p.ErrNilPayloadCompressor
+	}
 	if msg == nil {
 		return nil, p2p.ErrNilMessage
 	}
@@ -52,7 +55,12 @@ func NewMessage(msg *pubsub.Message, marshalizer p2p.Marshalizer) (*message.Mess
 			p2p.ErrUnsupportedFields)
 	}
 
-	newMsg.DataField = topicMessage.Payload
+	payload, err := payloadCompressor.DecompressPayload(topicMessage.Payload, topicMessage.Compression)
+	if err != nil {
+		return nil, fmt.Errorf("%w error: %s", p2p.ErrMessageUnmarshalError, err.Error())
+	}
+
+	newMsg.DataField = payload
 	newMsg.TimestampField = topicMessage.Timestamp
 
 	id, err := peer.IDFromBytes(newMsg.From())
@@ -1,6 +1,7 @@
 package libp2p_test
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"errors"
@@ -9,7 +10,9 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/compression"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -30,11 +33,25 @@ func getRandomID() []byte {
 	return []byte(id)
 }
 
+func createPayloadCompressor() p2p.PayloadCompressor {
+	pc, _ := compression.NewPayloadCompressor(compression.ArgsPayloadCompressor{
+		Config: config.PayloadCompressionConfig{
+			Enabled: true,
+			Type:    compression.SnappyType,
+			Topics:  []string{"topic"},
+		},
+		MaxDecompressedSize: libp2p.MaxSendBuffSize,
+		MetricsRegistry:     &testscommon.MetricsRegistryStub{},
+	})
+
+	return pc
+}
+
 func TestMessage_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
 	pMes := &pubsub.Message{}
-	m, err := libp2p.NewMessage(pMes, nil)
+	m, err := libp2p.NewMessage(pMes, nil, createPayloadCompressor())
 
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrNilMarshalizer))
@@ -59,7 +76,7 @@ func TestMessage_ShouldErrBecauseOfFromField(t *testing.T) {
 		Topic: &topic,
 	}
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	assert.True(t, check.IfNil(m))
 	assert.NotNil(t, err)
@@ -83,7 +100,7 @@ func TestMessage_ShouldWork(t *testing.T) {
 	}
 
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	require.Nil(t, err)
 	assert.False(t, check.IfNil(m))
@@ -107,7 +124,7 @@ func TestMessage_From(t *testing.T) {
 		Topic: &topic,
 	}
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	require.Nil(t, err)
 	assert.Equal(t, m.From(), from)
@@ -132,7 +149,7 @@ func TestMessage_Peer(t *testing.T) {
 		Topic: &topic,
 	}
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	require.Nil(t, err)
 	assert.Equal(t, core.PeerID(id), m.Peer())
@@ -157,7 +174,7 @@ func TestMessage_WrongVersionShouldErr(t *testing.T) {
 	}
 
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrUnsupportedMessageVersion))
@@ -183,7 +200,7 @@ func TestMessage_PopulatedPkFieldShouldErr(t *testing.T) {
 	}
 
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrUnsupportedFields))
@@ -209,7 +226,7 @@ func TestMessage_PopulatedSigFieldShouldErr(t *testing.T) {
 	}
 
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	assert.True(t, check.IfNil(m))
 	assert.True(t, errors.Is(err, p2p.ErrUnsupportedFields))
@@ -233,18 +250,82 @@ func TestMessage_NilTopic(t *testing.T) {
 		Topic: nil,
 	}
 	pMes := &pubsub.Message{Message: mes}
-	m, err := libp2p.NewMessage(pMes, marshalizer)
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
 
 	assert.Equal(t, p2p.ErrNilTopic, err)
 	assert.True(t, check.IfNil(m))
 }
 
+func TestMessage_NilPayloadCompressorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pMes := &pubsub.Message{}
+	m, err := libp2p.NewMessage(pMes, &testscommon.ProtoMarshalizerMock{}, nil)
+
+	assert.True(t, check.IfNil(m))
+	assert.Equal(t, p2p.ErrNilPayloadCompressor, err)
+}
+
+func TestMessage_CompressedPayloadShouldWork(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	payloadCompressor := createPayloadCompressor()
+	payload := bytes.Repeat([]byte("compressible data "), 100)
+	compressedPayload, compressionType := payloadCompressor.CompressPayload("topic", payload)
+	require.Equal(t, compression.SnappyCompression, compressionType)
+	require.True(t, len(compressedPayload) < len(payload))
+
+	topicMessage := &data.TopicMessage{
+		Version:     libp2p.CurrentTopicMessageVersion,
+		Timestamp:   time.Now().Unix(),
+		Payload:     compressedPayload,
+		Compression: compressionType,
+	}
+	buff, _ := marshalizer.Marshal(topicMessage)
+	topic := "topic"
+	mes := &pb.Message{
+		From:  getRandomID(),
+		Data:  buff,
+		Topic: &topic,
+	}
+	pMes := &pubsub.Message{Message: mes}
+	m, err := libp2p.NewMessage(pMes, marshalizer, payloadCompressor)
+
+	require.Nil(t, err)
+	assert.Equal(t, payload, m.Data())
+}
+
+func TestMessage_UnknownCompressionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &testscommon.ProtoMarshalizerMock{}
+	topicMessage := &data.TopicMessage{
+		Version:     libp2p.CurrentTopicMessageVersion,
+		Timestamp:   time.Now().Unix(),
+		Payload:     []byte("data"),
+		Compression: 1000,
+	}
+	buff, _ := marshalizer.Marshal(topicMessage)
+	topic := "topic"
+	mes := &pb.Message{
+		From:  getRandomID(),
+		Data:  buff,
+		Topic: &topic,
+	}
+	pMes := &pubsub.Message{Message: mes}
+	m, err := libp2p.NewMessage(pMes, marshalizer, createPayloadCompressor())
+
+	assert.True(t, check.IfNil(m))
+	assert.True(t, errors.Is(err, p2p.ErrMessageUnmarshalError))
+}
+
 func TestMessage_NilMessage(t *testing.T) {
 	t.Parallel()
 
 	marshalizer := &testscommon.ProtoMarshalizerMock{}
 
-	m, err := libp2p.NewMessage(nil, marshalizer)
+	m, err := libp2p.NewMessage(nil, marshalizer, createPayloadCompressor())
 
 	assert.Equal(t, p2p.ErrNilMessage, err)
 	assert.True(t, check.IfNil(m))
@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/config"
 	p2pDebug "github.com/ElrondNetwork/elrond-go/debug/p2p"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/p2p/compression"
 	"github.com/ElrondNetwork/elrond-go/p2p/data"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/connectionMonitor"
 	"github.com/ElrondNetwork/elrond-go/p2p/libp2p/disabled"
@@ -48,6 +49,9 @@ const (
 	// DirectSendID represents the protocol ID for sending and receiving direct P2P messages
 	DirectSendID = protocol.ID("/erd/directsend/1.0.0")
 
+	// PayloadCompressionID represents the protocol ID advertised by the peers able to decompress the messages payloads
+	PayloadCompressionID = protocol.ID("/erd/compression/1.0.0")
+
 	durationBetweenSends            = time.Microsecond * 10
 	durationCheckConnections        = time.Second
 	refreshPeersOnTopic             = time.Second * 3
@@ -118,6 +122,8 @@ type networkMessenger struct {
 	preferredPeersHolder p2p.PreferredPeersHolderHandler
 	connectionsWatcher   p2p.ConnectionsWatcher
 	peersRatingHandler   p2p.PeersRatingHandler
+	payloadCompressor    p2p.PayloadCompressor
+	compressBroadcasts   bool
 }
 
 // ArgsNetworkMessenger defines the options used to create a p2p wrapper
@@ -129,6 +135,7 @@ type ArgsNetworkMessenger struct {
 	PreferredPeersHolder p2p.PreferredPeersHolderHandler
 	NodeOperationMode    p2p.NodeOperation
 	PeersRatingHandler   p2p.PeersRatingHandler
+	MetricsRegistry      common.MetricsRegistry
 }
 
 // NewNetworkMessenger creates a libP2P messenger by opening a port on the current machine
@@ -149,6 +156,9 @@ func newNetworkMessenger(args ArgsNetworkMessenger, messageSigning messageSignin
 	if check.IfNil(args.PeersRatingHandler) {
 		return nil, fmt.Errorf("%w when creating a new network messenger", p2p.ErrNilPeersRatingHandler)
 	}
+	if check.IfNil(args.MetricsRegistry) {
+		return nil, fmt.Errorf("%w when creating a new network messenger", p2p.ErrNilMetricsRegistry)
+	}
 
 	p2pPrivKey, err := createP2PPrivKey(args.P2pConfig.Node.Seed)
 	if err != nil {
@@ -281,6 +291,11 @@ func addComponentsToNode(
 	p2pNode.debugger = p2pDebug.NewP2PDebugger(core.PeerID(p2pNode.p2pHost.ID()))
 	p2pNode.peersRatingHandler = args.PeersRatingHandler
 
+	err = p2pNode.createPayloadCompressor(args.P2pConfig.PayloadCompression, args.MetricsRegistry)
+	if err != nil {
+		return err
+	}
+
 	err = p2pNode.createPubSub(messageSigning)
 	if err != nil {
 		return err
@@ -318,6 +333,32 @@ func addComponentsToNode(
 	return nil
 }
 
+func (netMes *networkMessenger) createPayloadCompressor(
+	compressionConfig config.PayloadCompressionConfig,
+	metricsRegistry common.MetricsRegistry,
+) error {
+	args := compression.ArgsPayloadCompressor{
+		Config:              compressionConfig,
+		MaxDecompressedSize: maxSendBuffSize,
+		MetricsRegistry:     metricsRegistry,
+	}
+
+	var err error
+	netMes.payloadCompressor, err = compression.NewPayloadCompressor(args)
+	if err != nil {
+		return err
+	}
+	netMes.compressBroadcasts = compressionConfig.Enabled && compressionConfig.CompressBroadcasts
+
+	// the decompression is always available, so the protocol is advertised regardless of the configuration in order to
+	// let the other peers know they can send compressed direct messages to this peer
+	netMes.p2pHost.SetStreamHandler(PayloadCompressionID, func(s network.Stream) {
+		_ = s.Reset()
+	})
+
+	return nil
+}
+
 func (netMes *networkMessenger) createPubSub(messageSigning messageSigningConfig) error {
 	optsPS := make([]pubsub.Option, 0)
 	if messageSigning == withoutMessageSigning {
@@ -366,7 +407,7 @@ func (netMes *networkMessenger) createPubSub(messageSigning messageSigningConfig
 				continue
 			}
 
-			buffToSend := netMes.createMessageBytes(sendableData.Buff)
+			buffToSend := netMes.createMessageBytes(sendableData.Topic, sendableData.Buff, netMes.compressBroadcasts)
 			if len(buffToSend) == 0 {
 				continue
 			}
@@ -381,12 +422,15 @@ func (netMes *networkMessenger) createPubSub(messageSigning messageSigningConfig
 	return nil
 }
 
-func (netMes *networkMessenger) createMessageBytes(buff []byte) []byte {
+func (netMes *networkMessenger) createMessageBytes(topic string, buff []byte, shouldCompress bool) []byte {
 	message := &data.TopicMessage{
 		Version:   currentTopicMessageVersion,
 		Payload:   buff,
 		Timestamp: netMes.syncTimer.CurrentTime().Unix(),
 	}
+	if shouldCompress {
+		message.Payload, message.Compression = netMes.payloadCompressor.CompressPayload(topic, buff)
+	}
 
 	buffToSend, errMarshal := netMes.marshalizer.Marshal(message)
 	if errMarshal != nil {
@@ -982,7 +1026,7 @@ func (netMes *networkMessenger) pubsubCallback(topicProcs *topicProcessors, topi
 }
 
 func (netMes *networkMessenger) transformAndCheckMessage(pbMsg *pubsub.Message, pid core.PeerID, topic string) (p2p.MessageP2P, error) {
-	msg, errUnmarshal := NewMessage(pbMsg, netMes.marshalizer)
+	msg, errUnmarshal := NewMessage(pbMsg, netMes.marshalizer, netMes.payloadCompressor)
 	if errUnmarshal != nil {
 		// this error is so severe that will need to blacklist both the originator and the connected peer as there is
 		// no way this node can communicate with them
@@ -1135,7 +1179,8 @@ func (netMes *networkMessenger) SendToConnectedPeer(topic string, buff []byte, p
 		return err
 	}
 
-	buffToSend := netMes.createMessageBytes(buff)
+	shouldCompress := peerID != netMes.ID() && netMes.peerSupportsPayloadCompression(peerID)
+	buffToSend := netMes.createMessageBytes(topic, buff, shouldCompress)
 	if len(buffToSend) == 0 {
 		return nil
 	}
@@ -1150,6 +1195,15 @@ func (netMes *networkMessenger) SendToConnectedPeer(topic string, buff []byte, p
 	return err
 }
 
+func (netMes *networkMessenger) peerSupportsPayloadCompression(pid core.PeerID) bool {
+	supportedProtocols, err := netMes.p2pHost.Peerstore().SupportsProtocols(peer.ID(pid), string(PayloadCompressionID))
+	if err != nil {
+		return false
+	}
+
+	return len(supportedProtocols) > 0
+}
+
 func (netMes *networkMessenger) sendDirectToSelf(topic string, buff []byte) error {
 	msg := &pubsub.Message{
 		Message: &pubsubPb.Message{
@@ -93,6 +93,7 @@ func createMockNetworkArgs() libp2p.ArgsNetworkMessenger {
 		SyncTimer:            &libp2p.LocalSyncTimer{},
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
 		PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 }
 
@@ -1314,6 +1315,7 @@ func TestNetworkMessenger_PreventReprocessingShouldWork(t *testing.T) {
 		SyncTimer:            &libp2p.LocalSyncTimer{},
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
 		PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 
 	mes, _ := libp2p.NewNetworkMessenger(args)
@@ -1380,6 +1382,7 @@ func TestNetworkMessenger_PubsubCallbackNotMessageNotValidShouldNotCallHandler(t
 		SyncTimer:            &libp2p.LocalSyncTimer{},
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
 		PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 
 	mes, _ := libp2p.NewNetworkMessenger(args)
@@ -1453,6 +1456,7 @@ func TestNetworkMessenger_PubsubCallbackReturnsFalseIfHandlerErrors(t *testing.T
 		SyncTimer:            &libp2p.LocalSyncTimer{},
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
 		PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 
 	mes, _ := libp2p.NewNetworkMessenger(args)
@@ -1517,6 +1521,7 @@ func TestNetworkMessenger_UnjoinAllTopicsShouldWork(t *testing.T) {
 		SyncTimer:            &libp2p.LocalSyncTimer{},
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
 		PeersRatingHandler:   &p2pmocks.PeersRatingHandlerStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 
 	mes, _ := libp2p.NewNetworkMessenger(args)
@@ -1730,6 +1735,7 @@ func TestNetworkMessenger_Bootstrap(t *testing.T) {
 		},
 		SyncTimer:          &mock.SyncTimerStub{},
 		PeersRatingHandler: &p2pmocks.PeersRatingHandlerStub{},
+		MetricsRegistry:    &testscommon.MetricsRegistryStub{},
 	}
 
 	netMes, err := libp2p.NewNetworkMessenger(args)
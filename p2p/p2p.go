@@ -3,10 +3,12 @@ package p2p
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"io"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/libp2p/go-libp2p-core/peer"
 )
 
 const displayLastPidChars = 12
@@ -215,6 +217,16 @@ func PeerIdToShortString(pid core.PeerID) string {
 	return prettyPid
 }
 
+// NewPeerIDFromPretty creates a peer ID from its b58-encoded representation, as returned by the core.PeerID.Pretty
+func NewPeerIDFromPretty(prettyPid string) (core.PeerID, error) {
+	pid, err := peer.Decode(prettyPid)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidPeerID, err.Error())
+	}
+
+	return core.PeerID(pid), nil
+}
+
 // MessageOriginatorSeq will output the sequence number as hex
 func MessageOriginatorSeq(msg MessageP2P) string {
 	return hex.EncodeToString(msg.SeqNo())
@@ -340,3 +352,24 @@ type PeersRatingHandler interface {
 	GetTopRatedPeersFromList(peers []core.PeerID, minNumOfPeersExpected int) []core.PeerID
 	IsInterfaceNil() bool
 }
+
+// PayloadCompressor represent an entity able to compress and decompress the messages payloads
+type PayloadCompressor interface {
+	CompressPayload(topic string, payload []byte) ([]byte, uint32)
+	DecompressPayload(payload []byte, compressionType uint32) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// PeersRatingSnapshotHandler represent an entity able to export and import the peers ratings
+type PeersRatingSnapshotHandler interface {
+	GetPeersRatings() map[core.PeerID]int32
+	SetPeersRatings(ratings map[core.PeerID]int32)
+	IsInterfaceNil() bool
+}
+
+// PeersAddressesHandler represent an entity able to provide the known addresses of a peer and to connect to an address
+type PeersAddressesHandler interface {
+	PeerAddresses(pid core.PeerID) []string
+	ConnectToPeer(address string) error
+	IsInterfaceNil() bool
+}
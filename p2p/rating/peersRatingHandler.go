@@ -232,6 +232,56 @@ func (prh *peersRatingHandler) splitPeersByTiers(peers []core.PeerID) ([]core.Pe
 	return topRated, badRated
 }
 
+// GetPeersRatings returns the ratings of all the peers from both tiers
+func (prh *peersRatingHandler) GetPeersRatings() map[core.PeerID]int32 {
+	prh.mut.Lock()
+	defer prh.mut.Unlock()
+
+	ratings := make(map[core.PeerID]int32, prh.topRatedCache.Len()+prh.badRatedCache.Len())
+	copyRatingsFromCache(prh.topRatedCache, ratings)
+	copyRatingsFromCache(prh.badRatedCache, ratings)
+
+	return ratings
+}
+
+func copyRatingsFromCache(cache storage.Cacher, ratings map[core.PeerID]int32) {
+	for _, key := range cache.Keys() {
+		rating, found := cache.Get(key)
+		if !found {
+			continue
+		}
+
+		ratingInt, ok := rating.(int32)
+		if !ok {
+			continue
+		}
+
+		ratings[core.PeerID(key)] = ratingInt
+	}
+}
+
+// SetPeersRatings sets the provided ratings, placing each peer in the corresponding tier
+func (prh *peersRatingHandler) SetPeersRatings(ratings map[core.PeerID]int32) {
+	prh.mut.Lock()
+	defer prh.mut.Unlock()
+
+	for pid, rating := range ratings {
+		if rating > maxRating {
+			rating = maxRating
+		}
+		if rating < minRating {
+			rating = minRating
+		}
+
+		oldRating, found := prh.getOldRating(pid)
+		if !found {
+			prh.topRatedCache.Put(pid.Bytes(), defaultRating, int32Size)
+		}
+
+		prh.updateRating(pid, oldRating, rating)
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (prh *peersRatingHandler) IsInterfaceNil() bool {
 	return prh == nil
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
 )
@@ -424,3 +425,41 @@ func TestPeersRatingHandler_GetTopRatedPeersFromList(t *testing.T) {
 		assert.Equal(t, expectedListOfPeers, res)
 	})
 }
+
+func TestPeersRatingHandler_GetAndSetPeersRatings(t *testing.T) {
+	t.Parallel()
+
+	topRatedCache, _ := lrucache.NewCache(10)
+	badRatedCache, _ := lrucache.NewCache(10)
+	prh, _ := NewPeersRatingHandler(ArgPeersRatingHandler{
+		TopRatedCache: topRatedCache,
+		BadRatedCache: badRatedCache,
+	})
+	assert.False(t, check.IfNil(prh))
+
+	existingBadPid := core.PeerID("existing bad pid")
+	prh.AddPeer(existingBadPid)
+	prh.DecreaseRating(existingBadPid)
+	assert.True(t, badRatedCache.Has(existingBadPid.Bytes()))
+
+	goodPid, badPid, overMaxPid := core.PeerID("good pid"), core.PeerID("bad pid"), core.PeerID("over max pid")
+	prh.SetPeersRatings(map[core.PeerID]int32{
+		goodPid:        30,
+		badPid:         -10,
+		overMaxPid:     maxRating + 50,
+		existingBadPid: 40,
+	})
+
+	assert.True(t, topRatedCache.Has(goodPid.Bytes()))
+	assert.True(t, badRatedCache.Has(badPid.Bytes()))
+	assert.True(t, topRatedCache.Has(existingBadPid.Bytes()))
+	assert.False(t, badRatedCache.Has(existingBadPid.Bytes()))
+
+	expectedRatings := map[core.PeerID]int32{
+		goodPid:        30,
+		badPid:         -10,
+		overMaxPid:     maxRating,
+		existingBadPid: 40,
+	}
+	assert.Equal(t, expectedRatings, prh.GetPeersRatings())
+}
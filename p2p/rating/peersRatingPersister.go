@@ -0,0 +1,314 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. peersRatingSnapshot.proto
+package rating
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const (
+	peersRatingSnapshotKey = "PeersRatingSnapshot"
+	p2pAddressComponent    = "/p2p/"
+	minPersistInterval     = time.Second
+)
+
+// ArgPeersRatingPersister is the DTO used to create a new peers rating persister
+type ArgPeersRatingPersister struct {
+	PeersRatingHandler        p2p.PeersRatingSnapshotHandler
+	PeersAddressesHandler     p2p.PeersAddressesHandler
+	Storer                    storage.Storer
+	Marshalizer               marshal.Marshalizer
+	PersistInterval           time.Duration
+	RatingHalfLife            time.Duration
+	MaxNumOfKnownGoodPeers    int
+	MinRatingForKnownGoodPeer int32
+}
+
+type peersRatingPersister struct {
+	peersRatingHandler        p2p.PeersRatingSnapshotHandler
+	peersAddressesHandler     p2p.PeersAddressesHandler
+	storer                    storage.Storer
+	marshalizer               marshal.Marshalizer
+	ratingHalfLife            time.Duration
+	maxNumOfKnownGoodPeers    int
+	minRatingForKnownGoodPeer int32
+	getTimeHandler            func() time.Time
+	knownGoodPeers            []*PersistedPeerRating
+	mutPersist                sync.Mutex
+	cancelFunc                context.CancelFunc
+	closeOnce                 sync.Once
+}
+
+// NewPeersRatingPersister returns a new peers rating persister. The persisted ratings, if any, are decayed with
+// the time elapsed since they were saved and loaded into the provided peers rating handler
+func NewPeersRatingPersister(args ArgPeersRatingPersister) (*peersRatingPersister, error) {
+	err := checkPersisterArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	prp := &peersRatingPersister{
+		peersRatingHandler:        args.PeersRatingHandler,
+		peersAddressesHandler:     args.PeersAddressesHandler,
+		storer:                    args.Storer,
+		marshalizer:               args.Marshalizer,
+		ratingHalfLife:            args.RatingHalfLife,
+		maxNumOfKnownGoodPeers:    args.MaxNumOfKnownGoodPeers,
+		minRatingForKnownGoodPeer: args.MinRatingForKnownGoodPeer,
+		getTimeHandler:            time.Now,
+	}
+
+	prp.loadPersistedRatings()
+
+	var ctx context.Context
+	ctx, prp.cancelFunc = context.WithCancel(context.Background())
+	go prp.persistContinuously(ctx, args.PersistInterval)
+
+	return prp, nil
+}
+
+func checkPersisterArgs(args ArgPeersRatingPersister) error {
+	if check.IfNil(args.PeersRatingHandler) {
+		return p2p.ErrNilPeersRatingSnapshotHandler
+	}
+	if check.IfNil(args.PeersAddressesHandler) {
+		return p2p.ErrNilPeersAddressesHandler
+	}
+	if check.IfNil(args.Storer) {
+		return p2p.ErrNilStorer
+	}
+	if check.IfNil(args.Marshalizer) {
+		return p2p.ErrNilMarshalizer
+	}
+	if args.PersistInterval < minPersistInterval {
+		return fmt.Errorf("%w for PersistInterval, minimum %v, provided %v",
+			p2p.ErrInvalidDurationProvided, minPersistInterval, args.PersistInterval)
+	}
+	if args.RatingHalfLife <= 0 {
+		return fmt.Errorf("%w for RatingHalfLife, provided %v", p2p.ErrInvalidDurationProvided, args.RatingHalfLife)
+	}
+	if args.MaxNumOfKnownGoodPeers < 0 {
+		return fmt.Errorf("%w for MaxNumOfKnownGoodPeers, provided %d", p2p.ErrInvalidValue, args.MaxNumOfKnownGoodPeers)
+	}
+	if args.MinRatingForKnownGoodPeer <= defaultRating || args.MinRatingForKnownGoodPeer > maxRating {
+		return fmt.Errorf("%w for MinRatingForKnownGoodPeer, provided %d", p2p.ErrInvalidValue, args.MinRatingForKnownGoodPeer)
+	}
+
+	return nil
+}
+
+func (prp *peersRatingPersister) loadPersistedRatings() {
+	buff, err := prp.storer.Get([]byte(peersRatingSnapshotKey))
+	if err != nil {
+		log.Debug("peersRatingPersister: no persisted peers ratings found")
+		return
+	}
+
+	snapshot := &PeersRatingSnapshot{}
+	err = prp.marshalizer.Unmarshal(snapshot, buff)
+	if err != nil {
+		log.Warn("peersRatingPersister: can not unmarshal the persisted peers ratings", "error", err)
+		return
+	}
+
+	elapsed := prp.getTimeHandler().Sub(time.Unix(0, snapshot.Timestamp))
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	decayFactor := math.Pow(0.5, float64(elapsed)/float64(prp.ratingHalfLife))
+
+	ratings := make(map[core.PeerID]int32, len(snapshot.Peers))
+	knownGoodPeers := make([]*PersistedPeerRating, 0)
+	for _, peerRating := range snapshot.Peers {
+		if peerRating == nil || len(peerRating.Pid) == 0 {
+			continue
+		}
+
+		decayedRating := int32(float64(peerRating.Rating) * decayFactor)
+		ratings[core.PeerID(peerRating.Pid)] = decayedRating
+
+		isKnownGoodPeer := decayedRating >= prp.minRatingForKnownGoodPeer && len(peerRating.Addresses) > 0
+		if isKnownGoodPeer {
+			knownGoodPeers = append(knownGoodPeers, &PersistedPeerRating{
+				Pid:       peerRating.Pid,
+				Rating:    decayedRating,
+				Addresses: peerRating.Addresses,
+			})
+		}
+	}
+
+	prp.peersRatingHandler.SetPeersRatings(ratings)
+	prp.knownGoodPeers = prp.selectKnownGoodPeers(knownGoodPeers)
+
+	log.Debug("peersRatingPersister: loaded the persisted peers ratings",
+		"num ratings", len(ratings),
+		"num known good peers", len(prp.knownGoodPeers),
+		"saved", elapsed.Truncate(time.Second).String()+" ago")
+}
+
+func (prp *peersRatingPersister) selectKnownGoodPeers(peers []*PersistedPeerRating) []*PersistedPeerRating {
+	sort.SliceStable(peers, func(i, j int) bool {
+		return peers[i].Rating > peers[j].Rating
+	})
+
+	if len(peers) > prp.maxNumOfKnownGoodPeers {
+		peers = peers[:prp.maxNumOfKnownGoodPeers]
+	}
+
+	return peers
+}
+
+// ConnectToKnownGoodPeers tries to connect to the well rated peers loaded from the storage. It returns when all the
+// connection attempts ended or when the provided context is done, with the number of peers connected until then
+func (prp *peersRatingPersister) ConnectToKnownGoodPeers(ctx context.Context) int {
+	numConnected := uint32(0)
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(prp.knownGoodPeers))
+	for _, peerRating := range prp.knownGoodPeers {
+		go func(peerRating *PersistedPeerRating) {
+			defer wg.Done()
+
+			if prp.connectToPeer(ctx, peerRating) {
+				atomic.AddUint32(&numConnected, 1)
+			}
+		}(peerRating)
+	}
+
+	chDone := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(chDone)
+	}()
+
+	select {
+	case <-chDone:
+	case <-ctx.Done():
+		log.Debug("peersRatingPersister.ConnectToKnownGoodPeers: context done before all the connection attempts ended")
+	}
+
+	connected := atomic.LoadUint32(&numConnected)
+	log.Debug("peersRatingPersister.ConnectToKnownGoodPeers",
+		"num known good peers", len(prp.knownGoodPeers),
+		"num connected", connected)
+
+	return int(connected)
+}
+
+func (prp *peersRatingPersister) connectToPeer(ctx context.Context, peerRating *PersistedPeerRating) bool {
+	pid := core.PeerID(peerRating.Pid)
+	for _, address := range peerRating.Addresses {
+		if ctx.Err() != nil {
+			return false
+		}
+
+		err := prp.peersAddressesHandler.ConnectToPeer(address)
+		if err == nil {
+			return true
+		}
+
+		log.Trace("peersRatingPersister: can not connect to known good peer",
+			"pid", pid.Pretty(), "address", address, "error", err)
+	}
+
+	return false
+}
+
+func (prp *peersRatingPersister) persistContinuously(ctx context.Context, persistInterval time.Duration) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("peersRatingPersister's go routine is stopping...")
+			return
+		case <-time.After(persistInterval):
+		}
+
+		err := prp.persist()
+		if err != nil {
+			log.Debug("peersRatingPersister: can not persist the peers ratings", "error", err)
+		}
+	}
+}
+
+func (prp *peersRatingPersister) persist() error {
+	prp.mutPersist.Lock()
+	defer prp.mutPersist.Unlock()
+
+	ratings := prp.peersRatingHandler.GetPeersRatings()
+	peers := make([]*PersistedPeerRating, 0, len(ratings))
+	knownGoodPeers := make([]*PersistedPeerRating, 0)
+	for pid, rating := range ratings {
+		peerRating := &PersistedPeerRating{
+			Pid:    pid.Bytes(),
+			Rating: rating,
+		}
+		peers = append(peers, peerRating)
+
+		if rating >= prp.minRatingForKnownGoodPeer {
+			knownGoodPeers = append(knownGoodPeers, peerRating)
+		}
+	}
+
+	for _, peerRating := range prp.selectKnownGoodPeers(knownGoodPeers) {
+		peerRating.Addresses = prp.getDialableAddresses(core.PeerID(peerRating.Pid))
+	}
+
+	snapshot := &PeersRatingSnapshot{
+		Timestamp: prp.getTimeHandler().UnixNano(),
+		Peers:     peers,
+	}
+	buff, err := prp.marshalizer.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	return prp.storer.Put([]byte(peersRatingSnapshotKey), buff)
+}
+
+func (prp *peersRatingPersister) getDialableAddresses(pid core.PeerID) []string {
+	addresses := prp.peersAddressesHandler.PeerAddresses(pid)
+	dialableAddresses := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		if !strings.Contains(address, p2pAddressComponent) {
+			address += p2pAddressComponent + pid.Pretty()
+		}
+
+		dialableAddresses = append(dialableAddresses, address)
+	}
+
+	return dialableAddresses
+}
+
+// Close stops the persisting go routine, saves the current peers ratings and closes the storer
+func (prp *peersRatingPersister) Close() error {
+	var err error
+	prp.closeOnce.Do(func() {
+		prp.cancelFunc()
+
+		err = prp.persist()
+		if err != nil {
+			log.Debug("peersRatingPersister: can not persist the peers ratings on close", "error", err)
+		}
+
+		err = prp.storer.Close()
+	})
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prp *peersRatingPersister) IsInterfaceNil() bool {
+	return prp == nil
+}
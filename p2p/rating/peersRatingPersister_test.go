@@ -0,0 +1,328 @@
+package rating
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPeersRatingHandler() *peersRatingHandler {
+	topRatedCache, _ := lrucache.NewCache(100)
+	badRatedCache, _ := lrucache.NewCache(100)
+	prh, _ := NewPeersRatingHandler(ArgPeersRatingHandler{
+		TopRatedCache: topRatedCache,
+		BadRatedCache: badRatedCache,
+	})
+
+	return prh
+}
+
+func createMockArgsPersister() ArgPeersRatingPersister {
+	return ArgPeersRatingPersister{
+		PeersRatingHandler:        createPeersRatingHandler(),
+		PeersAddressesHandler:     &p2pmocks.MessengerStub{},
+		Storer:                    genericMocks.NewStorerMockWithErrKeyNotFound("peersRating", 0),
+		Marshalizer:               &marshal.GogoProtoMarshalizer{},
+		PersistInterval:           time.Minute,
+		RatingHalfLife:            time.Hour,
+		MaxNumOfKnownGoodPeers:    2,
+		MinRatingForKnownGoodPeer: 20,
+	}
+}
+
+func saveSnapshot(t *testing.T, args ArgPeersRatingPersister, snapshot *PeersRatingSnapshot) {
+	buff, err := args.Marshalizer.Marshal(snapshot)
+	require.Nil(t, err)
+	err = args.Storer.Put([]byte(peersRatingSnapshotKey), buff)
+	require.Nil(t, err)
+}
+
+func TestNewPeersRatingPersister(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil peers rating handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister()
+		args.PeersRatingHandler = nil
+
+		prp, err := NewPeersRatingPersister(args)
+		assert.Equal(t, p2p.ErrNilPeersRatingSnapshotHandler, err)
+		assert.True(t, check.IfNil(prp))
+	})
+	t.Run("nil peers addresses handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister()
+		args.PeersAddressesHandler = nil
+
+		prp, err := NewPeersRatingPersister(args)
+		assert.Equal(t, p2p.ErrNilPeersAddressesHandler, err)
+		assert.True(t, check.IfNil(prp))
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister()
+		args.Storer = nil
+
+		prp, err := NewPeersRatingPersister(args)
+		assert.Equal(t, p2p.ErrNilStorer, err)
+		assert.True(t, check.IfNil(prp))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister()
+		args.Marshalizer = nil
+
+		prp, err := NewPeersRatingPersister(args)
+		assert.Equal(t, p2p.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(prp))
+	})
+	t.Run("invalid persist interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister()
+		args.PersistInterval = time.Millisecond
+
+		prp, err := NewPeersRatingPersister(args)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidDurationProvided))
+		assert.True(t, strings.Contains(err.Error(), "PersistInterval"))
+		assert.True(t, check.IfNil(prp))
+	})
+	t.Run("invalid rating half life should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister()
+		args.RatingHalfLife = 0
+
+		prp, err := NewPeersRatingPersister(args)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidDurationProvided))
+		assert.True(t, strings.Contains(err.Error(), "RatingHalfLife"))
+		assert.True(t, check.IfNil(prp))
+	})
+	t.Run("invalid max num of known good peers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister()
+		args.MaxNumOfKnownGoodPeers = -1
+
+		prp, err := NewPeersRatingPersister(args)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "MaxNumOfKnownGoodPeers"))
+		assert.True(t, check.IfNil(prp))
+	})
+	t.Run("invalid min rating for known good peer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister()
+		args.MinRatingForKnownGoodPeer = 0
+
+		prp, err := NewPeersRatingPersister(args)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "MinRatingForKnownGoodPeer"))
+		assert.True(t, check.IfNil(prp))
+
+		args.MinRatingForKnownGoodPeer = maxRating + 1
+		prp, err = NewPeersRatingPersister(args)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidValue))
+		assert.True(t, check.IfNil(prp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		prp, err := NewPeersRatingPersister(createMockArgsPersister())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(prp))
+		assert.Equal(t, 0, len(prp.knownGoodPeers))
+
+		_ = prp.Close()
+	})
+}
+
+func TestPeersRatingPersister_LoadPersistedRatings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("corrupted data should not load", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister()
+		_ = args.Storer.Put([]byte(peersRatingSnapshotKey), []byte("not a snapshot"))
+
+		prp, err := NewPeersRatingPersister(args)
+		require.Nil(t, err)
+		assert.Equal(t, 0, len(args.PeersRatingHandler.GetPeersRatings()))
+
+		_ = prp.Close()
+	})
+	t.Run("should decay ratings and select known good peers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPersister()
+		saveSnapshot(t, args, &PeersRatingSnapshot{
+			Timestamp: time.Now().Add(-args.RatingHalfLife).UnixNano(),
+			Peers: []*PersistedPeerRating{
+				{Pid: []byte("pid 1"), Rating: 100, Addresses: []string{"address 1"}},
+				{Pid: []byte("pid 2"), Rating: 60, Addresses: []string{"address 2"}},
+				{Pid: []byte("pid 3"), Rating: 80, Addresses: []string{"address 3"}},
+				{Pid: []byte("pid 4"), Rating: 30, Addresses: []string{"address 4"}},
+				{Pid: []byte("pid 5"), Rating: -50},
+				{Rating: 100, Addresses: []string{"address without pid"}},
+			},
+		})
+
+		prp, err := NewPeersRatingPersister(args)
+		require.Nil(t, err)
+
+		ratings := args.PeersRatingHandler.GetPeersRatings()
+		require.Equal(t, 5, len(ratings))
+		assert.True(t, ratings["pid 1"] <= 50 && ratings["pid 1"] >= 49)
+		assert.True(t, ratings["pid 5"] >= -25 && ratings["pid 5"] <= -24)
+
+		require.Equal(t, 2, len(prp.knownGoodPeers))
+		assert.Equal(t, []byte("pid 1"), prp.knownGoodPeers[0].Pid)
+		assert.Equal(t, []byte("pid 3"), prp.knownGoodPeers[1].Pid)
+
+		_ = prp.Close()
+	})
+}
+
+func TestPeersRatingPersister_ConnectToKnownGoodPeers(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPersister()
+	args.MaxNumOfKnownGoodPeers = 10
+	saveSnapshot(t, args, &PeersRatingSnapshot{
+		Timestamp: time.Now().UnixNano(),
+		Peers: []*PersistedPeerRating{
+			{Pid: []byte("pid 1"), Rating: 100, Addresses: []string{"bad address 1", "good address 1"}},
+			{Pid: []byte("pid 2"), Rating: 90, Addresses: []string{"bad address 2"}},
+			{Pid: []byte("pid 3"), Rating: 80, Addresses: []string{"good address 3"}},
+		},
+	})
+
+	mutAddresses := sync.Mutex{}
+	dialedAddresses := make(map[string]struct{})
+	args.PeersAddressesHandler = &p2pmocks.MessengerStub{
+		ConnectToPeerCalled: func(address string) error {
+			mutAddresses.Lock()
+			dialedAddresses[address] = struct{}{}
+			mutAddresses.Unlock()
+
+			if strings.HasPrefix(address, "good") {
+				return nil
+			}
+			return errors.New("dial error")
+		},
+	}
+
+	prp, err := NewPeersRatingPersister(args)
+	require.Nil(t, err)
+
+	numConnected := prp.ConnectToKnownGoodPeers(context.Background())
+	assert.Equal(t, 2, numConnected)
+	assert.Equal(t, 4, len(dialedAddresses))
+
+	_ = prp.Close()
+}
+
+func TestPeersRatingPersister_ConnectToKnownGoodPeersShouldStopWhenTheContextIsDone(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPersister()
+	saveSnapshot(t, args, &PeersRatingSnapshot{
+		Timestamp: time.Now().UnixNano(),
+		Peers: []*PersistedPeerRating{
+			{Pid: []byte("pid 1"), Rating: 100, Addresses: []string{"address 1"}},
+		},
+	})
+
+	chUnblock := make(chan struct{})
+	args.PeersAddressesHandler = &p2pmocks.MessengerStub{
+		ConnectToPeerCalled: func(address string) error {
+			<-chUnblock
+			return nil
+		},
+	}
+
+	prp, err := NewPeersRatingPersister(args)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+	numConnected := prp.ConnectToKnownGoodPeers(ctx)
+	assert.Equal(t, 0, numConnected)
+
+	close(chUnblock)
+	_ = prp.Close()
+}
+
+func TestPeersRatingPersister_CloseShouldPersist(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsPersister()
+	args.PeersRatingHandler.SetPeersRatings(map[core.PeerID]int32{
+		"pid 1": 60,
+		"pid 2": 10,
+		"pid 3": -30,
+	})
+	args.PeersAddressesHandler = &p2pmocks.MessengerStub{
+		PeerAddressesCalled: func(pid core.PeerID) []string {
+			return []string{"/ip4/10.0.0.1/tcp/37373", "/ip4/10.0.0.2/tcp/37373/p2p/" + pid.Pretty()}
+		},
+	}
+
+	prp, err := NewPeersRatingPersister(args)
+	require.Nil(t, err)
+
+	err = prp.Close()
+	assert.Nil(t, err)
+
+	buff, err := args.Storer.Get([]byte(peersRatingSnapshotKey))
+	require.Nil(t, err)
+
+	snapshot := &PeersRatingSnapshot{}
+	err = args.Marshalizer.Unmarshal(snapshot, buff)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(snapshot.Peers))
+
+	pid := core.PeerID("pid 1")
+	for _, peerRating := range snapshot.Peers {
+		if string(peerRating.Pid) != string(pid) {
+			assert.Equal(t, 0, len(peerRating.Addresses))
+			continue
+		}
+
+		assert.Equal(t, int32(60), peerRating.Rating)
+		expectedAddresses := []string{
+			"/ip4/10.0.0.1/tcp/37373/p2p/" + pid.Pretty(),
+			"/ip4/10.0.0.2/tcp/37373/p2p/" + pid.Pretty(),
+		}
+		assert.Equal(t, expectedAddresses, peerRating.Addresses)
+	}
+
+	argsReload := createMockArgsPersister()
+	argsReload.Storer = args.Storer
+	prpReload, err := NewPeersRatingPersister(argsReload)
+	require.Nil(t, err)
+
+	assert.Equal(t, 3, len(argsReload.PeersRatingHandler.GetPeersRatings()))
+	require.Equal(t, 1, len(prpReload.knownGoodPeers))
+	assert.Equal(t, pid.Bytes(), prpReload.knownGoodPeers[0].Pid)
+
+	_ = prpReload.Close()
+}
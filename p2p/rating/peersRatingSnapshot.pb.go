@@ -0,0 +1,767 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: peersRatingSnapshot.proto
+
+package rating
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PersistedPeerRating holds the rating of a peer and, for the well rated peers, the addresses it can be dialed on
+type PersistedPeerRating struct {
+	Pid       []byte   `protobuf:"bytes,1,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	Rating    int32    `protobuf:"varint,2,opt,name=Rating,proto3" json:"Rating,omitempty"`
+	Addresses []string `protobuf:"bytes,3,rep,name=Addresses,proto3" json:"Addresses,omitempty"`
+}
+
+func (m *PersistedPeerRating) Reset()      { *m = PersistedPeerRating{} }
+func (*PersistedPeerRating) ProtoMessage() {}
+func (*PersistedPeerRating) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61ac589c3297436, []int{0}
+}
+func (m *PersistedPeerRating) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PersistedPeerRating) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PersistedPeerRating) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersistedPeerRating.Merge(m, src)
+}
+func (m *PersistedPeerRating) XXX_Size() int {
+	return m.Size()
+}
+func (m *PersistedPeerRating) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersistedPeerRating.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersistedPeerRating proto.InternalMessageInfo
+
+func (m *PersistedPeerRating) GetPid() []byte {
+	if m != nil {
+		return m.Pid
+	}
+	return nil
+}
+
+func (m *PersistedPeerRating) GetRating() int32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *PersistedPeerRating) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// PeersRatingSnapshot holds the peers ratings saved at the provided timestamp, in nanoseconds
+type PeersRatingSnapshot struct {
+	Timestamp int64                  `protobuf:"varint,1,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Peers     []*PersistedPeerRating `protobuf:"bytes,2,rep,name=Peers,proto3" json:"Peers,omitempty"`
+}
+
+func (m *PeersRatingSnapshot) Reset()      { *m = PeersRatingSnapshot{} }
+func (*PeersRatingSnapshot) ProtoMessage() {}
+func (*PeersRatingSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c61ac589c3297436, []int{1}
+}
+func (m *PeersRatingSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeersRatingSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PeersRatingSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeersRatingSnapshot.Merge(m, src)
+}
+func (m *PeersRatingSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeersRatingSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeersRatingSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeersRatingSnapshot proto.InternalMessageInfo
+
+func (m *PeersRatingSnapshot) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PeersRatingSnapshot) GetPeers() []*PersistedPeerRating {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PersistedPeerRating)(nil), "proto.PersistedPeerRating")
+	proto.RegisterType((*PeersRatingSnapshot)(nil), "proto.PeersRatingSnapshot")
+}
+
+func init() { proto.RegisterFile("peersRatingSnapshot.proto", fileDescriptor_c61ac589c3297436) }
+
+var fileDescriptor_c61ac589c3297436 = []byte{
+	// 266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x4e, 0x3d, 0x4e, 0xc3, 0x30,
+	0x14, 0xf6, 0x6b, 0xd4, 0x48, 0x35, 0x0c, 0x28, 0x48, 0x28, 0x54, 0xe8, 0x29, 0xea, 0x94, 0x85,
+	0x14, 0xc1, 0x05, 0x80, 0x13, 0x44, 0x86, 0x09, 0x89, 0x21, 0x21, 0x8f, 0x34, 0x43, 0xea, 0xc8,
+	0x76, 0x77, 0x8e, 0xc0, 0x31, 0x38, 0x0a, 0x63, 0xc6, 0x8c, 0xc4, 0x59, 0x18, 0x7b, 0x04, 0x14,
+	0x07, 0xa9, 0x03, 0x4c, 0xfe, 0xbe, 0xcf, 0xef, 0xfb, 0xe1, 0xe7, 0x0d, 0x91, 0xd2, 0x22, 0x33,
+	0xd5, 0xb6, 0x7c, 0xd8, 0x66, 0x8d, 0xde, 0x48, 0x93, 0x34, 0x4a, 0x1a, 0x19, 0xcc, 0xdd, 0xb3,
+	0xbc, 0x2c, 0x2b, 0xb3, 0xd9, 0xe5, 0xc9, 0x8b, 0xac, 0xd7, 0xa5, 0x2c, 0xe5, 0xda, 0xc9, 0xf9,
+	0xee, 0xd5, 0x31, 0x47, 0x1c, 0x9a, 0x5c, 0xab, 0x67, 0x7e, 0x9a, 0x92, 0xd2, 0x95, 0x36, 0x54,
+	0xa4, 0x44, 0x6a, 0x8a, 0x0e, 0x4e, 0xb8, 0x97, 0x56, 0x45, 0x08, 0x11, 0xc4, 0xc7, 0x62, 0x84,
+	0xc1, 0x19, 0xf7, 0xa7, 0xbf, 0x70, 0x16, 0x41, 0x3c, 0x17, 0xbf, 0x2c, 0xb8, 0xe0, 0x8b, 0xbb,
+	0xa2, 0x50, 0xa4, 0x35, 0xe9, 0xd0, 0x8b, 0xbc, 0x78, 0x21, 0x0e, 0xc2, 0x8a, 0xc6, 0xf8, 0x3f,
+	0x8b, 0x47, 0xd3, 0x63, 0x55, 0x93, 0x36, 0x59, 0xdd, 0xb8, 0x12, 0x4f, 0x1c, 0x84, 0xe0, 0x8a,
+	0xcf, 0x9d, 0x29, 0x9c, 0x45, 0x5e, 0x7c, 0x74, 0xbd, 0x9c, 0xa6, 0x26, 0xff, 0xec, 0x14, 0xd3,
+	0xe1, 0xfd, 0x6d, 0xdb, 0x23, 0xeb, 0x7a, 0x64, 0xfb, 0x1e, 0xe1, 0xcd, 0x22, 0x7c, 0x58, 0x84,
+	0x4f, 0x8b, 0xd0, 0x5a, 0x84, 0xce, 0x22, 0x7c, 0x59, 0x84, 0x6f, 0x8b, 0x6c, 0x6f, 0x11, 0xde,
+	0x07, 0x64, 0xed, 0x80, 0xac, 0x1b, 0x90, 0x3d, 0xf9, 0xca, 0x05, 0xe5, 0xbe, 0xeb, 0xb8, 0xf9,
+	0x19, 0x00, 0x82, 0x4b, 0x70, 0xba, 0x61, 0x01, 0x00, 0x00,
+}
+
+func (this *PersistedPeerRating) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PersistedPeerRating)
+	if !ok {
+		that2, ok := that.(PersistedPeerRating)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Pid, that1.Pid) {
+		return false
+	}
+	if this.Rating != that1.Rating {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *PeersRatingSnapshot) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PeersRatingSnapshot)
+	if !ok {
+		that2, ok := that.(PeersRatingSnapshot)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if len(this.Peers) != len(that1.Peers) {
+		return false
+	}
+	for i := range this.Peers {
+		if !this.Peers[i].Equal(that1.Peers[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PersistedPeerRating) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&rating.PersistedPeerRating{")
+	s = append(s, "Pid: "+fmt.Sprintf("%#v", this.Pid)+",\n")
+	s = append(s, "Rating: "+fmt.Sprintf("%#v", this.Rating)+",\n")
+	s = append(s, "Addresses: "+fmt.Sprintf("%#v", this.Addresses)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PeersRatingSnapshot) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&rating.PeersRatingSnapshot{")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	if this.Peers != nil {
+		s = append(s, "Peers: "+fmt.Sprintf("%#v", this.Peers)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringPeersRatingSnapshot(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *PersistedPeerRating) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PersistedPeerRating) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PersistedPeerRating) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintPeersRatingSnapshot(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Rating != 0 {
+		i = encodeVarintPeersRatingSnapshot(dAtA, i, uint64(m.Rating))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pid) > 0 {
+		i -= len(m.Pid)
+		copy(dAtA[i:], m.Pid)
+		i = encodeVarintPeersRatingSnapshot(dAtA, i, uint64(len(m.Pid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeersRatingSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeersRatingSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeersRatingSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Peers) > 0 {
+		for iNdEx := len(m.Peers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Peers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPeersRatingSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintPeersRatingSnapshot(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPeersRatingSnapshot(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPeersRatingSnapshot(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PersistedPeerRating) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pid)
+	if l > 0 {
+		n += 1 + l + sovPeersRatingSnapshot(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 1 + sovPeersRatingSnapshot(uint64(m.Rating))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovPeersRatingSnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PeersRatingSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovPeersRatingSnapshot(uint64(m.Timestamp))
+	}
+	if len(m.Peers) > 0 {
+		for _, e := range m.Peers {
+			l = e.Size()
+			n += 1 + l + sovPeersRatingSnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPeersRatingSnapshot(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPeersRatingSnapshot(x uint64) (n int) {
+	return sovPeersRatingSnapshot(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *PersistedPeerRating) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PersistedPeerRating{`,
+		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`Rating:` + fmt.Sprintf("%v", this.Rating) + `,`,
+		`Addresses:` + fmt.Sprintf("%v", this.Addresses) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PeersRatingSnapshot) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPeers := "[]*PersistedPeerRating{"
+	for _, f := range this.Peers {
+		repeatedStringForPeers += strings.Replace(f.String(), "PersistedPeerRating", "PersistedPeerRating", 1) + ","
+	}
+	repeatedStringForPeers += "}"
+	s := strings.Join([]string{`&PeersRatingSnapshot{`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Peers:` + repeatedStringForPeers + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringPeersRatingSnapshot(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *PersistedPeerRating) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeersRatingSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PersistedPeerRating: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PersistedPeerRating: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeersRatingSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPeersRatingSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeersRatingSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pid = append(m.Pid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pid == nil {
+				m.Pid = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			m.Rating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeersRatingSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rating |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeersRatingSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeersRatingSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeersRatingSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeersRatingSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPeersRatingSnapshot
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPeersRatingSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeersRatingSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeersRatingSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeersRatingSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeersRatingSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeersRatingSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeersRatingSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPeersRatingSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeersRatingSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Peers = append(m.Peers, &PersistedPeerRating{})
+			if err := m.Peers[len(m.Peers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeersRatingSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPeersRatingSnapshot
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPeersRatingSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPeersRatingSnapshot(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPeersRatingSnapshot
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeersRatingSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeersRatingSnapshot
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPeersRatingSnapshot
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPeersRatingSnapshot
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPeersRatingSnapshot
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPeersRatingSnapshot        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPeersRatingSnapshot          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPeersRatingSnapshot = fmt.Errorf("proto: unexpected end of group")
+)
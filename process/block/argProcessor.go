@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -27,6 +28,8 @@ type coreComponentsHolder interface {
 	StatusHandler() core.AppStatusHandler
 	EconomicsData() process.EconomicsDataHandler
 	ProcessStatusHandler() common.ProcessStatusHandler
+	MetricsRegistry() common.MetricsRegistry
+	Tracer() tracing.Tracer
 	IsInterfaceNil() bool
 }
 
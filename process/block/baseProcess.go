@@ -22,6 +22,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	nodeFactory "github.com/ElrondNetwork/elrond-go/cmd/node/factory"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
@@ -93,6 +94,8 @@ type baseProcessor struct {
 	vmContainer         process.VirtualMachinesContainer
 	gasConsumedProvider gasConsumedProvider
 	economicsData       process.EconomicsDataHandler
+	metricsRegistry     common.MetricsRegistry
+	tracer              tracing.Tracer
 
 	processDataTriesOnCommitEpoch  bool
 	scheduledMiniBlocksEnableEpoch uint32
@@ -443,6 +446,12 @@ func checkProcessorNilParameters(arguments ArgBaseProcessor) error {
 	if check.IfNil(arguments.CoreComponents.Uint64ByteSliceConverter()) {
 		return process.ErrNilUint64Converter
 	}
+	if check.IfNil(arguments.CoreComponents.MetricsRegistry()) {
+		return process.ErrNilMetricsRegistry
+	}
+	if check.IfNil(arguments.CoreComponents.Tracer()) {
+		return process.ErrNilTracer
+	}
 	if check.IfNil(arguments.RequestHandler) {
 		return process.ErrNilRequestHandler
 	}
@@ -1364,7 +1373,10 @@ func (bp *baseProcessor) RevertAccountsDBToSnapshot(accountsSnapshot map[state.A
 	}
 }
 
-func (bp *baseProcessor) commitAll(headerHandler data.HeaderHandler) error {
+func (bp *baseProcessor) commitAll(ctx context.Context, headerHandler data.HeaderHandler) error {
+	_, span := bp.tracer.StartHeaderSpan(ctx, "AccountsDB.Commit", headerHandler)
+	defer span.End()
+
 	if headerHandler.IsStartOfEpochBlock() {
 		return bp.commitInLastEpoch(headerHandler.GetEpoch())
 	}
@@ -24,6 +24,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/blockchain"
@@ -363,6 +364,8 @@ func createComponentHolderMocks() (
 		StatusField:               &statusHandlerMock.AppStatusHandlerStub{},
 		RoundField:                &mock.RoundHandlerMock{},
 		ProcessStatusHandlerField: &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistryField:      &testscommon.MetricsRegistryStub{},
+		TracerField:               tracing.NewDisabledTracer(),
 	}
 
 	dataComponents := &mock.DataComponentsMock{
@@ -429,6 +432,7 @@ func createMockTransactionCoordinatorArguments(
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 
 	return argsTransactionCoordinator
@@ -536,6 +540,22 @@ func TestCheckProcessorNilParameters(t *testing.T) {
 			},
 			expectedErr: process.ErrNilUint64Converter,
 		},
+		{
+			args: func() blproc.ArgBaseProcessor {
+				coreCompCopy := *coreComponents
+				coreCompCopy.MetricsRegistryField = nil
+				return createArgBaseProcessor(&coreCompCopy, dataComponents, bootstrapComponents, statusComponents)
+			},
+			expectedErr: process.ErrNilMetricsRegistry,
+		},
+		{
+			args: func() blproc.ArgBaseProcessor {
+				coreCompCopy := *coreComponents
+				coreCompCopy.TracerField = nil
+				return createArgBaseProcessor(&coreCompCopy, dataComponents, bootstrapComponents, statusComponents)
+			},
+			expectedErr: process.ErrNilTracer,
+		},
 		{
 			args: func() blproc.ArgBaseProcessor {
 				args := createArgBaseProcessor(coreComponents, dataComponents, bootstrapComponents, statusComponents)
@@ -2,6 +2,7 @@ package block
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"fmt"
 	"math/big"
@@ -22,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
 )
 
 const firstHeaderNonce = uint64(1)
@@ -129,6 +131,8 @@ func NewMetaProcessor(arguments ArgMetaProcessor) (*metaProcessor, error) {
 		processDataTriesOnCommitEpoch:  arguments.Config.Debug.EpochStart.ProcessDataTrieOnCommitEpoch,
 		gasConsumedProvider:            arguments.GasHandler,
 		economicsData:                  arguments.CoreComponents.EconomicsData(),
+		metricsRegistry:                arguments.CoreComponents.MetricsRegistry(),
+		tracer:                         arguments.CoreComponents.Tracer(),
 		scheduledTxsExecutionHandler:   arguments.ScheduledTxsExecutionHandler,
 		scheduledMiniBlocksEnableEpoch: arguments.ScheduledMiniBlocksEnableEpoch,
 		pruningDelay:                   pruningDelay,
@@ -187,6 +191,20 @@ func (mp *metaProcessor) ProcessBlock(
 	bodyHandler data.BodyHandler,
 	haveTime func() time.Duration,
 ) error {
+	return mp.ProcessBlockWithContext(context.Background(), headerHandler, bodyHandler, haveTime)
+}
+
+// ProcessBlockWithContext processes a block, tracing it as a child of the span found in the provided context
+func (mp *metaProcessor) ProcessBlockWithContext(
+	ctx context.Context,
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
+	haveTime func() time.Duration,
+) error {
+	defer mp.metricsRegistry.ObserveDurationSince(prometheus.BlockProcessingDuration, time.Now(), prometheus.PhaseProcess)
+	ctx, span := mp.tracer.StartHeaderSpan(ctx, "metaProcessor.ProcessBlock", headerHandler)
+	defer span.End()
+
 	if haveTime == nil {
 		return process.ErrNilHaveTimeHandler
 	}
@@ -363,7 +381,7 @@ func (mp *metaProcessor) ProcessBlock(
 	miniBlocks := body.MiniBlocks[mbIndex:]
 
 	startTime := time.Now()
-	err = mp.txCoordinator.ProcessBlockTransaction(header, &block.Body{MiniBlocks: miniBlocks}, haveTime)
+	err = mp.txCoordinator.ProcessBlockTransaction(ctx, header, &block.Body{MiniBlocks: miniBlocks}, haveTime)
 	elapsedTime := time.Since(startTime)
 	log.Debug("elapsed time to process block transaction",
 		"time [s]", elapsedTime,
@@ -748,6 +766,20 @@ func (mp *metaProcessor) CreateBlock(
 	initialHdr data.HeaderHandler,
 	haveTime func() bool,
 ) (data.HeaderHandler, data.BodyHandler, error) {
+	return mp.CreateBlockWithContext(context.Background(), initialHdr, haveTime)
+}
+
+// CreateBlockWithContext creates the final block and header for the current round, tracing the creation as a child
+// of the span found in the provided context
+func (mp *metaProcessor) CreateBlockWithContext(
+	ctx context.Context,
+	initialHdr data.HeaderHandler,
+	haveTime func() bool,
+) (data.HeaderHandler, data.BodyHandler, error) {
+	defer mp.metricsRegistry.ObserveDurationSince(prometheus.BlockProcessingDuration, time.Now(), prometheus.PhaseCreate)
+	_, span := mp.tracer.StartHeaderSpan(ctx, "metaProcessor.CreateBlock", initialHdr)
+	defer span.End()
+
 	if check.IfNil(initialHdr) {
 		return nil, nil, process.ErrNilBlockHeader
 	}
@@ -1189,6 +1221,20 @@ func (mp *metaProcessor) CommitBlock(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
 ) error {
+	return mp.CommitBlockWithContext(context.Background(), headerHandler, bodyHandler)
+}
+
+// CommitBlockWithContext commits the block in the blockchain, tracing the commit as a child of the span found in the
+// provided context
+func (mp *metaProcessor) CommitBlockWithContext(
+	ctx context.Context,
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
+) error {
+	defer mp.metricsRegistry.ObserveDurationSince(prometheus.BlockProcessingDuration, time.Now(), prometheus.PhaseCommit)
+	ctx, span := mp.tracer.StartHeaderSpan(ctx, "metaProcessor.CommitBlock", headerHandler)
+	defer span.End()
+
 	mp.processStatusHandler.SetBusy("metaProcessor.CommitBlock")
 	var err error
 	defer func() {
@@ -1242,7 +1288,7 @@ func (mp *metaProcessor) CommitBlock(
 	mp.saveMetaHeader(header, headerHash, marshalizedHeader)
 	mp.saveBody(body, header, headerHash)
 
-	err = mp.commitAll(headerHandler)
+	err = mp.commitAll(ctx, headerHandler)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,392 @@
+package preprocess
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// minNumGroupsForParallelExecution is the minimum number of independent groups for which the parallel execution is worth it
+const minNumGroupsForParallelExecution = 2
+
+const builtInFunctionArgumentsSeparator = "@"
+
+// parallelExecutionInfo holds how a transaction should be executed when the parallel execution is active
+type parallelExecutionInfo struct {
+	touchedAddresses [][]byte
+	exclusive        bool
+}
+
+// computeParallelExecutionGroups splits the provided transactions in groups that do not touch the same accounts. Each
+// group holds the indexes of its transactions in their original order and the groups are sorted by their first index,
+// so the outcome is deterministic. It returns false if at least one transaction can not be executed in parallel or if
+// there are not enough independent groups
+func (txs *transactions) computeParallelExecutionGroups(txsToExecute []*transaction.Transaction) ([][]int, []bool, bool) {
+	parents := make([]int, len(txsToExecute))
+	exclusive := make([]bool, len(txsToExecute))
+	addressOwner := make(map[string]int)
+
+	var find func(index int) int
+	find = func(index int) int {
+		if parents[index] != index {
+			parents[index] = find(parents[index])
+		}
+		return parents[index]
+	}
+
+	for index, tx := range txsToExecute {
+		parents[index] = index
+
+		info, ok := txs.getParallelExecutionInfo(tx)
+		if !ok {
+			return nil, nil, false
+		}
+		exclusive[index] = info.exclusive
+
+		for _, address := range info.touchedAddresses {
+			owner, found := addressOwner[string(address)]
+			if !found {
+				addressOwner[string(address)] = index
+				continue
+			}
+
+			rootOwner, rootIndex := find(owner), find(index)
+			if rootOwner == rootIndex {
+				continue
+			}
+			if rootOwner < rootIndex {
+				parents[rootIndex] = rootOwner
+			} else {
+				parents[rootOwner] = rootIndex
+			}
+		}
+	}
+
+	groups := make([][]int, 0)
+	groupIndexByRoot := make(map[int]int)
+	for index := range txsToExecute {
+		root := find(index)
+		groupIndex, found := groupIndexByRoot[root]
+		if !found {
+			groupIndex = len(groups)
+			groupIndexByRoot[root] = groupIndex
+			groups = append(groups, make([]int, 0))
+		}
+
+		groups[groupIndex] = append(groups[groupIndex], index)
+	}
+
+	if len(groups) < minNumGroupsForParallelExecution {
+		return nil, nil, false
+	}
+
+	return groups, exclusive, true
+}
+
+// getParallelExecutionInfo returns the accounts touched by the provided transaction. Only the move balance transactions
+// and the fungible ESDT transfers between user accounts are eligible. The move balance transactions never revert the
+// accounts journal so they can run at the same time, while an ESDT transfer reverts to its own snapshot on failure so
+// it has to run exclusively
+func (txs *transactions) getParallelExecutionInfo(tx *transaction.Transaction) (*parallelExecutionInfo, bool) {
+	if len(tx.RcvUserName) > 0 {
+		return nil, false
+	}
+	if core.IsSmartContractAddress(tx.RcvAddr) {
+		return nil, false
+	}
+	if txs.shardCoordinator.ComputeId(tx.RcvAddr) == core.MetachainShardId {
+		return nil, false
+	}
+
+	info := &parallelExecutionInfo{
+		touchedAddresses: [][]byte{tx.SndAddr, tx.RcvAddr},
+	}
+
+	txTypeOnSender, txTypeOnReceiver := txs.txTypeHandler.ComputeTransactionType(tx)
+	if txTypeOnSender != txTypeOnReceiver {
+		return nil, false
+	}
+
+	switch txTypeOnSender {
+	case process.MoveBalance:
+		return info, true
+	case process.BuiltInFunctionCall:
+		function := bytes.SplitN(tx.Data, []byte(builtInFunctionArgumentsSeparator), 2)[0]
+		if string(function) != core.BuiltInFunctionESDTTransfer {
+			return nil, false
+		}
+
+		info.exclusive = true
+		return info, true
+	default:
+		return nil, false
+	}
+}
+
+// executeGroupsInParallel executes the provided groups concurrently, the transactions of a group being executed
+// sequentially. The exclusive transactions revert the accounts journal on failure, so they are executed in their
+// original order, one after another, while no other transaction runs. The intermediate results and the logs are
+// produced in a non-deterministic order: the block stays deterministic because the intermediate results processors
+// sort the created transactions by hash when building the miniblocks and the logs are saved by transaction hash.
+// When a transaction fails, the transactions with a higher index which were not yet started are skipped, so all the
+// transactions before the lowest failed index are executed, exactly as in the sequential processing. It returns the
+// lowest index of a failed transaction together with its error and the flags of the executed transactions
+func (txs *transactions) executeGroupsInParallel(
+	groups [][]int,
+	exclusive []bool,
+	executeTx func(index int) error,
+) (int, []bool, error) {
+	numTxs := 0
+	for _, group := range groups {
+		numTxs += len(group)
+	}
+
+	errs := make([]error, numTxs)
+	executed := make([]bool, numTxs)
+	firstFailedIndex := int64(numTxs)
+
+	// each exclusive transaction waits for the previous exclusive one to be finished or skipped
+	exclusiveDone := make([]chan struct{}, numTxs)
+	previousExclusive := make([]int, numTxs)
+	lastExclusive := -1
+	for index := 0; index < numTxs; index++ {
+		previousExclusive[index] = lastExclusive
+		if exclusive[index] {
+			exclusiveDone[index] = make(chan struct{})
+			lastExclusive = index
+		}
+	}
+
+	isCancelled := func(index int) bool {
+		return int64(index) > atomic.LoadInt64(&firstFailedIndex)
+	}
+
+	// the groups are not throttled as an exclusive transaction might wait for one from a group started later
+	wg := sync.WaitGroup{}
+	wg.Add(len(groups))
+	for _, group := range groups {
+		go func(indexes []int) {
+			defer func() {
+				for _, index := range indexes {
+					if exclusive[index] && !executed[index] {
+						close(exclusiveDone[index])
+					}
+				}
+				wg.Done()
+			}()
+
+			for _, index := range indexes {
+				if exclusive[index] && previousExclusive[index] >= 0 {
+					<-exclusiveDone[previousExclusive[index]]
+				}
+				if isCancelled(index) {
+					return
+				}
+
+				errs[index] = txs.executeTxInParallelMode(index, exclusive[index], executeTx)
+				executed[index] = true
+				if exclusive[index] {
+					close(exclusiveDone[index])
+				}
+				if errs[index] != nil {
+					setMinIndex(&firstFailedIndex, int64(index))
+					return
+				}
+			}
+		}(group)
+	}
+
+	wg.Wait()
+
+	if firstFailedIndex < int64(numTxs) {
+		return int(firstFailedIndex), executed, errs[firstFailedIndex]
+	}
+
+	return numTxs, executed, nil
+}
+
+func setMinIndex(minIndex *int64, index int64) {
+	for {
+		current := atomic.LoadInt64(minIndex)
+		if index >= current || atomic.CompareAndSwapInt64(minIndex, current, index) {
+			return
+		}
+	}
+}
+
+func (txs *transactions) executeTxInParallelMode(index int, exclusive bool, executeTx func(index int) error) error {
+	if exclusive {
+		txs.mutParallelExecution.Lock()
+		defer txs.mutParallelExecution.Unlock()
+
+		return executeTx(index)
+	}
+
+	txs.mutParallelExecution.RLock()
+	defer txs.mutParallelExecution.RUnlock()
+
+	return executeTx(index)
+}
+
+// processTxsToMeInParallel executes in parallel the transactions destined to the current shard, if possible. It returns
+// false if nothing was executed and the transactions should be processed sequentially, otherwise it returns the number
+// of transactions processed before the first failed one
+func (txs *transactions) processTxsToMeInParallel(
+	txsToMe []*txcache.WrappedTransaction,
+	haveTime func() bool,
+	gasInfo *gasConsumedInfo,
+) (bool, int, error) {
+	txsToExecute := make([]*transaction.Transaction, len(txsToMe))
+	txHashes := make([][]byte, len(txsToMe))
+	for index := range txsToMe {
+		tx, ok := txsToMe[index].Tx.(*transaction.Transaction)
+		if !ok {
+			return false, 0, nil
+		}
+
+		txsToExecute[index] = tx
+		txHashes[index] = txsToMe[index].TxHash
+	}
+
+	groups, exclusive, ok := txs.computeParallelExecutionGroups(txsToExecute)
+	if !ok {
+		return false, 0, nil
+	}
+
+	// the gas is computed upfront, without the refunds, so any error here is left to the sequential processing
+	gasProvidedByTxs := make([]uint64, len(txsToMe))
+	gasInfoAfterTx := make([]gasConsumedInfo, len(txsToMe))
+	gasInfoAfterExecution := *gasInfo
+	for index := range txsToMe {
+		gasProvided, err := txs.computeGasProvided(
+			txsToMe[index].SenderShardID,
+			txsToMe[index].ReceiverShardID,
+			txsToExecute[index],
+			txHashes[index],
+			&gasInfoAfterExecution)
+		if err != nil {
+			return false, 0, nil
+		}
+
+		gasProvidedByTxs[index] = gasProvided
+		gasInfoAfterTx[index] = gasInfoAfterExecution
+	}
+
+	for index := range txsToMe {
+		txs.gasHandler.SetGasProvided(gasProvidedByTxs[index], txHashes[index])
+	}
+
+	index, executed, err := txs.executeGroupsInParallel(groups, exclusive, func(index int) error {
+		if !haveTime() {
+			return process.ErrTimeIsOut
+		}
+
+		txs.saveAccountBalanceForAddress(txsToExecute[index].GetRcvAddr())
+
+		return txs.processAndRemoveBadTransaction(
+			txHashes[index],
+			txsToExecute[index],
+			txsToMe[index].SenderShardID,
+			txsToMe[index].ReceiverShardID)
+	})
+
+	txs.updateGasAfterParallelExecution(txHashes, executed, gasInfoAfterTx, index, gasInfo)
+
+	return true, index, err
+}
+
+// processMiniBlockTxsInParallel executes in parallel the transactions of the provided miniblock, if possible. It
+// returns false if nothing was executed and the transactions should be processed sequentially, otherwise it returns
+// the hashes of the executed transactions, in their original order, and the number of transactions processed before
+// the first failed one
+func (txs *transactions) processMiniBlockTxsInParallel(
+	miniBlock *block.MiniBlock,
+	miniBlockTxs []*transaction.Transaction,
+	miniBlockTxHashes [][]byte,
+	haveTime func() bool,
+	haveAdditionalTime func() bool,
+	gasInfo *gasConsumedInfo,
+	maxGasLimitUsedForDestMeTxs uint64,
+) (bool, [][]byte, int, error) {
+	groups, exclusive, ok := txs.computeParallelExecutionGroups(miniBlockTxs)
+	if !ok {
+		return false, nil, 0, nil
+	}
+
+	// the gas is computed upfront, without the refunds, so any error here is left to the sequential processing
+	gasProvidedByTxs := make([]uint64, len(miniBlockTxs))
+	gasInfoAfterTx := make([]gasConsumedInfo, len(miniBlockTxs))
+	gasInfoAfterExecution := *gasInfo
+	for index := range miniBlockTxs {
+		gasProvided, err := txs.computeGasProvided(
+			miniBlock.SenderShardID,
+			miniBlock.ReceiverShardID,
+			miniBlockTxs[index],
+			miniBlockTxHashes[index],
+			&gasInfoAfterExecution)
+		isMaxGasLimitReached := txs.flagOptimizeGasUsedInCrossMiniBlocks.IsSet() &&
+			gasInfoAfterExecution.totalGasConsumedInSelfShard > maxGasLimitUsedForDestMeTxs
+		if err != nil || isMaxGasLimitReached {
+			return false, nil, 0, nil
+		}
+
+		gasProvidedByTxs[index] = gasProvided
+		gasInfoAfterTx[index] = gasInfoAfterExecution
+	}
+
+	for index := range miniBlockTxs {
+		txs.gasHandler.SetGasProvided(gasProvidedByTxs[index], miniBlockTxHashes[index])
+	}
+
+	index, executed, err := txs.executeGroupsInParallel(groups, exclusive, func(index int) error {
+		if !haveTime() && !haveAdditionalTime() {
+			return process.ErrTimeIsOut
+		}
+
+		txs.saveAccountBalanceForAddress(miniBlockTxs[index].GetRcvAddr())
+
+		_, errProcess := txs.txProcessor.ProcessTransaction(miniBlockTxs[index])
+		return errProcess
+	})
+
+	txs.updateGasAfterParallelExecution(miniBlockTxHashes, executed, gasInfoAfterTx, index, gasInfo)
+
+	executedTxHashes := make([][]byte, 0, len(miniBlockTxHashes))
+	for txIndex, txHash := range miniBlockTxHashes {
+		if executed[txIndex] {
+			executedTxHashes = append(executedTxHashes, txHash)
+		}
+	}
+
+	return true, executedTxHashes, index, err
+}
+
+// updateGasAfterParallelExecution leaves the gas info as the sequential processing would have left it after processing
+// the transactions up to the provided number of processed transactions, the failed one included
+func (txs *transactions) updateGasAfterParallelExecution(
+	txHashes [][]byte,
+	executed []bool,
+	gasInfoAfterTx []gasConsumedInfo,
+	numTxsProcessed int,
+	gasInfo *gasConsumedInfo,
+) {
+	lastIndex := numTxsProcessed
+	if lastIndex >= len(txHashes) {
+		lastIndex = len(txHashes) - 1
+	}
+
+	txs.gasHandler.RemoveGasProvided(txHashes[lastIndex+1:])
+	*gasInfo = gasInfoAfterTx[lastIndex]
+
+	for index := 0; index < numTxsProcessed; index++ {
+		if executed[index] {
+			txs.updateGasConsumedWithGasRefundedAndGasPenalized(txHashes[index], gasInfo)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+package preprocess
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createUserAddress(name string) []byte {
+	address := make([]byte, 32)
+	copy(address[len(address)-len(name):], name)
+	address[0] = 1
+
+	return address
+}
+
+func createTxsPreprocessorForParallelExecution(t *testing.T) *transactions {
+	args := createDefaultTransactionsProcessorArgs()
+	args.TxTypeHandler = &testscommon.TxTypeHandlerMock{
+		ComputeTransactionTypeCalled: func(tx data.TransactionHandler) (process.TransactionType, process.TransactionType) {
+			if len(tx.GetData()) > 0 {
+				return process.BuiltInFunctionCall, process.BuiltInFunctionCall
+			}
+			return process.MoveBalance, process.MoveBalance
+		},
+	}
+	txs, err := NewTransactionPreprocessor(args)
+	require.Nil(t, err)
+
+	return txs
+}
+
+func TestTransactions_ComputeParallelExecutionGroups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("independent transactions should be grouped by the touched accounts", func(t *testing.T) {
+		t.Parallel()
+
+		txs := createTxsPreprocessorForParallelExecution(t)
+		txsToExecute := []*transaction.Transaction{
+			{SndAddr: createUserAddress("alice"), RcvAddr: createUserAddress("bob")},
+			{SndAddr: createUserAddress("carol"), RcvAddr: createUserAddress("dave")},
+			{SndAddr: createUserAddress("bob"), RcvAddr: createUserAddress("eve")},
+			{SndAddr: createUserAddress("frank"), RcvAddr: createUserAddress("grace"), Data: []byte("ESDTTransfer@aa@01")},
+			{SndAddr: createUserAddress("eve"), RcvAddr: createUserAddress("dave")},
+		}
+
+		groups, exclusive, ok := txs.computeParallelExecutionGroups(txsToExecute)
+		require.True(t, ok)
+		assert.Equal(t, [][]int{{0, 1, 2, 4}, {3}}, groups)
+		assert.Equal(t, []bool{false, false, false, true, false}, exclusive)
+	})
+	t.Run("single group should not execute in parallel", func(t *testing.T) {
+		t.Parallel()
+
+		txs := createTxsPreprocessorForParallelExecution(t)
+		txsToExecute := []*transaction.Transaction{
+			{SndAddr: createUserAddress("alice"), RcvAddr: createUserAddress("bob")},
+			{SndAddr: createUserAddress("alice"), RcvAddr: createUserAddress("carol")},
+		}
+
+		_, _, ok := txs.computeParallelExecutionGroups(txsToExecute)
+		assert.False(t, ok)
+	})
+	t.Run("smart contract call should not execute in parallel", func(t *testing.T) {
+		t.Parallel()
+
+		txs := createTxsPreprocessorForParallelExecution(t)
+		txsToExecute := []*transaction.Transaction{
+			{SndAddr: createUserAddress("alice"), RcvAddr: createUserAddress("bob")},
+			{SndAddr: createUserAddress("carol"), RcvAddr: make([]byte, 32)},
+		}
+
+		_, _, ok := txs.computeParallelExecutionGroups(txsToExecute)
+		assert.False(t, ok)
+	})
+	t.Run("other built in function should not execute in parallel", func(t *testing.T) {
+		t.Parallel()
+
+		txs := createTxsPreprocessorForParallelExecution(t)
+		txsToExecute := []*transaction.Transaction{
+			{SndAddr: createUserAddress("alice"), RcvAddr: createUserAddress("bob")},
+			{SndAddr: createUserAddress("carol"), RcvAddr: createUserAddress("carol"), Data: []byte(core.BuiltInFunctionESDTNFTTransfer + "@aa")},
+		}
+
+		_, _, ok := txs.computeParallelExecutionGroups(txsToExecute)
+		assert.False(t, ok)
+	})
+	t.Run("receiver username should not execute in parallel", func(t *testing.T) {
+		t.Parallel()
+
+		txs := createTxsPreprocessorForParallelExecution(t)
+		txsToExecute := []*transaction.Transaction{
+			{SndAddr: createUserAddress("alice"), RcvAddr: createUserAddress("bob")},
+			{SndAddr: createUserAddress("carol"), RcvAddr: createUserAddress("dave"), RcvUserName: []byte("dave")},
+		}
+
+		_, _, ok := txs.computeParallelExecutionGroups(txsToExecute)
+		assert.False(t, ok)
+	})
+}
+
+func TestTransactions_ExecuteGroupsInParallelShouldReturnTheLowestFailedIndex(t *testing.T) {
+	t.Parallel()
+
+	txs := createTxsPreprocessorForParallelExecution(t)
+	expectedErr := errors.New("expected error")
+
+	index, executed, err := txs.executeGroupsInParallel(
+		[][]int{{0, 2, 4}, {1, 3}},
+		[]bool{false, true, false, false, true},
+		func(index int) error {
+			if index == 2 || index == 3 {
+				return expectedErr
+			}
+			return nil
+		},
+	)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, index)
+	assert.True(t, executed[0])
+	assert.True(t, executed[1])
+	assert.True(t, executed[2])
+	assert.False(t, executed[4])
+}
+
+func TestTransactions_ExecuteGroupsInParallelShouldSkipTheTxsAfterTheFailedOne(t *testing.T) {
+	t.Parallel()
+
+	txs := createTxsPreprocessorForParallelExecution(t)
+	expectedErr := errors.New("expected error")
+
+	index, executed, err := txs.executeGroupsInParallel(
+		[][]int{{0, 3}, {1, 2}},
+		[]bool{true, false, false, true},
+		func(index int) error {
+			if index == 0 {
+				return expectedErr
+			}
+			return nil
+		},
+	)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 0, index)
+	assert.True(t, executed[0])
+	assert.False(t, executed[3])
+}
+
+func TestTransactions_ExecuteGroupsInParallelShouldExecuteTheExclusiveTxsInOrder(t *testing.T) {
+	t.Parallel()
+
+	txs := createTxsPreprocessorForParallelExecution(t)
+
+	mutExecuted := sync.Mutex{}
+	executedExclusive := make([]int, 0)
+	exclusive := []bool{false, true, true, false, true, true, false, true}
+	index, executed, err := txs.executeGroupsInParallel(
+		[][]int{{0, 5, 7}, {1, 4}, {2, 3, 6}},
+		exclusive,
+		func(index int) error {
+			if exclusive[index] {
+				mutExecuted.Lock()
+				executedExclusive = append(executedExclusive, index)
+				mutExecuted.Unlock()
+			}
+			return nil
+		},
+	)
+
+	assert.Nil(t, err)
+	assert.Equal(t, len(exclusive), index)
+	assert.Equal(t, []int{1, 2, 4, 5, 7}, executedExclusive)
+	for _, isExecuted := range executed {
+		assert.True(t, isExecuted)
+	}
+}
+
+func TestTransactionsPreprocessor_ProcessMiniBlockInParallelShouldWork(t *testing.T) {
+	t.Parallel()
+
+	txsInPool := map[string]*transaction.Transaction{
+		"tx_hash1": {Nonce: 10, SndAddr: createUserAddress("alice"), RcvAddr: createUserAddress("bob")},
+		"tx_hash2": {Nonce: 11, SndAddr: createUserAddress("carol"), RcvAddr: createUserAddress("dave")},
+		"tx_hash3": {Nonce: 11, SndAddr: createUserAddress("alice"), RcvAddr: createUserAddress("eve")},
+	}
+	tdp := &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				ShardDataStoreCalled: func(id string) (c storage.Cacher) {
+					return &testscommon.CacherStub{
+						PeekCalled: func(key []byte) (value interface{}, ok bool) {
+							tx, found := txsInPool[string(key)]
+							return tx, found
+						},
+					}
+				},
+			}
+		},
+	}
+
+	mutProcessed := sync.Mutex{}
+	processedNonces := make(map[string][]uint64)
+	args := createDefaultTransactionsProcessorArgs()
+	args.TxDataPool = tdp.Transactions()
+	args.TxProcessor = &testscommon.TxProcessorMock{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			mutProcessed.Lock()
+			processedNonces[string(tx.SndAddr)] = append(processedNonces[string(tx.SndAddr)], tx.Nonce)
+			mutProcessed.Unlock()
+
+			return vmcommon.Ok, nil
+		},
+	}
+	txs, err := NewTransactionPreprocessor(args)
+	require.Nil(t, err)
+	txs.EpochConfirmed(2, 0)
+
+	miniBlock := &block.MiniBlock{
+		ReceiverShardID: 0,
+		SenderShardID:   1,
+		TxHashes:        [][]byte{[]byte("tx_hash1"), []byte("tx_hash2"), []byte("tx_hash3")},
+		Type:            block.TxBlock,
+	}
+
+	txsToBeReverted, numTxsProcessed, err := txs.ProcessMiniBlock(miniBlock, haveTimeTrue, haveAdditionalTimeFalse, getNumOfCrossInterMbsAndTxsZero, false)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(txsToBeReverted))
+	assert.Equal(t, 3, numTxsProcessed)
+	assert.Equal(t, []uint64{10, 11}, processedNonces[string(createUserAddress("alice"))])
+	assert.Equal(t, []uint64{11}, processedNonces[string(createUserAddress("carol"))])
+}
+
+func TestTransactionsPreprocessor_ProcessMiniBlockInParallelShouldReturnAllTxsToBeReverted(t *testing.T) {
+	t.Parallel()
+
+	txsInPool := map[string]*transaction.Transaction{
+		"tx_hash1": {Nonce: 10, SndAddr: createUserAddress("alice"), RcvAddr: createUserAddress("bob")},
+		"tx_hash2": {Nonce: 11, SndAddr: createUserAddress("carol"), RcvAddr: createUserAddress("dave")},
+	}
+	tdp := &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				ShardDataStoreCalled: func(id string) (c storage.Cacher) {
+					return &testscommon.CacherStub{
+						PeekCalled: func(key []byte) (value interface{}, ok bool) {
+							tx, found := txsInPool[string(key)]
+							return tx, found
+						},
+					}
+				},
+			}
+		},
+	}
+
+	expectedErr := errors.New("expected error")
+	args := createDefaultTransactionsProcessorArgs()
+	args.TxDataPool = tdp.Transactions()
+	args.TxProcessor = &testscommon.TxProcessorMock{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			if tx.Nonce == 11 {
+				return vmcommon.UserError, expectedErr
+			}
+			return vmcommon.Ok, nil
+		},
+	}
+	txs, err := NewTransactionPreprocessor(args)
+	require.Nil(t, err)
+	txs.EpochConfirmed(2, 0)
+
+	miniBlock := &block.MiniBlock{
+		ReceiverShardID: 0,
+		SenderShardID:   1,
+		TxHashes:        [][]byte{[]byte("tx_hash1"), []byte("tx_hash2")},
+		Type:            block.TxBlock,
+	}
+
+	txsToBeReverted, numTxsProcessed, err := txs.ProcessMiniBlock(miniBlock, haveTimeTrue, haveAdditionalTimeFalse, getNumOfCrossInterMbsAndTxsZero, false)
+
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, len(txsToBeReverted))
+	assert.Equal(t, 1, numTxsProcessed)
+}
@@ -64,6 +64,10 @@ type transactions struct {
 	flagScheduledMiniBlocks        atomic.Flag
 	txTypeHandler                  process.TxTypeHandler
 	scheduledTxsExecutionHandler   process.ScheduledTxsExecutionHandler
+
+	parallelTransactionsExecutionEnableEpoch uint32
+	flagParallelTransactionsExecution        atomic.Flag
+	mutParallelExecution                     sync.RWMutex
 }
 
 // ArgsTransactionPreProcessor holds the arguments to create a txs pre processor
@@ -87,6 +91,7 @@ type ArgsTransactionPreProcessor struct {
 	OptimizeGasUsedInCrossMiniBlocksEnableEpoch uint32
 	FrontRunningProtectionEnableEpoch           uint32
 	ScheduledMiniBlocksEnableEpoch              uint32
+	ParallelTransactionsExecutionEnableEpoch    uint32
 	TxTypeHandler                               process.TxTypeHandler
 	ScheduledTxsExecutionHandler                process.ScheduledTxsExecutionHandler
 }
@@ -175,6 +180,8 @@ func NewTransactionPreprocessor(
 		scheduledMiniBlocksEnableEpoch: args.ScheduledMiniBlocksEnableEpoch,
 		txTypeHandler:                  args.TxTypeHandler,
 		scheduledTxsExecutionHandler:   args.ScheduledTxsExecutionHandler,
+
+		parallelTransactionsExecutionEnableEpoch: args.ParallelTransactionsExecutionEnableEpoch,
 	}
 
 	txs.chRcvAllTxs = make(chan bool)
@@ -516,6 +523,14 @@ func (txs *transactions) processTxsToMe(
 			"gasConsumedByMiniBlockInReceiverShard", gasInfo.gasConsumedByMiniBlockInReceiverShard)
 	}()
 
+	if !scheduledMode && txs.flagParallelTransactionsExecution.IsSet() {
+		executedInParallel, numTxsProcessedInParallel, errParallel := txs.processTxsToMeInParallel(txsToMe, haveTime, &gasInfo)
+		if executedInParallel {
+			numTXsProcessed = numTxsProcessedInParallel
+			return errParallel
+		}
+	}
+
 	for index := range txsToMe {
 		if !haveTime() {
 			return process.ErrTimeIsOut
@@ -1484,7 +1499,28 @@ func (txs *transactions) ProcessMiniBlock(
 
 	numOfOldCrossInterMbs, numOfOldCrossInterTxs := getNumOfCrossInterMbsAndTxs()
 
-	for index := range miniBlockTxs {
+	executedInParallel := false
+	if !scheduledMode && txs.flagParallelTransactionsExecution.IsSet() {
+		var index int
+		var executedTxHashes [][]byte
+		executedInParallel, executedTxHashes, index, err = txs.processMiniBlockTxsInParallel(
+			miniBlock,
+			miniBlockTxs,
+			miniBlockTxHashes,
+			haveTime,
+			haveAdditionalTime,
+			&gasInfo,
+			maxGasLimitUsedForDestMeTxs)
+		if executedInParallel {
+			processedTxHashes = append(processedTxHashes, executedTxHashes...)
+			numTXsProcessed = index
+			if err != nil {
+				return processedTxHashes, index, err
+			}
+		}
+	}
+
+	for index := 0; index < len(miniBlockTxs) && !executedInParallel; index++ {
 		if !haveTime() && !haveAdditionalTime() {
 			return processedTxHashes, index, process.ErrTimeIsOut
 		}
@@ -1594,6 +1630,9 @@ func (txs *transactions) EpochConfirmed(epoch uint32, timestamp uint64) {
 
 	txs.flagScheduledMiniBlocks.SetValue(epoch >= txs.scheduledMiniBlocksEnableEpoch)
 	log.Debug("transactions: scheduled mini blocks", "enabled", txs.flagScheduledMiniBlocks.IsSet())
+
+	txs.flagParallelTransactionsExecution.SetValue(epoch >= txs.parallelTransactionsExecutionEnableEpoch)
+	log.Debug("transactions: parallel transactions execution", "enabled", txs.flagParallelTransactionsExecution.IsSet())
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
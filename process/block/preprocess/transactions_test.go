@@ -230,6 +230,7 @@ func createDefaultTransactionsProcessorArgs() ArgsTransactionPreProcessor {
 		OptimizeGasUsedInCrossMiniBlocksEnableEpoch: 2,
 		FrontRunningProtectionEnableEpoch:           30,
 		ScheduledMiniBlocksEnableEpoch:              2,
+		ParallelTransactionsExecutionEnableEpoch:    2,
 		TxTypeHandler:                               &testscommon.TxTypeHandlerMock{},
 		ScheduledTxsExecutionHandler:                &testscommon.ScheduledTxsExecutionStub{},
 	}
@@ -1848,7 +1849,8 @@ func TestTransactions_EpochConfirmed(t *testing.T) {
 			frontRunningProtectionEnableEpoch:           1,
 			optimizeGasUsedInCrossMiniBlocksEnableEpoch: 2,
 		},
-		scheduledMiniBlocksEnableEpoch: 3,
+		scheduledMiniBlocksEnableEpoch:           3,
+		parallelTransactionsExecutionEnableEpoch: 4,
 	}
 
 	txs.EpochConfirmed(0, 0)
@@ -1871,10 +1873,13 @@ func TestTransactions_EpochConfirmed(t *testing.T) {
 	assert.True(t, txs.flagOptimizeGasUsedInCrossMiniBlocks.IsSet())
 	assert.True(t, txs.flagScheduledMiniBlocks.IsSet())
 
+	assert.False(t, txs.flagParallelTransactionsExecution.IsSet())
+
 	txs.EpochConfirmed(4, 0)
 	assert.True(t, txs.flagFrontRunningProtection.IsSet())
 	assert.True(t, txs.flagOptimizeGasUsedInCrossMiniBlocks.IsSet())
 	assert.True(t, txs.flagScheduledMiniBlocks.IsSet())
+	assert.True(t, txs.flagParallelTransactionsExecution.IsSet())
 }
 
 func TestTransactions_ComputeCacheIdentifier(t *testing.T) {
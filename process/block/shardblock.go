@@ -2,6 +2,7 @@ package block
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"math/big"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
 )
 
 var _ process.BlockProcessor = (*shardProcessor)(nil)
@@ -117,6 +119,8 @@ func NewShardProcessor(arguments ArgShardProcessor) (*shardProcessor, error) {
 		processDataTriesOnCommitEpoch:  arguments.Config.Debug.EpochStart.ProcessDataTrieOnCommitEpoch,
 		gasConsumedProvider:            arguments.GasHandler,
 		economicsData:                  arguments.CoreComponents.EconomicsData(),
+		metricsRegistry:                arguments.CoreComponents.MetricsRegistry(),
+		tracer:                         arguments.CoreComponents.Tracer(),
 		scheduledTxsExecutionHandler:   arguments.ScheduledTxsExecutionHandler,
 		scheduledMiniBlocksEnableEpoch: arguments.ScheduledMiniBlocksEnableEpoch,
 		pruningDelay:                   pruningDelay,
@@ -157,6 +161,20 @@ func (sp *shardProcessor) ProcessBlock(
 	bodyHandler data.BodyHandler,
 	haveTime func() time.Duration,
 ) error {
+	return sp.ProcessBlockWithContext(context.Background(), headerHandler, bodyHandler, haveTime)
+}
+
+// ProcessBlockWithContext processes a block, tracing it as a child of the span found in the provided context
+func (sp *shardProcessor) ProcessBlockWithContext(
+	ctx context.Context,
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
+	haveTime func() time.Duration,
+) error {
+	defer sp.metricsRegistry.ObserveDurationSince(prometheus.BlockProcessingDuration, time.Now(), prometheus.PhaseProcess)
+	ctx, span := sp.tracer.StartHeaderSpan(ctx, "shardProcessor.ProcessBlock", headerHandler)
+	defer span.End()
+
 	if haveTime == nil {
 		return process.ErrNilHaveTimeHandler
 	}
@@ -318,7 +336,7 @@ func (sp *shardProcessor) ProcessBlock(
 	miniBlocks := body.MiniBlocks[mbIndex:]
 
 	startTime := time.Now()
-	err = sp.txCoordinator.ProcessBlockTransaction(header, &block.Body{MiniBlocks: miniBlocks}, haveTime)
+	err = sp.txCoordinator.ProcessBlockTransaction(ctx, header, &block.Body{MiniBlocks: miniBlocks}, haveTime)
 	elapsedTime := time.Since(startTime)
 	log.Debug("elapsed time to process block transaction",
 		"time [s]", elapsedTime,
@@ -770,6 +788,20 @@ func (sp *shardProcessor) CreateBlock(
 	initialHdr data.HeaderHandler,
 	haveTime func() bool,
 ) (data.HeaderHandler, data.BodyHandler, error) {
+	return sp.CreateBlockWithContext(context.Background(), initialHdr, haveTime)
+}
+
+// CreateBlockWithContext creates the final block and header for the current round, tracing the creation as a child
+// of the span found in the provided context
+func (sp *shardProcessor) CreateBlockWithContext(
+	ctx context.Context,
+	initialHdr data.HeaderHandler,
+	haveTime func() bool,
+) (data.HeaderHandler, data.BodyHandler, error) {
+	defer sp.metricsRegistry.ObserveDurationSince(prometheus.BlockProcessingDuration, time.Now(), prometheus.PhaseCreate)
+	_, span := sp.tracer.StartHeaderSpan(ctx, "shardProcessor.CreateBlock", initialHdr)
+	defer span.End()
+
 	if check.IfNil(initialHdr) {
 		return nil, nil, process.ErrNilBlockHeader
 	}
@@ -848,6 +880,20 @@ func (sp *shardProcessor) CommitBlock(
 	headerHandler data.HeaderHandler,
 	bodyHandler data.BodyHandler,
 ) error {
+	return sp.CommitBlockWithContext(context.Background(), headerHandler, bodyHandler)
+}
+
+// CommitBlockWithContext commits the block in the blockchain, tracing the commit as a child of the span found in the
+// provided context
+func (sp *shardProcessor) CommitBlockWithContext(
+	ctx context.Context,
+	headerHandler data.HeaderHandler,
+	bodyHandler data.BodyHandler,
+) error {
+	defer sp.metricsRegistry.ObserveDurationSince(prometheus.BlockProcessingDuration, time.Now(), prometheus.PhaseCommit)
+	ctx, span := sp.tracer.StartHeaderSpan(ctx, "shardProcessor.CommitBlock", headerHandler)
+	defer span.End()
+
 	var err error
 	sp.processStatusHandler.SetBusy("shardProcessor.CommitBlock")
 	defer func() {
@@ -928,7 +974,7 @@ func (sp *shardProcessor) CommitBlock(
 		return err
 	}
 
-	err = sp.commitAll(headerHandler)
+	err = sp.commitAll(ctx, headerHandler)
 	if err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package coordinator
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"math/big"
 	"sort"
@@ -19,6 +20,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/preprocess"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
@@ -54,6 +56,7 @@ type ArgTransactionCoordinator struct {
 	ScheduledTxsExecutionHandler      process.ScheduledTxsExecutionHandler
 	ScheduledMiniBlocksEnableEpoch    uint32
 	DoubleTransactionsDetector        process.DoubleTransactionDetector
+	Tracer                            tracing.Tracer
 }
 
 type transactionCoordinator struct {
@@ -88,6 +91,7 @@ type transactionCoordinator struct {
 	scheduledMiniBlocksEnableEpoch    uint32
 	flagScheduledMiniBlocks           atomic.Flag
 	doubleTransactionsDetector        process.DoubleTransactionDetector
+	tracer                            tracing.Tracer
 }
 
 // NewTransactionCoordinator creates a transaction coordinator to run and coordinate preprocessors and processors
@@ -113,6 +117,7 @@ func NewTransactionCoordinator(args ArgTransactionCoordinator) (*transactionCoor
 		scheduledTxsExecutionHandler:      args.ScheduledTxsExecutionHandler,
 		scheduledMiniBlocksEnableEpoch:    args.ScheduledMiniBlocksEnableEpoch,
 		doubleTransactionsDetector:        args.DoubleTransactionsDetector,
+		tracer:                            args.Tracer,
 	}
 	log.Debug("coordinator/process: enable epoch for block gas and fees re-check", "epoch", tc.blockGasAndFeesReCheckEnableEpoch)
 
@@ -411,8 +416,10 @@ func (tc *transactionCoordinator) RemoveTxsFromPool(body *block.Body) error {
 	return errFound
 }
 
-// ProcessBlockTransaction processes transactions and updates state tries
+// ProcessBlockTransaction processes transactions and updates state tries. The processing of each block type is
+// traced as a child of the span found in the provided context
 func (tc *transactionCoordinator) ProcessBlockTransaction(
+	ctx context.Context,
 	header data.HeaderHandler,
 	body *block.Body,
 	timeRemaining func() time.Duration,
@@ -432,7 +439,7 @@ func (tc *transactionCoordinator) ProcessBlockTransaction(
 	tc.doubleTransactionsDetector.ProcessBlockBody(body)
 
 	startTime := time.Now()
-	mbIndex, err := tc.processMiniBlocksToMe(header, body, haveTime)
+	mbIndex, err := tc.processMiniBlocksToMe(ctx, header, body, haveTime)
 	elapsedTime := time.Since(startTime)
 	log.Debug("elapsed time to processMiniBlocksToMe",
 		"time [s]", elapsedTime,
@@ -447,7 +454,7 @@ func (tc *transactionCoordinator) ProcessBlockTransaction(
 
 	miniBlocksFromMe := body.MiniBlocks[mbIndex:]
 	startTime = time.Now()
-	err = tc.processMiniBlocksFromMe(header, &block.Body{MiniBlocks: miniBlocksFromMe}, haveTime)
+	err = tc.processMiniBlocksFromMe(ctx, header, &block.Body{MiniBlocks: miniBlocksFromMe}, haveTime)
 	elapsedTime = time.Since(startTime)
 	log.Debug("elapsed time to processMiniBlocksFromMe",
 		"time [s]", elapsedTime,
@@ -460,6 +467,7 @@ func (tc *transactionCoordinator) ProcessBlockTransaction(
 }
 
 func (tc *transactionCoordinator) processMiniBlocksFromMe(
+	ctx context.Context,
 	header data.HeaderHandler,
 	body *block.Body,
 	haveTime func() bool,
@@ -493,7 +501,7 @@ func (tc *transactionCoordinator) processMiniBlocksFromMe(
 			return process.ErrMissingPreProcessor
 		}
 
-		err := preProc.ProcessBlockTransactions(header, separatedBodies[blockType], haveTime)
+		err := tc.processBlockTransactionsWithSpan(ctx, preProc, header, separatedBodies[blockType], blockType, haveTime)
 		if err != nil {
 			return err
 		}
@@ -505,6 +513,7 @@ func (tc *transactionCoordinator) processMiniBlocksFromMe(
 }
 
 func (tc *transactionCoordinator) processMiniBlocksToMe(
+	ctx context.Context,
 	header data.HeaderHandler,
 	body *block.Body,
 	haveTime func() bool,
@@ -535,7 +544,7 @@ func (tc *transactionCoordinator) processMiniBlocksToMe(
 		}
 
 		log.Debug("processMiniBlocksToMe: miniblock", "type", miniBlock.Type)
-		err := preProc.ProcessBlockTransactions(header, &block.Body{MiniBlocks: []*block.MiniBlock{miniBlock}}, haveTime)
+		err := tc.processBlockTransactionsWithSpan(ctx, preProc, header, &block.Body{MiniBlocks: []*block.MiniBlock{miniBlock}}, miniBlock.Type, haveTime)
 		if err != nil {
 			return mbIndex, err
 		}
@@ -546,6 +555,28 @@ func (tc *transactionCoordinator) processMiniBlocksToMe(
 	return mbIndex, nil
 }
 
+func (tc *transactionCoordinator) processBlockTransactionsWithSpan(
+	ctx context.Context,
+	preProc process.PreProcessor,
+	header data.HeaderHandler,
+	body *block.Body,
+	blockType block.Type,
+	haveTime func() bool,
+) error {
+	_, span := tc.tracer.StartHeaderSpan(
+		ctx,
+		"preprocessor.ProcessBlockTransactions",
+		header,
+		tracing.String(tracing.AttributeBlockType, blockType.String()),
+		tracing.Int(tracing.AttributeNumMiniBlocks, len(body.MiniBlocks)),
+	)
+	err := preProc.ProcessBlockTransactions(header, body, haveTime)
+	span.SetError(err)
+	span.End()
+
+	return err
+}
+
 // CreateMbsAndProcessCrossShardTransactionsDstMe creates miniblocks and processes cross shard transaction
 // with destination of current shard
 func (tc *transactionCoordinator) CreateMbsAndProcessCrossShardTransactionsDstMe(
@@ -1617,6 +1648,9 @@ func checkTransactionCoordinatorNilParameters(arguments ArgTransactionCoordinato
 	if check.IfNil(arguments.DoubleTransactionsDetector) {
 		return process.ErrNilDoubleTransactionsDetector
 	}
+	if check.IfNil(arguments.Tracer) {
+		return process.ErrNilTracer
+	}
 
 	return nil
 }
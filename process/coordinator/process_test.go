@@ -2,6 +2,7 @@ package coordinator
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -22,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/scheduled"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
@@ -240,6 +242,7 @@ func createMockTransactionCoordinatorArguments() ArgTransactionCoordinator {
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 
 	return argsTransactionCoordinator
@@ -454,6 +457,17 @@ func TestNewTransactionCoordinator_NilDoubleTransactionsDetector(t *testing.T) {
 	assert.Equal(t, process.ErrNilDoubleTransactionsDetector, err)
 }
 
+func TestNewTransactionCoordinator_NilTracer(t *testing.T) {
+	t.Parallel()
+
+	argsTransactionCoordinator := createMockTransactionCoordinatorArguments()
+	argsTransactionCoordinator.Tracer = nil
+	tc, err := NewTransactionCoordinator(argsTransactionCoordinator)
+
+	assert.True(t, check.IfNil(tc))
+	assert.Equal(t, process.ErrNilTracer, err)
+}
+
 func TestNewTransactionCoordinator_OK(t *testing.T) {
 	t.Parallel()
 
@@ -530,6 +544,7 @@ func createPreProcessorContainer() process.PreProcessorsContainer {
 		2,
 		2,
 		2,
+		2,
 		&testscommon.TxTypeHandlerMock{},
 		&testscommon.ScheduledTxsExecutionStub{},
 	)
@@ -627,6 +642,7 @@ func createPreProcessorContainerWithDataPool(
 		2,
 		2,
 		2,
+		2,
 		&testscommon.TxTypeHandlerMock{},
 		&testscommon.ScheduledTxsExecutionStub{},
 	)
@@ -897,6 +913,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactions(t *tes
 		2,
 		2,
 		2,
+		2,
 		&testscommon.TxTypeHandlerMock{},
 		&testscommon.ScheduledTxsExecutionStub{},
 	)
@@ -1038,6 +1055,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessCrossShardTransactionsNilPreP
 		2,
 		2,
 		2,
+		2,
 		&testscommon.TxTypeHandlerMock{},
 		&testscommon.ScheduledTxsExecutionStub{},
 	)
@@ -1148,6 +1166,7 @@ func TestTransactionCoordinator_CreateMbsAndProcessTransactionsFromMeNothingToPr
 		2,
 		2,
 		2,
+		2,
 		&testscommon.TxTypeHandlerMock{},
 		&testscommon.ScheduledTxsExecutionStub{},
 	)
@@ -1687,6 +1706,7 @@ func TestTransactionCoordinator_ProcessBlockTransactionProcessTxError(t *testing
 		2,
 		2,
 		2,
+		2,
 		&testscommon.TxTypeHandlerMock{},
 		&testscommon.ScheduledTxsExecutionStub{},
 	)
@@ -1704,7 +1724,7 @@ func TestTransactionCoordinator_ProcessBlockTransactionProcessTxError(t *testing
 	haveTime := func() time.Duration {
 		return time.Second
 	}
-	err = tc.ProcessBlockTransaction(&block.Header{}, &block.Body{}, haveTime)
+	err = tc.ProcessBlockTransaction(context.Background(), &block.Header{}, &block.Body{}, haveTime)
 	assert.Nil(t, err)
 
 	body := &block.Body{}
@@ -1712,19 +1732,19 @@ func TestTransactionCoordinator_ProcessBlockTransactionProcessTxError(t *testing
 	body.MiniBlocks = append(body.MiniBlocks, miniBlock)
 
 	tc.RequestBlockTransactions(body)
-	err = tc.ProcessBlockTransaction(&block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash")}}}, body, haveTime)
+	err = tc.ProcessBlockTransaction(context.Background(), &block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash")}}}, body, haveTime)
 	assert.Equal(t, process.ErrHigherNonceInTransaction, err)
 
 	noTime := func() time.Duration {
 		return 0
 	}
-	err = tc.ProcessBlockTransaction(&block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash")}}}, body, noTime)
+	err = tc.ProcessBlockTransaction(context.Background(), &block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash")}}}, body, noTime)
 	assert.Equal(t, process.ErrHigherNonceInTransaction, err)
 
 	txHashToAsk := []byte("tx_hashnotinPool")
 	miniBlock = &block.MiniBlock{SenderShardID: 0, ReceiverShardID: 0, Type: block.TxBlock, TxHashes: [][]byte{txHashToAsk}}
 	body.MiniBlocks = append(body.MiniBlocks, miniBlock)
-	err = tc.ProcessBlockTransaction(&block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash")}}}, body, haveTime)
+	err = tc.ProcessBlockTransaction(context.Background(), &block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash")}}}, body, haveTime)
 	assert.Equal(t, process.ErrHigherNonceInTransaction, err)
 }
 
@@ -1744,7 +1764,7 @@ func TestTransactionCoordinator_ProcessBlockTransaction(t *testing.T) {
 	haveTime := func() time.Duration {
 		return time.Second
 	}
-	err = tc.ProcessBlockTransaction(&block.Header{}, &block.Body{}, haveTime)
+	err = tc.ProcessBlockTransaction(context.Background(), &block.Header{}, &block.Body{}, haveTime)
 	assert.Nil(t, err)
 
 	body := &block.Body{}
@@ -1752,19 +1772,19 @@ func TestTransactionCoordinator_ProcessBlockTransaction(t *testing.T) {
 	body.MiniBlocks = append(body.MiniBlocks, miniBlock)
 
 	tc.RequestBlockTransactions(body)
-	err = tc.ProcessBlockTransaction(&block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash")}}}, body, haveTime)
+	err = tc.ProcessBlockTransaction(context.Background(), &block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash")}}}, body, haveTime)
 	assert.Nil(t, err)
 
 	noTime := func() time.Duration {
 		return -1
 	}
-	err = tc.ProcessBlockTransaction(&block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash")}}}, body, noTime)
+	err = tc.ProcessBlockTransaction(context.Background(), &block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash")}}}, body, noTime)
 	assert.Equal(t, process.ErrTimeIsOut, err)
 
 	txHashToAsk := []byte("tx_hashnotinPool")
 	miniBlock = &block.MiniBlock{SenderShardID: 0, ReceiverShardID: 0, Type: block.TxBlock, TxHashes: [][]byte{txHashToAsk}}
 	body.MiniBlocks = append(body.MiniBlocks, miniBlock)
-	err = tc.ProcessBlockTransaction(&block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash")}}}, body, haveTime)
+	err = tc.ProcessBlockTransaction(context.Background(), &block.Header{MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mbHash")}}}, body, haveTime)
 	assert.Equal(t, process.ErrMissingTransaction, err)
 }
 
@@ -1811,6 +1831,7 @@ func TestTransactionCoordinator_RequestMiniblocks(t *testing.T) {
 		2,
 		2,
 		2,
+		2,
 		&testscommon.TxTypeHandlerMock{},
 		&testscommon.ScheduledTxsExecutionStub{},
 	)
@@ -1952,6 +1973,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithOkTxsShouldExecuteThemAndNot
 		2,
 		2,
 		2,
+		2,
 		&testscommon.TxTypeHandlerMock{},
 		&testscommon.ScheduledTxsExecutionStub{},
 	)
@@ -2090,6 +2112,7 @@ func TestShardProcessor_ProcessMiniBlockCompleteWithErrorWhileProcessShouldCallR
 		2,
 		2,
 		2,
+		2,
 		&testscommon.TxTypeHandlerMock{},
 		&testscommon.ScheduledTxsExecutionStub{},
 	)
@@ -2527,6 +2550,7 @@ func TestTransactionCoordinator_VerifyCreatedMiniBlocksShouldReturnWhenEpochIsNo
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -2575,6 +2599,7 @@ func TestTransactionCoordinator_VerifyCreatedMiniBlocksShouldErrMaxGasLimitPerMi
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
@@ -2647,6 +2672,7 @@ func TestTransactionCoordinator_VerifyCreatedMiniBlocksShouldErrMaxAccumulatedFe
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
@@ -2724,6 +2750,7 @@ func TestTransactionCoordinator_VerifyCreatedMiniBlocksShouldErrMaxDeveloperFees
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
@@ -2801,6 +2828,7 @@ func TestTransactionCoordinator_VerifyCreatedMiniBlocksShouldWork(t *testing.T)
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
@@ -2861,6 +2889,7 @@ func TestTransactionCoordinator_GetAllTransactionsShouldWork(t *testing.T) {
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -2944,6 +2973,7 @@ func TestTransactionCoordinator_VerifyGasLimitShouldErrMaxGasLimitPerMiniBlockIn
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -3037,6 +3067,7 @@ func TestTransactionCoordinator_VerifyGasLimitShouldWork(t *testing.T) {
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -3116,6 +3147,7 @@ func TestTransactionCoordinator_CheckGasProvidedByMiniBlockInReceiverShardShould
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -3166,6 +3198,7 @@ func TestTransactionCoordinator_CheckGasProvidedByMiniBlockInReceiverShardShould
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -3223,6 +3256,7 @@ func TestTransactionCoordinator_CheckGasProvidedByMiniBlockInReceiverShardShould
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -3287,6 +3321,7 @@ func TestTransactionCoordinator_CheckGasProvidedByMiniBlockInReceiverShardShould
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -3354,6 +3389,7 @@ func TestTransactionCoordinator_CheckGasProvidedByMiniBlockInReceiverShardShould
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
@@ -3408,6 +3444,7 @@ func TestTransactionCoordinator_VerifyFeesShouldErrMissingTransaction(t *testing
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
@@ -3467,6 +3504,7 @@ func TestTransactionCoordinator_VerifyFeesShouldErrMaxAccumulatedFeesExceeded(t
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
@@ -3536,6 +3574,7 @@ func TestTransactionCoordinator_VerifyFeesShouldErrMaxDeveloperFeesExceeded(t *t
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -3611,6 +3650,7 @@ func TestTransactionCoordinator_VerifyFeesShouldErrMaxAccumulatedFeesExceededWhe
 		},
 		ScheduledMiniBlocksEnableEpoch: 2,
 		DoubleTransactionsDetector:     &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                         tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -3694,6 +3734,7 @@ func TestTransactionCoordinator_VerifyFeesShouldErrMaxDeveloperFeesExceededWhenS
 		},
 		ScheduledMiniBlocksEnableEpoch: 2,
 		DoubleTransactionsDetector:     &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                         tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -3777,6 +3818,7 @@ func TestTransactionCoordinator_VerifyFeesShouldWork(t *testing.T) {
 		},
 		ScheduledMiniBlocksEnableEpoch: 2,
 		DoubleTransactionsDetector:     &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                         tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -3853,6 +3895,7 @@ func TestTransactionCoordinator_GetMaxAccumulatedAndDeveloperFeesShouldErr(t *te
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -3905,6 +3948,7 @@ func TestTransactionCoordinator_GetMaxAccumulatedAndDeveloperFeesShouldWork(t *t
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 	tc, err := NewTransactionCoordinator(txCoordinatorArgs)
 	assert.Nil(t, err)
@@ -3971,6 +4015,7 @@ func TestTransactionCoordinator_RevertIfNeededShouldWork(t *testing.T) {
 		ScheduledTxsExecutionHandler:      &testscommon.ScheduledTxsExecutionStub{},
 		ScheduledMiniBlocksEnableEpoch:    2,
 		DoubleTransactionsDetector:        &testscommon.PanicDoubleTransactionsDetector{},
+		Tracer:                            tracing.NewDisabledTracer(),
 	}
 
 	txHashes := make([][]byte, 0)
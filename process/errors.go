@@ -1085,3 +1085,37 @@ var ErrMetaHeaderEpochOutOfRange = errors.New("epoch out of range for meta block
 
 // ErrNilESDTGlobalSettingsHandler signals that nil global settings handler was provided
 var ErrNilESDTGlobalSettingsHandler = errors.New("nil esdt global settings handler")
+
+// ErrPeerNotBlacklisted signals that the provided peer is not blacklisted
+var ErrPeerNotBlacklisted = errors.New("peer is not blacklisted")
+
+// ErrAntifloodIsDisabled signals that the antiflood mechanism is disabled
+var ErrAntifloodIsDisabled = errors.New("antiflood is disabled")
+
+// ErrNilLoadSource signals that a nil load source has been provided
+var ErrNilLoadSource = errors.New("nil load source")
+
+// ErrEmptyLoadSources signals that no load source has been provided
+var ErrEmptyLoadSources = errors.New("empty load sources")
+
+// ErrNilCpuStatisticsHandler signals that a nil CPU statistics handler has been provided
+var ErrNilCpuStatisticsHandler = errors.New("nil CPU statistics handler")
+
+// ErrInvalidRoundsPerEpoch signals that an invalid number of rounds per epoch has been provided
+var ErrInvalidRoundsPerEpoch = errors.New("invalid number of rounds per epoch")
+
+// ErrShufflingPreviewNotAvailable signals that the shuffling preview can only be computed by the metachain nodes that
+// hold the validators statistics
+var ErrShufflingPreviewNotAvailable = errors.New("the shuffling preview is available only on metachain nodes")
+
+// ErrNilValidatorHistoryHandler signals that a nil validator history handler has been provided
+var ErrNilValidatorHistoryHandler = errors.New("nil validator history handler")
+
+// ErrNilGasProfiler signals that a nil gas profiler has been provided
+var ErrNilGasProfiler = errors.New("nil gas profiler")
+
+// ErrNilMetricsRegistry signals that a nil metrics registry has been provided
+var ErrNilMetricsRegistry = errors.New("nil metrics registry")
+
+// ErrNilTracer signals that a nil tracer has been provided
+var ErrNilTracer = errors.New("nil tracer")
@@ -33,4 +33,5 @@ type CommonInterceptorsContainerFactoryArgs struct {
 	SizeCheckDelta            uint32
 	EnableSignTxWithHashEpoch uint32
 	RequestHandler            process.RequestHandler
+	GlobalThrottler           process.InterceptorThrottler
 }
@@ -19,7 +19,6 @@ import (
 	"github.com/ElrondNetwork/elrond-go/state"
 )
 
-const numGoRoutines = 100
 const chunksProcessorRequestInterval = time.Millisecond * 400
 
 type baseInterceptorsContainerFactory struct {
@@ -40,6 +39,7 @@ type baseInterceptorsContainerFactory struct {
 	preferredPeersHolder   process.PreferredPeersHolderHandler
 	hasher                 hashing.Hasher
 	requestHandler         process.RequestHandler
+	metricsRegistry        common.MetricsRegistry
 }
 
 func checkBaseParams(
@@ -57,6 +57,7 @@ func checkBaseParams(
 	whiteListerVerifiedTxs process.WhiteListHandler,
 	preferredPeersHolder process.PreferredPeersHolderHandler,
 	requestHandler process.RequestHandler,
+	globalThrottler process.InterceptorThrottler,
 ) error {
 	if check.IfNil(coreComponents) {
 		return process.ErrNilCoreComponentsHolder
@@ -88,6 +89,9 @@ func checkBaseParams(
 	if check.IfNil(coreComponents.EpochNotifier()) {
 		return process.ErrNilEpochNotifier
 	}
+	if check.IfNil(coreComponents.MetricsRegistry()) {
+		return process.ErrNilMetricsRegistry
+	}
 	if len(coreComponents.ChainID()) == 0 {
 		return process.ErrInvalidChainID
 	}
@@ -139,6 +143,9 @@ func checkBaseParams(
 	if check.IfNil(requestHandler) {
 		return process.ErrNilRequestHandler
 	}
+	if check.IfNil(globalThrottler) {
+		return process.ErrNilInterceptorThrottler
+	}
 
 	return nil
 }
@@ -240,6 +247,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneTxInterceptor(topic strin
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
+			MetricsRegistry:      bicf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -283,6 +291,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneUnsignedTxInterceptor(top
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
+			MetricsRegistry:      bicf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -326,6 +335,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneRewardTxInterceptor(topic
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
+			MetricsRegistry:      bicf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -368,6 +378,7 @@ func (bicf *baseInterceptorsContainerFactory) generateHeaderInterceptors() error
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
+			MetricsRegistry:      bicf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -456,6 +467,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneMiniBlocksInterceptor(top
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
+			MetricsRegistry:      bicf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -495,6 +507,7 @@ func (bicf *baseInterceptorsContainerFactory) generateMetachainHeaderInterceptor
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
+			MetricsRegistry:      bicf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -532,6 +545,7 @@ func (bicf *baseInterceptorsContainerFactory) createOneTrieNodesInterceptor(topi
 			WhiteListRequest:     bicf.whiteListHandler,
 			CurrentPeerId:        bicf.messenger.ID(),
 			PreferredPeersHolder: bicf.preferredPeersHolder,
+			MetricsRegistry:      bicf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -3,7 +3,6 @@ package interceptorscontainer
 import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
-	"github.com/ElrondNetwork/elrond-go-core/core/throttler"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
@@ -39,6 +38,7 @@ func NewMetaInterceptorsContainerFactory(
 		args.WhiteListerVerifiedTxs,
 		args.PreferredPeersHolder,
 		args.RequestHandler,
+		args.GlobalThrottler,
 	)
 	if err != nil {
 		return nil, err
@@ -103,17 +103,14 @@ func NewMetaInterceptorsContainerFactory(
 		preferredPeersHolder:   args.PreferredPeersHolder,
 		hasher:                 args.CoreComponents.Hasher(),
 		requestHandler:         args.RequestHandler,
+		globalThrottler:        args.GlobalThrottler,
+		metricsRegistry:        args.CoreComponents.MetricsRegistry(),
 	}
 
 	icf := &metaInterceptorsContainerFactory{
 		baseInterceptorsContainerFactory: base,
 	}
 
-	icf.globalThrottler, err = throttler.NewNumGoRoutinesThrottler(numGoRoutines)
-	if err != nil {
-		return nil, err
-	}
-
 	return icf, nil
 }
 
@@ -230,6 +227,7 @@ func (micf *metaInterceptorsContainerFactory) createOneShardHeaderInterceptor(to
 			WhiteListRequest:     micf.whiteListHandler,
 			CurrentPeerId:        micf.messenger.ID(),
 			PreferredPeersHolder: micf.preferredPeersHolder,
+			MetricsRegistry:      micf.metricsRegistry,
 		},
 	)
 	if err != nil {
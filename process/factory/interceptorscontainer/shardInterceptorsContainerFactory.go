@@ -3,7 +3,6 @@ package interceptorscontainer
 import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
-	"github.com/ElrondNetwork/elrond-go-core/core/throttler"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/factory"
@@ -37,6 +36,7 @@ func NewShardInterceptorsContainerFactory(
 		args.WhiteListerVerifiedTxs,
 		args.PreferredPeersHolder,
 		args.RequestHandler,
+		args.GlobalThrottler,
 	)
 	if err != nil {
 		return nil, err
@@ -102,17 +102,14 @@ func NewShardInterceptorsContainerFactory(
 		preferredPeersHolder:   args.PreferredPeersHolder,
 		hasher:                 args.CoreComponents.Hasher(),
 		requestHandler:         args.RequestHandler,
+		globalThrottler:        args.GlobalThrottler,
+		metricsRegistry:        args.CoreComponents.MetricsRegistry(),
 	}
 
 	icf := &shardInterceptorsContainerFactory{
 		baseInterceptorsContainerFactory: base,
 	}
 
-	icf.globalThrottler, err = throttler.NewNumGoRoutinesThrottler(numGoRoutines)
-	if err != nil {
-		return nil, err
-	}
-
 	return icf, nil
 }
 
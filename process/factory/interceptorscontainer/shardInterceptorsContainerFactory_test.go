@@ -375,6 +375,18 @@ func TestNewShardInterceptorsContainerFactory_EmptyEpochStartTriggerShouldErr(t
 	assert.Equal(t, process.ErrNilEpochStartTrigger, err)
 }
 
+func TestNewShardInterceptorsContainerFactory_NilGlobalThrottlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	coreComp, cryptoComp := createMockComponentHolders()
+	args := getArgumentsShard(coreComp, cryptoComp)
+	args.GlobalThrottler = nil
+	icf, err := interceptorscontainer.NewShardInterceptorsContainerFactory(args)
+
+	assert.Nil(t, icf)
+	assert.Equal(t, process.ErrNilInterceptorThrottler, err)
+}
+
 func TestNewShardInterceptorsContainerFactory_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -615,8 +627,9 @@ func createMockComponentHolders() (*mock.CoreComponentsMock, *mock.CryptoCompone
 		MinTransactionVersionCalled: func() uint32 {
 			return 1
 		},
-		EpochNotifierField:  &epochNotifier.EpochNotifierStub{},
-		TxVersionCheckField: versioning.NewTxVersionChecker(1),
+		EpochNotifierField:   &epochNotifier.EpochNotifierStub{},
+		TxVersionCheckField:  versioning.NewTxVersionChecker(1),
+		MetricsRegistryField: &testscommon.MetricsRegistryStub{},
 	}
 	cryptoComponents := &mock.CryptoComponentsMock{
 		BlockSig: &mock.SignerMock{},
@@ -656,5 +669,6 @@ func getArgumentsShard(
 		ArgumentsParser:         &mock.ArgumentParserMock{},
 		PreferredPeersHolder:    &p2pmocks.PeersHolderStub{},
 		RequestHandler:          &testscommon.RequestHandlerStub{},
+		GlobalThrottler:         &mock.InterceptorThrottlerStub{},
 	}
 }
@@ -38,6 +38,7 @@ type preProcessorsContainerFactory struct {
 	optimizeGasUsedInCrossMiniBlocksEnableEpoch uint32
 	frontRunningProtectionEnableEpoch           uint32
 	scheduledMiniBlocksEnableEpoch              uint32
+	parallelTransactionsExecutionEnableEpoch    uint32
 	txTypeHandler                               process.TxTypeHandler
 	scheduledTxsExecutionHandler                process.ScheduledTxsExecutionHandler
 }
@@ -65,6 +66,7 @@ func NewPreProcessorsContainerFactory(
 	optimizeGasUsedInCrossMiniBlocksEnableEpoch uint32,
 	frontRunningProtectionEnableEpoch uint32,
 	scheduledMiniBlocksEnableEpoch uint32,
+	parallelTransactionsExecutionEnableEpoch uint32,
 	txTypeHandler process.TxTypeHandler,
 	scheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler,
 ) (*preProcessorsContainerFactory, error) {
@@ -152,6 +154,7 @@ func NewPreProcessorsContainerFactory(
 		optimizeGasUsedInCrossMiniBlocksEnableEpoch: optimizeGasUsedInCrossMiniBlocksEnableEpoch,
 		frontRunningProtectionEnableEpoch:           frontRunningProtectionEnableEpoch,
 		scheduledMiniBlocksEnableEpoch:              scheduledMiniBlocksEnableEpoch,
+		parallelTransactionsExecutionEnableEpoch:    parallelTransactionsExecutionEnableEpoch,
 		txTypeHandler:                               txTypeHandler,
 		scheduledTxsExecutionHandler:                scheduledTxsExecutionHandler,
 	}, nil
@@ -225,6 +228,7 @@ func (ppcm *preProcessorsContainerFactory) createTxPreProcessor() (process.PrePr
 		OptimizeGasUsedInCrossMiniBlocksEnableEpoch: ppcm.optimizeGasUsedInCrossMiniBlocksEnableEpoch,
 		FrontRunningProtectionEnableEpoch:           ppcm.frontRunningProtectionEnableEpoch,
 		ScheduledMiniBlocksEnableEpoch:              ppcm.scheduledMiniBlocksEnableEpoch,
+		ParallelTransactionsExecutionEnableEpoch:    ppcm.parallelTransactionsExecutionEnableEpoch,
 		TxTypeHandler:                               ppcm.txTypeHandler,
 		ScheduledTxsExecutionHandler:                ppcm.scheduledTxsExecutionHandler,
 	}
@@ -0,0 +1,29 @@
+package gasProfiler
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+type disabledGasProfiler struct {
+}
+
+// NewDisabledGasProfiler returns a disabled instance of the gas profiler
+func NewDisabledGasProfiler() *disabledGasProfiler {
+	return &disabledGasProfiler{}
+}
+
+// RecordContractExecution does nothing
+func (dgp *disabledGasProfiler) RecordContractExecution(_ []byte, _ string, _ uint64, _ bool) {
+}
+
+// RecordBuiltInFunctionExecution does nothing
+func (dgp *disabledGasProfiler) RecordBuiltInFunctionExecution(_ string, _ uint64, _ bool) {
+}
+
+// GetGasProfile returns the disabled error
+func (dgp *disabledGasProfiler) GetGasProfile() (*common.GasProfile, error) {
+	return nil, ErrGasProfilerDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dgp *disabledGasProfiler) IsInterfaceNil() bool {
+	return dgp == nil
+}
@@ -0,0 +1,9 @@
+package gasProfiler
+
+import "errors"
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrGasProfilerDisabled signals that the gas profiler is disabled
+var ErrGasProfilerDisabled = errors.New("gas profiler is disabled")
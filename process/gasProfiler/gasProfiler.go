@@ -0,0 +1,265 @@
+package gasProfiler
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
+)
+
+var log = logger.GetOrCreate("process/gasProfiler")
+
+// ArgsGasProfiler is the DTO used to create a new gas profiler
+type ArgsGasProfiler struct {
+	PubkeyConverter     core.PubkeyConverter
+	WindowInSeconds     uint32
+	NumBuckets          uint32
+	MaxEntriesPerBucket int
+	MetricsRegistry     common.MetricsRegistry
+}
+
+type profileKey struct {
+	contract string
+	function string
+}
+
+type profileStats struct {
+	numCalls  uint64
+	numFailed uint64
+	gasUsed   uint64
+}
+
+type bucket struct {
+	index            int64
+	contracts        map[profileKey]*profileStats
+	builtInFunctions map[profileKey]*profileStats
+}
+
+type gasProfiler struct {
+	pubkeyConverter     core.PubkeyConverter
+	windowInSeconds     uint32
+	bucketDuration      time.Duration
+	maxEntriesPerBucket int
+	metricsRegistry     common.MetricsRegistry
+	mut                 sync.Mutex
+	buckets             []*bucket
+	getTimeHandler      func() time.Time
+}
+
+// NewGasProfiler creates a new gas profiler that aggregates the executions over a sliding window made of time buckets
+func NewGasProfiler(args ArgsGasProfiler) (*gasProfiler, error) {
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.MetricsRegistry) {
+		return nil, process.ErrNilMetricsRegistry
+	}
+	if args.NumBuckets == 0 {
+		return nil, fmt.Errorf("%w for NumBuckets", ErrInvalidValue)
+	}
+	if args.WindowInSeconds < args.NumBuckets {
+		return nil, fmt.Errorf("%w for WindowInSeconds, it should be at least NumBuckets", ErrInvalidValue)
+	}
+	if args.MaxEntriesPerBucket < 1 {
+		return nil, fmt.Errorf("%w for MaxEntriesPerBucket", ErrInvalidValue)
+	}
+
+	return &gasProfiler{
+		pubkeyConverter:     args.PubkeyConverter,
+		windowInSeconds:     args.WindowInSeconds,
+		bucketDuration:      time.Duration(args.WindowInSeconds/args.NumBuckets) * time.Second,
+		maxEntriesPerBucket: args.MaxEntriesPerBucket,
+		metricsRegistry:     args.MetricsRegistry,
+		buckets:             make([]*bucket, args.NumBuckets),
+		getTimeHandler:      time.Now,
+	}, nil
+}
+
+// RecordContractExecution records the gas used by a smart contract function execution
+func (gp *gasProfiler) RecordContractExecution(contract []byte, function string, gasUsed uint64, failed bool) {
+	gp.incrementPrometheusCounters(prometheus.GasKindContract, "", gasUsed, failed)
+
+	key := profileKey{
+		contract: string(contract),
+		function: function,
+	}
+
+	gp.mut.Lock()
+	defer gp.mut.Unlock()
+
+	currentBucket := gp.getCurrentBucket()
+	stats, found := currentBucket.contracts[key]
+	if !found {
+		if len(currentBucket.contracts) >= gp.maxEntriesPerBucket {
+			log.Trace("gasProfiler.RecordContractExecution: max entries reached", "function", function)
+			return
+		}
+
+		stats = &profileStats{}
+		currentBucket.contracts[key] = stats
+	}
+
+	stats.add(gasUsed, failed)
+}
+
+// RecordBuiltInFunctionExecution records the gas used by a built-in function execution
+func (gp *gasProfiler) RecordBuiltInFunctionExecution(function string, gasUsed uint64, failed bool) {
+	gp.incrementPrometheusCounters(prometheus.GasKindBuiltInFunction, function, gasUsed, failed)
+
+	key := profileKey{
+		function: function,
+	}
+
+	gp.mut.Lock()
+	defer gp.mut.Unlock()
+
+	currentBucket := gp.getCurrentBucket()
+	stats, found := currentBucket.builtInFunctions[key]
+	if !found {
+		if len(currentBucket.builtInFunctions) >= gp.maxEntriesPerBucket {
+			log.Trace("gasProfiler.RecordBuiltInFunctionExecution: max entries reached", "function", function)
+			return
+		}
+
+		stats = &profileStats{}
+		currentBucket.builtInFunctions[key] = stats
+	}
+
+	stats.add(gasUsed, failed)
+}
+
+func (gp *gasProfiler) incrementPrometheusCounters(kind string, function string, gasUsed uint64, failed bool) {
+	result := prometheus.ExecutionResultSuccess
+	if failed {
+		result = prometheus.ExecutionResultFailed
+	}
+
+	gp.metricsRegistry.AddToCounter(prometheus.GasProfilerGasUsed, gasUsed, kind, function)
+	gp.metricsRegistry.IncrementCounter(prometheus.GasProfilerCalls, kind, function, result)
+}
+
+// getCurrentBucket returns the bucket of the current time, resetting it if it still holds the data of an old window.
+// Should be called under mutex protection
+func (gp *gasProfiler) getCurrentBucket() *bucket {
+	index := gp.computeBucketIndex()
+	position := int(index % int64(len(gp.buckets)))
+
+	currentBucket := gp.buckets[position]
+	if currentBucket != nil && currentBucket.index == index {
+		return currentBucket
+	}
+
+	currentBucket = &bucket{
+		index:            index,
+		contracts:        make(map[profileKey]*profileStats),
+		builtInFunctions: make(map[profileKey]*profileStats),
+	}
+	gp.buckets[position] = currentBucket
+
+	return currentBucket
+}
+
+func (gp *gasProfiler) computeBucketIndex() int64 {
+	return gp.getTimeHandler().UnixNano() / int64(gp.bucketDuration)
+}
+
+// GetGasProfile returns the gas consumption aggregated over the buckets of the current window
+func (gp *gasProfiler) GetGasProfile() (*common.GasProfile, error) {
+	contracts := make(map[profileKey]*profileStats)
+	builtInFunctions := make(map[profileKey]*profileStats)
+
+	gp.mut.Lock()
+	oldestIndex := gp.computeBucketIndex() - int64(len(gp.buckets)) + 1
+	for _, b := range gp.buckets {
+		if b == nil || b.index < oldestIndex {
+			continue
+		}
+
+		for key, stats := range b.contracts {
+			aggregateStats(contracts, key, stats)
+		}
+		for key, stats := range b.builtInFunctions {
+			aggregateStats(builtInFunctions, key, stats)
+		}
+	}
+	gp.mut.Unlock()
+
+	profile := &common.GasProfile{
+		WindowInSeconds:  gp.windowInSeconds,
+		Contracts:        make([]*common.GasProfileEntry, 0, len(contracts)),
+		BuiltInFunctions: make([]*common.GasProfileEntry, 0, len(builtInFunctions)),
+	}
+	for key, stats := range contracts {
+		entry := stats.toEntry(key.function)
+		entry.Contract = gp.pubkeyConverter.Encode([]byte(key.contract))
+		profile.Contracts = append(profile.Contracts, entry)
+	}
+	for key, stats := range builtInFunctions {
+		profile.BuiltInFunctions = append(profile.BuiltInFunctions, stats.toEntry(key.function))
+	}
+
+	sortEntries(profile.Contracts)
+	sortEntries(profile.BuiltInFunctions)
+
+	return profile, nil
+}
+
+func aggregateStats(aggregated map[profileKey]*profileStats, key profileKey, stats *profileStats) {
+	aggregatedStats, found := aggregated[key]
+	if !found {
+		aggregatedStats = &profileStats{}
+		aggregated[key] = aggregatedStats
+	}
+
+	aggregatedStats.numCalls += stats.numCalls
+	aggregatedStats.numFailed += stats.numFailed
+	aggregatedStats.gasUsed += stats.gasUsed
+}
+
+func (ps *profileStats) add(gasUsed uint64, failed bool) {
+	ps.numCalls++
+	ps.gasUsed += gasUsed
+	if failed {
+		ps.numFailed++
+	}
+}
+
+func (ps *profileStats) toEntry(function string) *common.GasProfileEntry {
+	entry := &common.GasProfileEntry{
+		Function:  function,
+		NumCalls:  ps.numCalls,
+		NumFailed: ps.numFailed,
+		GasUsed:   ps.gasUsed,
+	}
+	if ps.numCalls > 0 {
+		entry.FailureRate = float64(ps.numFailed) / float64(ps.numCalls)
+		entry.AverageGasUsed = ps.gasUsed / ps.numCalls
+	}
+
+	return entry
+}
+
+func sortEntries(entries []*common.GasProfileEntry) {
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].GasUsed != entries[j].GasUsed {
+			return entries[i].GasUsed > entries[j].GasUsed
+		}
+		if entries[i].Contract != entries[j].Contract {
+			return entries[i].Contract < entries[j].Contract
+		}
+
+		return entries[i].Function < entries[j].Function
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gp *gasProfiler) IsInterfaceNil() bool {
+	return gp == nil
+}
@@ -0,0 +1,217 @@
+package gasProfiler
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsGasProfiler() ArgsGasProfiler {
+	return ArgsGasProfiler{
+		PubkeyConverter:     testscommon.NewPubkeyConverterMock(3),
+		WindowInSeconds:     60,
+		NumBuckets:          6,
+		MaxEntriesPerBucket: 100,
+		MetricsRegistry:     &testscommon.MetricsRegistryStub{},
+	}
+}
+
+func createGasProfilerWithTime(t *testing.T, args ArgsGasProfiler, currentTime *time.Time) *gasProfiler {
+	gp, err := NewGasProfiler(args)
+	require.Nil(t, err)
+	gp.getTimeHandler = func() time.Time {
+		return *currentTime
+	}
+
+	return gp
+}
+
+func TestNewGasProfiler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasProfiler()
+		args.PubkeyConverter = nil
+
+		gp, err := NewGasProfiler(args)
+		assert.Equal(t, process.ErrNilPubkeyConverter, err)
+		assert.True(t, check.IfNil(gp))
+	})
+	t.Run("nil metrics registry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasProfiler()
+		args.MetricsRegistry = nil
+
+		gp, err := NewGasProfiler(args)
+		assert.Equal(t, process.ErrNilMetricsRegistry, err)
+		assert.True(t, check.IfNil(gp))
+	})
+	t.Run("zero buckets should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasProfiler()
+		args.NumBuckets = 0
+
+		gp, err := NewGasProfiler(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, check.IfNil(gp))
+	})
+	t.Run("window smaller than the number of buckets should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasProfiler()
+		args.WindowInSeconds = args.NumBuckets - 1
+
+		gp, err := NewGasProfiler(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, check.IfNil(gp))
+	})
+	t.Run("invalid max entries should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasProfiler()
+		args.MaxEntriesPerBucket = 0
+
+		gp, err := NewGasProfiler(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, check.IfNil(gp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		gp, err := NewGasProfiler(createMockArgsGasProfiler())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(gp))
+	})
+}
+
+func TestGasProfiler_GetGasProfileShouldAggregateAndSort(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	gp := createGasProfilerWithTime(t, createMockArgsGasProfiler(), &currentTime)
+
+	gp.RecordContractExecution([]byte("sc1"), "getPrice", 100, false)
+	gp.RecordContractExecution([]byte("sc1"), "getPrice", 300, true)
+	gp.RecordContractExecution([]byte("sc2"), "swap", 1000, false)
+	gp.RecordBuiltInFunctionExecution("ESDTTransfer", 50, false)
+	currentTime = currentTime.Add(15 * time.Second)
+	gp.RecordBuiltInFunctionExecution("ESDTTransfer", 50, true)
+	gp.RecordBuiltInFunctionExecution("ESDTNFTTransfer", 10, false)
+
+	profile, err := gp.GetGasProfile()
+	require.Nil(t, err)
+	assert.Equal(t, &common.GasProfile{
+		WindowInSeconds: 60,
+		Contracts: []*common.GasProfileEntry{
+			{Contract: "736332", Function: "swap", NumCalls: 1, GasUsed: 1000, AverageGasUsed: 1000},
+			{Contract: "736331", Function: "getPrice", NumCalls: 2, NumFailed: 1, FailureRate: 0.5, GasUsed: 400, AverageGasUsed: 200},
+		},
+		BuiltInFunctions: []*common.GasProfileEntry{
+			{Function: "ESDTTransfer", NumCalls: 2, NumFailed: 1, FailureRate: 0.5, GasUsed: 100, AverageGasUsed: 50},
+			{Function: "ESDTNFTTransfer", NumCalls: 1, GasUsed: 10, AverageGasUsed: 10},
+		},
+	}, profile)
+}
+
+func TestGasProfiler_GetGasProfileShouldIgnoreTheBucketsOutsideTheWindow(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	gp := createGasProfilerWithTime(t, createMockArgsGasProfiler(), &currentTime)
+
+	gp.RecordContractExecution([]byte("sc1"), "getPrice", 100, false)
+	currentTime = currentTime.Add(30 * time.Second)
+	gp.RecordContractExecution([]byte("sc1"), "getPrice", 200, false)
+
+	profile, _ := gp.GetGasProfile()
+	require.Equal(t, 1, len(profile.Contracts))
+	assert.Equal(t, uint64(300), profile.Contracts[0].GasUsed)
+
+	// the first bucket went out of the window
+	currentTime = currentTime.Add(40 * time.Second)
+	profile, _ = gp.GetGasProfile()
+	require.Equal(t, 1, len(profile.Contracts))
+	assert.Equal(t, uint64(200), profile.Contracts[0].GasUsed)
+
+	// the reused bucket should not hold the old data
+	gp.RecordContractExecution([]byte("sc1"), "getPrice", 5, false)
+	currentTime = currentTime.Add(60 * time.Second)
+	gp.RecordContractExecution([]byte("sc2"), "swap", 7, false)
+	profile, _ = gp.GetGasProfile()
+	require.Equal(t, 1, len(profile.Contracts))
+	assert.Equal(t, uint64(7), profile.Contracts[0].GasUsed)
+}
+
+func TestGasProfiler_RecordShouldIgnoreTheNewEntriesWhenTheBucketIsFull(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	args := createMockArgsGasProfiler()
+	args.MaxEntriesPerBucket = 1
+	gp := createGasProfilerWithTime(t, args, &currentTime)
+
+	gp.RecordContractExecution([]byte("sc1"), "getPrice", 100, false)
+	gp.RecordContractExecution([]byte("sc2"), "swap", 100, false)
+	gp.RecordContractExecution([]byte("sc1"), "getPrice", 100, false)
+	gp.RecordBuiltInFunctionExecution("ESDTTransfer", 10, false)
+	gp.RecordBuiltInFunctionExecution("ESDTNFTTransfer", 10, false)
+
+	profile, _ := gp.GetGasProfile()
+	require.Equal(t, 1, len(profile.Contracts))
+	assert.Equal(t, uint64(2), profile.Contracts[0].NumCalls)
+	require.Equal(t, 1, len(profile.BuiltInFunctions))
+	assert.Equal(t, "ESDTTransfer", profile.BuiltInFunctions[0].Function)
+}
+
+func TestGasProfiler_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	gp, _ := NewGasProfiler(createMockArgsGasProfiler())
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			switch idx % 3 {
+			case 0:
+				gp.RecordContractExecution([]byte("sc1"), fmt.Sprintf("function%d", idx), 10, false)
+			case 1:
+				gp.RecordBuiltInFunctionExecution("ESDTTransfer", 10, true)
+			default:
+				_, _ = gp.GetGasProfile()
+			}
+
+			wg.Done()
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+func TestDisabledGasProfiler(t *testing.T) {
+	t.Parallel()
+
+	dgp := NewDisabledGasProfiler()
+	assert.False(t, check.IfNil(dgp))
+
+	dgp.RecordContractExecution([]byte("sc1"), "getPrice", 100, false)
+	dgp.RecordBuiltInFunctionExecution("ESDTTransfer", 10, false)
+
+	profile, err := dgp.GetGasProfile()
+	assert.Nil(t, profile)
+	assert.Equal(t, ErrGasProfilerDisabled, err)
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
 )
 
 type baseDataInterceptor struct {
@@ -19,6 +21,7 @@ type baseDataInterceptor struct {
 	mutDebugHandler      sync.RWMutex
 	debugHandler         process.InterceptedDebugger
 	preferredPeersHolder process.PreferredPeersHolderHandler
+	metricsRegistry      common.MetricsRegistry
 }
 
 func (bdi *baseDataInterceptor) preProcessMesage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
@@ -28,6 +31,7 @@ func (bdi *baseDataInterceptor) preProcessMesage(message p2p.MessageP2P, fromCon
 	if message.Data() == nil {
 		return process.ErrNilDataToProcess
 	}
+	bdi.metricsRegistry.IncrementCounter(prometheus.InterceptedMessages, bdi.topic)
 
 	if !bdi.shouldSkipAntifloodChecks(fromConnectedPeer, message) {
 		err := bdi.antifloodHandler.CanProcessMessage(message, fromConnectedPeer)
@@ -26,6 +26,7 @@ func newBaseDataInterceptorForPreProcess(
 		throttler:            throttler,
 		antifloodHandler:     antifloodHandler,
 		preferredPeersHolder: preferredPeersHolder,
+		metricsRegistry:      &testscommon.MetricsRegistryStub{},
 	}
 }
 
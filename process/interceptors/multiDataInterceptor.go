@@ -27,6 +27,7 @@ type ArgMultiDataInterceptor struct {
 	AntifloodHandler     process.P2PAntifloodHandler
 	WhiteListRequest     process.WhiteListHandler
 	PreferredPeersHolder process.PreferredPeersHolderHandler
+	MetricsRegistry      common.MetricsRegistry
 	CurrentPeerId        core.PeerID
 }
 
@@ -66,6 +67,9 @@ func NewMultiDataInterceptor(arg ArgMultiDataInterceptor) (*MultiDataInterceptor
 	if check.IfNil(arg.PreferredPeersHolder) {
 		return nil, process.ErrNilPreferredPeersHolder
 	}
+	if check.IfNil(arg.MetricsRegistry) {
+		return nil, process.ErrNilMetricsRegistry
+	}
 	if len(arg.CurrentPeerId) == 0 {
 		return nil, process.ErrEmptyPeerID
 	}
@@ -78,6 +82,7 @@ func NewMultiDataInterceptor(arg ArgMultiDataInterceptor) (*MultiDataInterceptor
 			currentPeerId:        arg.CurrentPeerId,
 			processor:            arg.Processor,
 			preferredPeersHolder: arg.PreferredPeersHolder,
+			metricsRegistry:      arg.MetricsRegistry,
 			debugHandler:         resolver.NewDisabledInterceptorResolver(),
 		},
 		marshalizer:      arg.Marshalizer,
@@ -31,6 +31,7 @@ func createMockArgMultiDataInterceptor() interceptors.ArgMultiDataInterceptor {
 		AntifloodHandler:     &mock.P2PAntifloodHandlerStub{},
 		WhiteListRequest:     &testscommon.WhiteListHandlerStub{},
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 		CurrentPeerId:        "pid",
 	}
 }
@@ -112,6 +113,17 @@ func TestNewMultiDataInterceptor_NilPreferredPeersHolderShouldErr(t *testing.T)
 	assert.Equal(t, process.ErrNilPreferredPeersHolder, err)
 }
 
+func TestNewMultiDataInterceptor_NilMetricsRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgMultiDataInterceptor()
+	arg.MetricsRegistry = nil
+	mdi, err := interceptors.NewMultiDataInterceptor(arg)
+
+	assert.Nil(t, mdi)
+	assert.Equal(t, process.ErrNilMetricsRegistry, err)
+}
+
 func TestNewMultiDataInterceptor_NilWhiteListHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -18,6 +18,7 @@ type ArgSingleDataInterceptor struct {
 	AntifloodHandler     process.P2PAntifloodHandler
 	WhiteListRequest     process.WhiteListHandler
 	PreferredPeersHolder process.PreferredPeersHolderHandler
+	MetricsRegistry      common.MetricsRegistry
 	CurrentPeerId        core.PeerID
 }
 
@@ -51,6 +52,9 @@ func NewSingleDataInterceptor(arg ArgSingleDataInterceptor) (*SingleDataIntercep
 	if check.IfNil(arg.PreferredPeersHolder) {
 		return nil, process.ErrNilPreferredPeersHolder
 	}
+	if check.IfNil(arg.MetricsRegistry) {
+		return nil, process.ErrNilMetricsRegistry
+	}
 	if len(arg.CurrentPeerId) == 0 {
 		return nil, process.ErrEmptyPeerID
 	}
@@ -63,6 +67,7 @@ func NewSingleDataInterceptor(arg ArgSingleDataInterceptor) (*SingleDataIntercep
 			currentPeerId:        arg.CurrentPeerId,
 			processor:            arg.Processor,
 			preferredPeersHolder: arg.PreferredPeersHolder,
+			metricsRegistry:      arg.MetricsRegistry,
 			debugHandler:         resolver.NewDisabledInterceptorResolver(),
 		},
 		factory:          arg.DataFactory,
@@ -26,6 +26,7 @@ func createMockArgSingleDataInterceptor() interceptors.ArgSingleDataInterceptor
 		AntifloodHandler:     &mock.P2PAntifloodHandlerStub{},
 		WhiteListRequest:     &testscommon.WhiteListHandlerStub{},
 		PreferredPeersHolder: &p2pmocks.PeersHolderStub{},
+		MetricsRegistry:      &testscommon.MetricsRegistryStub{},
 		CurrentPeerId:        "pid",
 	}
 }
@@ -123,6 +124,17 @@ func TestNewSingleDataInterceptor_NilPreferredPeersHolderShouldErr(t *testing.T)
 	assert.Equal(t, process.ErrNilPreferredPeersHolder, err)
 }
 
+func TestNewSingleDataInterceptor_NilMetricsRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSingleDataInterceptor()
+	arg.MetricsRegistry = nil
+	sdi, err := interceptors.NewSingleDataInterceptor(arg)
+
+	assert.Nil(t, sdi)
+	assert.Equal(t, process.ErrNilMetricsRegistry, err)
+}
+
 func TestNewSingleDataInterceptor_NilWhiteListHandlerShouldErr(t *testing.T) {
 	t.Parallel()
 
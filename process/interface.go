@@ -1,6 +1,7 @@
 package process
 
 import (
+	"context"
 	"math/big"
 	"time"
 
@@ -134,7 +135,7 @@ type TransactionCoordinator interface {
 	RemoveBlockDataFromPool(body *block.Body) error
 	RemoveTxsFromPool(body *block.Body) error
 
-	ProcessBlockTransaction(header data.HeaderHandler, body *block.Body, haveTime func() time.Duration) error
+	ProcessBlockTransaction(ctx context.Context, header data.HeaderHandler, body *block.Body, haveTime func() time.Duration) error
 
 	CreateBlockStarted()
 	CreateMbsAndProcessCrossShardTransactionsDstMe(header data.HeaderHandler, processedMiniBlocksHashes map[string]struct{}, haveTime func() bool, haveAdditionalTime func() bool, scheduledMode bool) (block.MiniBlockSlice, uint32, bool, error)
@@ -245,6 +246,15 @@ type BlockProcessor interface {
 	Close() error
 }
 
+// TracedBlockProcessor defines the block processor able to trace the creation, the processing and the commit of a
+// block as children of the span found in the provided context
+type TracedBlockProcessor interface {
+	CreateBlockWithContext(ctx context.Context, initialHdr data.HeaderHandler, haveTime func() bool) (data.HeaderHandler, data.BodyHandler, error)
+	ProcessBlockWithContext(ctx context.Context, header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error
+	CommitBlockWithContext(ctx context.Context, header data.HeaderHandler, body data.BodyHandler) error
+	IsInterfaceNil() bool
+}
+
 // ScheduledBlockProcessor is the interface for the scheduled miniBlocks execution part of the block processor
 type ScheduledBlockProcessor interface {
 	ProcessScheduledBlock(header data.HeaderHandler, body data.BodyHandler, haveTime func() time.Duration) error
@@ -269,6 +279,16 @@ type ValidatorStatisticsProcessor interface {
 	SaveNodesCoordinatorUpdates(epoch uint32) (bool, error)
 }
 
+// ValidatorHistoryHandler records and provides the per epoch rating and signing statistics of the validators
+type ValidatorHistoryHandler interface {
+	PrepareEpoch(validatorInfos map[uint32][]*state.ValidatorInfo)
+	EpochStartAction(hdr data.HeaderHandler)
+	EpochStartPrepare(metaHdr data.HeaderHandler, body data.BodyHandler)
+	NotifyOrder() uint32
+	GetHistory(pubKey []byte, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error)
+	IsInterfaceNil() bool
+}
+
 // TransactionLogProcessor is the main interface for saving logs generated by smart contract calls
 type TransactionLogProcessor interface {
 	GetAllCurrentLogs() []*data.LogData
@@ -278,6 +298,14 @@ type TransactionLogProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// GasProfilerHandler aggregates the gas consumed by the smart contracts and built-in functions executions
+type GasProfilerHandler interface {
+	RecordContractExecution(contract []byte, function string, gasUsed uint64, failed bool)
+	RecordBuiltInFunctionExecution(function string, gasUsed uint64, failed bool)
+	GetGasProfile() (*common.GasProfile, error)
+	IsInterfaceNil() bool
+}
+
 // TransactionLogProcessorDatabase is interface the  for saving logs also in RAM
 type TransactionLogProcessorDatabase interface {
 	GetLogFromCache(txHash []byte) (*data.LogData, bool)
@@ -293,6 +321,12 @@ type ValidatorsProvider interface {
 	Close() error
 }
 
+// ShufflingPreviewer is able to preview the nodes shuffling of the next epoch
+type ShufflingPreviewer interface {
+	PreviewNextEpochShuffling() (*common.NextEpochShufflingPreview, error)
+	IsInterfaceNil() bool
+}
+
 // Checker provides functionality to checks the integrity and validity of a data structure
 type Checker interface {
 	// IntegrityAndValidity does both validity and integrity checks on the data structure
@@ -698,6 +732,16 @@ type PeerBlackListCacher interface {
 	IsInterfaceNil() bool
 }
 
+// PeerBlacklistRegistry is a PeerBlackListCacher that also keeps the reason of each blacklisting decision and is
+// able to list or pardon the blacklisted peers
+type PeerBlacklistRegistry interface {
+	PeerBlackListCacher
+	UpsertWithReason(pid core.PeerID, span time.Duration, reason string, isManual bool) error
+	Remove(pid core.PeerID) error
+	GetBlacklistedPeers() []common.BlacklistedPeerInfo
+	Close() error
+}
+
 // PeerShardMapper can return the public key of a provided peer ID
 type PeerShardMapper interface {
 	GetPeerInfo(pid core.PeerID) core.P2PPeerInfo
@@ -834,6 +878,16 @@ type FloodPreventer interface {
 	IncreaseLoad(pid core.PeerID, size uint64) error
 	ApplyConsensusSize(size int)
 	Reset()
+	SetQuotaFactor(factor float64)
+	GetQuotaInfo() common.FloodPreventerQuotaInfo
+	IsInterfaceNil() bool
+}
+
+// LoadSource defines a component able to report how loaded the node is, as a ratio where 0 means idle and
+// 1 means saturated
+type LoadSource interface {
+	Name() string
+	Load() float64
 	IsInterfaceNil() bool
 }
 
@@ -844,6 +898,8 @@ type TopicFloodPreventer interface {
 	ResetForTopic(topic string)
 	ResetForNotRegisteredTopics()
 	SetMaxMessagesForTopic(topic string, maxNum uint32)
+	SetQuotaFactor(factor float64)
+	GetTopicsQuotaInfo() []common.TopicQuotaInfo
 	IsInterfaceNil() bool
 }
 
@@ -1121,6 +1177,7 @@ type CoreComponentsHolder interface {
 	ChanStopNodeProcess() chan endProcess.ArgEndProcess
 	NodeTypeProvider() core.NodeTypeProviderHandler
 	ProcessStatusHandler() common.ProcessStatusHandler
+	MetricsRegistry() common.MetricsRegistry
 	IsInterfaceNil() bool
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/tracing"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -36,6 +37,8 @@ type CoreComponentsMock struct {
 	NodeTypeProviderField       core.NodeTypeProviderHandler
 	EconomicsDataField          process.EconomicsDataHandler
 	ProcessStatusHandlerField   common.ProcessStatusHandler
+	MetricsRegistryField        common.MetricsRegistry
+	TracerField                 tracing.Tracer
 }
 
 // ChanStopNodeProcess -
@@ -151,6 +154,16 @@ func (ccm *CoreComponentsMock) EconomicsData() process.EconomicsDataHandler {
 	return &economicsmocks.EconomicsHandlerStub{}
 }
 
+// MetricsRegistry -
+func (ccm *CoreComponentsMock) MetricsRegistry() common.MetricsRegistry {
+	return ccm.MetricsRegistryField
+}
+
+// Tracer -
+func (ccm *CoreComponentsMock) Tracer() tracing.Tracer {
+	return ccm.TracerField
+}
+
 // ProcessStatusHandler -
 func (ccm *CoreComponentsMock) ProcessStatusHandler() common.ProcessStatusHandler {
 	return ccm.ProcessStatusHandlerField
@@ -1,12 +1,17 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go-core/core"
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
 
 // FloodPreventerStub -
 type FloodPreventerStub struct {
 	IncreaseLoadCalled       func(pid core.PeerID, size uint64) error
 	ApplyConsensusSizeCalled func(size int)
+	SetQuotaFactorCalled     func(factor float64)
 	ResetCalled              func()
+	GetQuotaInfoCalled       func() common.FloodPreventerQuotaInfo
 }
 
 // IncreaseLoad -
@@ -21,11 +26,27 @@ func (fps *FloodPreventerStub) ApplyConsensusSize(size int) {
 	}
 }
 
+// SetQuotaFactor -
+func (fps *FloodPreventerStub) SetQuotaFactor(factor float64) {
+	if fps.SetQuotaFactorCalled != nil {
+		fps.SetQuotaFactorCalled(factor)
+	}
+}
+
 // Reset -
 func (fps *FloodPreventerStub) Reset() {
 	fps.ResetCalled()
 }
 
+// GetQuotaInfo -
+func (fps *FloodPreventerStub) GetQuotaInfo() common.FloodPreventerQuotaInfo {
+	if fps.GetQuotaInfoCalled != nil {
+		return fps.GetQuotaInfoCalled()
+	}
+
+	return common.FloodPreventerQuotaInfo{}
+}
+
 // IsInterfaceNil -
 func (fps *FloodPreventerStub) IsInterfaceNil() bool {
 	return fps == nil
@@ -0,0 +1,38 @@
+package mock
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// GasProfilerStub -
+type GasProfilerStub struct {
+	RecordContractExecutionCalled        func(contract []byte, function string, gasUsed uint64, failed bool)
+	RecordBuiltInFunctionExecutionCalled func(function string, gasUsed uint64, failed bool)
+	GetGasProfileCalled                  func() (*common.GasProfile, error)
+}
+
+// RecordContractExecution -
+func (gps *GasProfilerStub) RecordContractExecution(contract []byte, function string, gasUsed uint64, failed bool) {
+	if gps.RecordContractExecutionCalled != nil {
+		gps.RecordContractExecutionCalled(contract, function, gasUsed, failed)
+	}
+}
+
+// RecordBuiltInFunctionExecution -
+func (gps *GasProfilerStub) RecordBuiltInFunctionExecution(function string, gasUsed uint64, failed bool) {
+	if gps.RecordBuiltInFunctionExecutionCalled != nil {
+		gps.RecordBuiltInFunctionExecutionCalled(function, gasUsed, failed)
+	}
+}
+
+// GetGasProfile -
+func (gps *GasProfilerStub) GetGasProfile() (*common.GasProfile, error) {
+	if gps.GetGasProfileCalled != nil {
+		return gps.GetGasProfileCalled()
+	}
+
+	return &common.GasProfile{}, nil
+}
+
+// IsInterfaceNil -
+func (gps *GasProfilerStub) IsInterfaceNil() bool {
+	return gps == nil
+}
@@ -0,0 +1,30 @@
+package mock
+
+// LoadSourceStub -
+type LoadSourceStub struct {
+	NameCalled func() string
+	LoadCalled func() float64
+}
+
+// Name -
+func (lss *LoadSourceStub) Name() string {
+	if lss.NameCalled != nil {
+		return lss.NameCalled()
+	}
+
+	return "stub"
+}
+
+// Load -
+func (lss *LoadSourceStub) Load() float64 {
+	if lss.LoadCalled != nil {
+		return lss.LoadCalled()
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (lss *LoadSourceStub) IsInterfaceNil() bool {
+	return lss == nil
+}
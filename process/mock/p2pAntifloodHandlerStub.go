@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
@@ -16,6 +17,8 @@ type P2PAntifloodHandlerStub struct {
 	SetDebuggerCalled                  func(debugger process.AntifloodDebugger) error
 	BlacklistPeerCalled                func(peer core.PeerID, reason string, duration time.Duration)
 	IsOriginatorEligibleForTopicCalled func(pid core.PeerID, topic string) error
+	SetQuotaFactorCalled               func(factor float64)
+	GetQuotaInfoCalled                 func() common.AntifloodQuotaInfo
 }
 
 // CanProcessMessage -
@@ -49,6 +52,22 @@ func (p2pahs *P2PAntifloodHandlerStub) ApplyConsensusSize(size int) {
 	}
 }
 
+// SetQuotaFactor -
+func (p2pahs *P2PAntifloodHandlerStub) SetQuotaFactor(factor float64) {
+	if p2pahs.SetQuotaFactorCalled != nil {
+		p2pahs.SetQuotaFactorCalled(factor)
+	}
+}
+
+// GetQuotaInfo -
+func (p2pahs *P2PAntifloodHandlerStub) GetQuotaInfo() common.AntifloodQuotaInfo {
+	if p2pahs.GetQuotaInfoCalled != nil {
+		return p2pahs.GetQuotaInfoCalled()
+	}
+
+	return common.AntifloodQuotaInfo{}
+}
+
 // SetDebugger -
 func (p2pahs *P2PAntifloodHandlerStub) SetDebugger(debugger process.AntifloodDebugger) error {
 	if p2pahs.SetDebuggerCalled != nil {
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 // PeerBlackListHandlerStub -
@@ -11,6 +12,11 @@ type PeerBlackListHandlerStub struct {
 	UpsertCalled func(pid core.PeerID, span time.Duration) error
 	HasCalled    func(pid core.PeerID) bool
 	SweepCalled  func()
+
+	UpsertWithReasonCalled    func(pid core.PeerID, span time.Duration, reason string, isManual bool) error
+	RemoveCalled              func(pid core.PeerID) error
+	GetBlacklistedPeersCalled func() []common.BlacklistedPeerInfo
+	CloseCalled               func() error
 }
 
 // Upsert -
@@ -40,6 +46,42 @@ func (pblhs *PeerBlackListHandlerStub) Sweep() {
 	pblhs.SweepCalled()
 }
 
+// UpsertWithReason -
+func (pblhs *PeerBlackListHandlerStub) UpsertWithReason(pid core.PeerID, span time.Duration, reason string, isManual bool) error {
+	if pblhs.UpsertWithReasonCalled == nil {
+		return nil
+	}
+
+	return pblhs.UpsertWithReasonCalled(pid, span, reason, isManual)
+}
+
+// Remove -
+func (pblhs *PeerBlackListHandlerStub) Remove(pid core.PeerID) error {
+	if pblhs.RemoveCalled == nil {
+		return nil
+	}
+
+	return pblhs.RemoveCalled(pid)
+}
+
+// GetBlacklistedPeers -
+func (pblhs *PeerBlackListHandlerStub) GetBlacklistedPeers() []common.BlacklistedPeerInfo {
+	if pblhs.GetBlacklistedPeersCalled == nil {
+		return make([]common.BlacklistedPeerInfo, 0)
+	}
+
+	return pblhs.GetBlacklistedPeersCalled()
+}
+
+// Close -
+func (pblhs *PeerBlackListHandlerStub) Close() error {
+	if pblhs.CloseCalled == nil {
+		return nil
+	}
+
+	return pblhs.CloseCalled()
+}
+
 // IsInterfaceNil -
 func (pblhs *PeerBlackListHandlerStub) IsInterfaceNil() bool {
 	return pblhs == nil
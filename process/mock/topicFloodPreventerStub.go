@@ -1,12 +1,17 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go-core/core"
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
 
 // TopicAntiFloodStub -
 type TopicAntiFloodStub struct {
 	IncreaseLoadCalled           func(pid core.PeerID, topic string, numMessages uint32) error
 	ResetForTopicCalled          func(topic string)
 	SetMaxMessagesForTopicCalled func(topic string, num uint32)
+	GetTopicsQuotaInfoCalled     func() []common.TopicQuotaInfo
+	SetQuotaFactorCalled         func(factor float64)
 }
 
 // IncreaseLoad -
@@ -36,6 +41,22 @@ func (t *TopicAntiFloodStub) SetMaxMessagesForTopic(topic string, num uint32) {
 	}
 }
 
+// SetQuotaFactor -
+func (t *TopicAntiFloodStub) SetQuotaFactor(factor float64) {
+	if t.SetQuotaFactorCalled != nil {
+		t.SetQuotaFactorCalled(factor)
+	}
+}
+
+// GetTopicsQuotaInfo -
+func (t *TopicAntiFloodStub) GetTopicsQuotaInfo() []common.TopicQuotaInfo {
+	if t.GetTopicsQuotaInfoCalled != nil {
+		return t.GetTopicsQuotaInfoCalled()
+	}
+
+	return make([]common.TopicQuotaInfo, 0)
+}
+
 // IsInterfaceNil -
 func (t *TopicAntiFloodStub) IsInterfaceNil() bool {
 	return t == nil
@@ -0,0 +1,43 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process/peer/history"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+type validatorHistory struct {
+}
+
+// NewValidatorHistory returns a disabled validator history implementation
+func NewValidatorHistory() *validatorHistory {
+	return &validatorHistory{}
+}
+
+// PrepareEpoch does nothing
+func (vh *validatorHistory) PrepareEpoch(_ map[uint32][]*state.ValidatorInfo) {
+}
+
+// EpochStartAction does nothing
+func (vh *validatorHistory) EpochStartAction(_ data.HeaderHandler) {
+}
+
+// EpochStartPrepare does nothing
+func (vh *validatorHistory) EpochStartPrepare(_ data.HeaderHandler, _ data.BodyHandler) {
+}
+
+// NotifyOrder returns the notification order of the validator history
+func (vh *validatorHistory) NotifyOrder() uint32 {
+	return common.ValidatorHistoryOrder
+}
+
+// GetHistory returns the history disabled error
+func (vh *validatorHistory) GetHistory(_ []byte, _ uint32, _ uint32) ([]*common.ValidatorEpochHistory, error) {
+	return nil, history.ErrHistoryDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vh *validatorHistory) IsInterfaceNil() bool {
+	return vh == nil
+}
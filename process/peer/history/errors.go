@@ -0,0 +1,18 @@
+package history
+
+import "errors"
+
+// ErrInvalidMaxEpochsPerRequest signals that an invalid maximum number of epochs per request was provided
+var ErrInvalidMaxEpochsPerRequest = errors.New("invalid maximum number of epochs per request")
+
+// ErrInvalidEpochsRange signals that the start epoch of the requested range is greater than the end epoch
+var ErrInvalidEpochsRange = errors.New("invalid epochs range")
+
+// ErrTooManyEpochsRequested signals that the requested range spans more epochs than allowed
+var ErrTooManyEpochsRequested = errors.New("too many epochs requested")
+
+// ErrEmptyPublicKey signals that an empty public key was provided
+var ErrEmptyPublicKey = errors.New("empty public key")
+
+// ErrHistoryDisabled signals that the validator history is not recorded by the node
+var ErrHistoryDisabled = errors.New("the validator history is disabled")
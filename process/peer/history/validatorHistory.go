@@ -0,0 +1,254 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. validatorHistory.proto
+
+package history
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// JailedEvent marks the epoch in which a validator was moved in the jailed list
+const JailedEvent = "jailed"
+
+// UnJailedEvent marks the epoch in which a validator left the jailed list
+const UnJailedEvent = "unJailed"
+
+const epochSize = 4
+
+var log = logger.GetOrCreate("process/peer/history")
+
+// ArgsValidatorHistory is the DTO used to create a new instance of validatorHistory
+type ArgsValidatorHistory struct {
+	Storer              storage.Storer
+	Marshalizer         marshal.Marshalizer
+	MaxRating           uint32
+	MaxEpochsPerRequest uint32
+}
+
+type validatorHistory struct {
+	storer              storage.Storer
+	marshalizer         marshal.Marshalizer
+	maxRating           uint32
+	maxEpochsPerRequest uint32
+	mutPending          sync.Mutex
+	pendingRecords      map[string]*EpochRecord
+	mutRecord           sync.Mutex
+	executeSave         func(handler func())
+}
+
+// NewValidatorHistory creates a component that records, when each epoch start block is committed, the rating and the
+// signing statistics of each validator
+func NewValidatorHistory(args ArgsValidatorHistory) (*validatorHistory, error) {
+	if check.IfNil(args.Storer) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if args.MaxRating == 0 {
+		return nil, process.ErrMaxRatingZero
+	}
+	if args.MaxEpochsPerRequest == 0 {
+		return nil, ErrInvalidMaxEpochsPerRequest
+	}
+
+	return &validatorHistory{
+		storer:              args.Storer,
+		marshalizer:         args.Marshalizer,
+		maxRating:           args.MaxRating,
+		maxEpochsPerRequest: args.MaxEpochsPerRequest,
+		executeSave: func(handler func()) {
+			go handler()
+		},
+	}, nil
+}
+
+// PrepareEpoch keeps the statistics of the provided validators until the epoch start block that produced them is
+// committed. The records are built on the calling go routine, as the validator infos might be changed afterwards.
+// A later call replaces the prepared records, as only the last processed epoch start block can be committed
+func (vh *validatorHistory) PrepareEpoch(validatorInfos map[uint32][]*state.ValidatorInfo) {
+	records := make(map[string]*EpochRecord)
+	for _, validatorsInShard := range validatorInfos {
+		for _, validator := range validatorsInShard {
+			records[string(validator.PublicKey)] = createRecord(validator)
+		}
+	}
+
+	vh.mutPending.Lock()
+	vh.pendingRecords = records
+	vh.mutPending.Unlock()
+}
+
+// EpochStartAction saves the prepared records once the epoch start block is committed. The records belong to the
+// epoch that has just ended
+func (vh *validatorHistory) EpochStartAction(hdr data.HeaderHandler) {
+	if check.IfNil(hdr) {
+		return
+	}
+
+	vh.mutPending.Lock()
+	records := vh.pendingRecords
+	vh.pendingRecords = nil
+	vh.mutPending.Unlock()
+
+	if len(records) == 0 {
+		return
+	}
+
+	epoch := hdr.GetEpoch()
+	if epoch > 0 {
+		epoch = epoch - 1
+	}
+
+	vh.executeSave(func() {
+		vh.saveRecords(epoch, records)
+	})
+}
+
+// EpochStartPrepare does nothing
+func (vh *validatorHistory) EpochStartPrepare(_ data.HeaderHandler, _ data.BodyHandler) {
+}
+
+// NotifyOrder returns the notification order of the validator history
+func (vh *validatorHistory) NotifyOrder() uint32 {
+	return common.ValidatorHistoryOrder
+}
+
+func createRecord(validator *state.ValidatorInfo) *EpochRecord {
+	return &EpochRecord{
+		ShardID:                       validator.ShardId,
+		List:                          validator.List,
+		Rating:                        validator.Rating,
+		TempRating:                    validator.TempRating,
+		NumLeaderSuccess:              validator.LeaderSuccess,
+		NumLeaderFailure:              validator.LeaderFailure,
+		NumValidatorSuccess:           validator.ValidatorSuccess,
+		NumValidatorFailure:           validator.ValidatorFailure,
+		NumValidatorIgnoredSignatures: validator.ValidatorIgnoredSignatures,
+	}
+}
+
+func (vh *validatorHistory) saveRecords(epoch uint32, records map[string]*EpochRecord) {
+	vh.mutRecord.Lock()
+	defer vh.mutRecord.Unlock()
+
+	numSaved := 0
+	for pubKey, record := range records {
+		record.Epoch = epoch
+		record.JailEvent = vh.computeJailEvent([]byte(pubKey), record)
+
+		buff, err := vh.marshalizer.Marshal(record)
+		if err != nil {
+			log.Warn("validatorHistory.saveRecords: marshal", "error", err.Error())
+			continue
+		}
+
+		err = vh.storer.Put(createKey([]byte(pubKey), epoch), buff)
+		if err != nil {
+			log.Warn("validatorHistory.saveRecords: put", "error", err.Error())
+			continue
+		}
+		numSaved++
+	}
+
+	log.Debug("validator history recorded", "epoch", epoch, "num validators", numSaved)
+}
+
+func (vh *validatorHistory) computeJailEvent(pubKey []byte, record *EpochRecord) string {
+	isJailed := record.List == string(common.JailedList)
+	wasJailed := false
+	if record.Epoch > 0 {
+		previousRecord, err := vh.getRecord(pubKey, record.Epoch-1)
+		wasJailed = err == nil && previousRecord.List == string(common.JailedList)
+	}
+
+	switch {
+	case isJailed && !wasJailed:
+		return JailedEvent
+	case !isJailed && wasJailed:
+		return UnJailedEvent
+	default:
+		return ""
+	}
+}
+
+// GetHistory returns the recorded statistics of the provided validator in the provided epochs range. The epochs in
+// which the validator was not recorded are skipped
+func (vh *validatorHistory) GetHistory(pubKey []byte, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error) {
+	if len(pubKey) == 0 {
+		return nil, ErrEmptyPublicKey
+	}
+	if fromEpoch > toEpoch {
+		return nil, fmt.Errorf("%w: from epoch %d is greater than to epoch %d", ErrInvalidEpochsRange, fromEpoch, toEpoch)
+	}
+	numEpochs := uint64(toEpoch) - uint64(fromEpoch) + 1
+	if numEpochs > uint64(vh.maxEpochsPerRequest) {
+		return nil, fmt.Errorf("%w: requested %d, maximum %d", ErrTooManyEpochsRequested, numEpochs, vh.maxEpochsPerRequest)
+	}
+
+	records := make([]*common.ValidatorEpochHistory, 0, numEpochs)
+	for epoch := uint64(fromEpoch); epoch <= uint64(toEpoch); epoch++ {
+		record, err := vh.getRecord(pubKey, uint32(epoch))
+		if err != nil {
+			continue
+		}
+
+		records = append(records, vh.toValidatorEpochHistory(record))
+	}
+
+	return records, nil
+}
+
+func (vh *validatorHistory) toValidatorEpochHistory(record *EpochRecord) *common.ValidatorEpochHistory {
+	return &common.ValidatorEpochHistory{
+		Epoch:                         record.Epoch,
+		ShardID:                       record.ShardID,
+		List:                          record.List,
+		Rating:                        float32(record.Rating) * 100 / float32(vh.maxRating),
+		TempRating:                    float32(record.TempRating) * 100 / float32(vh.maxRating),
+		NumLeaderSuccess:              record.NumLeaderSuccess,
+		NumLeaderFailure:              record.NumLeaderFailure,
+		NumValidatorSuccess:           record.NumValidatorSuccess,
+		NumValidatorFailure:           record.NumValidatorFailure,
+		NumValidatorIgnoredSignatures: record.NumValidatorIgnoredSignatures,
+		JailEvent:                     record.JailEvent,
+	}
+}
+
+func (vh *validatorHistory) getRecord(pubKey []byte, epoch uint32) (*EpochRecord, error) {
+	buff, err := vh.storer.Get(createKey(pubKey, epoch))
+	if err != nil {
+		return nil, err
+	}
+
+	record := &EpochRecord{}
+	err = vh.marshalizer.Unmarshal(record, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func createKey(pubKey []byte, epoch uint32) []byte {
+	key := make([]byte, len(pubKey)+epochSize)
+	copy(key, pubKey)
+	binary.BigEndian.PutUint32(key[len(pubKey):], epoch)
+
+	return key
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vh *validatorHistory) IsInterfaceNil() bool {
+	return vh == nil
+}
@@ -0,0 +1,802 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: validatorHistory.proto
+
+package history
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EpochRecord holds the rating and the signing statistics of a validator as committed in an epoch start block
+type EpochRecord struct {
+	Epoch                         uint32 `protobuf:"varint,1,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	ShardID                       uint32 `protobuf:"varint,2,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+	List                          string `protobuf:"bytes,3,opt,name=List,proto3" json:"List,omitempty"`
+	Rating                        uint32 `protobuf:"varint,4,opt,name=Rating,proto3" json:"Rating,omitempty"`
+	TempRating                    uint32 `protobuf:"varint,5,opt,name=TempRating,proto3" json:"TempRating,omitempty"`
+	NumLeaderSuccess              uint32 `protobuf:"varint,6,opt,name=NumLeaderSuccess,proto3" json:"NumLeaderSuccess,omitempty"`
+	NumLeaderFailure              uint32 `protobuf:"varint,7,opt,name=NumLeaderFailure,proto3" json:"NumLeaderFailure,omitempty"`
+	NumValidatorSuccess           uint32 `protobuf:"varint,8,opt,name=NumValidatorSuccess,proto3" json:"NumValidatorSuccess,omitempty"`
+	NumValidatorFailure           uint32 `protobuf:"varint,9,opt,name=NumValidatorFailure,proto3" json:"NumValidatorFailure,omitempty"`
+	NumValidatorIgnoredSignatures uint32 `protobuf:"varint,10,opt,name=NumValidatorIgnoredSignatures,proto3" json:"NumValidatorIgnoredSignatures,omitempty"`
+	JailEvent                     string `protobuf:"bytes,11,opt,name=JailEvent,proto3" json:"JailEvent,omitempty"`
+}
+
+func (m *EpochRecord) Reset()      { *m = EpochRecord{} }
+func (*EpochRecord) ProtoMessage() {}
+func (*EpochRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27dea74f8d999f83, []int{0}
+}
+func (m *EpochRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EpochRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochRecord.Merge(m, src)
+}
+func (m *EpochRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochRecord proto.InternalMessageInfo
+
+func (m *EpochRecord) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetList() string {
+	if m != nil {
+		return m.List
+	}
+	return ""
+}
+
+func (m *EpochRecord) GetRating() uint32 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetTempRating() uint32 {
+	if m != nil {
+		return m.TempRating
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetNumLeaderSuccess() uint32 {
+	if m != nil {
+		return m.NumLeaderSuccess
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetNumLeaderFailure() uint32 {
+	if m != nil {
+		return m.NumLeaderFailure
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetNumValidatorSuccess() uint32 {
+	if m != nil {
+		return m.NumValidatorSuccess
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetNumValidatorFailure() uint32 {
+	if m != nil {
+		return m.NumValidatorFailure
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetNumValidatorIgnoredSignatures() uint32 {
+	if m != nil {
+		return m.NumValidatorIgnoredSignatures
+	}
+	return 0
+}
+
+func (m *EpochRecord) GetJailEvent() string {
+	if m != nil {
+		return m.JailEvent
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EpochRecord)(nil), "proto.EpochRecord")
+}
+
+func init() { proto.RegisterFile("validatorHistory.proto", fileDescriptor_27dea74f8d999f83) }
+
+var fileDescriptor_27dea74f8d999f83 = []byte{
+	// 320 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x3d, 0x4b, 0x03, 0x31,
+	0x18, 0xc7, 0x2f, 0xb5, 0x2f, 0x36, 0xb5, 0xbe, 0x9c, 0x20, 0x41, 0xf1, 0xa1, 0x08, 0x42, 0x17,
+	0xdb, 0xc1, 0x4f, 0xa0, 0x58, 0xb1, 0x52, 0x3a, 0xb4, 0xe2, 0xe0, 0x96, 0xde, 0xc5, 0xbb, 0x40,
+	0xef, 0x52, 0x72, 0x49, 0xc1, 0xcd, 0xcd, 0xd5, 0x8f, 0xe1, 0x47, 0x71, 0xec, 0xd8, 0xd1, 0xa6,
+	0x8b, 0x63, 0x3f, 0x82, 0xf8, 0xd4, 0x03, 0x41, 0xa7, 0xe4, 0xff, 0xfb, 0xe5, 0x3f, 0x3c, 0x79,
+	0xe8, 0xc1, 0x94, 0x8f, 0x65, 0xc8, 0x8d, 0xd2, 0x37, 0x32, 0x33, 0x4a, 0x3f, 0xb5, 0x26, 0x5a,
+	0x19, 0xe5, 0x97, 0xf0, 0x38, 0x3c, 0x8b, 0xa4, 0x89, 0xed, 0xa8, 0x15, 0xa8, 0xa4, 0x1d, 0xa9,
+	0x48, 0xb5, 0x11, 0x8f, 0xec, 0x23, 0x26, 0x0c, 0x78, 0x5b, 0xb7, 0x4e, 0x5e, 0x0a, 0xb4, 0xd6,
+	0x99, 0xa8, 0x20, 0x1e, 0x88, 0x40, 0xe9, 0xd0, 0xaf, 0xd3, 0x12, 0x46, 0x46, 0x1a, 0xa4, 0x59,
+	0xf7, 0x77, 0x68, 0x65, 0x18, 0x73, 0x1d, 0x76, 0xaf, 0x58, 0x01, 0xc1, 0x16, 0x2d, 0xf6, 0x64,
+	0x66, 0xd8, 0x46, 0x83, 0x34, 0xab, 0xfe, 0x36, 0x2d, 0x0f, 0xb8, 0x91, 0x69, 0xc4, 0x8a, 0x68,
+	0x7d, 0x4a, 0xef, 0x44, 0x32, 0xf9, 0x61, 0x25, 0x64, 0x8c, 0xee, 0xf6, 0x6d, 0xd2, 0x13, 0x3c,
+	0x14, 0x7a, 0x68, 0x83, 0x40, 0x64, 0x19, 0x2b, 0xff, 0x31, 0xd7, 0x5c, 0x8e, 0xad, 0x16, 0xac,
+	0x82, 0xe6, 0x88, 0xee, 0xf7, 0x6d, 0x72, 0x9f, 0x0f, 0x9a, 0xd7, 0x36, 0xff, 0x93, 0x79, 0xb3,
+	0x8a, 0xf2, 0x94, 0x1e, 0xff, 0x96, 0xdd, 0x28, 0x55, 0x5a, 0x84, 0x43, 0x19, 0xa5, 0xdc, 0x58,
+	0x2d, 0x32, 0x46, 0xf1, 0xd9, 0x1e, 0xad, 0xde, 0x72, 0x39, 0xee, 0x4c, 0x45, 0x6a, 0x58, 0xed,
+	0x7b, 0x96, 0xcb, 0x8b, 0xd9, 0x02, 0xbc, 0xf9, 0x02, 0xbc, 0xd5, 0x02, 0xc8, 0xb3, 0x03, 0xf2,
+	0xe6, 0x80, 0xbc, 0x3b, 0x20, 0x33, 0x07, 0x64, 0xee, 0x80, 0x7c, 0x38, 0x20, 0x9f, 0x0e, 0xbc,
+	0x95, 0x03, 0xf2, 0xba, 0x04, 0x6f, 0xb6, 0x04, 0x6f, 0xbe, 0x04, 0xef, 0xa1, 0x12, 0xaf, 0x17,
+	0x31, 0x2a, 0xe3, 0x9f, 0x9e, 0x7f, 0x0d, 0x00, 0x5c, 0x4b, 0x1f, 0xb0, 0xa3, 0x01, 0x00, 0x00,
+}
+
+func (this *EpochRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EpochRecord)
+	if !ok {
+		that2, ok := that.(EpochRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	if this.List != that1.List {
+		return false
+	}
+	if this.Rating != that1.Rating {
+		return false
+	}
+	if this.TempRating != that1.TempRating {
+		return false
+	}
+	if this.NumLeaderSuccess != that1.NumLeaderSuccess {
+		return false
+	}
+	if this.NumLeaderFailure != that1.NumLeaderFailure {
+		return false
+	}
+	if this.NumValidatorSuccess != that1.NumValidatorSuccess {
+		return false
+	}
+	if this.NumValidatorFailure != that1.NumValidatorFailure {
+		return false
+	}
+	if this.NumValidatorIgnoredSignatures != that1.NumValidatorIgnoredSignatures {
+		return false
+	}
+	if this.JailEvent != that1.JailEvent {
+		return false
+	}
+	return true
+}
+func (this *EpochRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&history.EpochRecord{")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "List: "+fmt.Sprintf("%#v", this.List)+",\n")
+	s = append(s, "Rating: "+fmt.Sprintf("%#v", this.Rating)+",\n")
+	s = append(s, "TempRating: "+fmt.Sprintf("%#v", this.TempRating)+",\n")
+	s = append(s, "NumLeaderSuccess: "+fmt.Sprintf("%#v", this.NumLeaderSuccess)+",\n")
+	s = append(s, "NumLeaderFailure: "+fmt.Sprintf("%#v", this.NumLeaderFailure)+",\n")
+	s = append(s, "NumValidatorSuccess: "+fmt.Sprintf("%#v", this.NumValidatorSuccess)+",\n")
+	s = append(s, "NumValidatorFailure: "+fmt.Sprintf("%#v", this.NumValidatorFailure)+",\n")
+	s = append(s, "NumValidatorIgnoredSignatures: "+fmt.Sprintf("%#v", this.NumValidatorIgnoredSignatures)+",\n")
+	s = append(s, "JailEvent: "+fmt.Sprintf("%#v", this.JailEvent)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringValidatorHistory(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *EpochRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.JailEvent) > 0 {
+		i -= len(m.JailEvent)
+		copy(dAtA[i:], m.JailEvent)
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(len(m.JailEvent)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.NumValidatorIgnoredSignatures != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.NumValidatorIgnoredSignatures))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NumValidatorFailure != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.NumValidatorFailure))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.NumValidatorSuccess != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.NumValidatorSuccess))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.NumLeaderFailure != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.NumLeaderFailure))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NumLeaderSuccess != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.NumLeaderSuccess))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TempRating != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.TempRating))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Rating != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.Rating))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.List) > 0 {
+		i -= len(m.List)
+		copy(dAtA[i:], m.List)
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(len(m.List)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ShardID != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintValidatorHistory(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintValidatorHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovValidatorHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EpochRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.Epoch))
+	}
+	if m.ShardID != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.ShardID))
+	}
+	l = len(m.List)
+	if l > 0 {
+		n += 1 + l + sovValidatorHistory(uint64(l))
+	}
+	if m.Rating != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.Rating))
+	}
+	if m.TempRating != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.TempRating))
+	}
+	if m.NumLeaderSuccess != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.NumLeaderSuccess))
+	}
+	if m.NumLeaderFailure != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.NumLeaderFailure))
+	}
+	if m.NumValidatorSuccess != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.NumValidatorSuccess))
+	}
+	if m.NumValidatorFailure != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.NumValidatorFailure))
+	}
+	if m.NumValidatorIgnoredSignatures != 0 {
+		n += 1 + sovValidatorHistory(uint64(m.NumValidatorIgnoredSignatures))
+	}
+	l = len(m.JailEvent)
+	if l > 0 {
+		n += 1 + l + sovValidatorHistory(uint64(l))
+	}
+	return n
+}
+
+func sovValidatorHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozValidatorHistory(x uint64) (n int) {
+	return sovValidatorHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EpochRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EpochRecord{`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`List:` + fmt.Sprintf("%v", this.List) + `,`,
+		`Rating:` + fmt.Sprintf("%v", this.Rating) + `,`,
+		`TempRating:` + fmt.Sprintf("%v", this.TempRating) + `,`,
+		`NumLeaderSuccess:` + fmt.Sprintf("%v", this.NumLeaderSuccess) + `,`,
+		`NumLeaderFailure:` + fmt.Sprintf("%v", this.NumLeaderFailure) + `,`,
+		`NumValidatorSuccess:` + fmt.Sprintf("%v", this.NumValidatorSuccess) + `,`,
+		`NumValidatorFailure:` + fmt.Sprintf("%v", this.NumValidatorFailure) + `,`,
+		`NumValidatorIgnoredSignatures:` + fmt.Sprintf("%v", this.NumValidatorIgnoredSignatures) + `,`,
+		`JailEvent:` + fmt.Sprintf("%v", this.JailEvent) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringValidatorHistory(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EpochRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowValidatorHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rating", wireType)
+			}
+			m.Rating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rating |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TempRating", wireType)
+			}
+			m.TempRating = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TempRating |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumLeaderSuccess", wireType)
+			}
+			m.NumLeaderSuccess = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumLeaderSuccess |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumLeaderFailure", wireType)
+			}
+			m.NumLeaderFailure = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumLeaderFailure |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumValidatorSuccess", wireType)
+			}
+			m.NumValidatorSuccess = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumValidatorSuccess |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumValidatorFailure", wireType)
+			}
+			m.NumValidatorFailure = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumValidatorFailure |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumValidatorIgnoredSignatures", wireType)
+			}
+			m.NumValidatorIgnoredSignatures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumValidatorIgnoredSignatures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailEvent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailEvent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipValidatorHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthValidatorHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipValidatorHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowValidatorHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowValidatorHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthValidatorHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupValidatorHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthValidatorHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthValidatorHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowValidatorHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupValidatorHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,190 @@
+package history
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsValidatorHistory() ArgsValidatorHistory {
+	return ArgsValidatorHistory{
+		Storer:              genericMocks.NewStorerMockWithErrKeyNotFound("ValidatorHistory", 0),
+		Marshalizer:         &marshal.GogoProtoMarshalizer{},
+		MaxRating:           1000,
+		MaxEpochsPerRequest: 10,
+	}
+}
+
+func createValidatorInfos(list string, tempRating uint32) map[uint32][]*state.ValidatorInfo {
+	return map[uint32][]*state.ValidatorInfo{
+		0: {
+			{
+				PublicKey:                  []byte("pubKey"),
+				ShardId:                    0,
+				List:                       list,
+				Rating:                     500,
+				TempRating:                 tempRating,
+				LeaderSuccess:              1,
+				LeaderFailure:              2,
+				ValidatorSuccess:           3,
+				ValidatorFailure:           4,
+				ValidatorIgnoredSignatures: 5,
+			},
+		},
+	}
+}
+
+func TestNewValidatorHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsValidatorHistory()
+		args.Storer = nil
+
+		vh, err := NewValidatorHistory(args)
+		assert.Equal(t, process.ErrNilStorage, err)
+		assert.True(t, check.IfNil(vh))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsValidatorHistory()
+		args.Marshalizer = nil
+
+		vh, err := NewValidatorHistory(args)
+		assert.Equal(t, process.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(vh))
+	})
+	t.Run("zero max rating should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsValidatorHistory()
+		args.MaxRating = 0
+
+		vh, err := NewValidatorHistory(args)
+		assert.Equal(t, process.ErrMaxRatingZero, err)
+		assert.True(t, check.IfNil(vh))
+	})
+	t.Run("zero max epochs per request should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsValidatorHistory()
+		args.MaxEpochsPerRequest = 0
+
+		vh, err := NewValidatorHistory(args)
+		assert.Equal(t, ErrInvalidMaxEpochsPerRequest, err)
+		assert.True(t, check.IfNil(vh))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		vh, err := NewValidatorHistory(createMockArgsValidatorHistory())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(vh))
+	})
+}
+
+func TestValidatorHistory_GetHistoryInvalidArgumentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	vh, _ := NewValidatorHistory(createMockArgsValidatorHistory())
+
+	records, err := vh.GetHistory(nil, 0, 1)
+	assert.Equal(t, ErrEmptyPublicKey, err)
+	assert.Nil(t, records)
+
+	records, err = vh.GetHistory([]byte("pubKey"), 2, 1)
+	assert.True(t, errors.Is(err, ErrInvalidEpochsRange))
+	assert.Nil(t, records)
+
+	records, err = vh.GetHistory([]byte("pubKey"), 0, 10)
+	assert.True(t, errors.Is(err, ErrTooManyEpochsRequested))
+	assert.Nil(t, records)
+}
+
+func createSynchronousValidatorHistory() *validatorHistory {
+	vh, _ := NewValidatorHistory(createMockArgsValidatorHistory())
+	vh.executeSave = func(handler func()) {
+		handler()
+	}
+
+	return vh
+}
+
+func recordEpoch(vh *validatorHistory, epoch uint32, validatorInfos map[uint32][]*state.ValidatorInfo) {
+	vh.PrepareEpoch(validatorInfos)
+	vh.EpochStartAction(&block.MetaBlock{Epoch: epoch + 1})
+}
+
+func TestValidatorHistory_EpochStartActionShouldSaveTheStatisticsAndTheJailEvents(t *testing.T) {
+	t.Parallel()
+
+	vh := createSynchronousValidatorHistory()
+
+	recordEpoch(vh, 1, createValidatorInfos(string(common.EligibleList), 600))
+	recordEpoch(vh, 2, createValidatorInfos(string(common.JailedList), 100))
+	recordEpoch(vh, 3, createValidatorInfos(string(common.JailedList), 100))
+	recordEpoch(vh, 5, createValidatorInfos(string(common.WaitingList), 100))
+
+	records, err := vh.GetHistory([]byte("pubKey"), 0, 6)
+	require.Nil(t, err)
+	require.Equal(t, 4, len(records))
+	assert.Equal(t, &common.ValidatorEpochHistory{
+		Epoch:                         1,
+		ShardID:                       0,
+		List:                          string(common.EligibleList),
+		Rating:                        50,
+		TempRating:                    60,
+		NumLeaderSuccess:              1,
+		NumLeaderFailure:              2,
+		NumValidatorSuccess:           3,
+		NumValidatorFailure:           4,
+		NumValidatorIgnoredSignatures: 5,
+	}, records[0])
+	assert.Equal(t, JailedEvent, records[1].JailEvent)
+	assert.Equal(t, "", records[2].JailEvent)
+	assert.Equal(t, uint32(5), records[3].Epoch)
+	assert.Equal(t, "", records[3].JailEvent, "the previous epoch was not recorded")
+
+	recordEpoch(vh, 4, createValidatorInfos(string(common.WaitingList), 100))
+
+	records, err = vh.GetHistory([]byte("pubKey"), 4, 4)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, UnJailedEvent, records[0].JailEvent)
+
+	records, err = vh.GetHistory([]byte("another pubKey"), 0, 6)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(records))
+}
+
+func TestValidatorHistory_ShouldSaveOnlyTheLastPreparedRecordsWhenTheBlockIsCommitted(t *testing.T) {
+	t.Parallel()
+
+	vh := createSynchronousValidatorHistory()
+
+	vh.PrepareEpoch(createValidatorInfos(string(common.EligibleList), 600))
+	records, _ := vh.GetHistory([]byte("pubKey"), 0, 6)
+	assert.Equal(t, 0, len(records), "nothing should be saved before the epoch start block is committed")
+
+	vh.PrepareEpoch(createValidatorInfos(string(common.JailedList), 100))
+	vh.EpochStartAction(&block.MetaBlock{Epoch: 3})
+	vh.EpochStartAction(&block.MetaBlock{Epoch: 4})
+
+	records, err := vh.GetHistory([]byte("pubKey"), 0, 6)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(records), "the prepared records should be saved only once")
+	assert.Equal(t, uint32(2), records[0].Epoch)
+	assert.Equal(t, string(common.JailedList), records[0].List)
+}
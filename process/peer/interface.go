@@ -2,6 +2,8 @@ package peer
 
 import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/state"
 )
 
 // DataPool indicates the main functionality needed in order to fetch the required blocks from the pool
@@ -9,3 +11,19 @@ type DataPool interface {
 	Headers() dataRetriever.HeadersPool
 	IsInterfaceNil() bool
 }
+
+// NodesConfigPreviewer defines the nodes coordinator operations needed to preview the next epoch shuffling
+type NodesConfigPreviewer interface {
+	PreviewNodesConfig(epoch uint32, randomness []byte, validatorsInfo []*state.ShardValidatorInfo) (*nodesCoordinator.NodesConfigPreview, error)
+	GetAllEligibleValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
+	GetAllWaitingValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
+	GetAllLeavingValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error)
+	GetOwnPublicKey() []byte
+	IsInterfaceNil() bool
+}
+
+// EpochStartRoundHandler is able to provide the start round of the current epoch
+type EpochStartRoundHandler interface {
+	EpochStartRound() uint64
+	IsInterfaceNil() bool
+}
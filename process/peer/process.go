@@ -59,6 +59,7 @@ type ArgValidatorStatisticsProcessor struct {
 	StakingV2EnableEpoch                              uint32
 	StopDecreasingValidatorRatingWhenStuckEnableEpoch uint32
 	EpochNotifier                                     process.EpochNotifier
+	HistoryHandler                                    process.ValidatorHistoryHandler
 }
 
 type validatorStatistics struct {
@@ -71,6 +72,7 @@ type validatorStatistics struct {
 	peerAdapter                                       state.AccountsAdapter
 	rater                                             sharding.PeerAccountListAndRatingHandler
 	rewardsHandler                                    process.RewardsHandler
+	historyHandler                                    process.ValidatorHistoryHandler
 	maxComputableRounds                               uint64
 	maxConsecutiveRoundsOfRatingDecrease              uint64
 	missedBlocksCounters                              validatorRoundCounters
@@ -129,6 +131,9 @@ func NewValidatorStatisticsProcessor(arguments ArgValidatorStatisticsProcessor)
 	if check.IfNil(arguments.EpochNotifier) {
 		return nil, process.ErrNilEpochNotifier
 	}
+	if check.IfNil(arguments.HistoryHandler) {
+		return nil, process.ErrNilValidatorHistoryHandler
+	}
 
 	vs := &validatorStatistics{
 		peerAdapter:                          arguments.PeerAdapter,
@@ -141,6 +146,7 @@ func NewValidatorStatisticsProcessor(arguments ArgValidatorStatisticsProcessor)
 		missedBlocksCounters:                 make(validatorRoundCounters),
 		rater:                                arguments.Rater,
 		rewardsHandler:                       arguments.RewardsHandler,
+		historyHandler:                       arguments.HistoryHandler,
 		maxComputableRounds:                  arguments.MaxComputableRounds,
 		maxConsecutiveRoundsOfRatingDecrease: arguments.MaxConsecutiveRoundsOfRatingDecrease,
 		genesisNonce:                         arguments.GenesisNonce,
@@ -703,6 +709,8 @@ func (vs *validatorStatistics) ResetValidatorStatisticsAtNewEpoch(vInfos map[uin
 		}
 	}
 
+	vs.historyHandler.PrepareEpoch(vInfos)
+
 	return nil
 }
 
@@ -23,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
 	dataRetrieverMock "github.com/ElrondNetwork/elrond-go/testscommon/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/testscommon/epochNotifier"
 	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
@@ -118,6 +119,7 @@ func createMockArguments() peer.ArgValidatorStatisticsProcessor {
 		MaxConsecutiveRoundsOfRatingDecrease: 2000,
 		NodesSetup:                           &mock.NodesSetupStub{},
 		EpochNotifier:                        &epochNotifier.EpochNotifierStub{},
+		HistoryHandler:                       &testscommon.ValidatorHistoryHandlerStub{},
 		StakingV2EnableEpoch:                 5,
 		StopDecreasingValidatorRatingWhenStuckEnableEpoch: 1500,
 	}
@@ -242,6 +244,18 @@ func TestNewValidatorStatisticsProcessor_NilRewardsHandlerShouldErr(t *testing.T
 	assert.Nil(t, validatorStatistics)
 	assert.Equal(t, process.ErrNilRewardsHandler, err)
 }
+
+func TestNewValidatorStatisticsProcessor_NilHistoryHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockArguments()
+	arguments.HistoryHandler = nil
+	validatorStatistics, err := peer.NewValidatorStatisticsProcessor(arguments)
+
+	assert.Nil(t, validatorStatistics)
+	assert.Equal(t, process.ErrNilValidatorHistoryHandler, err)
+}
+
 func TestNewValidatorStatisticsProcessor_NilMarshalizerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -2093,6 +2107,38 @@ func TestValidatorStatistics_ProcessValidatorInfosEndOfEpochWithNilMapShouldErr(
 	assert.Equal(t, process.ErrNilValidatorInfos, err)
 }
 
+func TestValidatorStatistics_ResetValidatorStatisticsAtNewEpochShouldPrepareTheHistory(t *testing.T) {
+	arguments := createMockArguments()
+	pa0, _ := createPeerAccounts([]byte("addr1"), []byte("addrM"))
+	peerAdapter := getAccountsMock()
+	peerAdapter.LoadAccountCalled = func(address []byte) (handler vmcommon.AccountHandler, err error) {
+		return pa0, nil
+	}
+	arguments.PeerAdapter = peerAdapter
+	var preparedInfos map[uint32][]*state.ValidatorInfo
+	arguments.HistoryHandler = &testscommon.ValidatorHistoryHandlerStub{
+		PrepareEpochCalled: func(validatorInfos map[uint32][]*state.ValidatorInfo) {
+			preparedInfos = validatorInfos
+		},
+	}
+	validatorStatistics, _ := peer.NewValidatorStatisticsProcessor(arguments)
+
+	vi := make(map[uint32][]*state.ValidatorInfo)
+	vi[0] = []*state.ValidatorInfo{
+		{
+			PublicKey:        pa0.GetBLSPublicKey(),
+			List:             string(common.EligibleList),
+			TempRating:       80,
+			LeaderSuccess:    10,
+			ValidatorSuccess: 10,
+		},
+	}
+
+	err := validatorStatistics.ResetValidatorStatisticsAtNewEpoch(vi)
+	assert.Nil(t, err)
+	assert.Equal(t, vi, preparedInfos)
+}
+
 func TestValidatorStatistics_ProcessValidatorInfosEndOfEpochWithNoValidatorFailureShouldNotChangeTempRating(t *testing.T) {
 	arguments := createMockArguments()
 	rater := createMockRater()
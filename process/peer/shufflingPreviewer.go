@@ -0,0 +1,371 @@
+package peer
+
+import (
+	"bytes"
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+const (
+	// ShuffledOutTransition marks an eligible node that will be moved in a waiting list
+	ShuffledOutTransition = "shuffledOut"
+	// WaitingToEligibleTransition marks a waiting node that will become eligible
+	WaitingToEligibleTransition = "waitingToEligible"
+	// JoiningTransition marks a new node that will be distributed in a waiting list
+	JoiningTransition = "joining"
+	// UnJailedTransition marks a node that will be distributed again in a waiting list after being removed from the
+	// nodes lists in one of the latest epochs, which is the case of the unjailed nodes
+	UnJailedTransition = "unJailed"
+	// LeavingTransition marks a node that unstaked and will leave the nodes lists
+	LeavingTransition = "leaving"
+	// LeavingPostponedTransition marks a node that unstaked but has to remain in the nodes lists to keep the
+	// minimum number of nodes per shard
+	LeavingPostponedTransition = "leavingPostponed"
+	// JailedTransition marks a node that will be removed from the nodes lists because of its low rating and that will
+	// be jailed afterwards
+	JailedTransition = "jailed"
+	// ShardChangedTransition marks a node that will keep its list but will be moved in another shard
+	ShardChangedTransition = "shardChanged"
+	// UnchangedTransition marks a node that will keep its position
+	UnchangedTransition = "unchanged"
+)
+
+const numEpochsToSearchForRemovedNodes = 3
+
+var _ process.ShufflingPreviewer = (*shufflingPreviewer)(nil)
+
+type nodePosition struct {
+	shardID uint32
+	list    string
+}
+
+// ArgShufflingPreviewer contains all parameters needed for creating a shufflingPreviewer
+type ArgShufflingPreviewer struct {
+	NodesCoordinator    NodesConfigPreviewer
+	ValidatorStatistics process.ValidatorStatisticsProcessor
+	ChainHandler        data.ChainHandler
+	EpochStartTrigger   EpochStartRoundHandler
+	ShardCoordinator    sharding.Coordinator
+	PubKeyConverter     core.PubkeyConverter
+	RoundsPerEpoch      uint64
+}
+
+// shufflingPreviewer computes, from the current validators statistics and the randomness of the latest committed
+// metablock, the nodes lists of the next epoch. The end of epoch rating updates and the changes done by the system
+// smart contracts when the epoch starts are not taken into account
+type shufflingPreviewer struct {
+	nodesCoordinator    NodesConfigPreviewer
+	validatorStatistics process.ValidatorStatisticsProcessor
+	chainHandler        data.ChainHandler
+	epochStartTrigger   EpochStartRoundHandler
+	shardCoordinator    sharding.Coordinator
+	pubKeyConverter     core.PubkeyConverter
+	roundsPerEpoch      uint64
+
+	mutPreview     sync.Mutex
+	lastHeaderHash []byte
+	lastPreview    *common.NextEpochShufflingPreview
+}
+
+// NewShufflingPreviewer creates a new shufflingPreviewer instance
+func NewShufflingPreviewer(args ArgShufflingPreviewer) (*shufflingPreviewer, error) {
+	if check.IfNil(args.NodesCoordinator) {
+		return nil, process.ErrNilNodesCoordinator
+	}
+	if check.IfNil(args.ValidatorStatistics) {
+		return nil, process.ErrNilValidatorStatistics
+	}
+	if check.IfNil(args.ChainHandler) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(args.EpochStartTrigger) {
+		return nil, process.ErrNilEpochStartTrigger
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if args.RoundsPerEpoch == 0 {
+		return nil, process.ErrInvalidRoundsPerEpoch
+	}
+
+	return &shufflingPreviewer{
+		nodesCoordinator:    args.NodesCoordinator,
+		validatorStatistics: args.ValidatorStatistics,
+		chainHandler:        args.ChainHandler,
+		epochStartTrigger:   args.EpochStartTrigger,
+		shardCoordinator:    args.ShardCoordinator,
+		pubKeyConverter:     args.PubKeyConverter,
+		roundsPerEpoch:      args.RoundsPerEpoch,
+	}, nil
+}
+
+// PreviewNextEpochShuffling returns the nodes that will change their position in the next epoch together with the
+// positions of the node's own keys. The result is computed again only after a new metablock was committed
+func (sp *shufflingPreviewer) PreviewNextEpochShuffling() (*common.NextEpochShufflingPreview, error) {
+	if sp.shardCoordinator.SelfId() != core.MetachainShardId {
+		return nil, process.ErrShufflingPreviewNotAvailable
+	}
+
+	header := sp.chainHandler.GetCurrentBlockHeader()
+	if check.IfNil(header) {
+		return nil, process.ErrNilHeaderHandler
+	}
+	metaHeader, ok := header.(data.MetaHeaderHandler)
+	if !ok {
+		return nil, process.ErrWrongTypeAssertion
+	}
+	headerHash := sp.chainHandler.GetCurrentBlockHeaderHash()
+
+	sp.mutPreview.Lock()
+	defer sp.mutPreview.Unlock()
+
+	if sp.lastPreview != nil && bytes.Equal(sp.lastHeaderHash, headerHash) {
+		return sp.lastPreview, nil
+	}
+
+	preview, err := sp.computePreview(metaHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	sp.lastHeaderHash = headerHash
+	sp.lastPreview = preview
+
+	return preview, nil
+}
+
+func (sp *shufflingPreviewer) computePreview(metaHeader data.MetaHeaderHandler) (*common.NextEpochShufflingPreview, error) {
+	validatorsInfoMap, err := sp.validatorStatistics.GetValidatorInfoForRootHash(metaHeader.GetValidatorStatsRootHash())
+	if err != nil {
+		return nil, err
+	}
+	validatorsInfo := createSortedShardValidatorsInfo(validatorsInfoMap)
+
+	currentEpoch := metaHeader.GetEpoch()
+	nextEpoch := currentEpoch + 1
+	randomness := metaHeader.GetRandSeed()
+	nodesConfig, err := sp.nodesCoordinator.PreviewNodesConfig(nextEpoch, randomness, validatorsInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	currentPositions := sp.getCurrentPositions(currentEpoch, validatorsInfo)
+	nextPositions := getNextPositions(nodesConfig)
+	recentlyRemoved := sp.getRecentlyRemovedNodes(currentEpoch)
+	stillRemaining := createPubKeysSet(nodesConfig.StillRemaining)
+	unStakeLeaving := createPubKeysSet(nodesConfig.UnStakeLeaving)
+	ownPubKey := sp.nodesCoordinator.GetOwnPublicKey()
+
+	epochStartRound := sp.epochStartTrigger.EpochStartRound()
+	preview := &common.NextEpochShufflingPreview{
+		Epoch:             nextEpoch,
+		Randomness:        hex.EncodeToString(randomness),
+		RandomnessNonce:   metaHeader.GetNonce(),
+		IsRandomnessFinal: metaHeader.GetRound() >= epochStartRound+sp.roundsPerEpoch,
+		Nodes:             make([]*common.ShufflingPreviewNode, 0),
+		OwnNodes:          make([]*common.ShufflingPreviewNode, 0),
+	}
+
+	for _, vInfo := range validatorsInfo {
+		pubKey := string(vInfo.PublicKey)
+		current := currentPositions[pubKey]
+		next, isPositioned := nextPositions[pubKey]
+		if !isPositioned {
+			next = current
+		}
+
+		_, isStillRemaining := stillRemaining[pubKey]
+		_, isUnStakeLeaving := unStakeLeaving[pubKey]
+		_, isRecentlyRemoved := recentlyRemoved[pubKey]
+		node := &common.ShufflingPreviewNode{
+			PubKey:         sp.pubKeyConverter.Encode(vInfo.PublicKey),
+			CurrentShardID: current.shardID,
+			CurrentList:    current.list,
+			NextShardID:    next.shardID,
+			NextList:       next.list,
+		}
+		node.Transition = computeTransition(current, next, isStillRemaining, isUnStakeLeaving, isRecentlyRemoved)
+
+		if node.Transition != UnchangedTransition {
+			preview.Nodes = append(preview.Nodes, node)
+		}
+		if pubKey == string(ownPubKey) {
+			preview.OwnNodes = append(preview.OwnNodes, node)
+		}
+	}
+
+	return preview, nil
+}
+
+func computeTransition(
+	current nodePosition,
+	next nodePosition,
+	isStillRemaining bool,
+	isUnStakeLeaving bool,
+	isRecentlyRemoved bool,
+) string {
+	isCurrentlyValidating := current.list == string(common.EligibleList) || current.list == string(common.WaitingList)
+
+	switch {
+	case next.list == string(common.LeavingList) && !isUnStakeLeaving:
+		return JailedTransition
+	case next.list == string(common.LeavingList):
+		return LeavingTransition
+	case isStillRemaining:
+		return LeavingPostponedTransition
+	case current.list == string(common.EligibleList) && next.list == string(common.WaitingList):
+		return ShuffledOutTransition
+	case current.list == string(common.WaitingList) && next.list == string(common.EligibleList):
+		return WaitingToEligibleTransition
+	case !isCurrentlyValidating && next.list == string(common.WaitingList) && isRecentlyRemoved:
+		return UnJailedTransition
+	case !isCurrentlyValidating && next.list == string(common.WaitingList):
+		return JoiningTransition
+	case current.shardID != next.shardID:
+		return ShardChangedTransition
+	default:
+		return UnchangedTransition
+	}
+}
+
+// getCurrentPositions returns the positions from the nodes coordinator for the nodes of the current epoch and the
+// positions saved in the validators statistics for all the other nodes
+func (sp *shufflingPreviewer) getCurrentPositions(
+	epoch uint32,
+	validatorsInfo []*state.ShardValidatorInfo,
+) map[string]nodePosition {
+	positions := make(map[string]nodePosition, len(validatorsInfo))
+	for _, vInfo := range validatorsInfo {
+		positions[string(vInfo.PublicKey)] = nodePosition{
+			shardID: vInfo.ShardId,
+			list:    vInfo.List,
+		}
+	}
+
+	eligible, err := sp.nodesCoordinator.GetAllEligibleValidatorsPublicKeys(epoch)
+	if err != nil {
+		log.Debug("shufflingPreviewer.getCurrentPositions: eligible", "epoch", epoch, "error", err)
+	}
+	addPositions(positions, eligible, string(common.EligibleList))
+
+	waiting, err := sp.nodesCoordinator.GetAllWaitingValidatorsPublicKeys(epoch)
+	if err != nil {
+		log.Debug("shufflingPreviewer.getCurrentPositions: waiting", "epoch", epoch, "error", err)
+	}
+	addPositions(positions, waiting, string(common.WaitingList))
+
+	return positions
+}
+
+// getRecentlyRemovedNodes returns the nodes that were part of the nodes lists in the latest epochs
+func (sp *shufflingPreviewer) getRecentlyRemovedNodes(currentEpoch uint32) map[string]struct{} {
+	removedNodes := make(map[string]struct{})
+	firstEpoch := uint32(0)
+	if currentEpoch > numEpochsToSearchForRemovedNodes {
+		firstEpoch = currentEpoch - numEpochsToSearchForRemovedNodes
+	}
+
+	for epoch := firstEpoch; epoch <= currentEpoch; epoch++ {
+		getters := []func(epoch uint32) (map[uint32][][]byte, error){
+			sp.nodesCoordinator.GetAllEligibleValidatorsPublicKeys,
+			sp.nodesCoordinator.GetAllWaitingValidatorsPublicKeys,
+			sp.nodesCoordinator.GetAllLeavingValidatorsPublicKeys,
+		}
+		for _, getPubKeys := range getters {
+			pubKeysMap, err := getPubKeys(epoch)
+			if err != nil {
+				continue
+			}
+
+			for _, pubKeys := range pubKeysMap {
+				for _, pubKey := range pubKeys {
+					removedNodes[string(pubKey)] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return removedNodes
+}
+
+func getNextPositions(nodesConfig *nodesCoordinator.NodesConfigPreview) map[string]nodePosition {
+	positions := make(map[string]nodePosition)
+	addValidatorsPositions(positions, nodesConfig.Eligible, string(common.EligibleList))
+	addValidatorsPositions(positions, nodesConfig.Waiting, string(common.WaitingList))
+	addValidatorsPositions(positions, nodesConfig.Leaving, string(common.LeavingList))
+
+	return positions
+}
+
+func addPositions(positions map[string]nodePosition, pubKeysMap map[uint32][][]byte, list string) {
+	for shardID, pubKeys := range pubKeysMap {
+		for _, pubKey := range pubKeys {
+			positions[string(pubKey)] = nodePosition{
+				shardID: shardID,
+				list:    list,
+			}
+		}
+	}
+}
+
+func addValidatorsPositions(positions map[string]nodePosition, validatorsMap map[uint32][]nodesCoordinator.Validator, list string) {
+	for shardID, validators := range validatorsMap {
+		for _, v := range validators {
+			positions[string(v.PubKey())] = nodePosition{
+				shardID: shardID,
+				list:    list,
+			}
+		}
+	}
+}
+
+func createPubKeysSet(validatorsMap map[uint32][]nodesCoordinator.Validator) map[string]struct{} {
+	pubKeys := make(map[string]struct{})
+	for _, validators := range validatorsMap {
+		for _, v := range validators {
+			pubKeys[string(v.PubKey())] = struct{}{}
+		}
+	}
+
+	return pubKeys
+}
+
+// createSortedShardValidatorsInfo converts the validators info in the same way as the epoch start peer miniblocks
+func createSortedShardValidatorsInfo(validatorsInfoMap map[uint32][]*state.ValidatorInfo) []*state.ShardValidatorInfo {
+	validatorsInfo := make([]*state.ShardValidatorInfo, 0)
+	for _, validators := range validatorsInfoMap {
+		for _, validator := range validators {
+			validatorsInfo = append(validatorsInfo, &state.ShardValidatorInfo{
+				PublicKey:  validator.PublicKey,
+				ShardId:    validator.ShardId,
+				List:       validator.List,
+				Index:      validator.Index,
+				TempRating: validator.TempRating,
+			})
+		}
+	}
+
+	sort.Slice(validatorsInfo, func(i, j int) bool {
+		return bytes.Compare(validatorsInfo[i].PublicKey, validatorsInfo[j].PublicKey) < 0
+	})
+
+	return validatorsInfo
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *shufflingPreviewer) IsInterfaceNil() bool {
+	return sp == nil
+}
@@ -0,0 +1,273 @@
+package peer
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgShufflingPreviewer() ArgShufflingPreviewer {
+	return ArgShufflingPreviewer{
+		NodesCoordinator:    shardingMocks.NewNodesCoordinatorMock(),
+		ValidatorStatistics: &mock.ValidatorStatisticsProcessorStub{},
+		ChainHandler: &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return &block.MetaBlock{Nonce: 10, Round: 100, Epoch: 5, RandSeed: []byte("rand seed")}
+			},
+			GetCurrentBlockHeaderHashCalled: func() []byte {
+				return []byte("hash")
+			},
+		},
+		EpochStartTrigger: &testscommon.EpochStartTriggerStub{
+			EpochStartRoundCalled: func() uint64 {
+				return 50
+			},
+		},
+		ShardCoordinator: &testscommon.ShardsCoordinatorMock{CurrentShard: core.MetachainShardId},
+		PubKeyConverter:  testscommon.NewPubkeyConverterMock(32),
+		RoundsPerEpoch:   50,
+	}
+}
+
+func createPreviewValidators(pubKeys ...string) []nodesCoordinator.Validator {
+	validators := make([]nodesCoordinator.Validator, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		v, _ := nodesCoordinator.NewValidator([]byte(pubKey), 1, 0)
+		validators = append(validators, v)
+	}
+
+	return validators
+}
+
+func TestNewShufflingPreviewer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil nodes coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgShufflingPreviewer()
+		args.NodesCoordinator = nil
+
+		sp, err := NewShufflingPreviewer(args)
+		assert.Equal(t, process.ErrNilNodesCoordinator, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("nil validator statistics should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgShufflingPreviewer()
+		args.ValidatorStatistics = nil
+
+		sp, err := NewShufflingPreviewer(args)
+		assert.Equal(t, process.ErrNilValidatorStatistics, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("nil chain handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgShufflingPreviewer()
+		args.ChainHandler = nil
+
+		sp, err := NewShufflingPreviewer(args)
+		assert.Equal(t, process.ErrNilBlockChain, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("nil epoch start trigger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgShufflingPreviewer()
+		args.EpochStartTrigger = nil
+
+		sp, err := NewShufflingPreviewer(args)
+		assert.Equal(t, process.ErrNilEpochStartTrigger, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgShufflingPreviewer()
+		args.ShardCoordinator = nil
+
+		sp, err := NewShufflingPreviewer(args)
+		assert.Equal(t, process.ErrNilShardCoordinator, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgShufflingPreviewer()
+		args.PubKeyConverter = nil
+
+		sp, err := NewShufflingPreviewer(args)
+		assert.Equal(t, process.ErrNilPubkeyConverter, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("invalid rounds per epoch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgShufflingPreviewer()
+		args.RoundsPerEpoch = 0
+
+		sp, err := NewShufflingPreviewer(args)
+		assert.Equal(t, process.ErrInvalidRoundsPerEpoch, err)
+		assert.True(t, check.IfNil(sp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sp, err := NewShufflingPreviewer(createMockArgShufflingPreviewer())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(sp))
+	})
+}
+
+func TestShufflingPreviewer_PreviewNextEpochShufflingOnShardShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgShufflingPreviewer()
+	args.ShardCoordinator = &testscommon.ShardsCoordinatorMock{CurrentShard: 0}
+	sp, _ := NewShufflingPreviewer(args)
+
+	preview, err := sp.PreviewNextEpochShuffling()
+	assert.Equal(t, process.ErrShufflingPreviewNotAvailable, err)
+	assert.Nil(t, preview)
+}
+
+func TestShufflingPreviewer_PreviewNextEpochShufflingShouldComputeTheTransitions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgShufflingPreviewer()
+	args.ValidatorStatistics = &mock.ValidatorStatisticsProcessorStub{
+		GetValidatorInfoForRootHashCalled: func(rootHash []byte) (map[uint32][]*state.ValidatorInfo, error) {
+			return map[uint32][]*state.ValidatorInfo{
+				0: {
+					{PublicKey: []byte("key"), ShardId: 0, List: string(common.EligibleList)},
+					{PublicKey: []byte("shuffled out"), ShardId: 0, List: string(common.EligibleList)},
+					{PublicKey: []byte("unstaked"), ShardId: 0, List: string(common.LeavingList)},
+					{PublicKey: []byte("low rating"), ShardId: 0, List: string(common.EligibleList)},
+					{PublicKey: []byte("postponed"), ShardId: 0, List: string(common.LeavingList)},
+					{PublicKey: []byte("jailed"), ShardId: 0, List: string(common.JailedList)},
+					{PublicKey: []byte("new"), ShardId: 0, List: string(common.NewList)},
+					{PublicKey: []byte("unjailed"), ShardId: 0, List: string(common.NewList)},
+				},
+				1: {
+					{PublicKey: []byte("waiting"), ShardId: 1, List: string(common.WaitingList)},
+				},
+			}, nil
+		},
+	}
+	numPreviews := 0
+	nodesCoordinatorMock := shardingMocks.NewNodesCoordinatorMock()
+	nodesCoordinatorMock.GetAllEligibleValidatorsPublicKeysCalled = func(epoch uint32) (map[uint32][][]byte, error) {
+		if epoch != 5 {
+			return nil, nil
+		}
+		return map[uint32][][]byte{0: {[]byte("key"), []byte("shuffled out"), []byte("unstaked"), []byte("low rating")}}, nil
+	}
+	nodesCoordinatorMock.GetAllWaitingValidatorsPublicKeysCalled = func() (map[uint32][][]byte, error) {
+		return map[uint32][][]byte{0: {[]byte("postponed")}, 1: {[]byte("waiting")}}, nil
+	}
+	nodesCoordinatorMock.GetAllLeavingValidatorsPublicKeysCalled = func(epoch uint32) (map[uint32][][]byte, error) {
+		if epoch != 3 {
+			return nil, nil
+		}
+		return map[uint32][][]byte{0: {[]byte("unjailed")}}, nil
+	}
+	nodesCoordinatorMock.PreviewNodesConfigCalled = func(epoch uint32, randomness []byte, validatorsInfo []*state.ShardValidatorInfo) (*nodesCoordinator.NodesConfigPreview, error) {
+		numPreviews++
+		assert.Equal(t, uint32(6), epoch)
+		assert.Equal(t, []byte("rand seed"), randomness)
+		assert.Equal(t, 9, len(validatorsInfo))
+
+		return &nodesCoordinator.NodesConfigPreview{
+			Eligible: map[uint32][]nodesCoordinator.Validator{
+				0: createPreviewValidators("key"),
+				1: createPreviewValidators("waiting"),
+			},
+			Waiting: map[uint32][]nodesCoordinator.Validator{
+				0: createPreviewValidators("postponed", "new", "unjailed"),
+				1: createPreviewValidators("shuffled out"),
+			},
+			Leaving: map[uint32][]nodesCoordinator.Validator{
+				0: createPreviewValidators("unstaked", "low rating"),
+			},
+			StillRemaining: map[uint32][]nodesCoordinator.Validator{
+				0: createPreviewValidators("postponed"),
+			},
+			UnStakeLeaving: map[uint32][]nodesCoordinator.Validator{
+				0: createPreviewValidators("unstaked", "postponed"),
+			},
+			AdditionalLeaving: map[uint32][]nodesCoordinator.Validator{
+				0: createPreviewValidators("low rating"),
+			},
+			NbShards: 1,
+		}, nil
+	}
+	args.NodesCoordinator = nodesCoordinatorMock
+	sp, _ := NewShufflingPreviewer(args)
+
+	preview, err := sp.PreviewNextEpochShuffling()
+	require.Nil(t, err)
+	assert.Equal(t, uint32(6), preview.Epoch)
+	assert.Equal(t, hex.EncodeToString([]byte("rand seed")), preview.Randomness)
+	assert.Equal(t, uint64(10), preview.RandomnessNonce)
+	assert.True(t, preview.IsRandomnessFinal)
+
+	expectedTransitions := map[string]string{
+		"shuffled out": ShuffledOutTransition,
+		"unstaked":     LeavingTransition,
+		"low rating":   JailedTransition,
+		"postponed":    LeavingPostponedTransition,
+		"new":          JoiningTransition,
+		"unjailed":     UnJailedTransition,
+		"waiting":      WaitingToEligibleTransition,
+	}
+	transitions := make(map[string]string)
+	for _, node := range preview.Nodes {
+		pubKey, _ := hex.DecodeString(node.PubKey)
+		transitions[string(pubKey)] = node.Transition
+	}
+	assert.Equal(t, expectedTransitions, transitions)
+	require.Equal(t, 1, len(preview.OwnNodes))
+	assert.Equal(t, &common.ShufflingPreviewNode{
+		PubKey:         hex.EncodeToString([]byte("key")),
+		CurrentShardID: 0,
+		CurrentList:    string(common.EligibleList),
+		NextShardID:    0,
+		NextList:       string(common.EligibleList),
+		Transition:     UnchangedTransition,
+	}, preview.OwnNodes[0])
+
+	_, _ = sp.PreviewNextEpochShuffling()
+	assert.Equal(t, 1, numPreviews, "the preview should be computed again only for a new block")
+}
+
+func TestShufflingPreviewer_PreviewNextEpochShufflingRandomnessNotFinal(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgShufflingPreviewer()
+	args.ChainHandler = &testscommon.ChainHandlerStub{
+		GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+			return &block.MetaBlock{Nonce: 9, Round: 99, Epoch: 5, RandSeed: []byte("rand seed")}
+		},
+	}
+	sp, _ := NewShufflingPreviewer(args)
+
+	preview, err := sp.PreviewNextEpochShuffling()
+	require.Nil(t, err)
+	assert.False(t, preview.IsRandomnessFinal)
+	assert.Equal(t, 0, len(preview.Nodes))
+}
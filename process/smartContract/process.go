@@ -120,6 +120,7 @@ type scProcessor struct {
 	mutGasLock          sync.RWMutex
 	txLogsProcessor     process.TransactionLogProcessor
 	vmOutputCacher      storage.Cacher
+	gasProfiler         process.GasProfilerHandler
 	isGenesisProcessing bool
 }
 
@@ -146,6 +147,7 @@ type ArgsNewSmartContractProcessor struct {
 	EpochNotifier       process.EpochNotifier
 	VMOutputCacher      storage.Cacher
 	ArwenChangeLocker   common.Locker
+	GasProfiler         process.GasProfilerHandler
 	IsGenesisProcessing bool
 }
 
@@ -208,6 +210,9 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 	if check.IfNil(args.VMOutputCacher) {
 		return nil, process.ErrNilCacher
 	}
+	if check.IfNil(args.GasProfiler) {
+		return nil, process.ErrNilGasProfiler
+	}
 	if check.IfNil(args.BuiltInFunctions) {
 		return nil, process.ErrNilBuiltInFunction
 	}
@@ -245,6 +250,7 @@ func NewSmartContractProcessor(args ArgsNewSmartContractProcessor) (*scProcessor
 		backwardCompSaveKeyValueEnableEpoch:   args.EnableEpochs.BackwardCompSaveKeyValueEnableEpoch,
 		arwenChangeLocker:                     args.ArwenChangeLocker,
 		vmOutputCacher:                        args.VMOutputCacher,
+		gasProfiler:                           args.GasProfiler,
 		storePerByte:                          baseOperationCost["StorePerByte"],
 		persistPerByte:                        baseOperationCost["PersistPerByte"],
 		incrementSCRNonceInMultiTransferEnableEpoch: args.EnableEpochs.IncrementSCRNonceInMultiTransferEnableEpoch,
@@ -453,13 +459,16 @@ func (sc *scProcessor) executeSmartContractCall(
 	sc.arwenChangeLocker.RUnlock()
 	if err != nil {
 		log.Debug("run smart contract call error", "error", err.Error())
+		sc.gasProfiler.RecordContractExecution(vmInput.RecipientAddr, vmInput.Function, vmInput.GasProvided, true)
 		return userErrorVmOutput, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
 	}
 	if vmOutput == nil {
 		err = process.ErrNilVMOutput
 		log.Debug("run smart contract call error", "error", err.Error())
+		sc.gasProfiler.RecordContractExecution(vmInput.RecipientAddr, vmInput.Function, vmInput.GasProvided, true)
 		return userErrorVmOutput, sc.ProcessIfError(acntSnd, txHash, tx, err.Error(), []byte(""), snapshot, vmInput.GasLocked)
 	}
+	sc.recordContractExecution(vmInput, vmOutput)
 	vmOutput.GasRemaining += vmInput.GasLocked
 
 	if vmOutput.ReturnCode != vmcommon.Ok {
@@ -475,6 +484,15 @@ func (sc *scProcessor) executeSmartContractCall(
 	return vmOutput, nil
 }
 
+func (sc *scProcessor) recordContractExecution(vmInput *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) {
+	gasUsed := uint64(0)
+	if vmInput.GasProvided > vmOutput.GasRemaining {
+		gasUsed = vmInput.GasProvided - vmOutput.GasRemaining
+	}
+
+	sc.gasProfiler.RecordContractExecution(vmInput.RecipientAddr, vmInput.Function, gasUsed, vmOutput.ReturnCode != vmcommon.Ok)
+}
+
 func (sc *scProcessor) isInformativeTxHandler(txHandler data.TransactionHandler) bool {
 	if txHandler.GetValue().Cmp(zero) > 0 {
 		return false
@@ -946,6 +964,7 @@ func (sc *scProcessor) doExecuteBuiltInFunction(
 	_, txTypeOnDst := sc.txTypeHandler.ComputeTransactionType(tx)
 	builtInFuncGasUsed, err := sc.computeBuiltInFuncGasUsed(txTypeOnDst, vmInput.Function, vmInput.GasProvided, vmOutput.GasRemaining)
 	log.LogIfError(err, "function", "ExecuteBuiltInFunction.computeBuiltInFuncGasUsed")
+	sc.gasProfiler.RecordBuiltInFunctionExecution(vmInput.Function, builtInFuncGasUsed, vmOutput.ReturnCode != vmcommon.Ok)
 
 	if txTypeOnDst != process.SCInvoking {
 		vmOutput.GasRemaining += vmInput.GasLocked
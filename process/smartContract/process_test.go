@@ -19,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/block/postprocess"
 	"github.com/ElrondNetwork/elrond-go/process/economics"
+	"github.com/ElrondNetwork/elrond-go/process/gasProfiler"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -106,6 +107,7 @@ func createMockSmartContractProcessorArguments() ArgsNewSmartContractProcessor {
 		EpochNotifier:     &epochNotifier.EpochNotifierStub{},
 		ArwenChangeLocker: &sync.RWMutex{},
 		VMOutputCacher:    txcache.NewDisabledCache(),
+		GasProfiler:       gasProfiler.NewDisabledGasProfiler(),
 	}
 }
 
@@ -134,6 +136,17 @@ func TestNewSmartContractProcessorNilVMOutputCacher(t *testing.T) {
 	require.Equal(t, process.ErrNilCacher, err)
 }
 
+func TestNewSmartContractProcessorNilGasProfiler(t *testing.T) {
+	t.Parallel()
+
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.GasProfiler = nil
+	sc, err := NewSmartContractProcessor(arguments)
+
+	require.Nil(t, sc)
+	require.Equal(t, process.ErrNilGasProfiler, err)
+}
+
 func TestNewSmartContractProcessorNilBuiltInFunctions(t *testing.T) {
 	t.Parallel()
 
@@ -1634,6 +1647,61 @@ func TestScProcessor_ExecuteSmartContractTransactionGasConsumedChecksError(t *te
 	require.Equal(t, vmcommon.ExecutionFailed, errCode)
 }
 
+func TestScProcessor_ExecuteSmartContractTransactionShouldRecordTheGasUsedInTheGasProfiler(t *testing.T) {
+	t.Parallel()
+
+	gasProvided := uint64(0)
+	vm := &mock.VMContainerMock{}
+	vmExecutor := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			gasProvided = input.GasProvided
+			return &vmcommon.VMOutput{
+				GasRemaining: 30,
+				ReturnCode:   vmcommon.UserError}, nil
+		},
+	}
+	vm.GetCalled = func(key []byte) (vmcommon.VMExecutionHandler, error) {
+		return vmExecutor, nil
+	}
+	recordCalled := false
+	accntState := &stateMock.AccountsStub{}
+	arguments := createMockSmartContractProcessorArguments()
+	arguments.VmContainer = vm
+	arguments.ArgsParser = NewArgumentParser()
+	arguments.AccountsDB = accntState
+	arguments.GasProfiler = &mock.GasProfilerStub{
+		RecordContractExecutionCalled: func(contract []byte, function string, gasUsed uint64, failed bool) {
+			recordCalled = true
+			assert.Equal(t, []byte("DST0000000"), contract)
+			assert.Equal(t, "data", function)
+			assert.Equal(t, gasProvided-30, gasUsed)
+			assert.True(t, failed)
+		},
+	}
+	sc, _ := NewSmartContractProcessor(arguments)
+
+	tx := &transaction.Transaction{}
+	tx.Nonce = 0
+	tx.SndAddr = []byte("SRC")
+	tx.RcvAddr = []byte("DST0000000")
+	tx.Data = []byte("data")
+	tx.Value = big.NewInt(0)
+	tx.GasLimit = 1000
+	acntSrc, acntDst := createAccounts(tx)
+
+	accntState.LoadAccountCalled = func(address []byte) (handler vmcommon.AccountHandler, e error) {
+		return acntSrc, nil
+	}
+	accntState.RevertToSnapshotCalled = func(snapshot int) error {
+		return nil
+	}
+
+	acntDst.SetCode([]byte("code"))
+	_, err := sc.ExecuteSmartContractTransaction(tx, acntSrc, acntDst)
+	require.Nil(t, err)
+	require.True(t, recordCalled)
+}
+
 func TestScProcessor_ExecuteSmartContractTransactionVmOutputError(t *testing.T) {
 	t.Parallel()
 
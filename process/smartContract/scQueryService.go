@@ -6,6 +6,7 @@ import (
 	"math"
 	"math/big"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data"
@@ -13,6 +14,7 @@ import (
 	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 )
@@ -31,6 +33,7 @@ type SCQueryService struct {
 	arwenChangeLocker        common.Locker
 	bootstrapper             process.Bootstrapper
 	allowExternalQueriesChan chan struct{}
+	metricsRegistry          common.MetricsRegistry
 }
 
 // ArgsNewSCQueryService defines the arguments needed for the sc query service
@@ -43,6 +46,7 @@ type ArgsNewSCQueryService struct {
 	Bootstrapper             process.Bootstrapper
 	AllowExternalQueriesChan chan struct{}
 	MaxGasLimitPerQuery      uint64
+	MetricsRegistry          common.MetricsRegistry
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
@@ -70,6 +74,9 @@ func NewSCQueryService(
 	if args.AllowExternalQueriesChan == nil {
 		return nil, process.ErrNilAllowExternalQueriesChan
 	}
+	if check.IfNil(args.MetricsRegistry) {
+		return nil, process.ErrNilMetricsRegistry
+	}
 
 	gasForQuery := uint64(math.MaxUint64)
 	if args.MaxGasLimitPerQuery > 0 {
@@ -84,11 +91,14 @@ func NewSCQueryService(
 		bootstrapper:             args.Bootstrapper,
 		gasForQuery:              gasForQuery,
 		allowExternalQueriesChan: args.AllowExternalQueriesChan,
+		metricsRegistry:          args.MetricsRegistry,
 	}, nil
 }
 
 // ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract
 func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	defer service.metricsRegistry.ObserveDurationSince(prometheus.SCQueryDuration, time.Now())
+
 	if !service.shouldAllowQueriesExecution() {
 		return nil, process.ErrQueriesNotAllowedYet
 	}
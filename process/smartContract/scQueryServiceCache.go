@@ -0,0 +1,176 @@
+package smartContract
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// ArgsSCQueryServiceCache defines the arguments needed for the sc query service cache
+type ArgsSCQueryServiceCache struct {
+	SCQueryService    process.SCQueryService
+	BlockChain        data.ChainHandler
+	Cacher            storage.Cacher
+	ExcludedContracts [][]byte
+	MetricsRegistry   common.MetricsRegistry
+}
+
+type scQueryServiceCache struct {
+	scQueryService    process.SCQueryService
+	blockChain        data.ChainHandler
+	cacher            storage.Cacher
+	excludedContracts map[string]struct{}
+	metricsRegistry   common.MetricsRegistry
+	mutBlockHash      sync.Mutex
+	lastBlockHash     []byte
+}
+
+// NewSCQueryServiceCache returns a smart contract query service that will answer the identical queries executed on
+// the same block from a bounded cache, forwarding everything else towards the wrapped query service. The cache is keyed
+// on the current block header hash, as a query might read the block nonce, round or timestamp even when the state
+// root hash is unchanged
+func NewSCQueryServiceCache(args ArgsSCQueryServiceCache) (*scQueryServiceCache, error) {
+	if check.IfNil(args.SCQueryService) {
+		return nil, process.ErrNilScQueryElement
+	}
+	if check.IfNil(args.BlockChain) {
+		return nil, process.ErrNilBlockChain
+	}
+	if check.IfNil(args.Cacher) {
+		return nil, process.ErrNilCacher
+	}
+	if check.IfNil(args.MetricsRegistry) {
+		return nil, process.ErrNilMetricsRegistry
+	}
+
+	excludedContracts := make(map[string]struct{}, len(args.ExcludedContracts))
+	for _, address := range args.ExcludedContracts {
+		excludedContracts[string(address)] = struct{}{}
+	}
+
+	return &scQueryServiceCache{
+		scQueryService:    args.SCQueryService,
+		blockChain:        args.BlockChain,
+		cacher:            args.Cacher,
+		excludedContracts: excludedContracts,
+		metricsRegistry:   args.MetricsRegistry,
+	}, nil
+}
+
+// ExecuteQuery returns the cached VMOutput if the same query was already executed on the current block, otherwise it
+// will execute the query and cache its result
+func (cache *scQueryServiceCache) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+	if !cache.isCacheable(query) {
+		return cache.scQueryService.ExecuteQuery(query)
+	}
+
+	blockHash := cache.blockChain.GetCurrentBlockHeaderHash()
+	cache.clearIfBlockChanged(blockHash)
+
+	key := computeSCQueryCacheKey(query, blockHash)
+	cachedOutput, ok := cache.cacher.Get(key)
+	if ok {
+		vmOutput, isVMOutput := cachedOutput.(*vmcommon.VMOutput)
+		if isVMOutput {
+			cache.metricsRegistry.IncrementCounter(prometheus.SCQueryCacheRequests, prometheus.CacheResultHit)
+			return vmOutput, nil
+		}
+	}
+
+	cache.metricsRegistry.IncrementCounter(prometheus.SCQueryCacheRequests, prometheus.CacheResultMiss)
+	vmOutput, err := cache.scQueryService.ExecuteQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	// a block committed during the execution could have changed the state the query ran on
+	blockHashAfterExecution := cache.blockChain.GetCurrentBlockHeaderHash()
+	if bytes.Equal(blockHash, blockHashAfterExecution) {
+		cache.cacher.Put(key, vmOutput, 0)
+	}
+
+	return vmOutput, nil
+}
+
+func (cache *scQueryServiceCache) isCacheable(query *process.SCQuery) bool {
+	if query == nil || len(query.ScAddress) == 0 || len(query.FuncName) == 0 {
+		return false
+	}
+	// the sync state check is done by the wrapped query service, so these queries are not answered from cache
+	if query.ShouldBeSynced {
+		return false
+	}
+
+	_, isExcluded := cache.excludedContracts[string(query.ScAddress)]
+
+	return !isExcluded
+}
+
+func (cache *scQueryServiceCache) clearIfBlockChanged(blockHash []byte) {
+	cache.mutBlockHash.Lock()
+	defer cache.mutBlockHash.Unlock()
+
+	if bytes.Equal(cache.lastBlockHash, blockHash) {
+		return
+	}
+
+	cache.cacher.Clear()
+	cache.lastBlockHash = blockHash
+}
+
+func computeSCQueryCacheKey(query *process.SCQuery, blockHash []byte) []byte {
+	callerAddress := query.CallerAddr
+	if callerAddress == nil {
+		callerAddress = query.ScAddress
+	}
+	callValue := ""
+	if query.CallValue != nil {
+		callValue = query.CallValue.String()
+	}
+
+	buff := bytes.NewBuffer(make([]byte, 0))
+	writeWithLength(buff, blockHash)
+	writeWithLength(buff, query.ScAddress)
+	writeWithLength(buff, []byte(query.FuncName))
+	writeWithLength(buff, callerAddress)
+	writeWithLength(buff, []byte(callValue))
+	for _, arg := range query.Arguments {
+		writeWithLength(buff, arg)
+	}
+
+	return buff.Bytes()
+}
+
+func writeWithLength(buff *bytes.Buffer, value []byte) {
+	lenBytes := make([]byte, 4)
+	binary.BigEndian.PutUint32(lenBytes, uint32(len(value)))
+
+	_, _ = buff.Write(lenBytes)
+	_, _ = buff.Write(value)
+}
+
+// ComputeScCallGasLimit will forward the call towards the wrapped query service
+func (cache *scQueryServiceCache) ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error) {
+	return cache.scQueryService.ComputeScCallGasLimit(tx)
+}
+
+// Close will clear the cache and close the wrapped query service
+func (cache *scQueryServiceCache) Close() error {
+	cache.cacher.Clear()
+
+	return cache.scQueryService.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cache *scQueryServiceCache) IsInterfaceNil() bool {
+	return cache == nil
+}
@@ -0,0 +1,240 @@
+package smartContract
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type queryCacheTestContext struct {
+	cache         *scQueryServiceCache
+	numExecutions int
+	blockHash     []byte
+}
+
+func createQueryCacheTestContext(t *testing.T, excludedContracts ...[]byte) *queryCacheTestContext {
+	tc := &queryCacheTestContext{
+		blockHash: []byte("block hash 1"),
+	}
+
+	cacher, _ := lrucache.NewCache(100)
+	args := ArgsSCQueryServiceCache{
+		SCQueryService: &mock.ScQueryStub{
+			ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+				tc.numExecutions++
+				return &vmcommon.VMOutput{ReturnData: [][]byte{[]byte(query.FuncName)}}, nil
+			},
+		},
+		BlockChain: &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderHashCalled: func() []byte {
+				return tc.blockHash
+			},
+		},
+		Cacher:            cacher,
+		ExcludedContracts: excludedContracts,
+		MetricsRegistry:   &testscommon.MetricsRegistryStub{},
+	}
+
+	var err error
+	tc.cache, err = NewSCQueryServiceCache(args)
+	require.Nil(t, err)
+
+	return tc
+}
+
+func createTestQuery(funcName string, args ...[]byte) *process.SCQuery {
+	return &process.SCQuery{
+		ScAddress: []byte("sc address"),
+		FuncName:  funcName,
+		Arguments: args,
+	}
+}
+
+func TestNewSCQueryServiceCache(t *testing.T) {
+	t.Parallel()
+
+	createArgs := func() ArgsSCQueryServiceCache {
+		cacher, _ := lrucache.NewCache(10)
+		return ArgsSCQueryServiceCache{
+			SCQueryService:  &mock.ScQueryStub{},
+			BlockChain:      &testscommon.ChainHandlerStub{},
+			Cacher:          cacher,
+			MetricsRegistry: &testscommon.MetricsRegistryStub{},
+		}
+	}
+
+	t.Run("nil query service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.SCQueryService = nil
+
+		cache, err := NewSCQueryServiceCache(args)
+		assert.Equal(t, process.ErrNilScQueryElement, err)
+		assert.True(t, check.IfNil(cache))
+	})
+	t.Run("nil block chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.BlockChain = nil
+
+		cache, err := NewSCQueryServiceCache(args)
+		assert.Equal(t, process.ErrNilBlockChain, err)
+		assert.True(t, check.IfNil(cache))
+	})
+	t.Run("nil cacher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Cacher = nil
+
+		cache, err := NewSCQueryServiceCache(args)
+		assert.Equal(t, process.ErrNilCacher, err)
+		assert.True(t, check.IfNil(cache))
+	})
+	t.Run("nil metrics registry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.MetricsRegistry = nil
+
+		cache, err := NewSCQueryServiceCache(args)
+		assert.Equal(t, process.ErrNilMetricsRegistry, err)
+		assert.True(t, check.IfNil(cache))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := NewSCQueryServiceCache(createArgs())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(cache))
+	})
+}
+
+func TestScQueryServiceCache_ExecuteQueryShouldCacheOnTheSameBlock(t *testing.T) {
+	t.Parallel()
+
+	tc := createQueryCacheTestContext(t)
+
+	vmOutput, err := tc.cache.ExecuteQuery(createTestQuery("getPrice", []byte("arg")))
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("getPrice")}, vmOutput.ReturnData)
+
+	cachedOutput, err := tc.cache.ExecuteQuery(createTestQuery("getPrice", []byte("arg")))
+	require.Nil(t, err)
+	assert.True(t, vmOutput == cachedOutput)
+	assert.Equal(t, 1, tc.numExecutions)
+
+	// any change in the query parameters should execute the query
+	_, _ = tc.cache.ExecuteQuery(createTestQuery("getPrice", []byte("other arg")))
+	_, _ = tc.cache.ExecuteQuery(createTestQuery("getPrice", []byte("ar"), []byte("g")))
+	_, _ = tc.cache.ExecuteQuery(createTestQuery("getPrices", []byte("arg")))
+	queryWithCaller := createTestQuery("getPrice", []byte("arg"))
+	queryWithCaller.CallerAddr = []byte("caller")
+	_, _ = tc.cache.ExecuteQuery(queryWithCaller)
+	queryWithValue := createTestQuery("getPrice", []byte("arg"))
+	queryWithValue.CallValue = big.NewInt(1)
+	_, _ = tc.cache.ExecuteQuery(queryWithValue)
+	assert.Equal(t, 6, tc.numExecutions)
+}
+
+func TestScQueryServiceCache_ExecuteQueryShouldInvalidateWhenTheBlockChanges(t *testing.T) {
+	t.Parallel()
+
+	tc := createQueryCacheTestContext(t)
+
+	_, _ = tc.cache.ExecuteQuery(createTestQuery("getPrice"))
+	assert.Equal(t, 1, tc.numExecutions)
+	assert.Equal(t, 1, tc.cache.cacher.Len())
+
+	tc.blockHash = []byte("block hash 2")
+	_, _ = tc.cache.ExecuteQuery(createTestQuery("getPrice"))
+	assert.Equal(t, 2, tc.numExecutions)
+	assert.Equal(t, 1, tc.cache.cacher.Len())
+
+	_, _ = tc.cache.ExecuteQuery(createTestQuery("getPrice"))
+	assert.Equal(t, 2, tc.numExecutions)
+}
+
+func TestScQueryServiceCache_ExecuteQueryShouldNotCacheWhenTheBlockChangedDuringExecution(t *testing.T) {
+	t.Parallel()
+
+	tc := createQueryCacheTestContext(t)
+	tc.cache.scQueryService = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			tc.numExecutions++
+			tc.blockHash = []byte("block hash 2")
+			return &vmcommon.VMOutput{}, nil
+		},
+	}
+
+	_, err := tc.cache.ExecuteQuery(createTestQuery("getPrice"))
+	require.Nil(t, err)
+	assert.Equal(t, 0, tc.cache.cacher.Len())
+}
+
+func TestScQueryServiceCache_ExecuteQueryShouldNotCacheErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	tc := createQueryCacheTestContext(t)
+	tc.cache.scQueryService = &mock.ScQueryStub{
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+			tc.numExecutions++
+			return nil, expectedErr
+		},
+	}
+
+	_, err := tc.cache.ExecuteQuery(createTestQuery("getPrice"))
+	assert.Equal(t, expectedErr, err)
+	_, err = tc.cache.ExecuteQuery(createTestQuery("getPrice"))
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, tc.numExecutions)
+}
+
+func TestScQueryServiceCache_ExecuteQueryShouldNotCacheTheExcludedContractsAndTheSyncedQueries(t *testing.T) {
+	t.Parallel()
+
+	tc := createQueryCacheTestContext(t, []byte("sc address"))
+
+	_, _ = tc.cache.ExecuteQuery(createTestQuery("getPrice"))
+	_, _ = tc.cache.ExecuteQuery(createTestQuery("getPrice"))
+	assert.Equal(t, 2, tc.numExecutions)
+
+	tc = createQueryCacheTestContext(t)
+	query := createTestQuery("getPrice")
+	query.ShouldBeSynced = true
+	_, _ = tc.cache.ExecuteQuery(query)
+	_, _ = tc.cache.ExecuteQuery(query)
+	assert.Equal(t, 2, tc.numExecutions)
+	assert.Equal(t, 0, tc.cache.cacher.Len())
+}
+
+func TestScQueryServiceCache_CloseShouldClearTheCacheAndCloseTheQueryService(t *testing.T) {
+	t.Parallel()
+
+	closeCalled := false
+	tc := createQueryCacheTestContext(t)
+	tc.cache.scQueryService = &mock.ScQueryStub{
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+
+	_, _ = tc.cache.ExecuteQuery(createTestQuery("getPrice"))
+	err := tc.cache.Close()
+	assert.Nil(t, err)
+	assert.True(t, closeCalled)
+	assert.Equal(t, 0, tc.cache.cacher.Len())
+}
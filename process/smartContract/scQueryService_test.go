@@ -33,6 +33,7 @@ func createMockArgumentsForSCQuery() ArgsNewSCQueryService {
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             &mock.BootstrapperStub{},
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 	}
 }
 
@@ -102,6 +103,17 @@ func TestNewSCQueryService_NilBootstrapperShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilBootstrapper, err)
 }
 
+func TestNewSCQueryService_NilMetricsRegistryShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.MetricsRegistry = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilMetricsRegistry, err)
+}
+
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -745,6 +757,7 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             &mock.BootstrapperStub{},
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		MetricsRegistry:          &testscommon.MetricsRegistryStub{},
 	}
 
 	target, _ := NewSCQueryService(argsNewSCQueryService)
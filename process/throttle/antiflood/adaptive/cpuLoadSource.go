@@ -0,0 +1,44 @@
+package adaptive
+
+import (
+	"math"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.LoadSource = (*cpuLoadSource)(nil)
+
+const cpuLoadSourceName = "cpu"
+
+type cpuLoadSource struct {
+	cpuStatistics CpuStatisticsHandler
+}
+
+// NewCpuLoadSource creates a load source that reports the CPU usage of the current process
+func NewCpuLoadSource(cpuStatistics CpuStatisticsHandler) (*cpuLoadSource, error) {
+	if cpuStatistics == nil {
+		return nil, process.ErrNilCpuStatisticsHandler
+	}
+
+	return &cpuLoadSource{
+		cpuStatistics: cpuStatistics,
+	}, nil
+}
+
+// Name returns the name of this load source
+func (cls *cpuLoadSource) Name() string {
+	return cpuLoadSourceName
+}
+
+// Load returns the CPU usage as a ratio. It is a blocking call for a bounded time (1 second) as the CPU
+// usage is measured on the spot
+func (cls *cpuLoadSource) Load() float64 {
+	cls.cpuStatistics.ComputeStatistics()
+
+	return math.Min(float64(cls.cpuStatistics.CpuPercentUsage())/percentDivider, 1)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cls *cpuLoadSource) IsInterfaceNil() bool {
+	return cls == nil
+}
@@ -0,0 +1,50 @@
+package adaptive
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/stretchr/testify/assert"
+)
+
+type cpuStatisticsStub struct {
+	numComputeCalls int
+	cpuPercentUsage uint64
+}
+
+func (css *cpuStatisticsStub) ComputeStatistics() {
+	css.numComputeCalls++
+}
+
+func (css *cpuStatisticsStub) CpuPercentUsage() uint64 {
+	return css.cpuPercentUsage
+}
+
+func TestNewCpuLoadSource(t *testing.T) {
+	t.Parallel()
+
+	cls, err := NewCpuLoadSource(nil)
+	assert.Equal(t, process.ErrNilCpuStatisticsHandler, err)
+	assert.True(t, check.IfNil(cls))
+
+	cls, err = NewCpuLoadSource(&cpuStatisticsStub{})
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(cls))
+	assert.Equal(t, "cpu", cls.Name())
+}
+
+func TestCpuLoadSource_Load(t *testing.T) {
+	t.Parallel()
+
+	cpuStats := &cpuStatisticsStub{
+		cpuPercentUsage: 45,
+	}
+	cls, _ := NewCpuLoadSource(cpuStats)
+
+	assert.Equal(t, 0.45, cls.Load())
+	assert.Equal(t, 1, cpuStats.numComputeCalls)
+
+	cpuStats.cpuPercentUsage = 140
+	assert.Equal(t, 1.0, cls.Load())
+}
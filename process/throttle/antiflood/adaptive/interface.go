@@ -0,0 +1,16 @@
+package adaptive
+
+import "github.com/ElrondNetwork/elrond-go/common"
+
+// AntifloodQuotaHandler defines the behavior of an antiflood component whose quotas can be scaled
+type AntifloodQuotaHandler interface {
+	SetQuotaFactor(factor float64)
+	GetQuotaInfo() common.AntifloodQuotaInfo
+	IsInterfaceNil() bool
+}
+
+// CpuStatisticsHandler defines the behavior of a component able to compute the CPU usage
+type CpuStatisticsHandler interface {
+	ComputeStatistics()
+	CpuPercentUsage() uint64
+}
@@ -0,0 +1,255 @@
+package adaptive
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var log = logger.GetOrCreate("process/throttle/antiflood/adaptive")
+
+const (
+	percentDivider             = 100.0
+	maxLoadPercent             = 100
+	defaultQuotaPercent        = 100
+	defaultQuotaFactor         = 1.0
+	minSampleIntervalInSeconds = 1
+	minNumConsecutiveSamples   = 1
+)
+
+// ArgQuotaController is the DTO used to create a new adaptive quota controller
+type ArgQuotaController struct {
+	Config           config.AdaptiveQuotasConfig
+	LoadSources      []process.LoadSource
+	AntifloodHandler AntifloodQuotaHandler
+	StatusHandler    core.AppStatusHandler
+}
+
+type quotaController struct {
+	loadSources           []process.LoadSource
+	antifloodHandler      AntifloodQuotaHandler
+	statusHandler         core.AppStatusHandler
+	highLoad              float64
+	lowLoad               float64
+	numConsecutiveSamples uint32
+	minFactor             float64
+	maxFactor             float64
+	tightenStep           float64
+	relaxStep             float64
+	mutFactor             sync.Mutex
+	factor                float64
+	numHighLoadSamples    uint32
+	numLowLoadSamples     uint32
+	cancelFunc            context.CancelFunc
+}
+
+// NewQuotaController creates a component that periodically samples the node's load and tightens or relaxes the
+// antiflood quotas accordingly. The thresholds are applied with hysteresis so the quotas do not oscillate
+func NewQuotaController(args ArgQuotaController) (*quotaController, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	qc := &quotaController{
+		loadSources:           args.LoadSources,
+		antifloodHandler:      args.AntifloodHandler,
+		statusHandler:         args.StatusHandler,
+		highLoad:              float64(args.Config.HighLoadPercent) / percentDivider,
+		lowLoad:               float64(args.Config.LowLoadPercent) / percentDivider,
+		numConsecutiveSamples: args.Config.NumConsecutiveSamples,
+		minFactor:             float64(args.Config.MinQuotaPercent) / percentDivider,
+		maxFactor:             float64(args.Config.MaxQuotaPercent) / percentDivider,
+		tightenStep:           float64(args.Config.TightenStepPercent) / percentDivider,
+		relaxStep:             float64(args.Config.RelaxStepPercent) / percentDivider,
+		factor:                defaultQuotaFactor,
+	}
+
+	var ctx context.Context
+	ctx, qc.cancelFunc = context.WithCancel(context.Background())
+	sampleInterval := time.Duration(args.Config.SampleIntervalInSeconds) * time.Second
+	go qc.sampleContinuously(ctx, sampleInterval)
+
+	return qc, nil
+}
+
+func checkArgs(args ArgQuotaController) error {
+	if len(args.LoadSources) == 0 {
+		return process.ErrEmptyLoadSources
+	}
+	for _, loadSource := range args.LoadSources {
+		if check.IfNil(loadSource) {
+			return process.ErrNilLoadSource
+		}
+	}
+	if check.IfNil(args.AntifloodHandler) {
+		return process.ErrNilAntifloodHandler
+	}
+	if check.IfNil(args.StatusHandler) {
+		return process.ErrNilAppStatusHandler
+	}
+
+	cfg := args.Config
+	if cfg.SampleIntervalInSeconds < minSampleIntervalInSeconds {
+		return fmt.Errorf("%w for SampleIntervalInSeconds, minimum %d, provided %d",
+			process.ErrInvalidValue, minSampleIntervalInSeconds, cfg.SampleIntervalInSeconds)
+	}
+	if cfg.HighLoadPercent > maxLoadPercent {
+		return fmt.Errorf("%w for HighLoadPercent, maximum %d, provided %d",
+			process.ErrInvalidValue, maxLoadPercent, cfg.HighLoadPercent)
+	}
+	if cfg.LowLoadPercent >= cfg.HighLoadPercent {
+		return fmt.Errorf("%w for LowLoadPercent, it should be lower than HighLoadPercent %d, provided %d",
+			process.ErrInvalidValue, cfg.HighLoadPercent, cfg.LowLoadPercent)
+	}
+	if cfg.NumConsecutiveSamples < minNumConsecutiveSamples {
+		return fmt.Errorf("%w for NumConsecutiveSamples, minimum %d, provided %d",
+			process.ErrInvalidValue, minNumConsecutiveSamples, cfg.NumConsecutiveSamples)
+	}
+	if cfg.MinQuotaPercent == 0 || cfg.MinQuotaPercent > defaultQuotaPercent {
+		return fmt.Errorf("%w for MinQuotaPercent, it should be in the (0, %d] interval, provided %d",
+			process.ErrInvalidValue, defaultQuotaPercent, cfg.MinQuotaPercent)
+	}
+	if cfg.MaxQuotaPercent < defaultQuotaPercent {
+		return fmt.Errorf("%w for MaxQuotaPercent, minimum %d, provided %d",
+			process.ErrInvalidValue, defaultQuotaPercent, cfg.MaxQuotaPercent)
+	}
+	if cfg.TightenStepPercent == 0 {
+		return fmt.Errorf("%w for TightenStepPercent, provided 0", process.ErrInvalidValue)
+	}
+	if cfg.RelaxStepPercent == 0 {
+		return fmt.Errorf("%w for RelaxStepPercent, provided 0", process.ErrInvalidValue)
+	}
+
+	return nil
+}
+
+func (qc *quotaController) sampleContinuously(ctx context.Context, sampleInterval time.Duration) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("adaptive quotaController's go routine is stopping...")
+			return
+		case <-time.After(sampleInterval):
+		}
+
+		qc.sample()
+	}
+}
+
+func (qc *quotaController) sample() {
+	load, loadSourceName := qc.computeLoad()
+
+	qc.mutFactor.Lock()
+	oldFactor := qc.factor
+	qc.factor = qc.computeFactor(load)
+	newFactor := qc.factor
+	qc.mutFactor.Unlock()
+
+	if newFactor != oldFactor {
+		qc.antifloodHandler.SetQuotaFactor(newFactor)
+
+		log.Debug("adaptive antiflood quotas changed",
+			"load", load,
+			"load source", loadSourceName,
+			"old quota factor", oldFactor,
+			"new quota factor", newFactor,
+		)
+	}
+
+	qc.updateMetrics(load, newFactor)
+}
+
+// computeLoad returns the highest load reported by the load sources, together with the name of that source
+func (qc *quotaController) computeLoad() (float64, string) {
+	maxLoad := 0.0
+	maxLoadSourceName := ""
+	for _, loadSource := range qc.loadSources {
+		load := loadSource.Load()
+		log.Trace("quotaController.computeLoad", "source", loadSource.Name(), "load", load)
+
+		if load >= maxLoad {
+			maxLoad = load
+			maxLoadSourceName = loadSource.Name()
+		}
+	}
+
+	return math.Min(maxLoad, 1), maxLoadSourceName
+}
+
+func (qc *quotaController) computeFactor(load float64) float64 {
+	switch {
+	case load >= qc.highLoad:
+		qc.numLowLoadSamples = 0
+		qc.numHighLoadSamples++
+		if qc.numHighLoadSamples < qc.numConsecutiveSamples {
+			return qc.factor
+		}
+
+		qc.numHighLoadSamples = 0
+		return math.Max(qc.minFactor, qc.factor-qc.tightenStep)
+	case load <= qc.lowLoad:
+		qc.numHighLoadSamples = 0
+		qc.numLowLoadSamples++
+		if qc.numLowLoadSamples < qc.numConsecutiveSamples {
+			return qc.factor
+		}
+
+		qc.numLowLoadSamples = 0
+		return math.Min(qc.maxFactor, qc.factor+qc.relaxStep)
+	default:
+		qc.numHighLoadSamples = 0
+		qc.numLowLoadSamples = 0
+		return qc.factor
+	}
+}
+
+func (qc *quotaController) updateMetrics(load float64, factor float64) {
+	qc.statusHandler.SetUInt64Value(common.MetricAntifloodLoadPercent, toPercent(load))
+	qc.statusHandler.SetUInt64Value(common.MetricAntifloodQuotaPercent, toPercent(factor))
+
+	quotaInfo := qc.antifloodHandler.GetQuotaInfo()
+	for _, fp := range quotaInfo.FloodPreventers {
+		qc.statusHandler.SetUInt64Value(
+			common.MetricAntifloodMaxNumMessagesPerPeer+"_"+fp.Name,
+			uint64(fp.MaxNumMessagesPerPeer),
+		)
+		qc.statusHandler.SetUInt64Value(
+			common.MetricAntifloodMaxTotalSizePerPeer+"_"+fp.Name,
+			fp.MaxTotalSizePerPeer,
+		)
+	}
+}
+
+func toPercent(value float64) uint64 {
+	return uint64(math.Round(value * percentDivider))
+}
+
+// QuotaFactor returns the factor currently applied on the configured antiflood quotas
+func (qc *quotaController) QuotaFactor() float64 {
+	qc.mutFactor.Lock()
+	defer qc.mutFactor.Unlock()
+
+	return qc.factor
+}
+
+// Close stops the sampling go routine
+func (qc *quotaController) Close() error {
+	qc.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qc *quotaController) IsInterfaceNil() bool {
+	return qc == nil
+}
@@ -0,0 +1,228 @@
+package adaptive
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgQuotaController() ArgQuotaController {
+	return ArgQuotaController{
+		Config: config.AdaptiveQuotasConfig{
+			Enabled:                 true,
+			SampleIntervalInSeconds: 100,
+			HighLoadPercent:         80,
+			LowLoadPercent:          50,
+			NumConsecutiveSamples:   2,
+			MinQuotaPercent:         50,
+			MaxQuotaPercent:         120,
+			TightenStepPercent:      20,
+			RelaxStepPercent:        10,
+		},
+		LoadSources:      []process.LoadSource{&mock.LoadSourceStub{}},
+		AntifloodHandler: &mock.P2PAntifloodHandlerStub{},
+		StatusHandler:    statusHandler.NewAppStatusHandlerMock(),
+	}
+}
+
+func TestNewQuotaController(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty load sources should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgQuotaController()
+		args.LoadSources = nil
+
+		qc, err := NewQuotaController(args)
+		assert.Equal(t, process.ErrEmptyLoadSources, err)
+		assert.True(t, check.IfNil(qc))
+	})
+	t.Run("nil load source should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgQuotaController()
+		args.LoadSources = append(args.LoadSources, nil)
+
+		qc, err := NewQuotaController(args)
+		assert.Equal(t, process.ErrNilLoadSource, err)
+		assert.True(t, check.IfNil(qc))
+	})
+	t.Run("nil antiflood handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgQuotaController()
+		args.AntifloodHandler = nil
+
+		qc, err := NewQuotaController(args)
+		assert.Equal(t, process.ErrNilAntifloodHandler, err)
+		assert.True(t, check.IfNil(qc))
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgQuotaController()
+		args.StatusHandler = nil
+
+		qc, err := NewQuotaController(args)
+		assert.Equal(t, process.ErrNilAppStatusHandler, err)
+		assert.True(t, check.IfNil(qc))
+	})
+	t.Run("invalid config values should error", func(t *testing.T) {
+		t.Parallel()
+
+		testInvalidConfig(t, "SampleIntervalInSeconds", func(cfg *config.AdaptiveQuotasConfig) {
+			cfg.SampleIntervalInSeconds = 0
+		})
+		testInvalidConfig(t, "HighLoadPercent", func(cfg *config.AdaptiveQuotasConfig) {
+			cfg.HighLoadPercent = 101
+		})
+		testInvalidConfig(t, "LowLoadPercent", func(cfg *config.AdaptiveQuotasConfig) {
+			cfg.LowLoadPercent = cfg.HighLoadPercent
+		})
+		testInvalidConfig(t, "NumConsecutiveSamples", func(cfg *config.AdaptiveQuotasConfig) {
+			cfg.NumConsecutiveSamples = 0
+		})
+		testInvalidConfig(t, "MinQuotaPercent", func(cfg *config.AdaptiveQuotasConfig) {
+			cfg.MinQuotaPercent = 0
+		})
+		testInvalidConfig(t, "MinQuotaPercent", func(cfg *config.AdaptiveQuotasConfig) {
+			cfg.MinQuotaPercent = 101
+		})
+		testInvalidConfig(t, "MaxQuotaPercent", func(cfg *config.AdaptiveQuotasConfig) {
+			cfg.MaxQuotaPercent = 99
+		})
+		testInvalidConfig(t, "TightenStepPercent", func(cfg *config.AdaptiveQuotasConfig) {
+			cfg.TightenStepPercent = 0
+		})
+		testInvalidConfig(t, "RelaxStepPercent", func(cfg *config.AdaptiveQuotasConfig) {
+			cfg.RelaxStepPercent = 0
+		})
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		qc, err := NewQuotaController(createMockArgQuotaController())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(qc))
+		assert.Equal(t, 1.0, qc.QuotaFactor())
+
+		assert.Nil(t, qc.Close())
+	})
+}
+
+func testInvalidConfig(t *testing.T, fieldName string, modifier func(cfg *config.AdaptiveQuotasConfig)) {
+	args := createMockArgQuotaController()
+	modifier(&args.Config)
+
+	qc, err := NewQuotaController(args)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	assert.True(t, strings.Contains(err.Error(), fieldName))
+	assert.True(t, check.IfNil(qc))
+}
+
+func TestQuotaController_SampleShouldApplyHysteresis(t *testing.T) {
+	t.Parallel()
+
+	load := 0.0
+	args := createMockArgQuotaController()
+	args.LoadSources = []process.LoadSource{
+		&mock.LoadSourceStub{
+			LoadCalled: func() float64 {
+				return 0.1
+			},
+		},
+		&mock.LoadSourceStub{
+			LoadCalled: func() float64 {
+				return load
+			},
+		},
+	}
+	appliedFactors := make([]float64, 0)
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		SetQuotaFactorCalled: func(factor float64) {
+			appliedFactors = append(appliedFactors, factor)
+		},
+	}
+	qc, err := NewQuotaController(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = qc.Close()
+	}()
+
+	load = 0.9
+	qc.sample()
+	assert.Equal(t, 0, len(appliedFactors), "a single high load sample should not change the quotas")
+
+	qc.sample()
+	require.Equal(t, 1, len(appliedFactors))
+	assert.InDelta(t, 0.8, appliedFactors[0], 0.001)
+
+	load = 0.6
+	qc.sample()
+	load = 0.9
+	qc.sample()
+	assert.Equal(t, 1, len(appliedFactors), "a load between thresholds should reset the consecutive samples")
+
+	for i := 0; i < 6; i++ {
+		qc.sample()
+	}
+	require.Equal(t, 3, len(appliedFactors))
+	assert.InDelta(t, 0.5, appliedFactors[2], 0.001, "the quotas should not drop under the minimum")
+
+	load = 0.2
+	for i := 0; i < 20; i++ {
+		qc.sample()
+	}
+	assert.InDelta(t, 1.2, qc.QuotaFactor(), 0.001, "the quotas should not rise over the maximum")
+}
+
+func TestQuotaController_SampleShouldUpdateMetrics(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgQuotaController()
+	args.Config.NumConsecutiveSamples = 1
+	args.LoadSources = []process.LoadSource{
+		&mock.LoadSourceStub{
+			LoadCalled: func() float64 {
+				return 1.5
+			},
+		},
+	}
+	args.AntifloodHandler = &mock.P2PAntifloodHandlerStub{
+		GetQuotaInfoCalled: func() common.AntifloodQuotaInfo {
+			return common.AntifloodQuotaInfo{
+				FloodPreventers: []common.FloodPreventerQuotaInfo{
+					{
+						Name:                  "fast_reacting",
+						MaxNumMessagesPerPeer: 112,
+						MaxTotalSizePerPeer:   1024,
+					},
+				},
+			}
+		},
+	}
+	appStatusHandler := statusHandler.NewAppStatusHandlerMock()
+	args.StatusHandler = appStatusHandler
+	qc, err := NewQuotaController(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = qc.Close()
+	}()
+
+	qc.sample()
+
+	assert.Equal(t, uint64(100), appStatusHandler.GetUint64(common.MetricAntifloodLoadPercent))
+	assert.Equal(t, uint64(80), appStatusHandler.GetUint64(common.MetricAntifloodQuotaPercent))
+	assert.Equal(t, uint64(112), appStatusHandler.GetUint64(common.MetricAntifloodMaxNumMessagesPerPeer+"_fast_reacting"))
+	assert.Equal(t, uint64(1024), appStatusHandler.GetUint64(common.MetricAntifloodMaxTotalSizePerPeer+"_fast_reacting"))
+}
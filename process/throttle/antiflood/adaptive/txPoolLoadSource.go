@@ -0,0 +1,63 @@
+package adaptive
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.LoadSource = (*txPoolLoadSource)(nil)
+
+const txPoolLoadSourceName = "txpool"
+
+type txPoolLoadSource struct {
+	txPool       dataRetriever.ShardedDataCacherNotifier
+	maxNumTxs    uint32
+	maxTotalSize uint64
+}
+
+// NewTxPoolLoadSource creates a load source that reports the fill ratio of the transactions pool, computed both
+// on the number of transactions and on their total size
+func NewTxPoolLoadSource(
+	txPool dataRetriever.ShardedDataCacherNotifier,
+	maxNumTxs uint32,
+	maxTotalSize uint64,
+) (*txPoolLoadSource, error) {
+	if check.IfNil(txPool) {
+		return nil, process.ErrNilTransactionPool
+	}
+	if maxNumTxs == 0 {
+		return nil, fmt.Errorf("%w for maxNumTxs, provided 0", process.ErrInvalidValue)
+	}
+	if maxTotalSize == 0 {
+		return nil, fmt.Errorf("%w for maxTotalSize, provided 0", process.ErrInvalidValue)
+	}
+
+	return &txPoolLoadSource{
+		txPool:       txPool,
+		maxNumTxs:    maxNumTxs,
+		maxTotalSize: maxTotalSize,
+	}, nil
+}
+
+// Name returns the name of this load source
+func (tpls *txPoolLoadSource) Name() string {
+	return txPoolLoadSourceName
+}
+
+// Load returns the transactions pool fill ratio
+func (tpls *txPoolLoadSource) Load() float64 {
+	counts := tpls.txPool.GetCounts()
+	numTxsRatio := float64(counts.GetTotal()) / float64(tpls.maxNumTxs)
+	totalSizeRatio := float64(counts.GetTotalSize()) / float64(tpls.maxTotalSize)
+
+	return math.Min(math.Max(numTxsRatio, totalSizeRatio), 1)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tpls *txPoolLoadSource) IsInterfaceNil() bool {
+	return tpls == nil
+}
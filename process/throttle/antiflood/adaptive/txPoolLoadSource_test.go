@@ -0,0 +1,53 @@
+package adaptive
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/counting"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTxPoolLoadSource(t *testing.T) {
+	t.Parallel()
+
+	tpls, err := NewTxPoolLoadSource(nil, 10, 10)
+	assert.Equal(t, process.ErrNilTransactionPool, err)
+	assert.True(t, check.IfNil(tpls))
+
+	tpls, err = NewTxPoolLoadSource(testscommon.NewShardedDataStub(), 0, 10)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	assert.True(t, check.IfNil(tpls))
+
+	tpls, err = NewTxPoolLoadSource(testscommon.NewShardedDataStub(), 10, 0)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	assert.True(t, check.IfNil(tpls))
+
+	tpls, err = NewTxPoolLoadSource(testscommon.NewShardedDataStub(), 10, 10)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(tpls))
+	assert.Equal(t, "txpool", tpls.Name())
+}
+
+func TestTxPoolLoadSource_Load(t *testing.T) {
+	t.Parallel()
+
+	counts := counting.NewConcurrentShardedCountsWithSize()
+	txPool := testscommon.NewShardedDataStub()
+	txPool.GetCountsCalled = func() counting.CountsWithSize {
+		return counts
+	}
+	tpls, _ := NewTxPoolLoadSource(txPool, 100, 1000)
+
+	counts.PutCounts("0", 25, 100)
+	assert.Equal(t, 0.25, tpls.Load())
+
+	counts.PutCounts("0", 25, 500)
+	assert.Equal(t, 0.5, tpls.Load())
+
+	counts.PutCounts("1", 200, 0)
+	assert.Equal(t, 1.0, tpls.Load())
+}
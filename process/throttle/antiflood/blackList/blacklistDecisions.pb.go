@@ -0,0 +1,758 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blacklistDecisions.proto
+
+package blackList
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlacklistDecision holds a manual blacklist decision. The timestamps are in nanoseconds
+type BlacklistDecision struct {
+	Pid           []byte `protobuf:"bytes,1,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	Reason        string `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	BlacklistedAt int64  `protobuf:"varint,3,opt,name=BlacklistedAt,proto3" json:"BlacklistedAt,omitempty"`
+	ExpiresAt     int64  `protobuf:"varint,4,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+}
+
+func (m *BlacklistDecision) Reset()      { *m = BlacklistDecision{} }
+func (*BlacklistDecision) ProtoMessage() {}
+func (*BlacklistDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3bfe0d9a527452d, []int{0}
+}
+func (m *BlacklistDecision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlacklistDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlacklistDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlacklistDecision.Merge(m, src)
+}
+func (m *BlacklistDecision) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlacklistDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlacklistDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlacklistDecision proto.InternalMessageInfo
+
+func (m *BlacklistDecision) GetPid() []byte {
+	if m != nil {
+		return m.Pid
+	}
+	return nil
+}
+
+func (m *BlacklistDecision) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *BlacklistDecision) GetBlacklistedAt() int64 {
+	if m != nil {
+		return m.BlacklistedAt
+	}
+	return 0
+}
+
+func (m *BlacklistDecision) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// BlacklistDecisions holds all the manual blacklist decisions which did not expire
+type BlacklistDecisions struct {
+	Decisions []*BlacklistDecision `protobuf:"bytes,1,rep,name=Decisions,proto3" json:"Decisions,omitempty"`
+}
+
+func (m *BlacklistDecisions) Reset()      { *m = BlacklistDecisions{} }
+func (*BlacklistDecisions) ProtoMessage() {}
+func (*BlacklistDecisions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3bfe0d9a527452d, []int{1}
+}
+func (m *BlacklistDecisions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlacklistDecisions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlacklistDecisions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlacklistDecisions.Merge(m, src)
+}
+func (m *BlacklistDecisions) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlacklistDecisions) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlacklistDecisions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlacklistDecisions proto.InternalMessageInfo
+
+func (m *BlacklistDecisions) GetDecisions() []*BlacklistDecision {
+	if m != nil {
+		return m.Decisions
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BlacklistDecision)(nil), "proto.BlacklistDecision")
+	proto.RegisterType((*BlacklistDecisions)(nil), "proto.BlacklistDecisions")
+}
+
+func init() { proto.RegisterFile("blacklistDecisions.proto", fileDescriptor_e3bfe0d9a527452d) }
+
+var fileDescriptor_e3bfe0d9a527452d = []byte{
+	// 266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x48, 0xca, 0x49, 0x4c,
+	0xce, 0xce, 0xc9, 0x2c, 0x2e, 0x71, 0x49, 0x4d, 0xce, 0x2c, 0xce, 0xcc, 0xcf, 0x2b, 0xd6, 0x2b,
+	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x05, 0x53, 0x52, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
+	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9, 0xe9, 0xf9, 0xfa, 0x60, 0xe1, 0xa4, 0xd2, 0x34, 0x30,
+	0x0f, 0xcc, 0x01, 0xb3, 0x20, 0xba, 0x94, 0x1a, 0x19, 0xb9, 0x04, 0x9d, 0xd0, 0x8d, 0x14, 0x12,
+	0xe0, 0x62, 0x0e, 0xc8, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x09, 0x02, 0x31, 0x85, 0xc4,
+	0xb8, 0xd8, 0x82, 0x52, 0x13, 0x8b, 0xf3, 0xf3, 0x24, 0x98, 0x14, 0x18, 0x35, 0x38, 0x83, 0xa0,
+	0x3c, 0x21, 0x15, 0x2e, 0x5e, 0xb8, 0xf6, 0xd4, 0x14, 0xc7, 0x12, 0x09, 0x66, 0x05, 0x46, 0x0d,
+	0xe6, 0x20, 0x54, 0x41, 0x21, 0x19, 0x2e, 0x4e, 0xd7, 0x8a, 0x82, 0xcc, 0xa2, 0xd4, 0x62, 0xc7,
+	0x12, 0x09, 0x16, 0xb0, 0x0a, 0x84, 0x80, 0x92, 0x0f, 0x97, 0x10, 0x86, 0x13, 0x8a, 0x85, 0xcc,
+	0xb8, 0x38, 0xe1, 0x1c, 0x09, 0x46, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x09, 0x88, 0xa3, 0xf5, 0x30,
+	0x54, 0x07, 0x21, 0x94, 0x3a, 0x39, 0x5f, 0x78, 0x28, 0xc7, 0x70, 0xe3, 0xa1, 0x1c, 0xc3, 0x87,
+	0x87, 0x72, 0x8c, 0x0d, 0x8f, 0xe4, 0x18, 0x57, 0x3c, 0x92, 0x63, 0x3c, 0xf1, 0x48, 0x8e, 0xf1,
+	0xc2, 0x23, 0x39, 0xc6, 0x1b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x7c, 0xf1, 0x48, 0x8e,
+	0xe1, 0xc3, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
+	0x8e, 0x21, 0x8a, 0x13, 0x1c, 0xb6, 0x3e, 0x99, 0xc5, 0x25, 0x49, 0x6c, 0x60, 0x8b, 0x8c, 0x01,
+	0x03, 0x00, 0x7e, 0x20, 0x0a, 0xf2, 0x6f, 0x01, 0x00, 0x00,
+}
+
+func (this *BlacklistDecision) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlacklistDecision)
+	if !ok {
+		that2, ok := that.(BlacklistDecision)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Pid, that1.Pid) {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.BlacklistedAt != that1.BlacklistedAt {
+		return false
+	}
+	if this.ExpiresAt != that1.ExpiresAt {
+		return false
+	}
+	return true
+}
+func (this *BlacklistDecisions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlacklistDecisions)
+	if !ok {
+		that2, ok := that.(BlacklistDecisions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Decisions) != len(that1.Decisions) {
+		return false
+	}
+	for i := range this.Decisions {
+		if !this.Decisions[i].Equal(that1.Decisions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *BlacklistDecision) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&blackList.BlacklistDecision{")
+	s = append(s, "Pid: "+fmt.Sprintf("%#v", this.Pid)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "BlacklistedAt: "+fmt.Sprintf("%#v", this.BlacklistedAt)+",\n")
+	s = append(s, "ExpiresAt: "+fmt.Sprintf("%#v", this.ExpiresAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlacklistDecisions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&blackList.BlacklistDecisions{")
+	if this.Decisions != nil {
+		s = append(s, "Decisions: "+fmt.Sprintf("%#v", this.Decisions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringBlacklistDecisions(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *BlacklistDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlacklistDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlacklistDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintBlacklistDecisions(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlacklistedAt != 0 {
+		i = encodeVarintBlacklistDecisions(dAtA, i, uint64(m.BlacklistedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBlacklistDecisions(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pid) > 0 {
+		i -= len(m.Pid)
+		copy(dAtA[i:], m.Pid)
+		i = encodeVarintBlacklistDecisions(dAtA, i, uint64(len(m.Pid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlacklistDecisions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlacklistDecisions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlacklistDecisions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Decisions) > 0 {
+		for iNdEx := len(m.Decisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Decisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlacklistDecisions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlacklistDecisions(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlacklistDecisions(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlacklistDecision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Pid)
+	if l > 0 {
+		n += 1 + l + sovBlacklistDecisions(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBlacklistDecisions(uint64(l))
+	}
+	if m.BlacklistedAt != 0 {
+		n += 1 + sovBlacklistDecisions(uint64(m.BlacklistedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovBlacklistDecisions(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *BlacklistDecisions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Decisions) > 0 {
+		for _, e := range m.Decisions {
+			l = e.Size()
+			n += 1 + l + sovBlacklistDecisions(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovBlacklistDecisions(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlacklistDecisions(x uint64) (n int) {
+	return sovBlacklistDecisions(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *BlacklistDecision) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlacklistDecision{`,
+		`Pid:` + fmt.Sprintf("%v", this.Pid) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`BlacklistedAt:` + fmt.Sprintf("%v", this.BlacklistedAt) + `,`,
+		`ExpiresAt:` + fmt.Sprintf("%v", this.ExpiresAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlacklistDecisions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDecisions := "[]*BlacklistDecision{"
+	for _, f := range this.Decisions {
+		repeatedStringForDecisions += strings.Replace(f.String(), "BlacklistDecision", "BlacklistDecision", 1) + ","
+	}
+	repeatedStringForDecisions += "}"
+	s := strings.Join([]string{`&BlacklistDecisions{`,
+		`Decisions:` + repeatedStringForDecisions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringBlacklistDecisions(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *BlacklistDecision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlacklistDecisions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlacklistDecision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlacklistDecision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pid", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklistDecisions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlacklistDecisions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklistDecisions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pid = append(m.Pid[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pid == nil {
+				m.Pid = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklistDecisions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlacklistDecisions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklistDecisions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedAt", wireType)
+			}
+			m.BlacklistedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklistDecisions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlacklistedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklistDecisions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlacklistDecisions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlacklistDecisions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlacklistDecisions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlacklistDecisions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlacklistDecisions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlacklistDecisions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlacklistDecisions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlacklistDecisions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlacklistDecisions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlacklistDecisions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decisions = append(m.Decisions, &BlacklistDecision{})
+			if err := m.Decisions[len(m.Decisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlacklistDecisions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBlacklistDecisions
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBlacklistDecisions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlacklistDecisions(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlacklistDecisions
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlacklistDecisions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlacklistDecisions
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlacklistDecisions
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlacklistDecisions
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlacklistDecisions
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlacklistDecisions        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlacklistDecisions          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlacklistDecisions = fmt.Errorf("proto: unexpected end of group")
+)
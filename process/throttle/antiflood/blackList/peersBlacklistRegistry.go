@@ -0,0 +1,253 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. blacklistDecisions.proto
+package blackList
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ process.PeerBlacklistRegistry = (*peersBlacklistRegistry)(nil)
+
+const (
+	floodingReason             = "flooding"
+	manualDecisionsKey         = "manualBlacklistDecisions"
+	minManualBlacklistDuration = time.Second
+)
+
+// ArgPeersBlacklistRegistry is the DTO used to create a new peers blacklist registry
+type ArgPeersBlacklistRegistry struct {
+	Storer      storage.Storer
+	Marshalizer marshal.Marshalizer
+}
+
+type blacklistEntry struct {
+	reason        string
+	isManual      bool
+	blacklistedAt time.Time
+	expiresAt     time.Time
+}
+
+type peersBlacklistRegistry struct {
+	mut            sync.RWMutex
+	entries        map[core.PeerID]*blacklistEntry
+	storer         storage.Storer
+	marshalizer    marshal.Marshalizer
+	getTimeHandler func() time.Time
+}
+
+// NewPeersBlacklistRegistry creates a new peers blacklist registry. The manual decisions saved in the provided storer
+// and not yet expired are loaded back
+func NewPeersBlacklistRegistry(args ArgPeersBlacklistRegistry) (*peersBlacklistRegistry, error) {
+	if check.IfNil(args.Storer) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	pbr := &peersBlacklistRegistry{
+		entries:        make(map[core.PeerID]*blacklistEntry),
+		storer:         args.Storer,
+		marshalizer:    args.Marshalizer,
+		getTimeHandler: time.Now,
+	}
+	pbr.loadManualDecisions()
+
+	return pbr, nil
+}
+
+func (pbr *peersBlacklistRegistry) loadManualDecisions() {
+	buff, err := pbr.storer.Get([]byte(manualDecisionsKey))
+	if err != nil {
+		return
+	}
+
+	decisions := &BlacklistDecisions{}
+	err = pbr.marshalizer.Unmarshal(decisions, buff)
+	if err != nil {
+		log.Warn("peersBlacklistRegistry: can not unmarshal the manual blacklist decisions", "error", err)
+		return
+	}
+
+	now := pbr.getTimeHandler()
+	for _, decision := range decisions.Decisions {
+		if decision == nil || len(decision.Pid) == 0 {
+			continue
+		}
+
+		expiresAt := time.Unix(0, decision.ExpiresAt)
+		if !expiresAt.After(now) {
+			continue
+		}
+
+		pbr.entries[core.PeerID(decision.Pid)] = &blacklistEntry{
+			reason:        decision.Reason,
+			isManual:      true,
+			blacklistedAt: time.Unix(0, decision.BlacklistedAt),
+			expiresAt:     expiresAt,
+		}
+	}
+
+	log.Debug("peersBlacklistRegistry: loaded the manual blacklist decisions", "num", len(pbr.entries))
+}
+
+// Upsert will blacklist the provided peer for the provided duration, for flooding reasons
+func (pbr *peersBlacklistRegistry) Upsert(pid core.PeerID, span time.Duration) error {
+	return pbr.UpsertWithReason(pid, span, floodingReason, false)
+}
+
+// UpsertWithReason will blacklist the provided peer for the provided duration. If the peer is already blacklisted,
+// the expiry time is extended if needed. A manual decision is not overwritten by an automatic one
+func (pbr *peersBlacklistRegistry) UpsertWithReason(pid core.PeerID, span time.Duration, reason string, isManual bool) error {
+	if len(pid) == 0 {
+		return process.ErrEmptyPeerID
+	}
+	if isManual && span < minManualBlacklistDuration {
+		return fmt.Errorf("%w for blacklist duration, minimum %v, provided %v",
+			process.ErrInvalidValue, minManualBlacklistDuration, span)
+	}
+
+	pbr.mut.Lock()
+	defer pbr.mut.Unlock()
+
+	now := pbr.getTimeHandler()
+	expiresAt := now.Add(span)
+	existing, found := pbr.entries[pid]
+	if found && existing.expiresAt.After(now) {
+		if existing.expiresAt.After(expiresAt) {
+			expiresAt = existing.expiresAt
+		}
+		if existing.isManual && !isManual {
+			reason = existing.reason
+			isManual = true
+		}
+	}
+
+	pbr.entries[pid] = &blacklistEntry{
+		reason:        reason,
+		isManual:      isManual,
+		blacklistedAt: now,
+		expiresAt:     expiresAt,
+	}
+
+	if !isManual {
+		return nil
+	}
+
+	return pbr.persistManualDecisions()
+}
+
+// Remove pardons the provided peer
+func (pbr *peersBlacklistRegistry) Remove(pid core.PeerID) error {
+	pbr.mut.Lock()
+	defer pbr.mut.Unlock()
+
+	entry, found := pbr.entries[pid]
+	if !found || !entry.expiresAt.After(pbr.getTimeHandler()) {
+		return fmt.Errorf("%w for pid %s", process.ErrPeerNotBlacklisted, pid.Pretty())
+	}
+
+	delete(pbr.entries, pid)
+	if !entry.isManual {
+		return nil
+	}
+
+	return pbr.persistManualDecisions()
+}
+
+func (pbr *peersBlacklistRegistry) persistManualDecisions() error {
+	decisions := &BlacklistDecisions{}
+	for pid, entry := range pbr.entries {
+		if !entry.isManual {
+			continue
+		}
+
+		decisions.Decisions = append(decisions.Decisions, &BlacklistDecision{
+			Pid:           pid.Bytes(),
+			Reason:        entry.reason,
+			BlacklistedAt: entry.blacklistedAt.UnixNano(),
+			ExpiresAt:     entry.expiresAt.UnixNano(),
+		})
+	}
+
+	buff, err := pbr.marshalizer.Marshal(decisions)
+	if err != nil {
+		return err
+	}
+
+	return pbr.storer.Put([]byte(manualDecisionsKey), buff)
+}
+
+// Has returns true if the provided peer is blacklisted
+func (pbr *peersBlacklistRegistry) Has(pid core.PeerID) bool {
+	pbr.mut.RLock()
+	defer pbr.mut.RUnlock()
+
+	entry, found := pbr.entries[pid]
+
+	return found && entry.expiresAt.After(pbr.getTimeHandler())
+}
+
+// Sweep removes the expired blacklisting decisions
+func (pbr *peersBlacklistRegistry) Sweep() {
+	pbr.mut.Lock()
+	defer pbr.mut.Unlock()
+
+	now := pbr.getTimeHandler()
+	for pid, entry := range pbr.entries {
+		if !entry.expiresAt.After(now) {
+			delete(pbr.entries, pid)
+		}
+	}
+}
+
+// GetBlacklistedPeers returns the details of all blacklisted peers, sorted by the expiry time
+func (pbr *peersBlacklistRegistry) GetBlacklistedPeers() []common.BlacklistedPeerInfo {
+	pbr.mut.RLock()
+	defer pbr.mut.RUnlock()
+
+	now := pbr.getTimeHandler()
+	blacklistedPeers := make([]common.BlacklistedPeerInfo, 0, len(pbr.entries))
+	for pid, entry := range pbr.entries {
+		if !entry.expiresAt.After(now) {
+			continue
+		}
+
+		blacklistedPeers = append(blacklistedPeers, common.BlacklistedPeerInfo{
+			Pid:           pid.Pretty(),
+			Reason:        entry.reason,
+			IsManual:      entry.isManual,
+			BlacklistedAt: entry.blacklistedAt.Unix(),
+			ExpiresAt:     entry.expiresAt.Unix(),
+		})
+	}
+
+	sort.Slice(blacklistedPeers, func(i, j int) bool {
+		if blacklistedPeers[i].ExpiresAt == blacklistedPeers[j].ExpiresAt {
+			return blacklistedPeers[i].Pid < blacklistedPeers[j].Pid
+		}
+
+		return blacklistedPeers[i].ExpiresAt < blacklistedPeers[j].ExpiresAt
+	})
+
+	return blacklistedPeers
+}
+
+// Close closes the inner storer
+func (pbr *peersBlacklistRegistry) Close() error {
+	return pbr.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pbr *peersBlacklistRegistry) IsInterfaceNil() bool {
+	return pbr == nil
+}
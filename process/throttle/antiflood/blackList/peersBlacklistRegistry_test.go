@@ -0,0 +1,215 @@
+package blackList_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/blackList"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const manualDecisionsKey = "manualBlacklistDecisions"
+
+func createMockArgPeersBlacklistRegistry() blackList.ArgPeersBlacklistRegistry {
+	return blackList.ArgPeersBlacklistRegistry{
+		Storer:      genericMocks.NewStorerMockWithErrKeyNotFound("blacklist", 0),
+		Marshalizer: &marshal.GogoProtoMarshalizer{},
+	}
+}
+
+func TestNewPeersBlacklistRegistry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgPeersBlacklistRegistry()
+		args.Storer = nil
+
+		pbr, err := blackList.NewPeersBlacklistRegistry(args)
+		assert.Equal(t, process.ErrNilStorage, err)
+		assert.True(t, check.IfNil(pbr))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgPeersBlacklistRegistry()
+		args.Marshalizer = nil
+
+		pbr, err := blackList.NewPeersBlacklistRegistry(args)
+		assert.Equal(t, process.ErrNilMarshalizer, err)
+		assert.True(t, check.IfNil(pbr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pbr, err := blackList.NewPeersBlacklistRegistry(createMockArgPeersBlacklistRegistry())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(pbr))
+		assert.Equal(t, 0, len(pbr.GetBlacklistedPeers()))
+	})
+}
+
+func TestPeersBlacklistRegistry_UpsertShouldRecordFloodingReason(t *testing.T) {
+	t.Parallel()
+
+	pbr, _ := blackList.NewPeersBlacklistRegistry(createMockArgPeersBlacklistRegistry())
+
+	pid := core.PeerID("pid")
+	err := pbr.Upsert(pid, time.Minute)
+	require.Nil(t, err)
+
+	assert.True(t, pbr.Has(pid))
+	peers := pbr.GetBlacklistedPeers()
+	require.Equal(t, 1, len(peers))
+	assert.Equal(t, pid.Pretty(), peers[0].Pid)
+	assert.Equal(t, "flooding", peers[0].Reason)
+	assert.False(t, peers[0].IsManual)
+	assert.Equal(t, int64(60), peers[0].ExpiresAt-peers[0].BlacklistedAt)
+}
+
+func TestPeersBlacklistRegistry_UpsertWithReason(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty pid should error", func(t *testing.T) {
+		t.Parallel()
+
+		pbr, _ := blackList.NewPeersBlacklistRegistry(createMockArgPeersBlacklistRegistry())
+
+		err := pbr.UpsertWithReason("", time.Minute, "reason", false)
+		assert.Equal(t, process.ErrEmptyPeerID, err)
+	})
+	t.Run("too short manual duration should error", func(t *testing.T) {
+		t.Parallel()
+
+		pbr, _ := blackList.NewPeersBlacklistRegistry(createMockArgPeersBlacklistRegistry())
+
+		err := pbr.UpsertWithReason("pid", time.Millisecond, "reason", true)
+		assert.True(t, errors.Is(err, process.ErrInvalidValue))
+		assert.False(t, pbr.Has("pid"))
+	})
+	t.Run("automatic decision should not overwrite the manual one", func(t *testing.T) {
+		t.Parallel()
+
+		pbr, _ := blackList.NewPeersBlacklistRegistry(createMockArgPeersBlacklistRegistry())
+
+		pid := core.PeerID("pid")
+		_ = pbr.UpsertWithReason(pid, time.Hour, "spam wave", true)
+		_ = pbr.UpsertWithReason(pid, time.Minute, "invalid signature", false)
+
+		peers := pbr.GetBlacklistedPeers()
+		require.Equal(t, 1, len(peers))
+		assert.Equal(t, "spam wave", peers[0].Reason)
+		assert.True(t, peers[0].IsManual)
+		assert.True(t, peers[0].ExpiresAt-peers[0].BlacklistedAt >= int64(time.Hour.Seconds())-1)
+	})
+	t.Run("expired decision should not be reported", func(t *testing.T) {
+		t.Parallel()
+
+		pbr, _ := blackList.NewPeersBlacklistRegistry(createMockArgPeersBlacklistRegistry())
+
+		pid := core.PeerID("pid")
+		_ = pbr.UpsertWithReason(pid, time.Millisecond, "reason", false)
+		time.Sleep(time.Millisecond * 10)
+
+		assert.False(t, pbr.Has(pid))
+		assert.Equal(t, 0, len(pbr.GetBlacklistedPeers()))
+
+		pbr.Sweep()
+		err := pbr.Remove(pid)
+		assert.True(t, errors.Is(err, process.ErrPeerNotBlacklisted))
+	})
+}
+
+func TestPeersBlacklistRegistry_Remove(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not blacklisted peer should error", func(t *testing.T) {
+		t.Parallel()
+
+		pbr, _ := blackList.NewPeersBlacklistRegistry(createMockArgPeersBlacklistRegistry())
+
+		err := pbr.Remove("pid")
+		assert.True(t, errors.Is(err, process.ErrPeerNotBlacklisted))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pbr, _ := blackList.NewPeersBlacklistRegistry(createMockArgPeersBlacklistRegistry())
+
+		_ = pbr.Upsert("pid1", time.Minute)
+		_ = pbr.UpsertWithReason("pid2", time.Minute, "reason", true)
+
+		assert.Nil(t, pbr.Remove("pid1"))
+		assert.Nil(t, pbr.Remove("pid2"))
+		assert.False(t, pbr.Has("pid1"))
+		assert.False(t, pbr.Has("pid2"))
+	})
+}
+
+func TestPeersBlacklistRegistry_ManualDecisionsShouldBePersisted(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgPeersBlacklistRegistry()
+	pbr, _ := blackList.NewPeersBlacklistRegistry(args)
+
+	_ = pbr.Upsert("flooding pid", time.Hour)
+	_ = pbr.UpsertWithReason("manual pid 1", time.Hour, "spam", true)
+	_ = pbr.UpsertWithReason("manual pid 2", time.Hour, "spam", true)
+	_ = pbr.Remove("manual pid 2")
+	require.Nil(t, pbr.Close())
+
+	reloaded, err := blackList.NewPeersBlacklistRegistry(args)
+	require.Nil(t, err)
+
+	peers := reloaded.GetBlacklistedPeers()
+	require.Equal(t, 1, len(peers))
+	assert.Equal(t, core.PeerID("manual pid 1").Pretty(), peers[0].Pid)
+	assert.Equal(t, "spam", peers[0].Reason)
+	assert.True(t, peers[0].IsManual)
+	assert.True(t, reloaded.Has("manual pid 1"))
+}
+
+func TestPeersBlacklistRegistry_LoadShouldSkipExpiredAndCorruptedDecisions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("corrupted data", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgPeersBlacklistRegistry()
+		_ = args.Storer.Put([]byte(manualDecisionsKey), []byte("corrupted"))
+
+		pbr, err := blackList.NewPeersBlacklistRegistry(args)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(pbr.GetBlacklistedPeers()))
+	})
+	t.Run("expired decisions", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgPeersBlacklistRegistry()
+		now := time.Now()
+		decisions := &blackList.BlacklistDecisions{
+			Decisions: []*blackList.BlacklistDecision{
+				{Pid: []byte("expired"), Reason: "spam", ExpiresAt: now.Add(-time.Minute).UnixNano()},
+				{Pid: []byte("active"), Reason: "spam", ExpiresAt: now.Add(time.Minute).UnixNano()},
+				{Pid: []byte{}, Reason: "spam", ExpiresAt: now.Add(time.Minute).UnixNano()},
+			},
+		}
+		buff, _ := args.Marshalizer.Marshal(decisions)
+		_ = args.Storer.Put([]byte(manualDecisionsKey), buff)
+
+		pbr, err := blackList.NewPeersBlacklistRegistry(args)
+		require.Nil(t, err)
+
+		assert.False(t, pbr.Has("expired"))
+		assert.True(t, pbr.Has("active"))
+		assert.Equal(t, 1, len(pbr.GetBlacklistedPeers()))
+	})
+}
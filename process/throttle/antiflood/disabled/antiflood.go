@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/consensus"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
@@ -60,6 +61,33 @@ func (af *AntiFlood) SetDebugger(_ process.AntifloodDebugger) error {
 func (af *AntiFlood) BlacklistPeer(_ core.PeerID, _ string, _ time.Duration) {
 }
 
+// BlacklistPeerManually returns ErrAntifloodIsDisabled
+func (af *AntiFlood) BlacklistPeerManually(_ core.PeerID, _ string, _ time.Duration) error {
+	return process.ErrAntifloodIsDisabled
+}
+
+// PardonPeer returns ErrAntifloodIsDisabled
+func (af *AntiFlood) PardonPeer(_ core.PeerID) error {
+	return process.ErrAntifloodIsDisabled
+}
+
+// GetBlacklistedPeers returns an empty slice
+func (af *AntiFlood) GetBlacklistedPeers() []common.BlacklistedPeerInfo {
+	return make([]common.BlacklistedPeerInfo, 0)
+}
+
+// SetQuotaFactor does nothing
+func (af *AntiFlood) SetQuotaFactor(_ float64) {
+}
+
+// GetQuotaInfo returns an empty quota info
+func (af *AntiFlood) GetQuotaInfo() common.AntifloodQuotaInfo {
+	return common.AntifloodQuotaInfo{
+		FloodPreventers: make([]common.FloodPreventerQuotaInfo, 0),
+		Topics:          make([]common.TopicQuotaInfo, 0),
+	}
+}
+
 // Close does nothing
 func (af *AntiFlood) Close() error {
 	return nil
@@ -3,9 +3,11 @@ package disabled
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -23,6 +25,11 @@ func TestAntiFlood_ShouldNotPanic(t *testing.T) {
 	daf.SetMaxMessagesForTopic("test", 10)
 	daf.ResetForTopic("test")
 	daf.ApplyConsensusSize(0)
+	daf.SetQuotaFactor(0.5)
 	_ = daf.CanProcessMessagesOnTopic(core.PeerID(fmt.Sprint(1)), "test", 1, 0, nil)
 	_ = daf.CanProcessMessage(nil, core.PeerID(fmt.Sprint(2)))
+	assert.Equal(t, process.ErrAntifloodIsDisabled, daf.BlacklistPeerManually("pid", "reason", time.Second))
+	assert.Equal(t, process.ErrAntifloodIsDisabled, daf.PardonPeer("pid"))
+	assert.Equal(t, 0, len(daf.GetBlacklistedPeers()))
+	assert.Equal(t, 0, len(daf.GetQuotaInfo().FloodPreventers))
 }
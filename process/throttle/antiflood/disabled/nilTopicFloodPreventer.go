@@ -2,6 +2,7 @@ package disabled
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -34,6 +35,15 @@ func (ntfp *nilTopicFloodPreventer) ResetForNotRegisteredTopics() {
 func (ntfp *nilTopicFloodPreventer) SetMaxMessagesForTopic(_ string, _ uint32) {
 }
 
+// SetQuotaFactor does nothing
+func (ntfp *nilTopicFloodPreventer) SetQuotaFactor(_ float64) {
+}
+
+// GetTopicsQuotaInfo returns an empty slice
+func (ntfp *nilTopicFloodPreventer) GetTopicsQuotaInfo() []common.TopicQuotaInfo {
+	return make([]common.TopicQuotaInfo, 0)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ntfp *nilTopicFloodPreventer) IsInterfaceNil() bool {
 	return ntfp == nil
@@ -31,5 +31,7 @@ func TestNilTopicFloodPreventer_FunctionsShouldNotPanic(t *testing.T) {
 
 	ntfp.ResetForTopic("")
 	ntfp.SetMaxMessagesForTopic("", 0)
+	ntfp.SetQuotaFactor(0.5)
 	assert.Nil(t, ntfp.IncreaseLoad("", "", math.MaxUint32))
+	assert.Equal(t, 0, len(ntfp.GetTopicsQuotaInfo()))
 }
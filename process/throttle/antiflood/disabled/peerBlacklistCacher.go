@@ -4,10 +4,11 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
-var _ process.PeerBlackListCacher = (*PeerBlacklistCacher)(nil)
+var _ process.PeerBlacklistRegistry = (*PeerBlacklistCacher)(nil)
 
 // PeerBlacklistCacher is a mock implementation of PeerBlacklistHandler that does not manage black listed keys
 // (all keys [peers] are whitelisted)
@@ -19,6 +20,26 @@ func (pbc *PeerBlacklistCacher) Upsert(_ core.PeerID, _ time.Duration) error {
 	return nil
 }
 
+// UpsertWithReason does nothing
+func (pbc *PeerBlacklistCacher) UpsertWithReason(_ core.PeerID, _ time.Duration, _ string, _ bool) error {
+	return nil
+}
+
+// Remove does nothing
+func (pbc *PeerBlacklistCacher) Remove(_ core.PeerID) error {
+	return nil
+}
+
+// GetBlacklistedPeers returns an empty slice
+func (pbc *PeerBlacklistCacher) GetBlacklistedPeers() []common.BlacklistedPeerInfo {
+	return make([]common.BlacklistedPeerInfo, 0)
+}
+
+// Close does nothing
+func (pbc *PeerBlacklistCacher) Close() error {
+	return nil
+}
+
 // Sweep does nothing
 func (pbc *PeerBlacklistCacher) Sweep() {
 }
@@ -25,5 +25,14 @@ func TestBlacklistHandler_ShouldNotPanic(t *testing.T) {
 	err := pbc.Upsert("", time.Second)
 	assert.Nil(t, err)
 
+	err = pbc.UpsertWithReason("", time.Second, "reason", true)
+	assert.Nil(t, err)
+
+	err = pbc.Remove("a")
+	assert.Nil(t, err)
+
+	assert.Equal(t, 0, len(pbc.GetBlacklistedPeers()))
+	assert.Nil(t, pbc.Close())
+
 	pbc.Sweep()
 }
@@ -0,0 +1,15 @@
+package disabled
+
+// QuotaController is the adaptive antiflood quota controller used when the adaptive quotas are disabled
+type QuotaController struct {
+}
+
+// Close does nothing and returns nil
+func (qc *QuotaController) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (qc *QuotaController) IsInterfaceNil() bool {
+	return qc == nil
+}
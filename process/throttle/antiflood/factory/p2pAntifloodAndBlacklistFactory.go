@@ -7,6 +7,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/p2p"
@@ -16,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/floodPreventers"
 	"github.com/ElrondNetwork/elrond-go/statusHandler/p2pQuota"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	storageFactory "github.com/ElrondNetwork/elrond-go/storage/factory"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/timecache"
@@ -34,19 +36,33 @@ var durationSweepP2PBlacklist = time.Second * 5
 // AntiFloodComponents holds the handlers for the anti-flood and blacklist mechanisms
 type AntiFloodComponents struct {
 	AntiFloodHandler process.P2PAntifloodHandler
-	BlacklistHandler process.PeerBlackListCacher
+	BlacklistHandler process.PeerBlacklistRegistry
 	FloodPreventers  []process.FloodPreventer
 	TopicPreventer   process.TopicFloodPreventer
 	PubKeysCacher    process.TimeCacher
 }
 
-// NewP2PAntiFloodComponents will return instances of antiflood and blacklist, based on the config
-func NewP2PAntiFloodComponents(ctx context.Context, config config.Config, statusHandler core.AppStatusHandler, currentPid core.PeerID) (*AntiFloodComponents, error) {
+// NewP2PAntiFloodComponents will return instances of antiflood and blacklist, based on the config. The provided storer
+// and marshalizer are used to persist the manual blacklisting decisions
+func NewP2PAntiFloodComponents(
+	ctx context.Context,
+	config config.Config,
+	statusHandler core.AppStatusHandler,
+	currentPid core.PeerID,
+	blacklistStorer storage.Storer,
+	marshalizer marshal.Marshalizer,
+) (*AntiFloodComponents, error) {
 	if check.IfNil(statusHandler) {
 		return nil, p2p.ErrNilStatusHandler
 	}
+	if check.IfNil(blacklistStorer) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
 	if config.Antiflood.Enabled {
-		return initP2PAntiFloodComponents(ctx, config, statusHandler, currentPid)
+		return initP2PAntiFloodComponents(ctx, config, statusHandler, currentPid, blacklistStorer, marshalizer)
 	}
 
 	return &AntiFloodComponents{
@@ -63,9 +79,13 @@ func initP2PAntiFloodComponents(
 	mainConfig config.Config,
 	statusHandler core.AppStatusHandler,
 	currentPid core.PeerID,
+	blacklistStorer storage.Storer,
+	marshalizer marshal.Marshalizer,
 ) (*AntiFloodComponents, error) {
-	cache := timecache.NewTimeCache(defaultSpan)
-	p2pPeerBlackList, err := timecache.NewPeerTimeCache(cache)
+	p2pPeerBlackList, err := blackList.NewPeersBlacklistRegistry(blackList.ArgPeersBlacklistRegistry{
+		Storer:      blacklistStorer,
+		Marshalizer: marshalizer,
+	})
 	if err != nil {
 		return nil, err
 	}
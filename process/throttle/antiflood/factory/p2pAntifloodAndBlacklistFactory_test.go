@@ -6,9 +6,12 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/p2p"
+	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,11 +23,33 @@ func TestNewP2PAntiFloodAndBlackList_NilStatusHandlerShouldErr(t *testing.T) {
 
 	ctx := context.Background()
 	cfg := config.Config{}
-	components, err := NewP2PAntiFloodComponents(ctx, cfg, nil, currentPid)
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, nil, currentPid, storageUnit.NewNilStorer(), &marshal.GogoProtoMarshalizer{})
 	assert.Nil(t, components)
 	assert.Equal(t, p2p.ErrNilStatusHandler, err)
 }
 
+func TestNewP2PAntiFloodAndBlackList_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := config.Config{}
+	ash := statusHandler.NewAppStatusHandlerMock()
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid, storageUnit.NewNilStorer(), nil)
+	assert.Nil(t, components)
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+}
+
+func TestNewP2PAntiFloodAndBlackList_NilBlacklistStorerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cfg := config.Config{}
+	ash := statusHandler.NewAppStatusHandlerMock()
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid, nil, &marshal.GogoProtoMarshalizer{})
+	assert.Nil(t, components)
+	assert.Equal(t, process.ErrNilStorage, err)
+}
+
 func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(t *testing.T) {
 	t.Parallel()
 
@@ -35,7 +60,7 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnDisabledImplementations(
 	}
 	ash := statusHandler.NewAppStatusHandlerMock()
 	ctx := context.Background()
-	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid)
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid, storageUnit.NewNilStorer(), &marshal.GogoProtoMarshalizer{})
 	assert.NotNil(t, components)
 	assert.Nil(t, err)
 
@@ -69,7 +94,7 @@ func TestNewP2PAntiFloodAndBlackList_ShouldWorkAndReturnOkImplementations(t *tes
 
 	ash := statusHandler.NewAppStatusHandlerMock()
 	ctx := context.Background()
-	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid)
+	components, err := NewP2PAntiFloodComponents(ctx, cfg, ash, currentPid, storageUnit.NewNilStorer(), &marshal.GogoProtoMarshalizer{})
 	assert.Nil(t, err)
 	assert.NotNil(t, components.AntiFloodHandler)
 	assert.NotNil(t, components.BlacklistHandler)
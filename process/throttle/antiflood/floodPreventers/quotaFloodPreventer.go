@@ -2,10 +2,12 @@ package floodPreventers
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
@@ -30,6 +32,7 @@ const initNumMessages = 1
 const maxPercentReserved = 90.0
 const minPercentReserved = 0.0
 const quotaStructSize = 24
+const defaultQuotaFactor = 1.0
 
 type quota struct {
 	numReceivedMessages   uint32
@@ -55,6 +58,7 @@ type quotaFloodPreventer struct {
 	percentReserved               float32
 	increaseThreshold             uint32
 	increaseFactor                float32
+	quotaFactor                   float64
 }
 
 // NewQuotaFloodPreventer creates a new flood preventer based on quota / peer
@@ -113,6 +117,7 @@ func NewQuotaFloodPreventer(arg ArgQuotaFloodPreventer) (*quotaFloodPreventer, e
 		percentReserved:               arg.PercentReserved,
 		increaseThreshold:             arg.IncreaseThreshold,
 		increaseFactor:                arg.IncreaseFactor,
+		quotaFactor:                   defaultQuotaFactor,
 	}, nil
 }
 
@@ -146,8 +151,8 @@ func (qfp *quotaFloodPreventer) increaseLoad(pid core.PeerID, size uint64) error
 	q.numReceivedMessages++
 	q.sizeReceivedMessages += size
 
-	maxNumMessagesReached := qfp.isMaximumReached(uint64(qfp.computedMaxNumMessagesPerPeer), uint64(q.numReceivedMessages))
-	maxSizeMessagesReached := qfp.isMaximumReached(qfp.maxTotalSizePerPeer, q.sizeReceivedMessages)
+	maxNumMessagesReached := qfp.isMaximumReached(uint64(qfp.effectiveMaxNumMessagesPerPeer()), uint64(q.numReceivedMessages))
+	maxSizeMessagesReached := qfp.isMaximumReached(qfp.effectiveMaxTotalSizePerPeer(), q.sizeReceivedMessages)
 	isPeerQuotaReached := maxNumMessagesReached || maxSizeMessagesReached
 	if isPeerQuotaReached {
 		return fmt.Errorf("%w for pid %s", process.ErrSystemBusy, pid.Pretty())
@@ -159,6 +164,24 @@ func (qfp *quotaFloodPreventer) increaseLoad(pid core.PeerID, size uint64) error
 	return nil
 }
 
+func (qfp *quotaFloodPreventer) effectiveMaxNumMessagesPerPeer() uint32 {
+	value := uint32(float64(qfp.computedMaxNumMessagesPerPeer) * qfp.quotaFactor)
+	if value < minMessages {
+		return minMessages
+	}
+
+	return value
+}
+
+func (qfp *quotaFloodPreventer) effectiveMaxTotalSizePerPeer() uint64 {
+	value := uint64(float64(qfp.maxTotalSizePerPeer) * qfp.quotaFactor)
+	if value < minTotalSize {
+		return minTotalSize
+	}
+
+	return value
+}
+
 func (qfp *quotaFloodPreventer) isMaximumReached(absoluteMax uint64, counted uint64) bool {
 	max := uint64(100-qfp.percentReserved) * absoluteMax / 100
 
@@ -266,6 +289,66 @@ func (qfp *quotaFloodPreventer) ApplyConsensusSize(size int) {
 	)
 }
 
+// SetQuotaFactor scales the maximum number of messages and the maximum total size that can be received from a peer.
+// The scaling is applied on top of the values computed from the consensus size
+func (qfp *quotaFloodPreventer) SetQuotaFactor(factor float64) {
+	if factor <= 0 {
+		log.Warn("invalid quota factor in quota flood preventer",
+			"name", qfp.name,
+			"provided value", factor,
+		)
+		return
+	}
+
+	qfp.mutOperation.Lock()
+	qfp.quotaFactor = factor
+	qfp.mutOperation.Unlock()
+}
+
+// GetQuotaInfo returns the limits and the quota used by each peer in the current interval, sorted descending by
+// the number of received messages
+func (qfp *quotaFloodPreventer) GetQuotaInfo() common.FloodPreventerQuotaInfo {
+	qfp.mutOperation.RLock()
+	defer qfp.mutOperation.RUnlock()
+
+	keys := qfp.cacher.Keys()
+	peers := make([]common.PeerQuotaInfo, 0, len(keys))
+	for _, k := range keys {
+		val, ok := qfp.cacher.Peek(k)
+		if !ok {
+			continue
+		}
+
+		q, isQuota := val.(*quota)
+		if !isQuota {
+			continue
+		}
+
+		peers = append(peers, common.PeerQuotaInfo{
+			Pid:                   core.PeerID(k).Pretty(),
+			NumReceivedMessages:   q.numReceivedMessages,
+			SizeReceivedMessages:  q.sizeReceivedMessages,
+			NumProcessedMessages:  q.numProcessedMessages,
+			SizeProcessedMessages: q.sizeProcessedMessages,
+		})
+	}
+
+	sort.Slice(peers, func(i, j int) bool {
+		if peers[i].NumReceivedMessages == peers[j].NumReceivedMessages {
+			return peers[i].Pid < peers[j].Pid
+		}
+
+		return peers[i].NumReceivedMessages > peers[j].NumReceivedMessages
+	})
+
+	return common.FloodPreventerQuotaInfo{
+		Name:                  qfp.name,
+		MaxNumMessagesPerPeer: qfp.effectiveMaxNumMessagesPerPeer(),
+		MaxTotalSizePerPeer:   qfp.effectiveMaxTotalSizePerPeer(),
+		Peers:                 peers,
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (qfp *quotaFloodPreventer) IsInterfaceNil() bool {
 	return qfp == nil
@@ -13,6 +13,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func createDefaultArgument() ArgQuotaFloodPreventer {
@@ -488,3 +489,82 @@ func TestQuotaFloodPreventer_ApplyConsensusShouldWork(t *testing.T) {
 	err := qfp.IncreaseLoad(identifier, 0)
 	assert.NotNil(t, err)
 }
+
+func TestQuotaFloodPreventer_SetQuotaFactorInvalidValueShouldNotChange(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.BaseMaxNumMessagesPerPeer = 100
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	qfp.SetQuotaFactor(0)
+	qfp.SetQuotaFactor(-1)
+
+	assert.Equal(t, defaultQuotaFactor, qfp.quotaFactor)
+	assert.Equal(t, uint32(100), qfp.effectiveMaxNumMessagesPerPeer())
+}
+
+func TestQuotaFloodPreventer_SetQuotaFactorShouldScaleTheQuotas(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.Cacher = testscommon.NewCacherMock()
+	arg.BaseMaxNumMessagesPerPeer = 2000
+	arg.MaxTotalSizePerPeer = 10000
+	arg.IncreaseThreshold = 1000
+	arg.IncreaseFactor = 0.25
+	arg.PercentReserved = 0
+	qfp, _ := NewQuotaFloodPreventer(arg)
+	qfp.ApplyConsensusSize(2000)
+
+	qfp.SetQuotaFactor(0.5)
+
+	quotaInfo := qfp.GetQuotaInfo()
+	assert.Equal(t, uint32(1125), quotaInfo.MaxNumMessagesPerPeer)
+	assert.Equal(t, uint64(5000), quotaInfo.MaxTotalSizePerPeer)
+
+	identifier := core.PeerID("identifier")
+	for i := 0; i < 1125; i++ {
+		err := qfp.IncreaseLoad(identifier, 0)
+		assert.Nil(t, err, fmt.Sprintf("on iteration %d", i))
+	}
+	err := qfp.IncreaseLoad(identifier, 0)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+
+	qfp.SetQuotaFactor(0.00001)
+	quotaInfo = qfp.GetQuotaInfo()
+	assert.Equal(t, uint32(minMessages), quotaInfo.MaxNumMessagesPerPeer)
+	assert.Equal(t, uint64(minTotalSize), quotaInfo.MaxTotalSizePerPeer)
+}
+
+func TestQuotaFloodPreventer_GetQuotaInfo(t *testing.T) {
+	t.Parallel()
+
+	arg := createDefaultArgument()
+	arg.Cacher = testscommon.NewCacherMock()
+	arg.BaseMaxNumMessagesPerPeer = 100
+	arg.MaxTotalSizePerPeer = 10000
+	qfp, _ := NewQuotaFloodPreventer(arg)
+
+	pid1 := core.PeerID("pid1")
+	pid2 := core.PeerID("pid2")
+	_ = qfp.IncreaseLoad(pid1, 10)
+	_ = qfp.IncreaseLoad(pid2, 20)
+	_ = qfp.IncreaseLoad(pid2, 30)
+	arg.Cacher.Put([]byte("not a quota"), "value", 0)
+
+	quotaInfo := qfp.GetQuotaInfo()
+	assert.Equal(t, "test", quotaInfo.Name)
+	assert.Equal(t, uint32(100), quotaInfo.MaxNumMessagesPerPeer)
+	assert.Equal(t, uint64(10000), quotaInfo.MaxTotalSizePerPeer)
+	require.Equal(t, 2, len(quotaInfo.Peers))
+
+	assert.Equal(t, pid2.Pretty(), quotaInfo.Peers[0].Pid)
+	assert.Equal(t, uint32(2), quotaInfo.Peers[0].NumReceivedMessages)
+	assert.Equal(t, uint64(50), quotaInfo.Peers[0].SizeReceivedMessages)
+	assert.Equal(t, pid1.Pretty(), quotaInfo.Peers[1].Pid)
+	assert.Equal(t, uint64(10), quotaInfo.Peers[1].SizeProcessedMessages)
+
+	qfp.Reset()
+	assert.Equal(t, 0, len(qfp.GetQuotaInfo().Peers))
+}
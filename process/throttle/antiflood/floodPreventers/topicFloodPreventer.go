@@ -2,11 +2,13 @@ package floodPreventers
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 )
 
@@ -26,6 +28,7 @@ type topicFloodPreventer struct {
 	registeredTopics          map[string]struct{}
 	counterMap                map[string]map[core.PeerID]uint32
 	defaultMaxMessagesPerPeer uint32
+	quotaFactor               float64
 }
 
 // NewTopicFloodPreventer creates a new flood preventer based on topic
@@ -46,6 +49,7 @@ func NewTopicFloodPreventer(
 		counterMap:                make(map[string]map[core.PeerID]uint32),
 		registeredTopics:          make(map[string]struct{}),
 		defaultMaxMessagesPerPeer: maxMessagesPerPeer,
+		quotaFactor:               defaultQuotaFactor,
 	}, nil
 }
 
@@ -62,7 +66,7 @@ func (tfp *topicFloodPreventer) IncreaseLoad(pid core.PeerID, topic string, numM
 
 	tfp.counterMap[topic][pid] += numMessages
 
-	limitExceeded := tfp.counterMap[topic][pid] > tfp.maxMessagesForTopic(topic)
+	limitExceeded := tfp.counterMap[topic][pid] > tfp.scaleMaxMessages(tfp.maxMessagesForTopic(topic))
 	if limitExceeded {
 		return process.ErrSystemBusy
 	}
@@ -158,6 +162,64 @@ func (tfp *topicFloodPreventer) maxMessagesForTopicWildcard(topic string) uint32
 	return tfp.defaultMaxMessagesPerPeer
 }
 
+func (tfp *topicFloodPreventer) scaleMaxMessages(maxMessages uint32) uint32 {
+	value := uint32(float64(maxMessages) * tfp.quotaFactor)
+	if value < topicMinMessages {
+		return topicMinMessages
+	}
+
+	return value
+}
+
+// SetQuotaFactor scales the maximum number of messages that can be received from a peer on every topic
+func (tfp *topicFloodPreventer) SetQuotaFactor(factor float64) {
+	if factor <= 0 {
+		log.Warn("invalid quota factor in topic flood preventer", "provided value", factor)
+		return
+	}
+
+	tfp.mutTopicMaxMessages.Lock()
+	tfp.quotaFactor = factor
+	tfp.mutTopicMaxMessages.Unlock()
+}
+
+// GetTopicsQuotaInfo returns the number of messages received from each peer on the topics that had traffic in the
+// current interval, sorted by topic
+func (tfp *topicFloodPreventer) GetTopicsQuotaInfo() []common.TopicQuotaInfo {
+	tfp.mutTopicMaxMessages.RLock()
+	defer tfp.mutTopicMaxMessages.RUnlock()
+
+	topicsInfo := make([]common.TopicQuotaInfo, 0, len(tfp.counterMap))
+	for topic, peersCounters := range tfp.counterMap {
+		if len(peersCounters) == 0 {
+			continue
+		}
+
+		numMessagesPerPeer := make(map[string]uint32, len(peersCounters))
+		for pid, numMessages := range peersCounters {
+			numMessagesPerPeer[pid.Pretty()] = numMessages
+		}
+
+		maxMessages, ok := tfp.topicMaxMessages[topic]
+		if !ok {
+			maxMessages = tfp.maxMessagesForTopicWildcard(topic)
+		}
+		maxMessages = tfp.scaleMaxMessages(maxMessages)
+
+		topicsInfo = append(topicsInfo, common.TopicQuotaInfo{
+			Topic:              topic,
+			MaxMessagesPerPeer: maxMessages,
+			NumMessagesPerPeer: numMessagesPerPeer,
+		})
+	}
+
+	sort.Slice(topicsInfo, func(i, j int) bool {
+		return topicsInfo[i].Topic < topicsInfo[j].Topic
+	})
+
+	return topicsInfo
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (tfp *topicFloodPreventer) IsInterfaceNil() bool {
 	return tfp == nil
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/floodPreventers"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewTopicFloodPreventer_InvalidMaxNumOfMessagesShouldErr(t *testing.T) {
@@ -312,3 +313,59 @@ func TestTopicFloodPreventer_ResetForNotRegisteredTopicsWithWildcardShuldWork(t
 	err = tfp.IncreaseLoad(identifier, unregisteredTopic, defaultMaxMessages)
 	assert.Nil(t, err)
 }
+
+func TestTopicFloodPreventer_SetQuotaFactorShouldScaleTheTopicsLimits(t *testing.T) {
+	t.Parallel()
+
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(4)
+	id := core.PeerID("identifier")
+	topic := "topic_1"
+	tfp.SetMaxMessagesForTopic(topic, 10)
+
+	tfp.SetQuotaFactor(0)
+	tfp.SetQuotaFactor(0.5)
+
+	err := tfp.IncreaseLoad(id, topic, 5)
+	assert.Nil(t, err)
+	err = tfp.IncreaseLoad(id, topic, 1)
+	assert.Equal(t, process.ErrSystemBusy, err)
+
+	err = tfp.IncreaseLoad(id, "other topic", 2)
+	assert.Nil(t, err)
+	err = tfp.IncreaseLoad(id, "other topic", 1)
+	assert.Equal(t, process.ErrSystemBusy, err)
+
+	quotaInfo := tfp.GetTopicsQuotaInfo()
+	assert.Equal(t, uint32(2), quotaInfo[0].MaxMessagesPerPeer)
+	assert.Equal(t, uint32(5), quotaInfo[1].MaxMessagesPerPeer)
+
+	// the configured limits are not altered
+	assert.Equal(t, uint32(10), tfp.MaxMessagesForTopic(topic))
+}
+
+func TestTopicFloodPreventer_GetTopicsQuotaInfo(t *testing.T) {
+	t.Parallel()
+
+	tfp, _ := floodPreventers.NewTopicFloodPreventer(10)
+	tfp.SetMaxMessagesForTopic("heartbeat", 2)
+	tfp.SetMaxMessagesForTopic("transactions*", 5)
+
+	pid1 := core.PeerID("pid1")
+	pid2 := core.PeerID("pid2")
+	_ = tfp.IncreaseLoad(pid1, "heartbeat", 1)
+	_ = tfp.IncreaseLoad(pid2, "heartbeat", 3)
+	_ = tfp.IncreaseLoad(pid1, "transactions_0", 4)
+	_ = tfp.IncreaseLoad(pid1, "unknown", 1)
+	tfp.ResetForTopic("unknown")
+
+	topicsInfo := tfp.GetTopicsQuotaInfo()
+	require.Equal(t, 2, len(topicsInfo))
+
+	assert.Equal(t, "heartbeat", topicsInfo[0].Topic)
+	assert.Equal(t, uint32(2), topicsInfo[0].MaxMessagesPerPeer)
+	assert.Equal(t, map[string]uint32{pid1.Pretty(): 1, pid2.Pretty(): 3}, topicsInfo[0].NumMessagesPerPeer)
+
+	assert.Equal(t, "transactions_0", topicsInfo[1].Topic)
+	assert.Equal(t, uint32(5), topicsInfo[1].MaxMessagesPerPeer)
+	assert.Equal(t, map[string]uint32{pid1.Pretty(): 4}, topicsInfo[1].NumMessagesPerPeer)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
@@ -19,7 +20,7 @@ var log = logger.GetOrCreate("process/throttle/antiflood")
 var _ process.P2PAntifloodHandler = (*p2pAntiflood)(nil)
 
 type p2pAntiflood struct {
-	blacklistHandler    process.PeerBlackListCacher
+	blacklistHandler    process.PeerBlacklistRegistry
 	floodPreventers     []process.FloodPreventer
 	topicPreventer      process.TopicFloodPreventer
 	mutDebugger         sync.RWMutex
@@ -32,7 +33,7 @@ type p2pAntiflood struct {
 // NewP2PAntiflood creates a new p2p anti flood protection mechanism built on top of a flood preventer implementation.
 // It contains only the p2p anti flood logic that should be applied
 func NewP2PAntiflood(
-	blacklistHandler process.PeerBlackListCacher,
+	blacklistHandler process.PeerBlacklistRegistry,
 	topicFloodPreventer process.TopicFloodPreventer,
 	floodPreventers ...process.FloodPreventer,
 ) (*p2pAntiflood, error) {
@@ -235,7 +236,7 @@ func (af *p2pAntiflood) SetDebugger(debugger process.AntifloodDebugger) error {
 func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration time.Duration) {
 	peerIsBlacklisted := af.blacklistHandler.Has(peer)
 
-	err := af.blacklistHandler.Upsert(peer, duration)
+	err := af.blacklistHandler.UpsertWithReason(peer, duration, reason, false)
 	if err != nil {
 		log.Warn("error adding in blacklist",
 			"pid", peer.Pretty(),
@@ -255,9 +256,72 @@ func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration
 	}
 }
 
+// BlacklistPeerManually will add a peer to the black list as the result of an operator's decision
+func (af *p2pAntiflood) BlacklistPeerManually(peer core.PeerID, reason string, duration time.Duration) error {
+	err := af.blacklistHandler.UpsertWithReason(peer, duration, reason, true)
+	if err != nil {
+		return err
+	}
+
+	log.Info("manually blacklisted peer",
+		"pid", peer.Pretty(),
+		"time", duration,
+		"reason", reason,
+	)
+
+	return nil
+}
+
+// PardonPeer will remove a peer from the black list
+func (af *p2pAntiflood) PardonPeer(peer core.PeerID) error {
+	err := af.blacklistHandler.Remove(peer)
+	if err != nil {
+		return err
+	}
+
+	log.Info("pardoned blacklisted peer", "pid", peer.Pretty())
+
+	return nil
+}
+
+// GetBlacklistedPeers returns the details of the blacklisted peers
+func (af *p2pAntiflood) GetBlacklistedPeers() []common.BlacklistedPeerInfo {
+	return af.blacklistHandler.GetBlacklistedPeers()
+}
+
+// SetQuotaFactor scales the quotas of all contained flood preventers, including the topic flood preventer
+func (af *p2pAntiflood) SetQuotaFactor(factor float64) {
+	for _, fp := range af.floodPreventers {
+		fp.SetQuotaFactor(factor)
+	}
+	af.topicPreventer.SetQuotaFactor(factor)
+}
+
+// GetQuotaInfo returns the quota usage measured by all contained flood preventers
+func (af *p2pAntiflood) GetQuotaInfo() common.AntifloodQuotaInfo {
+	quotaInfo := common.AntifloodQuotaInfo{
+		FloodPreventers: make([]common.FloodPreventerQuotaInfo, 0, len(af.floodPreventers)),
+		Topics:          af.topicPreventer.GetTopicsQuotaInfo(),
+	}
+	for _, fp := range af.floodPreventers {
+		quotaInfo.FloodPreventers = append(quotaInfo.FloodPreventers, fp.GetQuotaInfo())
+	}
+
+	return quotaInfo
+}
+
 // Close will call the close function on all sub components
 func (af *p2pAntiflood) Close() error {
-	return af.debugger.Close()
+	af.mutDebugger.RLock()
+	errDebugger := af.debugger.Close()
+	af.mutDebugger.RUnlock()
+
+	errBlacklist := af.blacklistHandler.Close()
+	if errDebugger != nil {
+		return errDebugger
+	}
+
+	return errBlacklist
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -8,12 +8,14 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood"
 	"github.com/ElrondNetwork/elrond-go/process/throttle/antiflood/disabled"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewP2PAntiflood_NilBlacklistHandlerShouldErr(t *testing.T) {
@@ -326,6 +328,37 @@ func TestP2pAntiflood_ApplyConsensusSize(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestP2pAntiflood_SetQuotaFactor(t *testing.T) {
+	t.Parallel()
+
+	expectedFactor := 0.65
+	numCalls := 0
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{
+			SetQuotaFactorCalled: func(factor float64) {
+				assert.Equal(t, expectedFactor, factor)
+				numCalls++
+			},
+		},
+		&mock.FloodPreventerStub{
+			SetQuotaFactorCalled: func(factor float64) {
+				assert.Equal(t, expectedFactor, factor)
+				numCalls++
+			},
+		},
+		&mock.FloodPreventerStub{
+			SetQuotaFactorCalled: func(factor float64) {
+				assert.Equal(t, expectedFactor, factor)
+				numCalls++
+			},
+		},
+	)
+
+	afm.SetQuotaFactor(expectedFactor)
+	assert.Equal(t, 3, numCalls)
+}
+
 func TestP2pAntiflood_SetDebuggerNilDebuggerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -360,7 +393,13 @@ func TestP2pAntiflood_Close(t *testing.T) {
 
 	numCalls := int32(0)
 	afm, _ := antiflood.NewP2PAntiflood(
-		&mock.PeerBlackListHandlerStub{},
+		&mock.PeerBlackListHandlerStub{
+			CloseCalled: func() error {
+				atomic.AddInt32(&numCalls, 1)
+
+				return nil
+			},
+		},
 		&mock.TopicAntiFloodStub{},
 		&mock.FloodPreventerStub{},
 	)
@@ -375,7 +414,7 @@ func TestP2pAntiflood_Close(t *testing.T) {
 	err := afm.Close()
 
 	assert.Nil(t, err)
-	assert.Equal(t, int32(1), atomic.LoadInt32(&numCalls))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&numCalls))
 }
 
 func TestP2pAntiflood_BlacklistPeerErrShouldDoNothing(t *testing.T) {
@@ -385,7 +424,7 @@ func TestP2pAntiflood_BlacklistPeerErrShouldDoNothing(t *testing.T) {
 	expectedErr := errors.New("expected error")
 	afm, _ := antiflood.NewP2PAntiflood(
 		&mock.PeerBlackListHandlerStub{
-			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+			UpsertWithReasonCalled: func(pid core.PeerID, span time.Duration, reason string, isManual bool) error {
 				atomic.AddInt32(&numCalls, 1)
 
 				return expectedErr
@@ -406,8 +445,10 @@ func TestP2pAntiflood_BlacklistPeerShouldWork(t *testing.T) {
 	numCalls := int32(0)
 	afm, _ := antiflood.NewP2PAntiflood(
 		&mock.PeerBlackListHandlerStub{
-			UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+			UpsertWithReasonCalled: func(pid core.PeerID, span time.Duration, reason string, isManual bool) error {
 				atomic.AddInt32(&numCalls, 1)
+				assert.Equal(t, "reason", reason)
+				assert.False(t, isManual)
 
 				return nil
 			},
@@ -421,6 +462,121 @@ func TestP2pAntiflood_BlacklistPeerShouldWork(t *testing.T) {
 	assert.Equal(t, int32(1), atomic.LoadInt32(&numCalls))
 }
 
+func TestP2pAntiflood_BlacklistPeerManually(t *testing.T) {
+	t.Parallel()
+
+	t.Run("blacklist handler errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		afm, _ := antiflood.NewP2PAntiflood(
+			&mock.PeerBlackListHandlerStub{
+				UpsertWithReasonCalled: func(pid core.PeerID, span time.Duration, reason string, isManual bool) error {
+					return expectedErr
+				},
+			},
+			&mock.TopicAntiFloodStub{},
+			&mock.FloodPreventerStub{},
+		)
+
+		err := afm.BlacklistPeerManually("pid", "reason", time.Second)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		afm, _ := antiflood.NewP2PAntiflood(
+			&mock.PeerBlackListHandlerStub{
+				UpsertWithReasonCalled: func(pid core.PeerID, span time.Duration, reason string, isManual bool) error {
+					wasCalled = true
+					assert.Equal(t, core.PeerID("pid"), pid)
+					assert.Equal(t, time.Minute, span)
+					assert.Equal(t, "reason", reason)
+					assert.True(t, isManual)
+
+					return nil
+				},
+			},
+			&mock.TopicAntiFloodStub{},
+			&mock.FloodPreventerStub{},
+		)
+
+		err := afm.BlacklistPeerManually("pid", "reason", time.Minute)
+		assert.Nil(t, err)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestP2pAntiflood_PardonPeer(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{
+			RemoveCalled: func(pid core.PeerID) error {
+				if pid == "pid" {
+					return nil
+				}
+
+				return expectedErr
+			},
+		},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+
+	assert.Nil(t, afm.PardonPeer("pid"))
+	assert.Equal(t, expectedErr, afm.PardonPeer("another pid"))
+}
+
+func TestP2pAntiflood_GetBlacklistedPeers(t *testing.T) {
+	t.Parallel()
+
+	blacklistedPeers := []common.BlacklistedPeerInfo{{Pid: "pid", Reason: "reason"}}
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{
+			GetBlacklistedPeersCalled: func() []common.BlacklistedPeerInfo {
+				return blacklistedPeers
+			},
+		},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+
+	assert.Equal(t, blacklistedPeers, afm.GetBlacklistedPeers())
+}
+
+func TestP2pAntiflood_GetQuotaInfo(t *testing.T) {
+	t.Parallel()
+
+	topicsInfo := []common.TopicQuotaInfo{{Topic: "topic"}}
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{
+			GetTopicsQuotaInfoCalled: func() []common.TopicQuotaInfo {
+				return topicsInfo
+			},
+		},
+		&mock.FloodPreventerStub{
+			GetQuotaInfoCalled: func() common.FloodPreventerQuotaInfo {
+				return common.FloodPreventerQuotaInfo{Name: "fp1"}
+			},
+		},
+		&mock.FloodPreventerStub{
+			GetQuotaInfoCalled: func() common.FloodPreventerQuotaInfo {
+				return common.FloodPreventerQuotaInfo{Name: "fp2"}
+			},
+		},
+	)
+
+	quotaInfo := afm.GetQuotaInfo()
+	assert.Equal(t, topicsInfo, quotaInfo.Topics)
+	require.Equal(t, 2, len(quotaInfo.FloodPreventers))
+	assert.Equal(t, "fp1", quotaInfo.FloodPreventers[0].Name)
+	assert.Equal(t, "fp2", quotaInfo.FloodPreventers[1].Name)
+}
+
 func TestP2pAntiflood_IsOriginatorEligibleForTopic(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,87 @@
+package throttle
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+var _ process.InterceptorThrottler = (*interceptorsThrottler)(nil)
+var _ process.LoadSource = (*interceptorsThrottler)(nil)
+
+const interceptorsThrottlerName = "interceptors"
+
+// interceptorsThrottler limits the number of go routines used by the interceptors and is able to report
+// how saturated it was between two consecutive Load calls
+type interceptorsThrottler struct {
+	max     int32
+	counter int32
+	peak    int32
+}
+
+// NewInterceptorsThrottler creates a new interceptors throttler instance
+func NewInterceptorsThrottler(maxNumGoRoutines int32) (*interceptorsThrottler, error) {
+	if maxNumGoRoutines <= 0 {
+		return nil, fmt.Errorf("%w for maxNumGoRoutines, provided %d", process.ErrInvalidValue, maxNumGoRoutines)
+	}
+
+	return &interceptorsThrottler{
+		max: maxNumGoRoutines,
+	}, nil
+}
+
+// CanProcess returns true if the current counter is less than max
+func (it *interceptorsThrottler) CanProcess() bool {
+	canProcess := atomic.LoadInt32(&it.counter) < it.max
+	if !canProcess {
+		it.updatePeak(it.max)
+	}
+
+	return canProcess
+}
+
+// StartProcessing will increment the current counter
+func (it *interceptorsThrottler) StartProcessing() {
+	it.updatePeak(atomic.AddInt32(&it.counter, 1))
+}
+
+// EndProcessing will decrement the current counter
+func (it *interceptorsThrottler) EndProcessing() {
+	atomic.AddInt32(&it.counter, -1)
+}
+
+func (it *interceptorsThrottler) updatePeak(value int32) {
+	for {
+		peak := atomic.LoadInt32(&it.peak)
+		if value <= peak {
+			return
+		}
+		if atomic.CompareAndSwapInt32(&it.peak, peak, value) {
+			return
+		}
+	}
+}
+
+// Name returns the name of this load source
+func (it *interceptorsThrottler) Name() string {
+	return interceptorsThrottlerName
+}
+
+// Load returns the peak number of go routines used since the previous call, as a ratio of the maximum allowed
+func (it *interceptorsThrottler) Load() float64 {
+	peak := atomic.SwapInt32(&it.peak, atomic.LoadInt32(&it.counter))
+	if peak >= it.max {
+		return 1
+	}
+	if peak <= 0 {
+		return 0
+	}
+
+	return float64(peak) / float64(it.max)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (it *interceptorsThrottler) IsInterfaceNil() bool {
+	return it == nil
+}
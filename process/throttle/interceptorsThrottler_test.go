@@ -0,0 +1,60 @@
+package throttle_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/throttle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInterceptorsThrottler(t *testing.T) {
+	t.Parallel()
+
+	it, err := throttle.NewInterceptorsThrottler(0)
+	assert.True(t, errors.Is(err, process.ErrInvalidValue))
+	assert.True(t, check.IfNil(it))
+
+	it, err = throttle.NewInterceptorsThrottler(10)
+	assert.Nil(t, err)
+	assert.False(t, check.IfNil(it))
+	assert.Equal(t, "interceptors", it.Name())
+}
+
+func TestInterceptorsThrottler_CanProcess(t *testing.T) {
+	t.Parallel()
+
+	it, _ := throttle.NewInterceptorsThrottler(2)
+	assert.True(t, it.CanProcess())
+
+	it.StartProcessing()
+	it.StartProcessing()
+	assert.False(t, it.CanProcess())
+
+	it.EndProcessing()
+	assert.True(t, it.CanProcess())
+}
+
+func TestInterceptorsThrottler_Load(t *testing.T) {
+	t.Parallel()
+
+	it, _ := throttle.NewInterceptorsThrottler(4)
+	assert.Equal(t, 0.0, it.Load())
+
+	it.StartProcessing()
+	it.StartProcessing()
+	it.StartProcessing()
+	it.EndProcessing()
+	it.EndProcessing()
+	assert.Equal(t, 0.75, it.Load())
+	// the peak was reset to the current counter
+	assert.Equal(t, 0.25, it.Load())
+
+	it.StartProcessing()
+	it.StartProcessing()
+	it.StartProcessing()
+	assert.False(t, it.CanProcess())
+	assert.Equal(t, 1.0, it.Load())
+}
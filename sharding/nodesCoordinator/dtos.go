@@ -19,3 +19,14 @@ type ResUpdateNodes struct {
 	Leaving        []Validator
 	StillRemaining []Validator
 }
+
+// NodesConfigPreview holds the nodes configuration computed for an epoch without being applied
+type NodesConfigPreview struct {
+	Eligible          map[uint32][]Validator
+	Waiting           map[uint32][]Validator
+	Leaving           map[uint32][]Validator
+	StillRemaining    map[uint32][]Validator
+	UnStakeLeaving    map[uint32][]Validator
+	AdditionalLeaving map[uint32][]Validator
+	NbShards          uint32
+}
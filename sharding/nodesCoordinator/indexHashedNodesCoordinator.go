@@ -82,6 +82,7 @@ type indexHashedNodesCoordinator struct {
 	nodesConfig                   map[uint32]*epochNodesConfig
 	mutNodesConfig                sync.RWMutex
 	mutSavedStateKey              sync.RWMutex
+	mutShuffler                   sync.Mutex
 	nodesCoordinatorHelper        NodesCoordinatorHelper
 	consensusGroupCacher          Cacher
 	loadingFromDisk               atomic.Value
@@ -580,24 +581,89 @@ func (ihnc *indexHashedNodesCoordinator) EpochStartPrepare(metaHdr data.HeaderHa
 	ihnc.mutNodesConfig.RUnlock()
 
 	// TODO: compare with previous nodesConfig if exists
-	newNodesConfig, err := ihnc.computeNodesConfigFromList(copiedPrevious, allValidatorInfo)
+	nextConfig, err := ihnc.computeNextNodesConfig(copiedPrevious, allValidatorInfo, randomness, newEpoch)
 	if err != nil {
-		log.Error("could not compute nodes config from list - do nothing on nodesCoordinator epochStartPrepare")
+		log.Error("could not compute the next nodes config - do nothing on nodesCoordinator epochStartPrepare", "error", err.Error())
 		return
 	}
 
-	if copiedPrevious.nbShards != newNodesConfig.nbShards {
+	if copiedPrevious.nbShards != nextConfig.NbShards {
 		log.Warn("number of shards does not match",
 			"previous epoch", ihnc.currentEpoch,
 			"previous number of shards", copiedPrevious.nbShards,
 			"new epoch", newEpoch,
-			"new number of shards", newNodesConfig.nbShards)
+			"new number of shards", nextConfig.NbShards)
 	}
 
-	additionalLeavingMap, err := ihnc.nodesCoordinatorHelper.ComputeAdditionalLeaving(allValidatorInfo)
+	err = ihnc.setNodesPerShards(nextConfig.Eligible, nextConfig.Waiting, nextConfig.Leaving, newEpoch)
 	if err != nil {
-		log.Error("could not compute additionalLeaving Nodes  - do nothing on nodesCoordinator epochStartPrepare")
-		return
+		log.Error("set nodes per shard failed", "error", err.Error())
+	}
+
+	ihnc.fillPublicKeyToValidatorMap()
+	err = ihnc.saveState(randomness)
+	if err != nil {
+		log.Error("saving nodes coordinator config failed", "error", err.Error())
+	}
+
+	displayNodesConfiguration(
+		nextConfig.Eligible,
+		nextConfig.Waiting,
+		nextConfig.Leaving,
+		nextConfig.StillRemaining,
+		nextConfig.NbShards)
+
+	ihnc.mutSavedStateKey.Lock()
+	ihnc.savedStateKey = randomness
+	ihnc.mutSavedStateKey.Unlock()
+
+	ihnc.consensusGroupCacher.Clear()
+}
+
+// PreviewNodesConfig computes, without applying it, the nodes configuration of the provided epoch starting from the
+// configuration of the previous epoch, the provided validators info and the provided randomness
+func (ihnc *indexHashedNodesCoordinator) PreviewNodesConfig(
+	epoch uint32,
+	randomness []byte,
+	validatorsInfo []*state.ShardValidatorInfo,
+) (*NodesConfigPreview, error) {
+	if epoch == 0 {
+		return nil, ErrNilPreviousEpochConfig
+	}
+
+	ihnc.mutNodesConfig.RLock()
+	previousConfig, ok := ihnc.nodesConfig[epoch-1]
+	if !ok {
+		ihnc.mutNodesConfig.RUnlock()
+		return nil, fmt.Errorf("%w for epoch %d", ErrEpochNodesConfigDoesNotExist, epoch-1)
+	}
+
+	previousConfig.mutNodesMaps.RLock()
+	copiedPrevious := &epochNodesConfig{
+		eligibleMap: copyValidatorMap(previousConfig.eligibleMap),
+		waitingMap:  copyValidatorMap(previousConfig.waitingMap),
+		nbShards:    previousConfig.nbShards,
+	}
+	previousConfig.mutNodesMaps.RUnlock()
+	ihnc.mutNodesConfig.RUnlock()
+
+	return ihnc.computeNextNodesConfig(copiedPrevious, validatorsInfo, randomness, epoch)
+}
+
+func (ihnc *indexHashedNodesCoordinator) computeNextNodesConfig(
+	previousConfig *epochNodesConfig,
+	validatorsInfo []*state.ShardValidatorInfo,
+	randomness []byte,
+	newEpoch uint32,
+) (*NodesConfigPreview, error) {
+	newNodesConfig, err := ihnc.computeNodesConfigFromList(previousConfig, validatorsInfo)
+	if err != nil {
+		return nil, fmt.Errorf("%w while computing the nodes config from list", err)
+	}
+
+	additionalLeavingMap, err := ihnc.nodesCoordinatorHelper.ComputeAdditionalLeaving(validatorsInfo)
+	if err != nil {
+		return nil, fmt.Errorf("%w while computing the additional leaving nodes", err)
 	}
 
 	unStakeLeavingList := ihnc.createSortedListFromMap(newNodesConfig.leavingMap)
@@ -614,10 +680,13 @@ func (ihnc *indexHashedNodesCoordinator) EpochStartPrepare(metaHdr data.HeaderHa
 		Epoch:             newEpoch,
 	}
 
+	// the shuffler configuration is updated on each call, so the previews and the epoch start computation
+	// should not interleave
+	ihnc.mutShuffler.Lock()
 	resUpdateNodes, err := ihnc.shuffler.UpdateNodeLists(shufflerArgs)
+	ihnc.mutShuffler.Unlock()
 	if err != nil {
-		log.Error("could not compute UpdateNodeLists - do nothing on nodesCoordinator epochStartPrepare", "err", err.Error())
-		return
+		return nil, fmt.Errorf("%w while updating the node lists", err)
 	}
 
 	leavingNodesMap, stillRemainingNodesMap := createActuallyLeavingPerShards(
@@ -626,29 +695,15 @@ func (ihnc *indexHashedNodesCoordinator) EpochStartPrepare(metaHdr data.HeaderHa
 		resUpdateNodes.Leaving,
 	)
 
-	err = ihnc.setNodesPerShards(resUpdateNodes.Eligible, resUpdateNodes.Waiting, leavingNodesMap, newEpoch)
-	if err != nil {
-		log.Error("set nodes per shard failed", "error", err.Error())
-	}
-
-	ihnc.fillPublicKeyToValidatorMap()
-	err = ihnc.saveState(randomness)
-	if err != nil {
-		log.Error("saving nodes coordinator config failed", "error", err.Error())
-	}
-
-	displayNodesConfiguration(
-		resUpdateNodes.Eligible,
-		resUpdateNodes.Waiting,
-		leavingNodesMap,
-		stillRemainingNodesMap,
-		newNodesConfig.nbShards)
-
-	ihnc.mutSavedStateKey.Lock()
-	ihnc.savedStateKey = randomness
-	ihnc.mutSavedStateKey.Unlock()
-
-	ihnc.consensusGroupCacher.Clear()
+	return &NodesConfigPreview{
+		Eligible:          resUpdateNodes.Eligible,
+		Waiting:           resUpdateNodes.Waiting,
+		Leaving:           leavingNodesMap,
+		StillRemaining:    stillRemainingNodesMap,
+		UnStakeLeaving:    newNodesConfig.leavingMap,
+		AdditionalLeaving: additionalLeavingMap,
+		NbShards:          newNodesConfig.nbShards,
+	}, nil
 }
 
 func (ihnc *indexHashedNodesCoordinator) fillPublicKeyToValidatorMap() {
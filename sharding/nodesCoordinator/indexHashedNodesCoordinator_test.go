@@ -1155,6 +1155,63 @@ func TestIndexHashedNodesCoordinator_EpochStart(t *testing.T) {
 	require.False(t, isValidator)
 }
 
+func TestIndexHashedNodesCoordinator_PreviewNodesConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing previous epoch config should error", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createArguments())
+
+		preview, err := ihnc.PreviewNodesConfig(0, []byte("rand seed"), nil)
+		assert.Equal(t, ErrNilPreviousEpochConfig, err)
+		assert.Nil(t, preview)
+
+		preview, err = ihnc.PreviewNodesConfig(5, []byte("rand seed"), nil)
+		assert.True(t, errors.Is(err, ErrEpochNodesConfigDoesNotExist))
+		assert.Nil(t, preview)
+	})
+	t.Run("should compute the same lists as the epoch start without applying them", func(t *testing.T) {
+		t.Parallel()
+
+		ihnc, _ := NewIndexHashedNodesCoordinator(createArguments())
+		epoch := uint32(1)
+		header := &block.MetaBlock{
+			PrevRandSeed: []byte("rand seed"),
+			EpochStart:   block.EpochStart{LastFinalizedHeaders: []block.EpochStartShardData{{}}},
+			Epoch:        epoch,
+		}
+		body := createBlockBodyFromNodesCoordinator(ihnc, 0)
+		validatorsInfo, err := createValidatorInfoFromBody(body, ihnc.marshalizer, ihnc.numTotalEligible)
+		require.Nil(t, err)
+
+		preview, err := ihnc.PreviewNodesConfig(epoch, header.PrevRandSeed, validatorsInfo)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(1), preview.NbShards)
+		assert.False(t, ihnc.IsEpochInConfig(epoch))
+
+		ihnc.EpochStartPrepare(header, body)
+
+		eligible, _ := ihnc.GetAllEligibleValidatorsPublicKeys(epoch)
+		waiting, _ := ihnc.GetAllWaitingValidatorsPublicKeys(epoch)
+		for shardID, validators := range preview.Eligible {
+			assert.Equal(t, eligible[shardID], pubKeysFromValidators(validators))
+		}
+		for shardID, validators := range preview.Waiting {
+			assert.Equal(t, waiting[shardID], pubKeysFromValidators(validators))
+		}
+	})
+}
+
+func pubKeysFromValidators(validators []Validator) [][]byte {
+	pubKeys := make([][]byte, 0, len(validators))
+	for _, v := range validators {
+		pubKeys = append(pubKeys, v.PubKey())
+	}
+
+	return pubKeys
+}
+
 func TestIndexHashedNodesCoordinator_setNodesPerShardsShouldTriggerWrongConfiguration(t *testing.T) {
 	t.Parallel()
 
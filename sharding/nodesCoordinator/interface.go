@@ -26,6 +26,7 @@ type NodesCoordinator interface {
 	GetSavedStateKey() []byte
 	ShardIdForEpoch(epoch uint32) (uint32, error)
 	ShuffleOutForEpoch(_ uint32)
+	PreviewNodesConfig(epoch uint32, randomness []byte, validatorsInfo []*state.ShardValidatorInfo) (*NodesConfigPreview, error)
 	GetConsensusWhitelistedNodes(epoch uint32) (map[string]struct{}, error)
 	ConsensusGroupSize(uint32) int
 	GetNumTotalEligible() uint64
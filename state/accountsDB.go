@@ -16,6 +16,7 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -82,6 +83,7 @@ type AccountsDB struct {
 	processingMode       common.NodeProcessingMode
 	loadCodeMeasurements *loadingMeasurements
 	processStatusHandler common.ProcessStatusHandler
+	metricsRegistry      common.MetricsRegistry
 
 	stackDebug []byte
 }
@@ -97,6 +99,7 @@ type ArgsAccountsDB struct {
 	StoragePruningManager StoragePruningManager
 	ProcessingMode        common.NodeProcessingMode
 	ProcessStatusHandler  common.ProcessStatusHandler
+	MetricsRegistry       common.MetricsRegistry
 }
 
 // NewAccountsDB creates a new account manager
@@ -122,6 +125,7 @@ func NewAccountsDB(args ArgsAccountsDB) (*AccountsDB, error) {
 		processingMode:       args.ProcessingMode,
 		lastSnapshot:         &snapshotInfo{},
 		processStatusHandler: args.ProcessStatusHandler,
+		metricsRegistry:      args.MetricsRegistry,
 	}
 
 	trieStorageManager := adb.mainTrie.GetStorageManager()
@@ -152,6 +156,9 @@ func checkArgsAccountsDB(args ArgsAccountsDB) error {
 	if check.IfNil(args.ProcessStatusHandler) {
 		return ErrNilProcessStatusHandler
 	}
+	if check.IfNil(args.MetricsRegistry) {
+		return ErrNilMetricsRegistry
+	}
 
 	return nil
 }
@@ -847,6 +854,8 @@ func (adb *AccountsDB) Commit() ([]byte, error) {
 }
 
 func (adb *AccountsDB) commit() ([]byte, error) {
+	defer adb.metricsRegistry.ObserveDurationSince(prometheus.TrieCommitDuration, time.Now())
+
 	log.Trace("accountsDB.Commit started")
 	adb.entries = make([]JournalEntry, 0)
 
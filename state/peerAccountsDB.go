@@ -36,6 +36,7 @@ func NewPeerAccountsDB(args ArgsAccountsDB) (*PeerAccountsDB, error) {
 			processingMode:        args.ProcessingMode,
 			lastSnapshot:          &snapshotInfo{},
 			processStatusHandler:  args.ProcessStatusHandler,
+			metricsRegistry:       args.MetricsRegistry,
 		},
 	}
 
@@ -80,6 +80,16 @@ func TestNewPeerAccountsDB(t *testing.T) {
 		assert.True(t, check.IfNil(adb))
 		assert.Equal(t, state.ErrNilProcessStatusHandler, err)
 	})
+	t.Run("nil metrics registry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockAccountsDBArgs()
+		args.MetricsRegistry = nil
+
+		adb, err := state.NewPeerAccountsDB(args)
+		assert.True(t, check.IfNil(adb))
+		assert.Equal(t, state.ErrNilMetricsRegistry, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,7 @@
+package statusHandler
+
+// MetricsRegistryHandler defines the registry of the histograms and counters rendered together with the status metrics
+type MetricsRegistryHandler interface {
+	PrometheusString(shardID uint32) string
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,194 @@
+package prometheus
+
+import (
+	"fmt"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var log = logger.GetOrCreate("statusHandler/prometheus")
+
+const (
+	// BlockProcessingDuration is the histogram of the block processing durations, labeled by phase
+	BlockProcessingDuration = "erd_block_processing_duration_seconds"
+	// ConsensusSubroundDuration is the histogram of the consensus subrounds durations, labeled by subround
+	ConsensusSubroundDuration = "erd_consensus_subround_duration_seconds"
+	// TrieCommitDuration is the histogram of the accounts commit durations, the data tries commits included
+	TrieCommitDuration = "erd_trie_commit_duration_seconds"
+	// TrieSnapshotDuration is the histogram of the trie snapshot durations, labeled by the snapshot type
+	TrieSnapshotDuration = "erd_trie_snapshot_duration_seconds"
+	// SCQueryDuration is the histogram of the smart contract queries durations
+	SCQueryDuration = "erd_sc_query_duration_seconds"
+	// SCQueryCacheRequests is the counter of the smart contract queries handled by the query cache, labeled by result
+	SCQueryCacheRequests = "erd_sc_query_cache_requests_total"
+	// GasProfilerGasUsed is the counter of the gas used by the smart contracts and built-in functions executions,
+	// labeled by kind and by the built-in function name
+	GasProfilerGasUsed = "erd_gas_profiler_gas_used_total"
+	// GasProfilerCalls is the counter of the smart contracts and built-in functions executions, labeled by kind, by the
+	// built-in function name and by result
+	GasProfilerCalls = "erd_gas_profiler_calls_total"
+	// RestRequestDuration is the histogram of the REST API requests durations, labeled by method and route
+	RestRequestDuration = "erd_rest_request_duration_seconds"
+	// InterceptedMessages is the counter of the messages received by the interceptors, labeled by topic
+	InterceptedMessages = "erd_intercepted_messages_total"
+	// ResolverRequests is the counter of the requests received by the resolvers, labeled by topic
+	ResolverRequests = "erd_resolver_requests_total"
+	// P2PCompressedMessages is the counter of the p2p messages sent with a compressed payload, labeled by topic
+	P2PCompressedMessages = "erd_p2p_compressed_messages_total"
+	// P2PCompressionSavedBytes is the counter of the bytes saved by compressing the p2p payloads, labeled by topic
+	P2PCompressionSavedBytes = "erd_p2p_compression_saved_bytes_total"
+)
+
+const (
+	// PhaseCreate labels the block creation phase
+	PhaseCreate = "create"
+	// PhaseProcess labels the block processing phase
+	PhaseProcess = "process"
+	// PhaseCommit labels the block commit phase
+	PhaseCommit = "commit"
+	// SnapshotTypeSnapshot labels the trie snapshots
+	SnapshotTypeSnapshot = "snapshot"
+	// SnapshotTypeCheckpoint labels the trie checkpoints
+	SnapshotTypeCheckpoint = "checkpoint"
+	// CacheResultHit labels the requests answered from a cache
+	CacheResultHit = "hit"
+	// CacheResultMiss labels the requests that could not be answered from a cache
+	CacheResultMiss = "miss"
+	// GasKindContract labels the smart contracts executions
+	GasKindContract = "contract"
+	// GasKindBuiltInFunction labels the built-in functions executions
+	GasKindBuiltInFunction = "builtin"
+	// ExecutionResultSuccess labels the successful executions
+	ExecutionResultSuccess = "success"
+	// ExecutionResultFailed labels the failed executions
+	ExecutionResultFailed = "failed"
+)
+
+var defaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+var snapshotBuckets = []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200, 1800, 3600}
+
+var metricDefinitions = []metricDefinition{
+	{
+		name:       BlockProcessingDuration,
+		help:       "Duration of the block processing phases in seconds",
+		metricType: typeHistogram,
+		labelNames: []string{"phase"},
+		buckets:    defaultBuckets,
+	},
+	{
+		name:       ConsensusSubroundDuration,
+		help:       "Duration of the consensus subrounds in seconds",
+		metricType: typeHistogram,
+		labelNames: []string{"subround"},
+		buckets:    defaultBuckets,
+	},
+	{
+		name:       TrieCommitDuration,
+		help:       "Duration of the accounts commits in seconds, the data tries commits included",
+		metricType: typeHistogram,
+		labelNames: []string{},
+		buckets:    defaultBuckets,
+	},
+	{
+		name:       TrieSnapshotDuration,
+		help:       "Duration of the trie snapshots and checkpoints in seconds",
+		metricType: typeHistogram,
+		labelNames: []string{"type"},
+		buckets:    snapshotBuckets,
+	},
+	{
+		name:       SCQueryDuration,
+		help:       "Duration of the smart contract queries in seconds",
+		metricType: typeHistogram,
+		labelNames: []string{},
+		buckets:    defaultBuckets,
+	},
+	{
+		name:       SCQueryCacheRequests,
+		help:       "Number of smart contract queries handled by the query cache",
+		metricType: typeCounter,
+		labelNames: []string{"result"},
+	},
+	{
+		name:       GasProfilerGasUsed,
+		help:       "Gas used by the smart contracts and built-in functions executions. The function label is set only for the built-in functions",
+		metricType: typeCounter,
+		labelNames: []string{"kind", "function"},
+	},
+	{
+		name:       GasProfilerCalls,
+		help:       "Number of smart contracts and built-in functions executions. The function label is set only for the built-in functions",
+		metricType: typeCounter,
+		labelNames: []string{"kind", "function", "result"},
+	},
+	{
+		name:       RestRequestDuration,
+		help:       "Duration of the REST API requests in seconds",
+		metricType: typeHistogram,
+		labelNames: []string{"method", "route"},
+		buckets:    defaultBuckets,
+	},
+	{
+		name:       InterceptedMessages,
+		help:       "Number of messages received by the interceptors",
+		metricType: typeCounter,
+		labelNames: []string{"topic"},
+	},
+	{
+		name:       ResolverRequests,
+		help:       "Number of requests received by the resolvers",
+		metricType: typeCounter,
+		labelNames: []string{"topic"},
+	},
+	{
+		name:       P2PCompressedMessages,
+		help:       "Number of p2p messages sent with a compressed payload",
+		metricType: typeCounter,
+		labelNames: []string{"topic"},
+	},
+	{
+		name:       P2PCompressionSavedBytes,
+		help:       "Number of bytes saved by compressing the p2p payloads",
+		metricType: typeCounter,
+		labelNames: []string{"topic"},
+	},
+}
+
+// NewRegistry creates a registry holding all the histograms and counters of the node
+func NewRegistry() *registry {
+	return newRegistry(metricDefinitions)
+}
+
+// ObserveDuration records the provided duration in the histogram with the provided name
+func (r *registry) ObserveDuration(name string, duration time.Duration, labelValues ...string) {
+	r.observe(name, duration.Seconds(), labelValues)
+}
+
+// ObserveDurationSince records the duration elapsed since the provided start time in the histogram with the provided
+// name. It is meant to be deferred at the beginning of the measured function
+func (r *registry) ObserveDurationSince(name string, start time.Time, labelValues ...string) {
+	r.ObserveDuration(name, time.Since(start), labelValues...)
+}
+
+// IncrementCounter increments the counter with the provided name
+func (r *registry) IncrementCounter(name string, labelValues ...string) {
+	r.add(name, 1, labelValues)
+}
+
+// AddToCounter adds the provided value to the counter with the provided name
+func (r *registry) AddToCounter(name string, value uint64, labelValues ...string) {
+	r.add(name, value, labelValues)
+}
+
+// PrometheusString returns the histograms and the counters in the Prometheus exposition format, labeled with the
+// provided shard ID
+func (r *registry) PrometheusString(shardID uint32) string {
+	return r.render(common.MetricShardId, fmt.Sprintf("%d", shardID))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (r *registry) IsInterfaceNil() bool {
+	return r == nil
+}
@@ -0,0 +1,200 @@
+package prometheus
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	typeHistogram = "histogram"
+	typeCounter   = "counter"
+)
+
+const labelValuesSeparator = "\x00"
+
+// metricDefinition describes a histogram or a counter metric
+type metricDefinition struct {
+	name       string
+	help       string
+	metricType string
+	labelNames []string
+	buckets    []float64
+}
+
+// series holds the values of a metric for a set of label values
+type series struct {
+	labelValues  []string
+	bucketCounts []uint64
+	sum          float64
+	count        uint64
+}
+
+type metric struct {
+	definition metricDefinition
+	mutSeries  sync.Mutex
+	series     map[string]*series
+}
+
+// registry holds the histogram and counter metrics and renders them in the Prometheus exposition format
+type registry struct {
+	metrics map[string]*metric
+}
+
+func newRegistry(definitions []metricDefinition) *registry {
+	r := &registry{
+		metrics: make(map[string]*metric),
+	}
+
+	for _, definition := range definitions {
+		r.metrics[definition.name] = &metric{
+			definition: definition,
+			series:     make(map[string]*series),
+		}
+	}
+
+	return r
+}
+
+func (r *registry) observe(name string, value float64, labelValues []string) {
+	m, ok := r.metrics[name]
+	if !ok || m.definition.metricType != typeHistogram {
+		log.Trace("registry.observe: unknown histogram", "name", name)
+		return
+	}
+
+	s, ok := m.getSeries(labelValues)
+	if !ok {
+		return
+	}
+
+	m.mutSeries.Lock()
+	for index, upperBound := range m.definition.buckets {
+		if value <= upperBound {
+			s.bucketCounts[index]++
+		}
+	}
+	s.sum += value
+	s.count++
+	m.mutSeries.Unlock()
+}
+
+func (r *registry) add(name string, value uint64, labelValues []string) {
+	m, ok := r.metrics[name]
+	if !ok || m.definition.metricType != typeCounter {
+		log.Trace("registry.add: unknown counter", "name", name)
+		return
+	}
+
+	s, ok := m.getSeries(labelValues)
+	if !ok {
+		return
+	}
+
+	m.mutSeries.Lock()
+	s.count += value
+	m.mutSeries.Unlock()
+}
+
+func (m *metric) getSeries(labelValues []string) (*series, bool) {
+	if len(labelValues) != len(m.definition.labelNames) {
+		log.Trace("metric: wrong number of label values",
+			"name", m.definition.name,
+			"expected", len(m.definition.labelNames),
+			"provided", len(labelValues))
+		return nil, false
+	}
+
+	key := strings.Join(labelValues, labelValuesSeparator)
+
+	m.mutSeries.Lock()
+	defer m.mutSeries.Unlock()
+
+	s, ok := m.series[key]
+	if !ok {
+		s = &series{
+			labelValues:  append(make([]string, 0, len(labelValues)), labelValues...),
+			bucketCounts: make([]uint64, len(m.definition.buckets)),
+		}
+		m.series[key] = s
+	}
+
+	return s, true
+}
+
+// render returns all the metrics that have at least one value in the Prometheus exposition format. The constant
+// label is added to every series
+func (r *registry) render(constLabelName string, constLabelValue string) string {
+	names := make([]string, 0, len(r.metrics))
+	for name := range r.metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	builder := strings.Builder{}
+	for _, name := range names {
+		r.metrics[name].render(&builder, constLabelName, constLabelValue)
+	}
+
+	return builder.String()
+}
+
+func (m *metric) render(builder *strings.Builder, constLabelName string, constLabelValue string) {
+	m.mutSeries.Lock()
+	defer m.mutSeries.Unlock()
+
+	if len(m.series) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(m.series))
+	for key := range m.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	definition := m.definition
+	builder.WriteString(fmt.Sprintf("# HELP %s %s\n", definition.name, definition.help))
+	builder.WriteString(fmt.Sprintf("# TYPE %s %s\n", definition.name, definition.metricType))
+
+	for _, key := range keys {
+		s := m.series[key]
+		labels := make([]string, 0, len(s.labelValues)+1)
+		labels = append(labels, formatLabel(constLabelName, constLabelValue))
+		for index, labelName := range definition.labelNames {
+			labels = append(labels, formatLabel(labelName, s.labelValues[index]))
+		}
+		labelsString := strings.Join(labels, ",")
+
+		if definition.metricType == typeCounter {
+			builder.WriteString(fmt.Sprintf("%s{%s} %d\n", definition.name, labelsString, s.count))
+			continue
+		}
+
+		for index, upperBound := range definition.buckets {
+			builder.WriteString(fmt.Sprintf("%s_bucket{%s,%s} %d\n",
+				definition.name, labelsString, formatLabel("le", formatFloat(upperBound)), s.bucketCounts[index]))
+		}
+		builder.WriteString(fmt.Sprintf("%s_bucket{%s,%s} %d\n",
+			definition.name, labelsString, formatLabel("le", formatFloat(math.Inf(1))), s.count))
+		builder.WriteString(fmt.Sprintf("%s_sum{%s} %s\n", definition.name, labelsString, formatFloat(s.sum)))
+		builder.WriteString(fmt.Sprintf("%s_count{%s} %d\n", definition.name, labelsString, s.count))
+	}
+}
+
+var labelValueEscaper = strings.NewReplacer("\\", "\\\\", "\"", "\\\"", "\n", "\\n")
+
+func formatLabel(name string, value string) string {
+	return fmt.Sprintf("%s=\"%s\"", name, labelValueEscaper.Replace(value))
+}
+
+func formatFloat(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,95 @@
+package prometheus
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createTestRegistry() *registry {
+	return newRegistry([]metricDefinition{
+		{
+			name:       "test_histogram",
+			help:       "test histogram",
+			metricType: typeHistogram,
+			labelNames: []string{"phase"},
+			buckets:    []float64{0.1, 1},
+		},
+		{
+			name:       "test_counter",
+			help:       "test counter",
+			metricType: typeCounter,
+			labelNames: []string{"topic"},
+		},
+	})
+}
+
+func TestRegistry_RenderEmptyShouldNotOutputMetrics(t *testing.T) {
+	t.Parallel()
+
+	r := createTestRegistry()
+	assert.Equal(t, "", r.render("shard", "0"))
+}
+
+func TestRegistry_RenderShouldUseTheExpositionFormat(t *testing.T) {
+	t.Parallel()
+
+	r := createTestRegistry()
+	r.observe("test_histogram", 0.05, []string{"create"})
+	r.observe("test_histogram", 0.5, []string{"create"})
+	r.observe("test_histogram", 2, []string{"create"})
+	r.add("test_counter", 1, []string{"topic\"1"})
+	r.add("test_counter", 2, []string{"topic\"1"})
+
+	expected := `# HELP test_counter test counter
+# TYPE test_counter counter
+test_counter{shard="1",topic="topic\"1"} 3
+# HELP test_histogram test histogram
+# TYPE test_histogram histogram
+test_histogram_bucket{shard="1",phase="create",le="0.1"} 1
+test_histogram_bucket{shard="1",phase="create",le="1"} 2
+test_histogram_bucket{shard="1",phase="create",le="+Inf"} 3
+test_histogram_sum{shard="1",phase="create"} 2.55
+test_histogram_count{shard="1",phase="create"} 3
+`
+	assert.Equal(t, expected, r.render("shard", "1"))
+}
+
+func TestRegistry_WrongUsageShouldBeIgnored(t *testing.T) {
+	t.Parallel()
+
+	r := createTestRegistry()
+	r.observe("missing", 1, []string{"create"})
+	r.observe("test_counter", 1, []string{"topic"})
+	r.observe("test_histogram", 1, []string{"create", "extra label"})
+	r.add("test_histogram", 1, []string{"create"})
+
+	assert.Equal(t, "", r.render("shard", "0"))
+}
+
+func TestRegistry_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	r := createTestRegistry()
+	numOperations := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(index int) {
+			switch index % 3 {
+			case 0:
+				r.observe("test_histogram", 0.5, []string{"process"})
+			case 1:
+				r.add("test_counter", 1, []string{"topic"})
+			default:
+				_ = r.render("shard", "0")
+			}
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Contains(t, r.render("shard", "0"), `test_histogram_count{shard="0",phase="process"} 334`)
+	assert.Contains(t, r.render("shard", "0"), `test_counter{shard="0",topic="topic"} 333`)
+}
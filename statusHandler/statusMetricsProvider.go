@@ -5,7 +5,9 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/disabled"
 )
 
 // statusMetrics will handle displaying at /node/details all metrics already collected for other status handlers
@@ -18,14 +20,23 @@ type statusMetrics struct {
 
 	int64Metrics       map[string]int64
 	mutInt64Operations sync.RWMutex
+
+	metricsRegistry MetricsRegistryHandler
 }
 
-// NewStatusMetrics will return an instance of the struct
+// NewStatusMetrics will return an instance of the struct, without rendering any histograms or counters
 func NewStatusMetrics() *statusMetrics {
+	return NewStatusMetricsWithRegistry(disabled.NewMetricsRegistry())
+}
+
+// NewStatusMetricsWithRegistry will return an instance of the struct, rendering the histograms and counters of the
+// provided metrics registry
+func NewStatusMetricsWithRegistry(metricsRegistry MetricsRegistryHandler) *statusMetrics {
 	return &statusMetrics{
-		uint64Metrics: make(map[string]uint64),
-		stringMetrics: make(map[string]string),
-		int64Metrics:  make(map[string]int64),
+		uint64Metrics:   make(map[string]uint64),
+		stringMetrics:   make(map[string]string),
+		int64Metrics:    make(map[string]int64),
+		metricsRegistry: metricsRegistry,
 	}
 }
 
@@ -179,6 +190,10 @@ func (sm *statusMetrics) StatusMetricsWithoutP2PPrometheusString() (string, erro
 		}
 	}
 
+	if !check.IfNil(sm.metricsRegistry) {
+		stringBuilder.WriteString(sm.metricsRegistry.PrometheusString(uint32(shardID)))
+	}
+
 	return stringBuilder.String(), nil
 }
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -117,7 +118,7 @@ func TestStatusMetricsProvider_AddUint64Value(t *testing.T) {
 func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldPutDefaultShardIDLabel(t *testing.T) {
 	t.Parallel()
 
-	sm := statusHandler.NewStatusMetrics()
+	sm := statusHandler.NewStatusMetricsWithRegistry(prometheus.NewRegistry())
 	key1, value1 := "test-key7", uint64(100)
 	key2, value2 := "test-key8", "value8"
 	sm.SetUInt64Value(key1, value1)
@@ -133,7 +134,7 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldPutCorrectSh
 	t.Parallel()
 
 	shardID := uint32(37)
-	sm := statusHandler.NewStatusMetrics()
+	sm := statusHandler.NewStatusMetricsWithRegistry(prometheus.NewRegistry())
 	key1, value1 := "test-key7", uint64(100)
 	key2, value2 := "test-key8", "value8"
 	key3, value3 := common.MetricShardId, shardID
@@ -147,6 +148,27 @@ func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldPutCorrectSh
 	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
 }
 
+func TestStatusMetrics_StatusMetricsWithoutP2PPrometheusStringShouldRenderTheProvidedRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry := prometheus.NewRegistry()
+	sm := statusHandler.NewStatusMetricsWithRegistry(registry)
+	sm.SetUInt64Value(common.MetricShardId, 2)
+
+	strRes, _ := sm.StatusMetricsWithoutP2PPrometheusString()
+	assert.False(t, strings.Contains(strRes, prometheus.SCQueryDuration))
+
+	registry.ObserveDuration(prometheus.SCQueryDuration, time.Second)
+	strRes, _ = sm.StatusMetricsWithoutP2PPrometheusString()
+	expectedMetricOutput := fmt.Sprintf("%s_count{%s=\"%d\"} 1", prometheus.SCQueryDuration, common.MetricShardId, 2)
+	assert.True(t, strings.Contains(strRes, expectedMetricOutput))
+
+	sm = statusHandler.NewStatusMetricsWithRegistry(prometheus.NewRegistry())
+	sm.SetUInt64Value(common.MetricShardId, 2)
+	strRes, _ = sm.StatusMetricsWithoutP2PPrometheusString()
+	assert.False(t, strings.Contains(strRes, prometheus.SCQueryDuration))
+}
+
 func TestStatusMetrics_NetworkConfig(t *testing.T) {
 	t.Parallel()
 
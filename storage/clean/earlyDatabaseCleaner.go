@@ -0,0 +1,73 @@
+package clean
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsEarlyDatabaseCleaner holds the arguments needed for creating an earlyDatabaseCleaner
+type ArgsEarlyDatabaseCleaner struct {
+	StorageListProvider    StorageListProviderHandler
+	OldDataCleanerProvider OldDataCleanerProvider
+}
+
+type earlyDatabaseCleaner struct {
+	mutClean               sync.Mutex
+	storageListProvider    StorageListProviderHandler
+	oldDataCleanerProvider OldDataCleanerProvider
+}
+
+// NewEarlyDatabaseCleaner returns a new instance of earlyDatabaseCleaner, able to remove the old epochs databases
+// before the epoch change, when the node is about to run out of disk space
+func NewEarlyDatabaseCleaner(args ArgsEarlyDatabaseCleaner) (*earlyDatabaseCleaner, error) {
+	if check.IfNil(args.StorageListProvider) {
+		return nil, storage.ErrNilStorageListProvider
+	}
+	if check.IfNil(args.OldDataCleanerProvider) {
+		return nil, storage.ErrNilOldDataCleanerProvider
+	}
+
+	return &earlyDatabaseCleaner{
+		storageListProvider:    args.StorageListProvider,
+		oldDataCleanerProvider: args.OldDataCleanerProvider,
+	}, nil
+}
+
+// CleanEpochsOlderThan removes the closed persisters of the epochs older than the provided one and returns the number
+// of epochs with removed persisters. The persisters are removed by their storers, which close them first and keep the
+// configured number of epochs. It does nothing if the node has to keep the old data
+func (edc *earlyDatabaseCleaner) CleanEpochsOlderThan(epoch uint32) (int, error) {
+	if !edc.oldDataCleanerProvider.ShouldClean() {
+		return 0, nil
+	}
+
+	edc.mutClean.Lock()
+	defer edc.mutClean.Unlock()
+
+	log.Debug("early cleaning of old databases", "older than epoch", epoch)
+
+	removedEpochs := make(map[uint32]struct{})
+	for unitType, storer := range edc.storageListProvider.GetAllStorers() {
+		persistersRemover, ok := storer.(OldEpochsPersistersRemover)
+		if !ok {
+			continue
+		}
+
+		epochs, err := persistersRemover.RemoveClosedPersistersOlderThan(epoch)
+		for _, removedEpoch := range epochs {
+			removedEpochs[removedEpoch] = struct{}{}
+		}
+		if err != nil {
+			log.Warn("cannot remove the old persisters", "unit", unitType.String(), "error", err.Error())
+		}
+	}
+
+	return len(removedEpochs), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (edc *earlyDatabaseCleaner) IsInterfaceNil() bool {
+	return edc == nil
+}
@@ -0,0 +1,118 @@
+package clean
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+type persistersRemoverStub struct {
+	storageStubs.StorerStub
+	removeClosedPersistersOlderThanCalled func(epoch uint32) ([]uint32, error)
+}
+
+func (prs *persistersRemoverStub) RemoveClosedPersistersOlderThan(epoch uint32) ([]uint32, error) {
+	return prs.removeClosedPersistersOlderThanCalled(epoch)
+}
+
+func createMockArgsEarlyDatabaseCleaner(storers map[dataRetriever.UnitType]storage.Storer, shouldClean bool) ArgsEarlyDatabaseCleaner {
+	return ArgsEarlyDatabaseCleaner{
+		StorageListProvider: &mock.StorageListProviderStub{
+			GetAllStorersCalled: func() map[dataRetriever.UnitType]storage.Storer {
+				return storers
+			},
+		},
+		OldDataCleanerProvider: &testscommon.OldDataCleanerProviderStub{
+			ShouldCleanCalled: func() bool {
+				return shouldClean
+			},
+		},
+	}
+}
+
+func TestNewEarlyDatabaseCleaner(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage list provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEarlyDatabaseCleaner(nil, true)
+		args.StorageListProvider = nil
+		edc, err := NewEarlyDatabaseCleaner(args)
+		assert.Equal(t, storage.ErrNilStorageListProvider, err)
+		assert.True(t, check.IfNil(edc))
+	})
+	t.Run("nil old data cleaner provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEarlyDatabaseCleaner(nil, true)
+		args.OldDataCleanerProvider = nil
+		edc, err := NewEarlyDatabaseCleaner(args)
+		assert.Equal(t, storage.ErrNilOldDataCleanerProvider, err)
+		assert.True(t, check.IfNil(edc))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		edc, err := NewEarlyDatabaseCleaner(createMockArgsEarlyDatabaseCleaner(nil, true))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(edc))
+	})
+}
+
+func TestEarlyDatabaseCleaner_CleanEpochsOlderThan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should remove the older epochs through the storers", func(t *testing.T) {
+		t.Parallel()
+
+		requestedEpochs := make([]uint32, 0)
+		createRemover := func(removedEpochs []uint32, err error) *persistersRemoverStub {
+			return &persistersRemoverStub{
+				removeClosedPersistersOlderThanCalled: func(epoch uint32) ([]uint32, error) {
+					requestedEpochs = append(requestedEpochs, epoch)
+					return removedEpochs, err
+				},
+			}
+		}
+		storers := map[dataRetriever.UnitType]storage.Storer{
+			dataRetriever.TransactionUnit:         createRemover([]uint32{0, 1}, nil),
+			dataRetriever.MiniBlockUnit:           createRemover([]uint32{1}, errors.New("expected error")),
+			dataRetriever.BootstrapUnit:           &storageStubs.StorerStub{},
+			dataRetriever.BlockHeaderUnit:         createRemover(nil, nil),
+			dataRetriever.ReceiptsUnit:            createRemover([]uint32{2}, nil),
+			dataRetriever.MetaBlockUnit:           createRemover([]uint32{}, nil),
+			dataRetriever.UnsignedTransactionUnit: createRemover([]uint32{0}, nil),
+		}
+
+		edc, _ := NewEarlyDatabaseCleaner(createMockArgsEarlyDatabaseCleaner(storers, true))
+		numRemoved, err := edc.CleanEpochsOlderThan(3)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, numRemoved)
+		assert.Equal(t, []uint32{3, 3, 3, 3, 3, 3}, requestedEpochs)
+	})
+	t.Run("should not remove anything if the old data should be kept", func(t *testing.T) {
+		t.Parallel()
+
+		storers := map[dataRetriever.UnitType]storage.Storer{
+			dataRetriever.TransactionUnit: &persistersRemoverStub{
+				removeClosedPersistersOlderThanCalled: func(epoch uint32) ([]uint32, error) {
+					assert.Fail(t, "should not have been called")
+					return nil, nil
+				},
+			},
+		}
+
+		edc, _ := NewEarlyDatabaseCleaner(createMockArgsEarlyDatabaseCleaner(storers, false))
+		numRemoved, err := edc.CleanEpochsOlderThan(2)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, numRemoved)
+	})
+}
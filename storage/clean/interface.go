@@ -25,6 +25,11 @@ type OldDataCleanerProvider interface {
 	IsInterfaceNil() bool
 }
 
+// OldEpochsPersistersRemover defines the storers able to remove the closed persisters of the old epochs
+type OldEpochsPersistersRemover interface {
+	RemoveClosedPersistersOlderThan(epoch uint32) ([]uint32, error)
+}
+
 // NodeTypeProviderHandler defines the actions needed for a component that can handle the node type
 type NodeTypeProviderHandler interface {
 	SetType(nodeType core.NodeType)
@@ -0,0 +1,261 @@
+package diskspace
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/statistics/machine"
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+var log = logger.GetOrCreate("storage/diskspace")
+
+const (
+	statusOk       = "ok"
+	statusWarning  = "warning"
+	statusLow      = "low"
+	statusCritical = "critical"
+)
+
+// ArgsDiskSpaceWatchdog holds the arguments needed for creating a diskSpaceWatchdog
+type ArgsDiskSpaceWatchdog struct {
+	Config              config.DiskSpaceWatchdogConfig
+	WorkingDir          string
+	NumActivePersisters uint32
+	IsFullArchive       bool
+	StatusHandler       core.AppStatusHandler
+	EpochProvider       EpochProvider
+	OldEpochsCleaner    OldEpochsCleaner
+	ChanStopNodeProcess chan endProcess.ArgEndProcess
+}
+
+type diskSpaceWatchdog struct {
+	mutCheck                sync.Mutex
+	workingDir              string
+	numActivePersisters     uint32
+	isFullArchive           bool
+	warningThreshold        uint64
+	cleanupThreshold        uint64
+	stopThreshold           uint64
+	statusHandler           core.AppStatusHandler
+	epochProvider           EpochProvider
+	oldEpochsCleaner        OldEpochsCleaner
+	chanStopNodeProcess     chan endProcess.ArgEndProcess
+	acquireDiskStatistics   func(path string) (machine.DiskStatistics, error)
+	stopSignalSent          bool
+	lastCleanupEpochTrigger uint32
+	cleanupTriggered        bool
+	checkInterval           time.Duration
+	cancelFunc              func()
+	isClosed                bool
+}
+
+// NewDiskSpaceWatchdog creates a component that monitors the free space of the working directory volume. The checks
+// are done periodically after calling StartWatching
+func NewDiskSpaceWatchdog(args ArgsDiskSpaceWatchdog) (*diskSpaceWatchdog, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &diskSpaceWatchdog{
+		workingDir:            args.WorkingDir,
+		numActivePersisters:   args.NumActivePersisters,
+		isFullArchive:         args.IsFullArchive,
+		warningThreshold:      args.Config.FreeSpaceWarningThresholdInMB * core.MegabyteSize,
+		cleanupThreshold:      args.Config.FreeSpaceCleanupThresholdInMB * core.MegabyteSize,
+		stopThreshold:         args.Config.FreeSpaceStopThresholdInMB * core.MegabyteSize,
+		statusHandler:         args.StatusHandler,
+		epochProvider:         args.EpochProvider,
+		oldEpochsCleaner:      args.OldEpochsCleaner,
+		chanStopNodeProcess:   args.ChanStopNodeProcess,
+		acquireDiskStatistics: machine.AcquireDiskStatistics,
+		checkInterval:         time.Duration(args.Config.CheckIntervalInSeconds) * time.Second,
+		cancelFunc:            func() {},
+	}, nil
+}
+
+func checkArgs(args ArgsDiskSpaceWatchdog) error {
+	if len(args.WorkingDir) == 0 {
+		return ErrEmptyWorkingDir
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilAppStatusHandler
+	}
+	if check.IfNil(args.EpochProvider) {
+		return ErrNilEpochProvider
+	}
+	if check.IfNil(args.OldEpochsCleaner) {
+		return ErrNilOldEpochsCleaner
+	}
+	if args.ChanStopNodeProcess == nil {
+		return ErrNilChanStopNodeProcess
+	}
+	if args.Config.CheckIntervalInSeconds == 0 {
+		return ErrInvalidCheckInterval
+	}
+
+	cfg := args.Config
+	if cfg.FreeSpaceWarningThresholdInMB < cfg.FreeSpaceCleanupThresholdInMB ||
+		cfg.FreeSpaceCleanupThresholdInMB < cfg.FreeSpaceStopThresholdInMB {
+		return fmt.Errorf("%w: warning %d MB, cleanup %d MB, stop %d MB, the thresholds should be in decreasing order",
+			ErrInvalidThresholds,
+			cfg.FreeSpaceWarningThresholdInMB,
+			cfg.FreeSpaceCleanupThresholdInMB,
+			cfg.FreeSpaceStopThresholdInMB)
+	}
+
+	return nil
+}
+
+// StartWatching starts the go routine that checks the free disk space periodically
+func (dsw *diskSpaceWatchdog) StartWatching() {
+	ctx, cancelFunc := context.WithCancel(context.Background())
+
+	dsw.mutCheck.Lock()
+	dsw.cancelFunc()
+	dsw.cancelFunc = cancelFunc
+	dsw.mutCheck.Unlock()
+
+	go dsw.watch(ctx)
+}
+
+func (dsw *diskSpaceWatchdog) watch(ctx context.Context) {
+	for {
+		dsw.Diagnose(false)
+
+		select {
+		case <-time.After(dsw.checkInterval):
+		case <-ctx.Done():
+			log.Debug("diskSpaceWatchdog's go routine is stopping...")
+			return
+		}
+	}
+}
+
+// Diagnose checks the free disk space, removes the old epochs databases if the space is low and stops the node
+// gracefully if the space is below the stop threshold
+func (dsw *diskSpaceWatchdog) Diagnose(_ bool) {
+	dsw.mutCheck.Lock()
+	defer dsw.mutCheck.Unlock()
+
+	if dsw.isClosed {
+		return
+	}
+
+	stats, err := dsw.acquireDiskStatistics(dsw.workingDir)
+	if err != nil {
+		log.Warn("diskSpaceWatchdog: cannot read the disk statistics", "path", dsw.workingDir, "error", err.Error())
+		return
+	}
+
+	if stats.Free < dsw.cleanupThreshold && dsw.cleanOldEpochs() {
+		stats, err = dsw.acquireDiskStatistics(dsw.workingDir)
+		if err != nil {
+			log.Warn("diskSpaceWatchdog: cannot read the disk statistics", "path", dsw.workingDir, "error", err.Error())
+			return
+		}
+	}
+
+	status := dsw.computeStatus(stats.Free)
+	dsw.statusHandler.SetUInt64Value(common.MetricDiskTotalSpace, stats.Total)
+	dsw.statusHandler.SetUInt64Value(common.MetricDiskFreeSpace, stats.Free)
+	dsw.statusHandler.SetStringValue(common.MetricDiskSpaceStatus, status)
+
+	switch status {
+	case statusWarning, statusLow:
+		log.Warn("the node is running out of disk space", "status", status, "stats", stats.String())
+	case statusCritical:
+		log.Error("the node ran out of disk space", "status", status, "stats", stats.String())
+		dsw.stopNode(stats)
+	default:
+		log.Trace("diskSpaceWatchdog.Diagnose", "status", status, "stats", stats.String())
+	}
+}
+
+func (dsw *diskSpaceWatchdog) computeStatus(freeSpace uint64) string {
+	switch {
+	case freeSpace < dsw.stopThreshold:
+		return statusCritical
+	case freeSpace < dsw.cleanupThreshold:
+		return statusLow
+	case freeSpace < dsw.warningThreshold:
+		return statusWarning
+	default:
+		return statusOk
+	}
+}
+
+// cleanOldEpochs removes the databases of the epochs that do not have active persisters anymore. It returns true if
+// something was removed
+func (dsw *diskSpaceWatchdog) cleanOldEpochs() bool {
+	if dsw.isFullArchive {
+		return false
+	}
+
+	currentEpoch := dsw.epochProvider.CurrentEpoch()
+	oldestActiveEpoch := int64(currentEpoch) - int64(dsw.numActivePersisters) + 1
+	if oldestActiveEpoch <= 0 {
+		return false
+	}
+
+	// the same epochs can not be cleaned twice, so skip the disk listing if nothing changed
+	if dsw.cleanupTriggered && dsw.lastCleanupEpochTrigger == currentEpoch {
+		return false
+	}
+	dsw.cleanupTriggered = true
+	dsw.lastCleanupEpochTrigger = currentEpoch
+
+	numRemoved, err := dsw.oldEpochsCleaner.CleanEpochsOlderThan(uint32(oldestActiveEpoch))
+	if err != nil {
+		log.Warn("diskSpaceWatchdog: cannot clean the old epochs", "error", err.Error())
+		return false
+	}
+
+	log.Info("diskSpaceWatchdog: cleaned the old epochs databases due to low disk space",
+		"older than epoch", oldestActiveEpoch,
+		"num removed epochs", numRemoved)
+
+	return numRemoved > 0
+}
+
+func (dsw *diskSpaceWatchdog) stopNode(stats machine.DiskStatistics) {
+	if dsw.stopSignalSent {
+		return
+	}
+
+	argEndProcess := endProcess.ArgEndProcess{
+		Reason: common.DiskSpaceExhausted,
+		Description: fmt.Sprintf("free disk space %s on %s is below the stop threshold of %s",
+			core.ConvertBytes(stats.Free), dsw.workingDir, core.ConvertBytes(dsw.stopThreshold)),
+	}
+
+	select {
+	case dsw.chanStopNodeProcess <- argEndProcess:
+		dsw.stopSignalSent = true
+	default:
+		log.Debug("diskSpaceWatchdog.stopNode: could not write on the end process channel")
+	}
+}
+
+// Close stops the periodic checks. It waits for an ongoing check to finish, so no old epochs are cleaned after this call
+func (dsw *diskSpaceWatchdog) Close() error {
+	dsw.mutCheck.Lock()
+	dsw.isClosed = true
+	dsw.cancelFunc()
+	dsw.mutCheck.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dsw *diskSpaceWatchdog) IsInterfaceNil() bool {
+	return dsw == nil
+}
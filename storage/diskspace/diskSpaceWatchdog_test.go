@@ -0,0 +1,288 @@
+package diskspace
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/statistics/machine"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/testscommon/epochNotifier"
+	"github.com/ElrondNetwork/elrond-go/testscommon/statusHandler"
+	storageStubs "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsDiskSpaceWatchdog() ArgsDiskSpaceWatchdog {
+	return ArgsDiskSpaceWatchdog{
+		Config: config.DiskSpaceWatchdogConfig{
+			Enabled:                       true,
+			CheckIntervalInSeconds:        1,
+			FreeSpaceWarningThresholdInMB: 300,
+			FreeSpaceCleanupThresholdInMB: 200,
+			FreeSpaceStopThresholdInMB:    100,
+		},
+		WorkingDir:          "workingDir",
+		NumActivePersisters: 2,
+		StatusHandler:       &statusHandler.AppStatusHandlerStub{},
+		EpochProvider:       &epochNotifier.EpochNotifierStub{},
+		OldEpochsCleaner:    &storageStubs.OldEpochsCleanerStub{},
+		ChanStopNodeProcess: make(chan endProcess.ArgEndProcess, 1),
+	}
+}
+
+func createDiskSpaceWatchdogWithFreeSpace(t *testing.T, args ArgsDiskSpaceWatchdog, freeSpaceInMB ...uint64) *diskSpaceWatchdog {
+	dsw, err := NewDiskSpaceWatchdog(args)
+	require.Nil(t, err)
+
+	numCalls := 0
+	dsw.acquireDiskStatistics = func(path string) (machine.DiskStatistics, error) {
+		freeSpace := freeSpaceInMB[numCalls] * core.MegabyteSize
+		if numCalls < len(freeSpaceInMB)-1 {
+			numCalls++
+		}
+
+		return machine.DiskStatistics{
+			Total: 1000 * core.MegabyteSize,
+			Free:  freeSpace,
+		}, nil
+	}
+
+	return dsw
+}
+
+func TestNewDiskSpaceWatchdog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty working dir should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		args.WorkingDir = ""
+		dsw, err := NewDiskSpaceWatchdog(args)
+		assert.Equal(t, ErrEmptyWorkingDir, err)
+		assert.True(t, check.IfNil(dsw))
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		args.StatusHandler = nil
+		dsw, err := NewDiskSpaceWatchdog(args)
+		assert.Equal(t, ErrNilAppStatusHandler, err)
+		assert.True(t, check.IfNil(dsw))
+	})
+	t.Run("nil epoch provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		args.EpochProvider = nil
+		dsw, err := NewDiskSpaceWatchdog(args)
+		assert.Equal(t, ErrNilEpochProvider, err)
+		assert.True(t, check.IfNil(dsw))
+	})
+	t.Run("nil old epochs cleaner should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		args.OldEpochsCleaner = nil
+		dsw, err := NewDiskSpaceWatchdog(args)
+		assert.Equal(t, ErrNilOldEpochsCleaner, err)
+		assert.True(t, check.IfNil(dsw))
+	})
+	t.Run("nil channel to stop the node should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		args.ChanStopNodeProcess = nil
+		dsw, err := NewDiskSpaceWatchdog(args)
+		assert.Equal(t, ErrNilChanStopNodeProcess, err)
+		assert.True(t, check.IfNil(dsw))
+	})
+	t.Run("zero check interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		args.Config.CheckIntervalInSeconds = 0
+		dsw, err := NewDiskSpaceWatchdog(args)
+		assert.Equal(t, ErrInvalidCheckInterval, err)
+		assert.True(t, check.IfNil(dsw))
+	})
+	t.Run("thresholds not in decreasing order should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		args.Config.FreeSpaceStopThresholdInMB = 250
+		dsw, err := NewDiskSpaceWatchdog(args)
+		assert.True(t, errors.Is(err, ErrInvalidThresholds))
+		assert.True(t, check.IfNil(dsw))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dsw, err := NewDiskSpaceWatchdog(createMockArgsDiskSpaceWatchdog())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(dsw))
+	})
+}
+
+func TestDiskSpaceWatchdog_Diagnose(t *testing.T) {
+	t.Parallel()
+
+	t.Run("enough free space should only save the metrics", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		metrics := make(map[string]interface{})
+		args.StatusHandler = &statusHandler.AppStatusHandlerStub{
+			SetUInt64ValueHandler: func(key string, value uint64) {
+				metrics[key] = value
+			},
+			SetStringValueHandler: func(key string, value string) {
+				metrics[key] = value
+			},
+		}
+		args.OldEpochsCleaner = &storageStubs.OldEpochsCleanerStub{
+			CleanEpochsOlderThanCalled: func(epoch uint32) (int, error) {
+				assert.Fail(t, "should have not called clean")
+				return 0, nil
+			},
+		}
+		dsw := createDiskSpaceWatchdogWithFreeSpace(t, args, 500)
+
+		dsw.Diagnose(false)
+
+		assert.Equal(t, uint64(1000*core.MegabyteSize), metrics[common.MetricDiskTotalSpace])
+		assert.Equal(t, uint64(500*core.MegabyteSize), metrics[common.MetricDiskFreeSpace])
+		assert.Equal(t, statusOk, metrics[common.MetricDiskSpaceStatus])
+		assert.Equal(t, 0, len(args.ChanStopNodeProcess))
+	})
+	t.Run("low free space should clean the old epochs once per epoch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		args.EpochProvider = &epochNotifier.EpochNotifierStub{
+			CurrentEpochCalled: func() uint32 {
+				return 7
+			},
+		}
+		cleanedEpochs := make([]uint32, 0)
+		args.OldEpochsCleaner = &storageStubs.OldEpochsCleanerStub{
+			CleanEpochsOlderThanCalled: func(epoch uint32) (int, error) {
+				cleanedEpochs = append(cleanedEpochs, epoch)
+				return 3, nil
+			},
+		}
+		status := ""
+		args.StatusHandler = &statusHandler.AppStatusHandlerStub{
+			SetStringValueHandler: func(key string, value string) {
+				status = value
+			},
+		}
+		dsw := createDiskSpaceWatchdogWithFreeSpace(t, args, 150, 250)
+
+		dsw.Diagnose(false)
+		assert.Equal(t, []uint32{6}, cleanedEpochs)
+		assert.Equal(t, statusWarning, status)
+
+		dsw.Diagnose(true)
+		assert.Equal(t, []uint32{6}, cleanedEpochs)
+		assert.Equal(t, 0, len(args.ChanStopNodeProcess))
+	})
+	t.Run("no old epochs should not clean", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		args.OldEpochsCleaner = &storageStubs.OldEpochsCleanerStub{
+			CleanEpochsOlderThanCalled: func(epoch uint32) (int, error) {
+				assert.Fail(t, "should have not called clean")
+				return 0, nil
+			},
+		}
+		dsw := createDiskSpaceWatchdogWithFreeSpace(t, args, 150)
+
+		dsw.Diagnose(false)
+	})
+	t.Run("full archive should not clean", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		args.IsFullArchive = true
+		args.EpochProvider = &epochNotifier.EpochNotifierStub{
+			CurrentEpochCalled: func() uint32 {
+				return 7
+			},
+		}
+		args.OldEpochsCleaner = &storageStubs.OldEpochsCleanerStub{
+			CleanEpochsOlderThanCalled: func(epoch uint32) (int, error) {
+				assert.Fail(t, "should have not called clean")
+				return 0, nil
+			},
+		}
+		dsw := createDiskSpaceWatchdogWithFreeSpace(t, args, 150)
+
+		dsw.Diagnose(false)
+	})
+	t.Run("critical free space should stop the node once", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		args.ChanStopNodeProcess = make(chan endProcess.ArgEndProcess, 2)
+		dsw := createDiskSpaceWatchdogWithFreeSpace(t, args, 50)
+
+		dsw.Diagnose(false)
+		dsw.Diagnose(false)
+
+		require.Equal(t, 1, len(args.ChanStopNodeProcess))
+		argEndProcess := <-args.ChanStopNodeProcess
+		assert.Equal(t, common.DiskSpaceExhausted, argEndProcess.Reason)
+	})
+	t.Run("disk statistics error should not save metrics", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDiskSpaceWatchdog()
+		args.StatusHandler = &statusHandler.AppStatusHandlerStub{
+			SetStringValueHandler: func(key string, value string) {
+				assert.Fail(t, "should have not saved metrics")
+			},
+		}
+		dsw, _ := NewDiskSpaceWatchdog(args)
+		dsw.acquireDiskStatistics = func(path string) (machine.DiskStatistics, error) {
+			return machine.DiskStatistics{}, errors.New("expected error")
+		}
+
+		dsw.Diagnose(false)
+	})
+}
+
+func TestDiskSpaceWatchdog_StartWatchingAndClose(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDiskSpaceWatchdog()
+	numChecks := uint32(0)
+	args.StatusHandler = &statusHandler.AppStatusHandlerStub{
+		SetStringValueHandler: func(key string, value string) {
+			if key == common.MetricDiskSpaceStatus {
+				atomic.AddUint32(&numChecks, 1)
+			}
+		},
+	}
+	dsw := createDiskSpaceWatchdogWithFreeSpace(t, args, 500)
+	dsw.checkInterval = time.Millisecond * 10
+
+	dsw.StartWatching()
+	time.Sleep(time.Millisecond * 100)
+	assert.Nil(t, dsw.Close())
+
+	numChecksAfterClose := atomic.LoadUint32(&numChecks)
+	assert.True(t, numChecksAfterClose > 1)
+
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, numChecksAfterClose, atomic.LoadUint32(&numChecks))
+}
@@ -0,0 +1,24 @@
+package diskspace
+
+import "errors"
+
+// ErrNilAppStatusHandler signals that a nil app status handler has been provided
+var ErrNilAppStatusHandler = errors.New("nil app status handler")
+
+// ErrNilEpochProvider signals that a nil epoch provider has been provided
+var ErrNilEpochProvider = errors.New("nil epoch provider")
+
+// ErrNilOldEpochsCleaner signals that a nil old epochs cleaner has been provided
+var ErrNilOldEpochsCleaner = errors.New("nil old epochs cleaner")
+
+// ErrNilChanStopNodeProcess signals that a nil channel to stop the node has been provided
+var ErrNilChanStopNodeProcess = errors.New("nil channel to stop node")
+
+// ErrEmptyWorkingDir signals that an empty working directory has been provided
+var ErrEmptyWorkingDir = errors.New("empty working directory")
+
+// ErrInvalidThresholds signals that the provided free space thresholds are invalid
+var ErrInvalidThresholds = errors.New("invalid free space thresholds")
+
+// ErrInvalidCheckInterval signals that an invalid check interval has been provided
+var ErrInvalidCheckInterval = errors.New("invalid check interval")
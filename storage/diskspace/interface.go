@@ -0,0 +1,13 @@
+package diskspace
+
+// EpochProvider defines the component able to provide the current epoch
+type EpochProvider interface {
+	CurrentEpoch() uint32
+	IsInterfaceNil() bool
+}
+
+// OldEpochsCleaner defines the component able to remove the databases of the old epochs
+type OldEpochsCleaner interface {
+	CleanEpochsOlderThan(epoch uint32) (int, error)
+	IsInterfaceNil() bool
+}
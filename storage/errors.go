@@ -147,3 +147,6 @@ var ErrNilOldDataCleanerProvider = errors.New("nil old data cleaner provider")
 
 // ErrNilStoredDataFactory signals that a nil stored data factory has been provided
 var ErrNilStoredDataFactory = errors.New("nil stored data factory")
+
+// ErrInvalidFilePath signals that an invalid file path has been provided
+var ErrInvalidFilePath = errors.New("invalid file path")
@@ -311,6 +311,18 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 		return nil, err
 	}
 
+	createdStorers, err = psf.setupSlashingEvidenceStorer(store)
+	successfullyCreatedStorers = append(successfullyCreatedStorers, createdStorers...)
+	if err != nil {
+		return nil, err
+	}
+
+	createdStorers, err = psf.setupValidatorHistoryStorer(store)
+	successfullyCreatedStorers = append(successfullyCreatedStorers, createdStorers...)
+	if err != nil {
+		return nil, err
+	}
+
 	err = psf.initOldDatabasesCleaningIfNeeded(store)
 	if err != nil {
 		return nil, err
@@ -539,6 +551,18 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 		return nil, err
 	}
 
+	createdStorers, err = psf.setupSlashingEvidenceStorer(store)
+	successfullyCreatedStorers = append(successfullyCreatedStorers, createdStorers...)
+	if err != nil {
+		return nil, err
+	}
+
+	createdStorers, err = psf.setupValidatorHistoryStorer(store)
+	successfullyCreatedStorers = append(successfullyCreatedStorers, createdStorers...)
+	if err != nil {
+		return nil, err
+	}
+
 	err = psf.initOldDatabasesCleaningIfNeeded(store)
 	if err != nil {
 		return nil, err
@@ -570,6 +594,52 @@ func (psf *StorageServiceFactory) setupLogsAndEventsStorer(chainStorer *dataRetr
 	return createdStorers, nil
 }
 
+func (psf *StorageServiceFactory) setupSlashingEvidenceStorer(chainStorer *dataRetriever.ChainStorer) ([]storage.Storer, error) {
+	createdStorers := make([]storage.Storer, 0)
+
+	if !psf.generalConfig.Consensus.SlashingDetector.Enabled {
+		return createdStorers, nil
+	}
+
+	shardID := core.GetShardIDString(psf.shardCoordinator.SelfId())
+	slashingEvidenceDbConfig := GetDBFromConfig(psf.generalConfig.SlashingEvidenceStorage.DB)
+	slashingEvidenceDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, psf.generalConfig.SlashingEvidenceStorage.DB.FilePath)
+	slashingEvidenceStorageUnit, err := storageUnit.NewStorageUnitFromConf(
+		GetCacherFromConfig(psf.generalConfig.SlashingEvidenceStorage.Cache),
+		slashingEvidenceDbConfig)
+	if err != nil {
+		return createdStorers, err
+	}
+
+	createdStorers = append(createdStorers, slashingEvidenceStorageUnit)
+	chainStorer.AddStorer(dataRetriever.SlashingEvidenceUnit, slashingEvidenceStorageUnit)
+
+	return createdStorers, nil
+}
+
+func (psf *StorageServiceFactory) setupValidatorHistoryStorer(chainStorer *dataRetriever.ChainStorer) ([]storage.Storer, error) {
+	createdStorers := make([]storage.Storer, 0)
+
+	if !psf.generalConfig.ValidatorStatistics.History.Enabled {
+		return createdStorers, nil
+	}
+
+	shardID := core.GetShardIDString(psf.shardCoordinator.SelfId())
+	validatorHistoryDbConfig := GetDBFromConfig(psf.generalConfig.ValidatorHistoryStorage.DB)
+	validatorHistoryDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, psf.generalConfig.ValidatorHistoryStorage.DB.FilePath)
+	validatorHistoryStorageUnit, err := storageUnit.NewStorageUnitFromConf(
+		GetCacherFromConfig(psf.generalConfig.ValidatorHistoryStorage.Cache),
+		validatorHistoryDbConfig)
+	if err != nil {
+		return createdStorers, err
+	}
+
+	createdStorers = append(createdStorers, validatorHistoryStorageUnit)
+	chainStorer.AddStorer(dataRetriever.ValidatorHistoryUnit, validatorHistoryStorageUnit)
+
+	return createdStorers, nil
+}
+
 func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetriever.ChainStorer) ([]storage.Storer, error) {
 	createdStorers := make([]storage.Storer, 0)
 
@@ -652,6 +722,34 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	createdStorers = append(createdStorers, esdtSuppliesUnit)
 	chainStorer.AddStorer(dataRetriever.ESDTSuppliesUnit, esdtSuppliesUnit)
 
+	if psf.generalConfig.DbLookupExtensions.ESDTHoldersIndexEnabled {
+		esdtHoldersConfig := psf.generalConfig.DbLookupExtensions.ESDTHoldersStorageConfig
+		esdtHoldersDbConfig := GetDBFromConfig(esdtHoldersConfig.DB)
+		esdtHoldersDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, esdtHoldersConfig.DB.FilePath)
+		esdtHoldersCacherConfig := GetCacherFromConfig(esdtHoldersConfig.Cache)
+		esdtHoldersUnit, errCreate := storageUnit.NewStorageUnitFromConf(esdtHoldersCacherConfig, esdtHoldersDbConfig)
+		if errCreate != nil {
+			return createdStorers, errCreate
+		}
+
+		createdStorers = append(createdStorers, esdtHoldersUnit)
+		chainStorer.AddStorer(dataRetriever.ESDTHoldersUnit, esdtHoldersUnit)
+	}
+
+	if psf.generalConfig.DbLookupExtensions.EventsIndexEnabled {
+		eventsIndexConfig := psf.generalConfig.DbLookupExtensions.EventsIndexStorageConfig
+		eventsIndexDbConfig := GetDBFromConfig(eventsIndexConfig.DB)
+		eventsIndexDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, eventsIndexConfig.DB.FilePath)
+		eventsIndexCacherConfig := GetCacherFromConfig(eventsIndexConfig.Cache)
+		eventsIndexUnit, errCreate := storageUnit.NewStorageUnitFromConf(eventsIndexCacherConfig, eventsIndexDbConfig)
+		if errCreate != nil {
+			return createdStorers, errCreate
+		}
+
+		createdStorers = append(createdStorers, eventsIndexUnit)
+		chainStorer.AddStorer(dataRetriever.EventsIndexUnit, eventsIndexUnit)
+	}
+
 	return createdStorers, nil
 }
 
@@ -680,6 +778,13 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(
 		Notifier:                  psf.epochStartNotifier,
 		MaxBatchSize:              storageConfig.DB.MaxBatchSize,
 		EnabledDbLookupExtensions: psf.generalConfig.DbLookupExtensions.Enabled,
+		MaxSizeInBytes:            psf.generalConfig.StoragePruning.MaxSizeInBytesPerStorer,
+	}
+
+	coldStorageConfig := psf.generalConfig.StoragePruning.ColdStorage
+	if coldStorageConfig.Enabled {
+		args.ColdStoragePath = coldStorageConfig.Path
+		args.CompressColdStorage = coldStorageConfig.Compress
 	}
 
 	return args
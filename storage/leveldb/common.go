@@ -6,9 +6,11 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/errors"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 const resourceUnavailable = "resource temporarily unavailable"
@@ -135,3 +137,14 @@ func (bldb *baseLevelDb) RangeKeys(handler func(key []byte, value []byte) bool)
 
 	iterator.Release()
 }
+
+// Compact will compact the whole key space of the underlying database, rewriting the tables and discarding the
+// deleted and overwritten entries
+func (bldb *baseLevelDb) Compact() error {
+	db := bldb.getDbPointer()
+	if db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	return db.CompactRange(util.Range{})
+}
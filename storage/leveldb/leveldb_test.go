@@ -409,3 +409,29 @@ func TestDB_SpecialValueTest(t *testing.T) {
 
 	_ = ldb.Close()
 }
+
+func TestDB_CompactShouldKeepTheData(t *testing.T) {
+	t.Parallel()
+
+	ldb := createLevelDb(t, 10, 1, 10)
+
+	key := []byte("key")
+	val := []byte("val")
+	err := ldb.Put(key, val)
+	require.Nil(t, err)
+	err = ldb.Put([]byte("removed key"), val)
+	require.Nil(t, err)
+	err = ldb.Remove([]byte("removed key"))
+	require.Nil(t, err)
+
+	err = ldb.Compact()
+	assert.Nil(t, err)
+
+	valRecovered, err := ldb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, valRecovered)
+
+	_ = ldb.Close()
+	err = ldb.Compact()
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+}
@@ -0,0 +1,406 @@
+package pruning
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const archiveExtension = ".tar.gz"
+const extractedDirSuffix = "_extracted"
+const tempSuffix = "_tmp"
+
+// compactor defines the persisters that are able to compact their data
+type compactor interface {
+	Compact() error
+}
+
+// coldStorage moves the persisters of the old epochs from the database path to the cold tier directory
+type coldStorage struct {
+	databasePath     string
+	coldPath         string
+	compress         bool
+	persisterFactory DbFactoryHandler
+}
+
+func newColdStorage(args *StorerArgs) *coldStorage {
+	if len(args.ColdStoragePath) == 0 {
+		return nil
+	}
+
+	return &coldStorage{
+		databasePath:     args.PathManager.DatabasePath(),
+		coldPath:         args.ColdStoragePath,
+		compress:         args.CompressColdStorage,
+		persisterFactory: args.PersisterFactory,
+	}
+}
+
+// pathInColdStorage returns the path of the provided persister path inside the cold tier
+func (cs *coldStorage) pathInColdStorage(path string) (string, error) {
+	relativePath, err := filepath.Rel(cs.databasePath, path)
+	if err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(relativePath, "..") {
+		return "", fmt.Errorf("%w: %s is not inside %s", storage.ErrInvalidFilePath, path, cs.databasePath)
+	}
+
+	coldPath := filepath.Join(cs.coldPath, relativePath)
+	if cs.compress {
+		coldPath += archiveExtension
+	}
+
+	return coldPath, nil
+}
+
+// findInColdStorage returns the cold tier path of the provided persister path, if it was moved there
+func (cs *coldStorage) findInColdStorage(path string) (string, bool, bool) {
+	relativePath, err := filepath.Rel(cs.databasePath, path)
+	if err != nil {
+		return "", false, false
+	}
+
+	coldPath := filepath.Join(cs.coldPath, relativePath)
+	if isDirectory(coldPath) {
+		return coldPath, false, true
+	}
+
+	archivePath := coldPath + archiveExtension
+	_, err = os.Stat(archivePath)
+	if err == nil {
+		return archivePath, true, true
+	}
+
+	return "", false, false
+}
+
+// move compacts the closed persister and moves it in the cold tier, archiving it if the compression is enabled
+func (cs *coldStorage) move(pd *persisterData) error {
+	coldPath, err := cs.pathInColdStorage(pd.path)
+	if err != nil {
+		return err
+	}
+
+	cs.compact(pd.path)
+
+	err = os.MkdirAll(filepath.Dir(coldPath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	if cs.compress {
+		err = archiveDirectory(pd.path, coldPath)
+	} else {
+		err = moveDirectory(pd.path, coldPath)
+	}
+	if err != nil {
+		return err
+	}
+
+	log.Debug("moved persister in cold storage", "from", pd.path, "to", coldPath, "compressed", cs.compress)
+
+	pd.Lock()
+	pd.path = coldPath
+	pd.isCold = true
+	pd.isArchived = cs.compress
+	pd.persister = cs.persisterFactory.CreateDisabled()
+	pd.Unlock()
+
+	return nil
+}
+
+func (cs *coldStorage) compact(path string) {
+	persister, err := cs.persisterFactory.Create(path)
+	if err != nil {
+		log.Debug("coldStorage.compact: cannot open persister", "path", path, "error", err.Error())
+		return
+	}
+
+	persisterCompactor, ok := persister.(compactor)
+	if ok {
+		err = persisterCompactor.Compact()
+		if err != nil {
+			log.Debug("coldStorage.compact", "path", path, "error", err.Error())
+		}
+	}
+
+	err = persister.Close()
+	if err != nil {
+		log.Debug("coldStorage.compact: cannot close persister", "path", path, "error", err.Error())
+	}
+}
+
+// extract unpacks the archived persister in a directory placed next to the archive. The archive is unpacked in a
+// temporary directory which is renamed when done, so an already existing extracted directory is complete and reused
+func (cs *coldStorage) extract(archivePath string) (string, error) {
+	extractedPath := strings.TrimSuffix(archivePath, archiveExtension) + extractedDirSuffix
+	if isDirectory(extractedPath) {
+		return extractedPath, nil
+	}
+
+	tempExtractedPath := extractedPath + tempSuffix
+	err := os.RemoveAll(tempExtractedPath)
+	if err != nil {
+		return "", err
+	}
+
+	err = extractArchive(archivePath, tempExtractedPath)
+	if err != nil {
+		_ = os.RemoveAll(tempExtractedPath)
+		return "", err
+	}
+
+	err = os.Rename(tempExtractedPath, extractedPath)
+	if err != nil {
+		_ = os.RemoveAll(tempExtractedPath)
+		return "", err
+	}
+
+	return extractedPath, nil
+}
+
+func isDirectory(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	return info.IsDir()
+}
+
+func directorySize(path string) (uint64, error) {
+	size := uint64(0)
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += uint64(info.Size())
+		}
+
+		return nil
+	})
+
+	return size, err
+}
+
+func moveDirectory(source string, destination string) error {
+	err := os.Rename(source, destination)
+	if err == nil {
+		return nil
+	}
+
+	// the cold storage is usually on a different volume, case in which the directory has to be copied
+	tempDestination := destination + tempSuffix
+	err = copyDirectory(source, tempDestination)
+	if err != nil {
+		_ = os.RemoveAll(tempDestination)
+		return err
+	}
+
+	err = os.Rename(tempDestination, destination)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(source)
+}
+
+func copyDirectory(source string, destination string) error {
+	return filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(destination, relativePath)
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode())
+		}
+
+		return copyFile(path, target, info.Mode())
+	})
+}
+
+func copyFile(source string, destination string, mode os.FileMode) error {
+	sourceFile, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = sourceFile.Close()
+	}()
+
+	destinationFile, err := os.OpenFile(destination, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destinationFile, sourceFile)
+	if err != nil {
+		_ = destinationFile.Close()
+		return err
+	}
+
+	return destinationFile.Close()
+}
+
+func archiveDirectory(source string, archivePath string) error {
+	tempArchivePath := archivePath + tempSuffix
+	err := writeArchive(source, tempArchivePath)
+	if err != nil {
+		_ = os.Remove(tempArchivePath)
+		return err
+	}
+
+	err = os.Rename(tempArchivePath, archivePath)
+	if err != nil {
+		return err
+	}
+
+	return os.RemoveAll(source)
+}
+
+func writeArchive(source string, archivePath string) error {
+	file, err := os.Create(archivePath)
+	if err != nil {
+		return err
+	}
+
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err = filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relativePath)
+
+		err = tarWriter.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		return copyFileContent(path, tarWriter)
+	})
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = gzipWriter.Close()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func copyFileContent(path string, writer io.Writer) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	_, err = io.Copy(writer, file)
+
+	return err
+}
+
+func extractArchive(archivePath string, destination string) error {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = gzipReader.Close()
+	}()
+
+	err = os.MkdirAll(destination, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, errNext := tarReader.Next()
+		if errNext == io.EOF {
+			return nil
+		}
+		if errNext != nil {
+			return errNext
+		}
+
+		target := filepath.Join(destination, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(target, filepath.Clean(destination)+string(os.PathSeparator)) {
+			return fmt.Errorf("%w: %s", storage.ErrInvalidFilePath, header.Name)
+		}
+
+		err = os.MkdirAll(filepath.Dir(target), os.ModePerm)
+		if err != nil {
+			return err
+		}
+
+		err = writeFileFromReader(target, tarReader, os.FileMode(header.Mode))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func writeFileFromReader(path string, reader io.Reader, mode os.FileMode) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, reader)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
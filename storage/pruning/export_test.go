@@ -1,6 +1,7 @@
 package pruning
 
 import (
+	"context"
 	"sort"
 
 	"github.com/ElrondNetwork/elrond-go-core/data"
@@ -53,6 +54,17 @@ func (ps *PruningStorer) AddMockActivePersister(epoch uint32, persister storage.
 	ps.persistersMapByEpoch[epoch] = pd
 }
 
+// OpenPersisterInEpoch -
+func (ps *PruningStorer) OpenPersisterInEpoch(epoch uint32) (func(), error) {
+	ps.lock.RLock()
+	pd := ps.persistersMapByEpoch[epoch]
+	ps.lock.RUnlock()
+
+	_, closePersister, err := ps.createAndInitPersisterIfClosed(pd)
+
+	return closePersister, err
+}
+
 // PersistersMapByEpochToSlice -
 func (ps *PruningStorer) PersistersMapByEpochToSlice() []uint32 {
 	slice := make([]uint32, 0)
@@ -97,6 +109,11 @@ func (ps *PruningStorer) ChangeEpochSimple(epochNum uint32) error {
 	return ps.changeEpoch(&block.Header{Epoch: epochNum})
 }
 
+// ApplyRetentionPolicies -
+func (ps *PruningStorer) ApplyRetentionPolicies() {
+	ps.applyRetentionPolicies(context.Background())
+}
+
 // ChangeEpochWithExisting -
 func (ps *PruningStorer) ChangeEpochWithExisting(epoch uint32) error {
 	return ps.changeEpochWithExisting(epoch)
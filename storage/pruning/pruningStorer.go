@@ -1,11 +1,14 @@
 package pruning
 
 import (
+	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"math"
+	"os"
 	"runtime/debug"
+	"sort"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -38,6 +41,13 @@ type persisterData struct {
 	path      string
 	epoch     uint32
 	isClosed  bool
+	// isCold is set for the persisters moved in the cold storage, case in which path points inside the cold storage
+	isCold     bool
+	isArchived bool
+	// extractedPath holds the directory where the archived persister was extracted, reused on the next openings
+	extractedPath string
+	// mutFiles guards the persister files so they are not opened while being moved in the cold storage or destroyed
+	mutFiles sync.Mutex
 	sync.RWMutex
 }
 
@@ -57,8 +67,37 @@ func (pd *persisterData) setIsClosed(closed bool) {
 // Close closes the underlying persister
 func (pd *persisterData) Close() error {
 	pd.setIsClosed(true)
-	err := pd.persister.Close()
-	return err
+	return pd.persister.Close()
+}
+
+// removeExtracted removes the directory of the extracted archived persister, if any
+func (pd *persisterData) removeExtracted() {
+	pd.Lock()
+	extractedPath := pd.extractedPath
+	pd.extractedPath = ""
+	pd.Unlock()
+	if len(extractedPath) == 0 {
+		return
+	}
+
+	err := os.RemoveAll(extractedPath)
+	if err != nil {
+		log.Warn("cannot remove the extracted persister", "path", extractedPath, "error", err.Error())
+	}
+}
+
+func (pd *persisterData) getIsCold() bool {
+	pd.RLock()
+	defer pd.RUnlock()
+
+	return pd.isCold
+}
+
+func (pd *persisterData) getPath() string {
+	pd.RLock()
+	defer pd.RUnlock()
+
+	return pd.path
 }
 
 func (pd *persisterData) getPersister() storage.Persister {
@@ -98,6 +137,13 @@ type PruningStorer struct {
 	numOfActivePersisters  uint32
 	epochForPutOperation   uint32
 	pruningEnabled         bool
+	maxSizeInBytes         uint64
+	coldStorage            *coldStorage
+	// persistersToMove holds the closed persisters waiting to be moved in the cold storage by the retention worker
+	persistersToMove []*persisterData
+	retentionTrigger chan struct{}
+	cancelRetention  func()
+	mutRetention     sync.Mutex
 }
 
 // NewPruningStorer will return a new instance of PruningStorer without sharded directories' naming scheme
@@ -156,11 +202,15 @@ func initPruningStorer(
 	pdb.customDatabaseRemover = args.CustomDatabaseRemover
 	pdb.persistersMapByEpoch = persistersMapByEpoch
 	pdb.activePersisters = activePersisters
+	pdb.maxSizeInBytes = args.MaxSizeInBytes
+	pdb.coldStorage = newColdStorage(args)
 
 	pdb.extendPersisterLifeHandler = func() bool {
 		return false
 	}
 
+	pdb.startRetentionWorker()
+
 	return pdb, nil
 }
 
@@ -229,9 +279,37 @@ func initPersistersInEpoch(
 		}
 	}
 
+	addColdPersisters(args, shardIDStr, oldestEpochKeep, persistersMapByEpoch)
+
 	return persisters, persistersMapByEpoch, nil
 }
 
+// addColdPersisters registers as closed persisters the older epochs found in the cold storage, so they can still be read
+func addColdPersisters(args *StorerArgs, shardIDStr string, oldestEpochKeep int64, persistersMapByEpoch map[uint32]*persisterData) {
+	cs := newColdStorage(args)
+	if cs == nil {
+		return
+	}
+
+	for epoch := oldestEpochKeep - 1; epoch >= 0; epoch-- {
+		filePath := createPersisterPathForEpoch(args, uint32(epoch), shardIDStr)
+		coldPath, isArchived, found := cs.findInColdStorage(filePath)
+		if !found {
+			continue
+		}
+
+		persistersMapByEpoch[uint32(epoch)] = &persisterData{
+			persister:  args.PersisterFactory.CreateDisabled(),
+			epoch:      uint32(epoch),
+			path:       coldPath,
+			isClosed:   true,
+			isCold:     true,
+			isArchived: isArchived,
+		}
+		log.Debug("added a cold storage persister", "epoch", epoch, "identifier", args.Identifier, "path", coldPath)
+	}
+}
+
 func createPersisterIfPruningDisabled(
 	args *StorerArgs,
 	shardIDStr string,
@@ -342,7 +420,7 @@ func (ps *PruningStorer) PutInEpoch(key, data []byte, epoch uint32) error {
 		return fmt.Errorf("put in epoch: persister for epoch %d not found", epoch)
 	}
 
-	persister, closePersister, err := ps.createAndInitPersisterIfClosedProtected(pd)
+	persister, closePersister, err := ps.createAndInitPersisterIfClosed(pd)
 	if err != nil {
 		return err
 	}
@@ -351,14 +429,9 @@ func (ps *PruningStorer) PutInEpoch(key, data []byte, epoch uint32) error {
 	return ps.doPutInPersister(key, data, persister)
 }
 
-func (ps *PruningStorer) createAndInitPersisterIfClosedProtected(pd *persisterData) (storage.Persister, func(), error) {
-	ps.lock.Lock()
-	defer ps.lock.Unlock()
-
-	return ps.createAndInitPersisterIfClosedUnprotected(pd)
-}
-
-func (ps *PruningStorer) createAndInitPersisterIfClosedUnprotected(pd *persisterData) (storage.Persister, func(), error) {
+// createAndInitPersisterIfClosed does not hold the storer mutex as the persister data guards its own files, so the
+// other operations are not blocked while an archived persister is extracted
+func (ps *PruningStorer) createAndInitPersisterIfClosed(pd *persisterData) (storage.Persister, func(), error) {
 	isOpen := !pd.getIsClosed()
 	if isOpen {
 		noopClose := func() {}
@@ -369,13 +442,16 @@ func (ps *PruningStorer) createAndInitPersisterIfClosedUnprotected(pd *persister
 }
 
 func (ps *PruningStorer) createAndInitPersister(pd *persisterData) (storage.Persister, func(), error) {
+	pd.mutFiles.Lock()
+	defer pd.mutFiles.Unlock()
+
 	isOpen := !pd.getIsClosed()
 	if isOpen {
 		noopClose := func() {}
 		return pd.getPersister(), noopClose, nil
 	}
 
-	persister, err := ps.persisterFactory.Create(pd.path)
+	persister, err := openPersister(ps.persisterFactory, ps.coldStorage, pd)
 	if err != nil {
 		log.Warn("createAndInitPersister()", "error", err.Error())
 		return nil, nil, err
@@ -430,6 +506,14 @@ func (ps *PruningStorer) Get(key []byte) ([]byte, error) {
 
 // Close will close PruningStorer
 func (ps *PruningStorer) Close() error {
+	ps.stopRetentionWorker()
+
+	ps.lock.RLock()
+	for _, pd := range ps.persistersMapByEpoch {
+		pd.removeExtracted()
+	}
+	ps.lock.RUnlock()
+
 	closedSuccessfully := true
 	for _, pd := range ps.activePersisters {
 		err := pd.Close()
@@ -466,7 +550,7 @@ func (ps *PruningStorer) GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 			hex.EncodeToString(key), ps.identifier)
 	}
 
-	persister, closePersister, err := ps.createAndInitPersisterIfClosedProtected(pd)
+	persister, closePersister, err := ps.createAndInitPersisterIfClosed(pd)
 	if err != nil {
 		return nil, err
 	}
@@ -500,7 +584,7 @@ func (ps *PruningStorer) GetBulkFromEpoch(keys [][]byte, epoch uint32) (map[stri
 		return nil, errors.New("persister does not exist")
 	}
 
-	persisterToRead, closePersister, err := ps.createAndInitPersisterIfClosedProtected(pd)
+	persisterToRead, closePersister, err := ps.createAndInitPersisterIfClosed(pd)
 	if err != nil {
 		return nil, err
 	}
@@ -654,7 +738,10 @@ func (ps *PruningStorer) DestroyUnit() error {
 	numOfPersistersRemoved := 0
 	totalNumOfPersisters := len(ps.persistersMapByEpoch)
 	for _, pd := range ps.persistersMapByEpoch {
-		if pd.getIsClosed() {
+		pd.removeExtracted()
+		if pd.getIsCold() {
+			err = os.RemoveAll(pd.getPath())
+		} else if pd.getIsClosed() {
 			err = pd.getPersister().DestroyClosed()
 		} else {
 			err = pd.getPersister().Destroy()
@@ -774,6 +861,9 @@ func (ps *PruningStorer) changeEpoch(header data.HeaderHandler) error {
 		log.Warn("closing persisters", "error", err.Error())
 		return err
 	}
+
+	ps.triggerRetention()
+
 	return nil
 }
 
@@ -853,13 +943,9 @@ func (ps *PruningStorer) changeEpochWithExisting(epoch uint32) error {
 	}
 
 	for _, p := range persisters {
-		if p.getIsClosed() {
-			db, errCreate := ps.persisterFactory.Create(p.path)
-			if errCreate != nil {
-				return errCreate
-			}
-
-			p.setPersisterAndIsClosed(db, false)
+		_, _, errCreate := ps.createAndInitPersister(p)
+		if errCreate != nil {
+			return errCreate
 		}
 
 		activePersisters = append(activePersisters, p)
@@ -884,12 +970,11 @@ func (ps *PruningStorer) extendActivePersisters(from uint32, to uint32) error {
 	reOpenedPersisters := make([]*persisterData, 0)
 	for _, p := range persisters {
 		if p.getIsClosed() {
-			persister, err := ps.persisterFactory.Create(p.path)
+			_, _, err := ps.createAndInitPersister(p)
 			if err != nil {
 				return err
 			}
 			reOpenedPersisters = append(reOpenedPersisters, p)
-			p.setPersisterAndIsClosed(persister, false)
 		}
 	}
 
@@ -927,8 +1012,20 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 
 	for _, epochToRemove := range epochsToRemove {
 		persisterToRemove := ps.persistersMapByEpoch[epochToRemove]
-		if ps.customDatabaseRemover.ShouldRemove(persisterToRemove.path, epochToRemove) {
-			log.Debug("destroying persister", "path", persisterToRemove.path)
+		if persisterToRemove.getIsCold() {
+			continue
+		}
+
+		shouldRemove := ps.customDatabaseRemover.ShouldRemove(persisterToRemove.getPath(), epochToRemove)
+		if ps.coldStorage != nil && (shouldRemove || shouldRemoveFromMapDueToOldData) {
+			// the persister is kept in the map as it can still be read from the cold storage, the move being done
+			// by the retention worker
+			ps.persistersToMove = append(ps.persistersToMove, persisterToRemove)
+			continue
+		}
+
+		if shouldRemove {
+			log.Debug("destroying persister", "path", persisterToRemove.getPath())
 			err := persisterToRemove.getPersister().DestroyClosed()
 			if err != nil {
 				return err
@@ -946,6 +1043,240 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 	return nil
 }
 
+// startRetentionWorker starts the goroutine which moves the old persisters in the cold storage and applies the size
+// retention policy, so the disk heavy operations are not done while holding the storer mutex
+func (ps *PruningStorer) startRetentionWorker() {
+	if ps.coldStorage == nil && ps.maxSizeInBytes == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ps.cancelRetention = cancel
+	ps.retentionTrigger = make(chan struct{}, 1)
+
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ps.retentionTrigger:
+				ps.applyRetentionPolicies(ctx)
+			}
+		}
+	}()
+}
+
+func (ps *PruningStorer) triggerRetention() {
+	if ps.retentionTrigger == nil {
+		return
+	}
+
+	select {
+	case ps.retentionTrigger <- struct{}{}:
+	default:
+	}
+}
+
+// stopRetentionWorker stops the retention worker and waits for the persister it currently handles, if any
+func (ps *PruningStorer) stopRetentionWorker() {
+	if ps.cancelRetention == nil {
+		return
+	}
+
+	ps.cancelRetention()
+	ps.mutRetention.Lock()
+	ps.mutRetention.Unlock()
+}
+
+func (ps *PruningStorer) applyRetentionPolicies(ctx context.Context) {
+	ps.mutRetention.Lock()
+	defer ps.mutRetention.Unlock()
+
+	ps.moveWaitingPersistersToColdStorage(ctx)
+	ps.applySizeRetentionPolicy(ctx)
+}
+
+func (ps *PruningStorer) moveWaitingPersistersToColdStorage(ctx context.Context) {
+	ps.lock.Lock()
+	persistersToMove := ps.persistersToMove
+	ps.persistersToMove = nil
+	ps.lock.Unlock()
+
+	notMoved := make([]*persisterData, 0)
+	for i, pd := range persistersToMove {
+		if ctx.Err() != nil {
+			notMoved = append(notMoved, persistersToMove[i:]...)
+			break
+		}
+
+		moved, err := ps.moveToColdStorage(pd)
+		if err != nil {
+			log.Warn("PruningStorer - move in cold storage", "identifier", ps.identifier, "epoch", pd.epoch, "error", err.Error())
+		}
+		if !moved && !pd.getIsCold() {
+			notMoved = append(notMoved, pd)
+		}
+	}
+
+	// the opened or failed persisters are kept in the queue so they will be moved on the next retention run
+	ps.lock.Lock()
+	ps.persistersToMove = append(notMoved, ps.persistersToMove...)
+	ps.lock.Unlock()
+}
+
+func (ps *PruningStorer) moveToColdStorage(pd *persisterData) (bool, error) {
+	pd.mutFiles.Lock()
+	defer pd.mutFiles.Unlock()
+
+	if pd.getIsCold() {
+		return false, nil
+	}
+	if !pd.getIsClosed() {
+		log.Debug("PruningStorer - skip moving an opened persister in cold storage", "identifier", ps.identifier, "epoch", pd.epoch)
+		return false, nil
+	}
+
+	err := ps.coldStorage.move(pd)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// applySizeRetentionPolicy evicts the oldest closed persisters while the persisters stored on the database path exceed
+// the size budget. The evicted persisters are moved in the cold storage if enabled, otherwise they are destroyed if
+// the old data should be cleaned
+func (ps *PruningStorer) applySizeRetentionPolicy(ctx context.Context) {
+	if ps.maxSizeInBytes == 0 {
+		return
+	}
+
+	ps.lock.RLock()
+	persisters := make([]*persisterData, 0, len(ps.persistersMapByEpoch))
+	for _, pd := range ps.persistersMapByEpoch {
+		if !pd.getIsCold() {
+			persisters = append(persisters, pd)
+		}
+	}
+	ps.lock.RUnlock()
+
+	sort.Slice(persisters, func(i, j int) bool {
+		return persisters[i].epoch < persisters[j].epoch
+	})
+
+	sizes := make([]uint64, len(persisters))
+	totalSize := uint64(0)
+	for i, pd := range persisters {
+		path := pd.getPath()
+		size, err := directorySize(path)
+		if err != nil {
+			log.Debug("PruningStorer - cannot compute persister size", "path", path, "error", err.Error())
+			continue
+		}
+
+		sizes[i] = size
+		totalSize += size
+	}
+
+	for i, pd := range persisters {
+		if totalSize <= ps.maxSizeInBytes || ctx.Err() != nil {
+			return
+		}
+
+		evicted, err := ps.evictPersister(pd)
+		if err != nil {
+			log.Warn("PruningStorer - size retention", "identifier", ps.identifier, "epoch", pd.epoch, "error", err.Error())
+			continue
+		}
+		if evicted {
+			totalSize -= sizes[i]
+		}
+	}
+
+	if totalSize > ps.maxSizeInBytes {
+		log.Warn("PruningStorer - size budget exceeded",
+			"identifier", ps.identifier,
+			"size", totalSize,
+			"max size", ps.maxSizeInBytes)
+	}
+}
+
+func (ps *PruningStorer) evictPersister(pd *persisterData) (bool, error) {
+	if ps.coldStorage != nil {
+		return ps.moveToColdStorage(pd)
+	}
+
+	if !ps.oldDataCleanerProvider.ShouldClean() {
+		return false, nil
+	}
+
+	log.Debug("destroying persister due to size retention", "path", pd.getPath())
+
+	return ps.destroyClosedPersister(pd)
+}
+
+// destroyClosedPersister destroys the provided persister, if still closed, and removes it from the map
+func (ps *PruningStorer) destroyClosedPersister(pd *persisterData) (bool, error) {
+	pd.mutFiles.Lock()
+	defer pd.mutFiles.Unlock()
+
+	if !pd.getIsClosed() || pd.getIsCold() {
+		return false, nil
+	}
+
+	err := pd.getPersister().DestroyClosed()
+	if err != nil {
+		return false, err
+	}
+
+	ps.lock.Lock()
+	if ps.persistersMapByEpoch[pd.epoch] == pd {
+		delete(ps.persistersMapByEpoch, pd.epoch)
+	}
+	ps.lock.Unlock()
+
+	return true, nil
+}
+
+// RemoveClosedPersistersOlderThan destroys the closed persisters of the epochs older than the provided one, before the
+// epoch change would do it. The persisters of the epochs that should be kept are never removed. It returns the epochs
+// of the destroyed persisters
+func (ps *PruningStorer) RemoveClosedPersistersOlderThan(epoch uint32) ([]uint32, error) {
+	ps.lock.RLock()
+	if !ps.pruningEnabled || len(ps.activePersisters) == 0 {
+		ps.lock.RUnlock()
+		return nil, nil
+	}
+
+	oldestEpochToKeep := int64(ps.activePersisters[0].epoch) - int64(ps.numOfEpochsToKeep) + 1
+	if oldestEpochToKeep > int64(epoch) {
+		oldestEpochToKeep = int64(epoch)
+	}
+
+	persistersToRemove := make([]*persisterData, 0)
+	for persisterEpoch, pd := range ps.persistersMapByEpoch {
+		if int64(persisterEpoch) < oldestEpochToKeep {
+			persistersToRemove = append(persistersToRemove, pd)
+		}
+	}
+	ps.lock.RUnlock()
+
+	removedEpochs := make([]uint32, 0, len(persistersToRemove))
+	for _, pd := range persistersToRemove {
+		log.Debug("destroying persister before the epoch change", "path", pd.getPath())
+		removed, err := ps.destroyClosedPersister(pd)
+		if err != nil {
+			return removedEpochs, err
+		}
+		if removed {
+			removedEpochs = append(removedEpochs, pd.epoch)
+		}
+	}
+
+	return removedEpochs, nil
+}
+
 func (ps *PruningStorer) processPersistersToClose() []*persisterData {
 	persistersToClose := make([]*persisterData, 0)
 
@@ -1005,20 +1336,66 @@ func createPersisterDataForEpoch(args *StorerArgs, epoch uint32, shard string) (
 	// e.g. determined from directories in persister path or taken from boot storer
 	filePath := createPersisterPathForEpoch(args, epoch, shard)
 
-	db, err := args.PersisterFactory.Create(filePath)
+	p := &persisterData{
+		epoch:    epoch,
+		path:     filePath,
+		isClosed: false,
+	}
+
+	cs := newColdStorage(args)
+	if cs != nil && !isDirectory(filePath) {
+		coldPath, isArchived, found := cs.findInColdStorage(filePath)
+		if found {
+			p.path = coldPath
+			p.isCold = true
+			p.isArchived = isArchived
+		}
+	}
+
+	db, err := openPersister(args.PersisterFactory, cs, p)
 	if err != nil {
 		log.Warn("persister create error", "error", err.Error())
 		return nil, err
 	}
+	p.persister = db
 
-	p := &persisterData{
-		persister: db,
-		epoch:     epoch,
-		path:      filePath,
-		isClosed:  false,
+	return p, nil
+}
+
+// openPersister creates the persister of the provided persister data, extracting it first if it was archived in the
+// cold storage. The extracted directory is kept, so the next openings of the same persister reuse it
+func openPersister(persisterFactory DbFactoryHandler, cs *coldStorage, pd *persisterData) (storage.Persister, error) {
+	pd.RLock()
+	path := pd.path
+	isArchived := pd.isArchived
+	pd.RUnlock()
+
+	if !isArchived || cs == nil {
+		return persisterFactory.Create(path)
 	}
 
-	return p, nil
+	pd.RLock()
+	extractedPath := pd.extractedPath
+	pd.RUnlock()
+	if !isDirectory(extractedPath) {
+		var err error
+		extractedPath, err = cs.extract(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	persister, err := persisterFactory.Create(extractedPath)
+	if err != nil {
+		_ = os.RemoveAll(extractedPath)
+		return nil, err
+	}
+
+	pd.Lock()
+	pd.extractedPath = extractedPath
+	pd.Unlock()
+
+	return persister, nil
 }
 
 func computeOldestEpoch(metaBlock *block.MetaBlock) uint32 {
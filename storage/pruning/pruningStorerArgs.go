@@ -23,6 +23,9 @@ type StorerArgs struct {
 	StartingEpoch             uint32
 	PruningEnabled            bool
 	EnabledDbLookupExtensions bool
+	MaxSizeInBytes            uint64
+	ColdStoragePath           string
+	CompressColdStorage       bool
 }
 
 // FullHistoryStorerArgs will hold the arguments needed for full history PruningStorer
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -1088,3 +1089,278 @@ func TestPruningStorer_ConcurrentOperations(t *testing.T) {
 	// if the "resource temporary unavailable" occurs, this test will take longer than this to execute
 	require.True(t, elapsedTime < 100*time.Second)
 }
+
+func createColdStorageTestArgs(t *testing.T, testDir string, compress bool) *pruning.StorerArgs {
+	args := getDefaultArgs()
+	args.PersisterFactory = factory.NewPersisterFactory(config.DBConfig{
+		Type:              "LvlDBSerial",
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+		BatchDelaySeconds: 2,
+	})
+	databasePath := filepath.Join(testDir, "db")
+	var err error
+	args.PathManager, err = pathmanager.NewPathManager(databasePath+"/epoch_[E]/shard_[S]/[I]", "shard_[S]/[I]", databasePath)
+	require.Nil(t, err)
+	args.CustomDatabaseRemover = &testscommon.CustomDatabaseRemoverStub{
+		ShouldRemoveCalled: func(dbIdentifier string, epoch uint32) bool {
+			return true
+		},
+	}
+	args.NumOfActivePersisters = 1
+	args.ColdStoragePath = filepath.Join(testDir, "cold")
+	args.CompressColdStorage = compress
+
+	return args
+}
+
+func TestPruningStorer_ColdStorage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("uncompressed", testPruningStorerColdStorage(false))
+	t.Run("compressed", testPruningStorerColdStorage(true))
+}
+
+func testPruningStorerColdStorage(compress bool) func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		testDir := t.TempDir()
+		args := createColdStorageTestArgs(t, testDir, compress)
+		ps, err := pruning.NewPruningStorer(args)
+		require.Nil(t, err)
+
+		key, val := []byte("key"), []byte("value")
+		err = ps.Put(key, val)
+		require.Nil(t, err)
+
+		for epoch := uint32(1); epoch <= 2; epoch++ {
+			err = ps.ChangeEpochSimple(epoch)
+			require.Nil(t, err)
+		}
+		ps.ApplyRetentionPolicies()
+
+		hotPath := filepath.Join(testDir, "db", "epoch_0", "shard_0", "id")
+		coldPath := filepath.Join(testDir, "cold", "epoch_0", "shard_0", "id")
+		if compress {
+			coldPath += ".tar.gz"
+		}
+		_, err = os.Stat(hotPath)
+		assert.True(t, os.IsNotExist(err))
+		_, err = os.Stat(coldPath)
+		assert.Nil(t, err)
+
+		extractedPath := filepath.Join(testDir, "cold", "epoch_0", "shard_0", "id_extracted")
+		for i := 0; i < 2; i++ {
+			ps.ClearCache()
+			res, errGet := ps.GetFromEpoch(key, 0)
+			assert.Nil(t, errGet)
+			assert.Equal(t, val, res)
+
+			// the extracted persister is reused between the reads
+			_, errGet = os.Stat(extractedPath)
+			assert.Equal(t, compress, errGet == nil)
+		}
+
+		err = ps.Close()
+		require.Nil(t, err)
+
+		_, err = os.Stat(extractedPath)
+		assert.True(t, os.IsNotExist(err))
+
+		// the cold persisters should still be readable after a restart
+		args.StartingEpoch = 2
+		ps, err = pruning.NewPruningStorer(args)
+		require.Nil(t, err)
+		defer func() {
+			_ = ps.Close()
+		}()
+
+		res, err := ps.GetFromEpoch(key, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, val, res)
+	}
+}
+
+func TestPruningStorer_SizeRetentionPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should move the oldest persisters in cold storage", func(t *testing.T) {
+		t.Parallel()
+
+		testDir := t.TempDir()
+		args := createColdStorageTestArgs(t, testDir, false)
+		args.CustomDatabaseRemover = &testscommon.CustomDatabaseRemoverStub{}
+		args.NumOfEpochsToKeep = 10
+		args.MaxSizeInBytes = 1
+		ps, err := pruning.NewPruningStorer(args)
+		require.Nil(t, err)
+		defer func() {
+			_ = ps.Close()
+		}()
+
+		err = ps.Put([]byte("key"), []byte("value"))
+		require.Nil(t, err)
+		err = ps.ChangeEpochSimple(1)
+		require.Nil(t, err)
+		ps.ApplyRetentionPolicies()
+
+		_, err = os.Stat(filepath.Join(testDir, "cold", "epoch_0", "shard_0", "id"))
+		assert.Nil(t, err)
+		_, err = os.Stat(filepath.Join(testDir, "db", "epoch_1", "shard_0", "id"))
+		assert.Nil(t, err, "active persister should not be moved")
+		assert.Equal(t, []uint32{0, 1}, ps.PersistersMapByEpochToSlice())
+	})
+	t.Run("without cold storage should destroy the oldest persisters if old data should be cleaned", func(t *testing.T) {
+		t.Parallel()
+
+		testDir := t.TempDir()
+		args := createColdStorageTestArgs(t, testDir, false)
+		args.CustomDatabaseRemover = &testscommon.CustomDatabaseRemoverStub{}
+		args.OldDataCleanerProvider = &testscommon.OldDataCleanerProviderStub{
+			ShouldCleanCalled: func() bool {
+				return true
+			},
+		}
+		args.ColdStoragePath = ""
+		args.NumOfEpochsToKeep = 10
+		args.MaxSizeInBytes = 1
+		ps, err := pruning.NewPruningStorer(args)
+		require.Nil(t, err)
+		defer func() {
+			_ = ps.Close()
+		}()
+
+		err = ps.Put([]byte("key"), []byte("value"))
+		require.Nil(t, err)
+		err = ps.ChangeEpochSimple(1)
+		require.Nil(t, err)
+		ps.ApplyRetentionPolicies()
+
+		_, err = os.Stat(filepath.Join(testDir, "db", "epoch_0", "shard_0", "id"))
+		assert.True(t, os.IsNotExist(err))
+		assert.Equal(t, []uint32{1}, ps.PersistersMapByEpochToSlice())
+	})
+	t.Run("without cold storage should keep the persisters if old data should not be cleaned", func(t *testing.T) {
+		t.Parallel()
+
+		testDir := t.TempDir()
+		args := createColdStorageTestArgs(t, testDir, false)
+		args.CustomDatabaseRemover = &testscommon.CustomDatabaseRemoverStub{}
+		args.ColdStoragePath = ""
+		args.NumOfEpochsToKeep = 10
+		args.MaxSizeInBytes = 1
+		ps, err := pruning.NewPruningStorer(args)
+		require.Nil(t, err)
+		defer func() {
+			_ = ps.Close()
+		}()
+
+		err = ps.ChangeEpochSimple(1)
+		require.Nil(t, err)
+		ps.ApplyRetentionPolicies()
+
+		_, err = os.Stat(filepath.Join(testDir, "db", "epoch_0", "shard_0", "id"))
+		assert.Nil(t, err)
+		assert.Equal(t, []uint32{0, 1}, ps.PersistersMapByEpochToSlice())
+	})
+}
+
+func TestPruningStorer_ColdStorageMoveShouldBeDoneInBackground(t *testing.T) {
+	t.Parallel()
+
+	testDir := t.TempDir()
+	args := createColdStorageTestArgs(t, testDir, true)
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = ps.Close()
+	}()
+
+	err = ps.Put([]byte("key"), []byte("value"))
+	require.Nil(t, err)
+	for epoch := uint32(1); epoch <= 2; epoch++ {
+		err = ps.ChangeEpochSimple(epoch)
+		require.Nil(t, err)
+	}
+
+	coldPath := filepath.Join(testDir, "cold", "epoch_0", "shard_0", "id.tar.gz")
+	require.Eventually(t, func() bool {
+		_, errStat := os.Stat(coldPath)
+		return errStat == nil
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func TestPruningStorer_ColdStorageShouldRetryTheOpenedPersisters(t *testing.T) {
+	t.Parallel()
+
+	testDir := t.TempDir()
+	args := createColdStorageTestArgs(t, testDir, false)
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = ps.Close()
+	}()
+
+	err = ps.Put([]byte("key"), []byte("value"))
+	require.Nil(t, err)
+	err = ps.ChangeEpochSimple(1)
+	require.Nil(t, err)
+
+	// the persister is opened before being queued, so the background retention run can not move it either
+	closePersister, err := ps.OpenPersisterInEpoch(0)
+	require.Nil(t, err)
+	err = ps.ChangeEpochSimple(2)
+	require.Nil(t, err)
+	ps.ApplyRetentionPolicies()
+
+	hotPath := filepath.Join(testDir, "db", "epoch_0", "shard_0", "id")
+	coldPath := filepath.Join(testDir, "cold", "epoch_0", "shard_0", "id")
+	_, err = os.Stat(hotPath)
+	assert.Nil(t, err, "opened persister should not be moved")
+	_, err = os.Stat(coldPath)
+	assert.True(t, os.IsNotExist(err))
+
+	closePersister()
+	ps.ApplyRetentionPolicies()
+
+	_, err = os.Stat(hotPath)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(coldPath)
+	assert.Nil(t, err)
+}
+
+func TestPruningStorer_RemoveClosedPersistersOlderThan(t *testing.T) {
+	t.Parallel()
+
+	testDir := t.TempDir()
+	args := createColdStorageTestArgs(t, testDir, false)
+	args.ColdStoragePath = ""
+	args.CustomDatabaseRemover = &testscommon.CustomDatabaseRemoverStub{}
+	args.NumOfEpochsToKeep = 3
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = ps.Close()
+	}()
+
+	for epoch := uint32(1); epoch <= 4; epoch++ {
+		err = ps.ChangeEpochSimple(epoch)
+		require.Nil(t, err)
+	}
+	assert.Equal(t, []uint32{0, 1, 2, 3, 4}, ps.PersistersMapByEpochToSlice())
+
+	// the epochs to keep are 2, 3 and 4
+	removedEpochs, err := ps.RemoveClosedPersistersOlderThan(4)
+	assert.Nil(t, err)
+	sort.Slice(removedEpochs, func(i, j int) bool {
+		return removedEpochs[i] < removedEpochs[j]
+	})
+	assert.Equal(t, []uint32{0, 1}, removedEpochs)
+	assert.Equal(t, []uint32{2, 3, 4}, ps.PersistersMapByEpochToSlice())
+
+	_, err = os.Stat(filepath.Join(testDir, "db", "epoch_1", "shard_0", "id"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(testDir, "db", "epoch_2", "shard_0", "id"))
+	assert.Nil(t, err)
+}
@@ -134,7 +134,7 @@ func (ps *triePruningStorer) PutInEpochWithoutCache(key []byte, data []byte, epo
 		return fmt.Errorf("put in epoch: persister for epoch %d not found", epoch)
 	}
 
-	persister, closePersister, err := ps.createAndInitPersisterIfClosedProtected(pd)
+	persister, closePersister, err := ps.createAndInitPersisterIfClosed(pd)
 	if err != nil {
 		return err
 	}
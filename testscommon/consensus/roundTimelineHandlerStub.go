@@ -0,0 +1,104 @@
+package consensus
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// RoundTimelineHandlerStub -
+type RoundTimelineHandlerStub struct {
+	StartRoundCalled                 func(round int64, leader string, consensusGroupSize int, signaturesThreshold int, selfRole string)
+	BlockProposedCalled              func(round int64, blockHash []byte)
+	BlockReceivedCalled              func(round int64, blockHash []byte)
+	BlockProcessedCalled             func(round int64)
+	SignatureSentCalled              func(round int64)
+	SignatureReceivedCalled          func(round int64, pubKey string)
+	SignaturesThresholdReachedCalled func(round int64)
+	FinalInfoBroadcastCalled         func(round int64)
+	FinalInfoReceivedCalled          func(round int64)
+	BlockCommittedCalled             func(round int64)
+	GetRoundsCalled                  func() []*common.ConsensusRoundTimeline
+}
+
+// StartRound -
+func (stub *RoundTimelineHandlerStub) StartRound(round int64, leader string, consensusGroupSize int, signaturesThreshold int, selfRole string) {
+	if stub.StartRoundCalled != nil {
+		stub.StartRoundCalled(round, leader, consensusGroupSize, signaturesThreshold, selfRole)
+	}
+}
+
+// BlockProposed -
+func (stub *RoundTimelineHandlerStub) BlockProposed(round int64, blockHash []byte) {
+	if stub.BlockProposedCalled != nil {
+		stub.BlockProposedCalled(round, blockHash)
+	}
+}
+
+// BlockReceived -
+func (stub *RoundTimelineHandlerStub) BlockReceived(round int64, blockHash []byte) {
+	if stub.BlockReceivedCalled != nil {
+		stub.BlockReceivedCalled(round, blockHash)
+	}
+}
+
+// BlockProcessed -
+func (stub *RoundTimelineHandlerStub) BlockProcessed(round int64) {
+	if stub.BlockProcessedCalled != nil {
+		stub.BlockProcessedCalled(round)
+	}
+}
+
+// SignatureSent -
+func (stub *RoundTimelineHandlerStub) SignatureSent(round int64) {
+	if stub.SignatureSentCalled != nil {
+		stub.SignatureSentCalled(round)
+	}
+}
+
+// SignatureReceived -
+func (stub *RoundTimelineHandlerStub) SignatureReceived(round int64, pubKey string) {
+	if stub.SignatureReceivedCalled != nil {
+		stub.SignatureReceivedCalled(round, pubKey)
+	}
+}
+
+// SignaturesThresholdReached -
+func (stub *RoundTimelineHandlerStub) SignaturesThresholdReached(round int64) {
+	if stub.SignaturesThresholdReachedCalled != nil {
+		stub.SignaturesThresholdReachedCalled(round)
+	}
+}
+
+// FinalInfoBroadcast -
+func (stub *RoundTimelineHandlerStub) FinalInfoBroadcast(round int64) {
+	if stub.FinalInfoBroadcastCalled != nil {
+		stub.FinalInfoBroadcastCalled(round)
+	}
+}
+
+// FinalInfoReceived -
+func (stub *RoundTimelineHandlerStub) FinalInfoReceived(round int64) {
+	if stub.FinalInfoReceivedCalled != nil {
+		stub.FinalInfoReceivedCalled(round)
+	}
+}
+
+// BlockCommitted -
+func (stub *RoundTimelineHandlerStub) BlockCommitted(round int64) {
+	if stub.BlockCommittedCalled != nil {
+		stub.BlockCommittedCalled(round)
+	}
+}
+
+// GetRounds -
+func (stub *RoundTimelineHandlerStub) GetRounds() []*common.ConsensusRoundTimeline {
+	if stub.GetRoundsCalled != nil {
+		return stub.GetRoundsCalled()
+	}
+
+	return make([]*common.ConsensusRoundTimeline, 0)
+}
+
+// IsInterfaceNil -
+func (stub *RoundTimelineHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
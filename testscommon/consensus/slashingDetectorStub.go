@@ -0,0 +1,42 @@
+package consensus
+
+import (
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/consensus"
+	"github.com/ElrondNetwork/elrond-go/p2p"
+)
+
+// SlashingDetectorStub -
+type SlashingDetectorStub struct {
+	CheckProposalCalled  func(cnsMsg *consensus.Message, p2pMessage p2p.MessageP2P)
+	CheckSignatureCalled func(cnsMsg *consensus.Message, p2pMessage p2p.MessageP2P)
+	GetEvidencesCalled   func() []*common.SlashingEvidence
+}
+
+// CheckProposal -
+func (stub *SlashingDetectorStub) CheckProposal(cnsMsg *consensus.Message, p2pMessage p2p.MessageP2P) {
+	if stub.CheckProposalCalled != nil {
+		stub.CheckProposalCalled(cnsMsg, p2pMessage)
+	}
+}
+
+// CheckSignature -
+func (stub *SlashingDetectorStub) CheckSignature(cnsMsg *consensus.Message, p2pMessage p2p.MessageP2P) {
+	if stub.CheckSignatureCalled != nil {
+		stub.CheckSignatureCalled(cnsMsg, p2pMessage)
+	}
+}
+
+// GetEvidences -
+func (stub *SlashingDetectorStub) GetEvidences() []*common.SlashingEvidence {
+	if stub.GetEvidencesCalled != nil {
+		return stub.GetEvidencesCalled()
+	}
+
+	return make([]*common.SlashingEvidence, 0)
+}
+
+// IsInterfaceNil -
+func (stub *SlashingDetectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/dblookupext/eventsIndex"
 )
 
 // HistoryRepositoryStub -
@@ -16,6 +17,9 @@ type HistoryRepositoryStub struct {
 	GetEpochByHashCalled               func(hash []byte) (uint32, error)
 	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
 	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
+	GetESDTHoldersCalled               func(token string, from uint32, size uint32) ([]*esdtSupply.HolderBalance, uint32, error)
+	GetEventsCalled                    func(query eventsIndex.EventsQuery, from uint32, size uint32) (*eventsIndex.EventsPage, error)
+	GetCollectionNFTsCalled            func(collection string, from uint32, size uint32) ([]*esdtSupply.NFTHolders, uint32, error)
 	IsEnabledCalled                    func() bool
 }
 
@@ -87,6 +91,33 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// GetESDTHolders -
+func (hp *HistoryRepositoryStub) GetESDTHolders(token string, from uint32, size uint32) ([]*esdtSupply.HolderBalance, uint32, error) {
+	if hp.GetESDTHoldersCalled != nil {
+		return hp.GetESDTHoldersCalled(token, from, size)
+	}
+
+	return nil, 0, nil
+}
+
+// GetCollectionNFTs -
+func (hp *HistoryRepositoryStub) GetCollectionNFTs(collection string, from uint32, size uint32) ([]*esdtSupply.NFTHolders, uint32, error) {
+	if hp.GetCollectionNFTsCalled != nil {
+		return hp.GetCollectionNFTsCalled(collection, from, size)
+	}
+
+	return nil, 0, nil
+}
+
+// GetEvents -
+func (hp *HistoryRepositoryStub) GetEvents(query eventsIndex.EventsQuery, from uint32, size uint32) (*eventsIndex.EventsPage, error) {
+	if hp.GetEventsCalled != nil {
+		return hp.GetEventsCalled(query, from, size)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
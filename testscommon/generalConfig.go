@@ -39,7 +39,13 @@ func GetGeneralConfig() config.Config {
 			SignatureLength: 48,
 		},
 		Consensus: config.ConsensusConfig{
-			Type: "bls",
+			Type:               "bls",
+			RoundsTimelineSize: 10,
+			SlashingDetector: config.SlashingDetectorConfig{
+				Enabled:         true,
+				NumRoundsToKeep: 5,
+				MaxNumEvidences: 10,
+			},
 		},
 		ValidatorStatistics: config.ValidatorStatisticsConfig{
 			CacheRefreshIntervalInSec: uint32(100),
@@ -267,6 +273,26 @@ func GetGeneralConfig() config.Config {
 				MaxOpenFiles:      10,
 			},
 		},
+		SlashingEvidenceStorage: config.StorageConfig{
+			Cache: getLRUCacheConfig(),
+			DB: config.DBConfig{
+				FilePath:          AddTimestampSuffix("SlashingEvidenceStorageDB"),
+				Type:              string(storageUnit.MemoryDB),
+				BatchDelaySeconds: 30,
+				MaxBatchSize:      6,
+				MaxOpenFiles:      10,
+			},
+		},
+		ValidatorHistoryStorage: config.StorageConfig{
+			Cache: getLRUCacheConfig(),
+			DB: config.DBConfig{
+				FilePath:          AddTimestampSuffix("ValidatorHistoryStorageDB"),
+				Type:              string(storageUnit.MemoryDB),
+				BatchDelaySeconds: 30,
+				MaxBatchSize:      6,
+				MaxOpenFiles:      10,
+			},
+		},
 		SmartContractsStorage: config.StorageConfig{
 			Cache: getLRUCacheConfig(),
 			DB: config.DBConfig{
@@ -395,8 +421,23 @@ func GetGeneralConfig() config.Config {
 			Name:     "VMOutputCacher",
 		},
 		PeersRatingConfig: config.PeersRatingConfig{
-			TopRatedCacheCapacity: 1000,
-			BadRatedCacheCapacity: 1000,
+			TopRatedCacheCapacity:     1000,
+			BadRatedCacheCapacity:     1000,
+			PersistenceEnabled:        false,
+			PersistIntervalInSeconds:  60,
+			RatingHalfLifeInMinutes:   120,
+			MaxNumOfKnownGoodPeers:    50,
+			MinRatingForKnownGoodPeer: 20,
+			Storage: config.StorageConfig{
+				Cache: getLRUCacheConfig(),
+				DB: config.DBConfig{
+					FilePath:          AddTimestampSuffix("PeersRating"),
+					Type:              string(storageUnit.MemoryDB),
+					BatchDelaySeconds: 30,
+					MaxBatchSize:      6,
+					MaxOpenFiles:      10,
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,54 @@
+package testscommon
+
+import "time"
+
+// MetricsRegistryStub -
+type MetricsRegistryStub struct {
+	ObserveDurationCalled      func(name string, duration time.Duration, labelValues ...string)
+	ObserveDurationSinceCalled func(name string, start time.Time, labelValues ...string)
+	IncrementCounterCalled     func(name string, labelValues ...string)
+	AddToCounterCalled         func(name string, value uint64, labelValues ...string)
+	PrometheusStringCalled     func(shardID uint32) string
+}
+
+// ObserveDuration -
+func (stub *MetricsRegistryStub) ObserveDuration(name string, duration time.Duration, labelValues ...string) {
+	if stub.ObserveDurationCalled != nil {
+		stub.ObserveDurationCalled(name, duration, labelValues...)
+	}
+}
+
+// ObserveDurationSince -
+func (stub *MetricsRegistryStub) ObserveDurationSince(name string, start time.Time, labelValues ...string) {
+	if stub.ObserveDurationSinceCalled != nil {
+		stub.ObserveDurationSinceCalled(name, start, labelValues...)
+	}
+}
+
+// IncrementCounter -
+func (stub *MetricsRegistryStub) IncrementCounter(name string, labelValues ...string) {
+	if stub.IncrementCounterCalled != nil {
+		stub.IncrementCounterCalled(name, labelValues...)
+	}
+}
+
+// AddToCounter -
+func (stub *MetricsRegistryStub) AddToCounter(name string, value uint64, labelValues ...string) {
+	if stub.AddToCounterCalled != nil {
+		stub.AddToCounterCalled(name, value, labelValues...)
+	}
+}
+
+// PrometheusString -
+func (stub *MetricsRegistryStub) PrometheusString(shardID uint32) string {
+	if stub.PrometheusStringCalled != nil {
+		return stub.PrometheusStringCalled(shardID)
+	}
+
+	return ""
+}
+
+// IsInterfaceNil -
+func (stub *MetricsRegistryStub) IsInterfaceNil() bool {
+	return stub == nil
+}
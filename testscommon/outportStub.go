@@ -3,6 +3,7 @@ package testscommon
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/outport"
 )
 
@@ -12,6 +13,7 @@ type OutportStub struct {
 	SaveValidatorsRatingCalled  func(index string, validatorsInfo []*indexer.ValidatorRatingInfo)
 	SaveValidatorsPubKeysCalled func(shardPubKeys map[uint32][][]byte, epoch uint32)
 	HasDriversCalled            func() bool
+	SaveSlashingEvidenceCalled  func(evidence *common.SlashingEvidence)
 }
 
 // SaveBlock -
@@ -35,6 +37,13 @@ func (as *OutportStub) SaveValidatorsPubKeys(shardPubKeys map[uint32][][]byte, e
 	}
 }
 
+// SaveSlashingEvidence -
+func (as *OutportStub) SaveSlashingEvidence(evidence *common.SlashingEvidence) {
+	if as.SaveSlashingEvidenceCalled != nil {
+		as.SaveSlashingEvidenceCalled(evidence)
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (as *OutportStub) IsInterfaceNil() bool {
 	return as == nil
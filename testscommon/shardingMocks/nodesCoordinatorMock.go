@@ -24,7 +24,9 @@ type NodesCoordinatorMock struct {
 	GetValidatorWithPublicKeyCalled          func(publicKey []byte) (validator nodesCoordinator.Validator, shardId uint32, err error)
 	GetAllEligibleValidatorsPublicKeysCalled func(epoch uint32) (map[uint32][][]byte, error)
 	GetAllWaitingValidatorsPublicKeysCalled  func() (map[uint32][][]byte, error)
+	GetAllLeavingValidatorsPublicKeysCalled  func(epoch uint32) (map[uint32][][]byte, error)
 	ConsensusGroupSizeCalled                 func(uint32) int
+	PreviewNodesConfigCalled                 func(epoch uint32, randomness []byte, validatorsInfo []*state.ShardValidatorInfo) (*nodesCoordinator.NodesConfigPreview, error)
 }
 
 // NewNodesCoordinatorMock -
@@ -71,7 +73,10 @@ func (ncm *NodesCoordinatorMock) GetChance(uint32) uint32 {
 }
 
 // GetAllLeavingValidatorsPublicKeys -
-func (ncm *NodesCoordinatorMock) GetAllLeavingValidatorsPublicKeys(_ uint32) (map[uint32][][]byte, error) {
+func (ncm *NodesCoordinatorMock) GetAllLeavingValidatorsPublicKeys(epoch uint32) (map[uint32][][]byte, error) {
+	if ncm.GetAllLeavingValidatorsPublicKeysCalled != nil {
+		return ncm.GetAllLeavingValidatorsPublicKeysCalled(epoch)
+	}
 	return nil, nil
 }
 
@@ -255,6 +260,14 @@ func (ncm *NodesCoordinatorMock) ShardIdForEpoch(_ uint32) (uint32, error) {
 func (ncm *NodesCoordinatorMock) ShuffleOutForEpoch(_ uint32) {
 }
 
+// PreviewNodesConfig -
+func (ncm *NodesCoordinatorMock) PreviewNodesConfig(epoch uint32, randomness []byte, validatorsInfo []*state.ShardValidatorInfo) (*nodesCoordinator.NodesConfigPreview, error) {
+	if ncm.PreviewNodesConfigCalled != nil {
+		return ncm.PreviewNodesConfigCalled(epoch, randomness, validatorsInfo)
+	}
+	return &nodesCoordinator.NodesConfigPreview{}, nil
+}
+
 // GetConsensusWhitelistedNodes return the whitelisted nodes allowed to send consensus messages, for each of the shards
 func (ncm *NodesCoordinatorMock) GetConsensusWhitelistedNodes(
 	_ uint32,
@@ -16,6 +16,7 @@ type NodesCoordinatorStub struct {
 	ConsensusGroupSizeCalled            func(shardID uint32) int
 	ComputeConsensusGroupCalled         func(randomness []byte, round uint64, shardId uint32, epoch uint32) (validatorsGroup []nodesCoordinator.Validator, err error)
 	EpochStartPrepareCalled             func(metaHdr data.HeaderHandler, body data.BodyHandler)
+	PreviewNodesConfigCalled            func(epoch uint32, randomness []byte, validatorsInfo []*state.ShardValidatorInfo) (*nodesCoordinator.NodesConfigPreview, error)
 }
 
 // NodesCoordinatorToRegistry -
@@ -147,6 +148,15 @@ func (ncm *NodesCoordinatorStub) ShardIdForEpoch(_ uint32) (uint32, error) {
 func (ncm *NodesCoordinatorStub) ShuffleOutForEpoch(_ uint32) {
 }
 
+// PreviewNodesConfig -
+func (ncm *NodesCoordinatorStub) PreviewNodesConfig(epoch uint32, randomness []byte, validatorsInfo []*state.ShardValidatorInfo) (*nodesCoordinator.NodesConfigPreview, error) {
+	if ncm.PreviewNodesConfigCalled != nil {
+		return ncm.PreviewNodesConfigCalled(epoch, randomness, validatorsInfo)
+	}
+
+	return &nodesCoordinator.NodesConfigPreview{}, nil
+}
+
 // GetConsensusWhitelistedNodes return the whitelisted nodes allowed to send consensus messages, for each of the shards
 func (ncm *NodesCoordinatorStub) GetConsensusWhitelistedNodes(_ uint32) (map[string]struct{}, error) {
 	panic("not implemented")
@@ -4,6 +4,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/cmd/node/factory"
+	"github.com/ElrondNetwork/elrond-go/common/disabled"
 	"github.com/ElrondNetwork/elrond-go/statusHandler"
 )
 
@@ -16,5 +17,6 @@ func (shfm *StatusHandlersFactoryMock) Create(_ marshal.Marshalizer, _ typeConve
 	return &StatusHandlersUtilsMock{
 		AppStatusHandler: NewAppStatusHandlerMock(),
 		StatusMetrics:    statusHandler.NewStatusMetrics(),
+		Registry:         disabled.NewMetricsRegistry(),
 	}, nil
 }
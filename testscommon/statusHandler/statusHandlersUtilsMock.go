@@ -2,6 +2,7 @@ package statusHandler
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
@@ -10,6 +11,7 @@ import (
 type StatusHandlersUtilsMock struct {
 	StatusMetrics    external.StatusMetricsHandler
 	AppStatusHandler core.AppStatusHandler
+	Registry         common.MetricsRegistry
 }
 
 // UpdateStorerAndMetricsForPersistentHandler -
@@ -27,6 +29,11 @@ func (shum *StatusHandlersUtilsMock) Metrics() external.StatusMetricsHandler {
 	return shum.StatusMetrics
 }
 
+// MetricsRegistry -
+func (shum *StatusHandlersUtilsMock) MetricsRegistry() common.MetricsRegistry {
+	return shum.Registry
+}
+
 // IsInterfaceNil -
 func (shum *StatusHandlersUtilsMock) IsInterfaceNil() bool {
 	return shum == nil
@@ -0,0 +1,20 @@
+package storage
+
+// OldEpochsCleanerStub -
+type OldEpochsCleanerStub struct {
+	CleanEpochsOlderThanCalled func(epoch uint32) (int, error)
+}
+
+// CleanEpochsOlderThan -
+func (stub *OldEpochsCleanerStub) CleanEpochsOlderThan(epoch uint32) (int, error) {
+	if stub.CleanEpochsOlderThanCalled != nil {
+		return stub.CleanEpochsOlderThanCalled(epoch)
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (stub *OldEpochsCleanerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
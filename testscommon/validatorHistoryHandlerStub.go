@@ -0,0 +1,51 @@
+package testscommon
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// ValidatorHistoryHandlerStub -
+type ValidatorHistoryHandlerStub struct {
+	PrepareEpochCalled     func(validatorInfos map[uint32][]*state.ValidatorInfo)
+	EpochStartActionCalled func(hdr data.HeaderHandler)
+	GetHistoryCalled       func(pubKey []byte, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error)
+}
+
+// PrepareEpoch -
+func (vhhs *ValidatorHistoryHandlerStub) PrepareEpoch(validatorInfos map[uint32][]*state.ValidatorInfo) {
+	if vhhs.PrepareEpochCalled != nil {
+		vhhs.PrepareEpochCalled(validatorInfos)
+	}
+}
+
+// EpochStartAction -
+func (vhhs *ValidatorHistoryHandlerStub) EpochStartAction(hdr data.HeaderHandler) {
+	if vhhs.EpochStartActionCalled != nil {
+		vhhs.EpochStartActionCalled(hdr)
+	}
+}
+
+// EpochStartPrepare -
+func (vhhs *ValidatorHistoryHandlerStub) EpochStartPrepare(_ data.HeaderHandler, _ data.BodyHandler) {
+}
+
+// NotifyOrder -
+func (vhhs *ValidatorHistoryHandlerStub) NotifyOrder() uint32 {
+	return 0
+}
+
+// GetHistory -
+func (vhhs *ValidatorHistoryHandlerStub) GetHistory(pubKey []byte, fromEpoch uint32, toEpoch uint32) ([]*common.ValidatorEpochHistory, error) {
+	if vhhs.GetHistoryCalled != nil {
+		return vhhs.GetHistoryCalled(pubKey, fromEpoch, toEpoch)
+	}
+
+	return make([]*common.ValidatorEpochHistory, 0), nil
+}
+
+// IsInterfaceNil -
+func (vhhs *ValidatorHistoryHandlerStub) IsInterfaceNil() bool {
+	return vhhs == nil
+}
@@ -877,6 +877,9 @@ func (bn *branchNode) getNextHashAndKey(key []byte) (bool, []byte, []byte) {
 	if len(key) == 0 || check.IfNil(bn) {
 		return false, nil, nil
 	}
+	if int(key[0]) >= len(bn.EncodedChildren) {
+		return false, nil, nil
+	}
 
 	wantHash := bn.EncodedChildren[key[0]]
 	nextKey := key[1:]
@@ -51,6 +51,7 @@ func createTrieStorageManager(store storage.Storer) (common.StorageManager, stor
 		GeneralConfig:          generalCfg,
 		CheckpointHashesHolder: hashesHolder.NewCheckpointHashesHolder(10000000, uint64(hasherMock.Size())),
 		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:        &testscommon.MetricsRegistryStub{},
 	}
 	tsm, _ := NewTrieStorageManager(args)
 
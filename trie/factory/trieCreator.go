@@ -22,6 +22,7 @@ type TrieCreateArgs struct {
 	CheckpointsEnabled bool
 	MaxTrieLevelInMem  uint
 	IdleProvider       trie.IdleNodeProvider
+	MetricsRegistry    common.MetricsRegistry
 }
 
 type trieCreator struct {
@@ -74,6 +75,7 @@ func (tc *trieCreator) Create(args TrieCreateArgs) (common.StorageManager, commo
 		GeneralConfig:          tc.trieStorageManagerConfig,
 		CheckpointHashesHolder: checkpointHashesHolder,
 		IdleProvider:           args.IdleProvider,
+		MetricsRegistry:        args.MetricsRegistry,
 	}
 
 	log.Debug("trie checkpoints status", "enabled", args.CheckpointsEnabled)
@@ -164,6 +166,7 @@ func CreateTriesComponentsForShardId(
 		CheckpointsEnabled: generalConfig.StateTriesConfig.CheckpointsEnabled,
 		MaxTrieLevelInMem:  generalConfig.StateTriesConfig.MaxStateTrieLevelInMemory,
 		IdleProvider:       coreComponentsHolder.ProcessStatusHandler(),
+		MetricsRegistry:    coreComponentsHolder.MetricsRegistry(),
 	}
 	userStorageManager, userAccountTrie, err := trFactory.Create(args)
 	if err != nil {
@@ -183,6 +186,7 @@ func CreateTriesComponentsForShardId(
 		CheckpointsEnabled: generalConfig.StateTriesConfig.CheckpointsEnabled,
 		MaxTrieLevelInMem:  generalConfig.StateTriesConfig.MaxPeerTrieLevelInMemory,
 		IdleProvider:       coreComponentsHolder.ProcessStatusHandler(),
+		MetricsRegistry:    coreComponentsHolder.MetricsRegistry(),
 	}
 	peerStorageManager, peerAccountsTrie, err := trFactory.Create(args)
 	if err != nil {
@@ -31,6 +31,7 @@ func getCreateArgs() factory.TrieCreateArgs {
 		CheckpointsEnabled: false,
 		MaxTrieLevelInMem:  5,
 		IdleProvider:       &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:    &testscommon.MetricsRegistryStub{},
 	}
 }
 
@@ -0,0 +1,21 @@
+package trie
+
+// MergeProofs merges the provided Merkle proofs into a single multi-proof. The nodes shared between the proofs
+// (e.g. the root node and the upper branch nodes) will only be present once in the resulting proof
+func MergeProofs(proofs ...[][]byte) [][]byte {
+	multiProof := make([][]byte, 0)
+	addedNodes := make(map[string]struct{})
+	for _, proof := range proofs {
+		for _, encodedNode := range proof {
+			_, alreadyAdded := addedNodes[string(encodedNode)]
+			if alreadyAdded {
+				continue
+			}
+
+			addedNodes[string(encodedNode)] = struct{}{}
+			multiProof = append(multiProof, encodedNode)
+		}
+	}
+
+	return multiProof
+}
@@ -31,3 +31,75 @@ func NewMerkleProofVerifier(marshalizer marshal.Marshalizer, hasher hashing.Hash
 func (mpv *merkleProofVerifier) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	return mpv.trie.VerifyProof(rootHash, key, proof)
 }
+
+// VerifyProofAndGetValue verifies the given Merkle proof and, if the proof is valid, returns the value
+// found in the leaf node that corresponds to the given key
+func (mpv *merkleProofVerifier) VerifyProofAndGetValue(rootHash []byte, key []byte, proof [][]byte) ([]byte, bool, error) {
+	nodes := mpv.createHashToNodeMap(proof)
+
+	return mpv.getValueFromProofNodes(rootHash, key, nodes)
+}
+
+// VerifyMultiProof verifies that the given multi-proof (a deduplicated set of trie nodes) proves all the provided keys
+func (mpv *merkleProofVerifier) VerifyMultiProof(rootHash []byte, keys [][]byte, multiProof [][]byte) (bool, error) {
+	if len(keys) == 0 {
+		return false, nil
+	}
+
+	nodes := mpv.createHashToNodeMap(multiProof)
+	for _, key := range keys {
+		_, ok, err := mpv.getValueFromProofNodes(rootHash, key, nodes)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (mpv *merkleProofVerifier) createHashToNodeMap(proof [][]byte) map[string][]byte {
+	nodes := make(map[string][]byte, len(proof))
+	for _, encodedNode := range proof {
+		if len(encodedNode) == 0 {
+			continue
+		}
+
+		hash := mpv.trie.hasher.Compute(string(encodedNode))
+		nodes[string(hash)] = encodedNode
+	}
+
+	return nodes
+}
+
+func (mpv *merkleProofVerifier) getValueFromProofNodes(rootHash []byte, key []byte, nodes map[string][]byte) ([]byte, bool, error) {
+	wantHash := rootHash
+	hexKey := keyBytesToHex(key)
+	for {
+		encodedNode, found := nodes[string(wantHash)]
+		if !found {
+			return nil, false, nil
+		}
+
+		n, err := decodeNode(encodedNode, mpv.trie.marshalizer, mpv.trie.hasher)
+		if err != nil {
+			return nil, false, err
+		}
+
+		var proofVerified bool
+		proofVerified, wantHash, hexKey = n.getNextHashAndKey(hexKey)
+		if proofVerified {
+			return n.getValue(), true, nil
+		}
+		if len(wantHash) == 0 {
+			return nil, false, nil
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mpv *merkleProofVerifier) IsInterfaceNil() bool {
+	return mpv == nil
+}
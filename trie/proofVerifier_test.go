@@ -1,6 +1,7 @@
 package trie
 
 import (
+	"bytes"
 	"encoding/hex"
 	"testing"
 
@@ -47,3 +48,110 @@ func TestMerkleProofVerifier_VerifyProof(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, ok)
 }
+
+func TestMerkleProofVerifier_VerifyProofAndGetValue(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.RootHash()
+	marsh, hsh := getTestMarshalizerAndHasher()
+	mpv, _ := NewMerkleProofVerifier(marsh, hsh)
+
+	proof, _, _ := tr.GetProof([]byte("dog"))
+	value, ok, err := mpv.VerifyProofAndGetValue(rootHash, []byte("dog"), proof)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("puppy"), value)
+
+	value, ok, err = mpv.VerifyProofAndGetValue(rootHash, []byte("doe"), proof)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Nil(t, value)
+}
+
+func TestMerkleProofVerifier_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	tr := initTrie()
+	rootHash, _ := tr.RootHash()
+	marsh, hsh := getTestMarshalizerAndHasher()
+	mpv, _ := NewMerkleProofVerifier(marsh, hsh)
+
+	keys := [][]byte{[]byte("doe"), []byte("dog"), []byte("ddog")}
+	proofs := make([][][]byte, 0, len(keys))
+	numNodes := 0
+	for _, key := range keys {
+		proof, _, _ := tr.GetProof(key)
+		proofs = append(proofs, proof)
+		numNodes += len(proof)
+	}
+	multiProof := MergeProofs(proofs...)
+	assert.True(t, len(multiProof) < numNodes)
+
+	t.Run("all keys should verify", func(t *testing.T) {
+		ok, err := mpv.VerifyMultiProof(rootHash, keys, multiProof)
+		assert.Nil(t, err)
+		assert.True(t, ok)
+	})
+	t.Run("missing key should not verify", func(t *testing.T) {
+		ok, err := mpv.VerifyMultiProof(rootHash, append(keys, []byte("cat")), multiProof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("incomplete multi proof should not verify", func(t *testing.T) {
+		ok, err := mpv.VerifyMultiProof(rootHash, keys, MergeProofs(proofs[0], proofs[1]))
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("different root hash should not verify", func(t *testing.T) {
+		ok, err := mpv.VerifyMultiProof([]byte("root hash"), keys, multiProof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("no keys should not verify", func(t *testing.T) {
+		ok, err := mpv.VerifyMultiProof(rootHash, nil, multiProof)
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestMerkleProofVerifier_VerifyMultiProofWithCraftedNodesShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	marsh, hsh := getTestMarshalizerAndHasher()
+	mpv, _ := NewMerkleProofVerifier(marsh, hsh)
+
+	t.Run("extension node key longer than the searched key", func(t *testing.T) {
+		t.Parallel()
+
+		en := &extensionNode{
+			CollapsedEn: CollapsedEn{
+				Key:          bytes.Repeat([]byte{1}, 200),
+				EncodedChild: []byte("child"),
+			},
+			baseNode: &baseNode{marsh: marsh, hasher: hsh},
+		}
+		encodedNode, _ := en.getEncodedNode()
+		rootHash := hsh.Compute(string(encodedNode))
+
+		ok, err := mpv.VerifyMultiProof(rootHash, [][]byte{[]byte("key")}, [][]byte{encodedNode})
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+	t.Run("branch node with fewer children", func(t *testing.T) {
+		t.Parallel()
+
+		bn := &branchNode{
+			CollapsedBn: CollapsedBn{
+				EncodedChildren: [][]byte{[]byte("child")},
+			},
+			baseNode: &baseNode{marsh: marsh, hasher: hsh},
+		}
+		encodedNode, _ := bn.getEncodedNode()
+		rootHash := hsh.Compute(string(encodedNode))
+
+		ok, err := mpv.VerifyMultiProof(rootHash, [][]byte{[]byte("key")}, [][]byte{encodedNode})
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/statusHandler/prometheus"
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
@@ -33,6 +34,7 @@ type trieStorageManager struct {
 	closer                 core.SafeCloser
 	closed                 bool
 	idleProvider           IdleNodeProvider
+	metricsRegistry        common.MetricsRegistry
 }
 
 type snapshotsQueueEntry struct {
@@ -52,6 +54,7 @@ type NewTrieStorageManagerArgs struct {
 	GeneralConfig          config.TrieStorageManagerConfig
 	CheckpointHashesHolder CheckpointHashesHolder
 	IdleProvider           IdleNodeProvider
+	MetricsRegistry        common.MetricsRegistry
 }
 
 // NewTrieStorageManager creates a new instance of trieStorageManager
@@ -74,6 +77,9 @@ func NewTrieStorageManager(args NewTrieStorageManagerArgs) (*trieStorageManager,
 	if check.IfNil(args.IdleProvider) {
 		return nil, ErrNilIdleNodeProvider
 	}
+	if check.IfNil(args.MetricsRegistry) {
+		return nil, ErrNilMetricsRegistry
+	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
 
@@ -87,6 +93,7 @@ func NewTrieStorageManager(args NewTrieStorageManagerArgs) (*trieStorageManager,
 		checkpointHashesHolder: args.CheckpointHashesHolder,
 		closer:                 closing.NewSafeChanCloser(),
 		idleProvider:           args.IdleProvider,
+		metricsRegistry:        args.MetricsRegistry,
 	}
 	goRoutinesThrottler, err := throttler.NewNumGoRoutinesThrottler(int32(args.GeneralConfig.SnapshotsGoroutineNum))
 	if err != nil {
@@ -397,7 +404,9 @@ func (tsm *trieStorageManager) finishOperation(snapshotEntry *snapshotsQueueEntr
 }
 
 func (tsm *trieStorageManager) takeSnapshot(snapshotEntry *snapshotsQueueEntry, msh marshal.Marshalizer, hsh hashing.Hasher, ctx context.Context, goRoutinesThrottler core.Throttler) {
+	startTime := time.Now()
 	defer func() {
+		tsm.metricsRegistry.ObserveDurationSince(prometheus.TrieSnapshotDuration, startTime, prometheus.SnapshotTypeSnapshot)
 		tsm.finishOperation(snapshotEntry, "trie snapshot finished")
 		goRoutinesThrottler.EndProcessing()
 	}()
@@ -435,7 +444,9 @@ func (tsm *trieStorageManager) takeSnapshot(snapshotEntry *snapshotsQueueEntry,
 }
 
 func (tsm *trieStorageManager) takeCheckpoint(checkpointEntry *snapshotsQueueEntry, msh marshal.Marshalizer, hsh hashing.Hasher, ctx context.Context, goRoutinesThrottler core.Throttler) {
+	startTime := time.Now()
 	defer func() {
+		tsm.metricsRegistry.ObserveDurationSince(prometheus.TrieSnapshotDuration, startTime, prometheus.SnapshotTypeCheckpoint)
 		tsm.finishOperation(checkpointEntry, "trie checkpoint finished")
 		goRoutinesThrottler.EndProcessing()
 	}()
@@ -31,6 +31,7 @@ func getNewTrieStorageManagerArgs() trie.NewTrieStorageManagerArgs {
 		GeneralConfig:          config.TrieStorageManagerConfig{SnapshotsGoroutineNum: 1},
 		CheckpointHashesHolder: hashesHolder.NewCheckpointHashesHolder(10, hashSize),
 		IdleProvider:           &testscommon.ProcessStatusHandlerStub{},
+		MetricsRegistry:        &testscommon.MetricsRegistryStub{},
 	}
 }
 
@@ -64,6 +65,15 @@ func TestNewTrieStorageManager(t *testing.T) {
 		assert.Nil(t, ts)
 		assert.Equal(t, trie.ErrNilCheckpointHashesHolder, err)
 	})
+	t.Run("nil metrics registry", func(t *testing.T) {
+		t.Parallel()
+
+		args := getNewTrieStorageManagerArgs()
+		args.MetricsRegistry = nil
+		ts, err := trie.NewTrieStorageManager(args)
+		assert.Nil(t, ts)
+		assert.Equal(t, trie.ErrNilMetricsRegistry, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
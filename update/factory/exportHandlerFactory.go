@@ -340,6 +340,7 @@ func (e *exportHandlerFactory) Create() (update.ExportHandler, error) {
 		InputAntifloodHandler:      e.inputAntifloodHandler,
 		OutputAntifloodHandler:     e.outputAntifloodHandler,
 		PeersRatingHandler:         e.peersRatingHandler,
+		MetricsRegistry:            e.CoreComponents.MetricsRegistry(),
 	}
 	resolversFactory, err := NewResolversContainerFactory(argsResolvers)
 	if err != nil {
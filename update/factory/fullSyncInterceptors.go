@@ -43,6 +43,7 @@ type fullSyncInterceptorsContainerFactory struct {
 	whiteListerVerifiedTxs update.WhiteListHandler
 	antifloodHandler       process.P2PAntifloodHandler
 	preferredPeersHolder   update.PreferredPeersHolderHandler
+	metricsRegistry        common.MetricsRegistry
 }
 
 // ArgsNewFullSyncInterceptorsContainerFactory holds the arguments needed for fullSyncInterceptorsContainerFactory
@@ -151,6 +152,7 @@ func NewFullSyncInterceptorsContainerFactory(
 		whiteListHandler:       args.WhiteListHandler,
 		whiteListerVerifiedTxs: args.WhiteListerVerifiedTxs,
 		antifloodHandler:       args.AntifloodHandler,
+		metricsRegistry:        args.CoreComponents.MetricsRegistry(),
 		//TODO: inject the real peers holder once we have the peers mapping before epoch bootstrap finishes
 		preferredPeersHolder: disabled.NewPreferredPeersHolder(),
 	}
@@ -233,6 +235,9 @@ func checkBaseParams(
 	if check.IfNil(coreComponents.Uint64ByteSliceConverter()) {
 		return process.ErrNilUint64Converter
 	}
+	if check.IfNil(coreComponents.MetricsRegistry()) {
+		return process.ErrNilMetricsRegistry
+	}
 	if len(coreComponents.ChainID()) == 0 {
 		return process.ErrInvalidChainID
 	}
@@ -518,6 +523,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneTxInterceptor(topic s
 			WhiteListRequest:     ficf.whiteListHandler,
 			CurrentPeerId:        ficf.messenger.ID(),
 			PreferredPeersHolder: ficf.preferredPeersHolder,
+			MetricsRegistry:      ficf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -553,6 +559,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneUnsignedTxInterceptor
 			WhiteListRequest:     ficf.whiteListHandler,
 			CurrentPeerId:        ficf.messenger.ID(),
 			PreferredPeersHolder: ficf.preferredPeersHolder,
+			MetricsRegistry:      ficf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -588,6 +595,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneRewardTxInterceptor(t
 			WhiteListRequest:     ficf.whiteListHandler,
 			CurrentPeerId:        ficf.messenger.ID(),
 			PreferredPeersHolder: ficf.preferredPeersHolder,
+			MetricsRegistry:      ficf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -660,6 +668,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneMiniBlocksInterceptor
 			WhiteListRequest:     ficf.whiteListHandler,
 			CurrentPeerId:        ficf.messenger.ID(),
 			PreferredPeersHolder: ficf.preferredPeersHolder,
+			MetricsRegistry:      ficf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -700,6 +709,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) generateMetachainHeaderInterce
 			WhiteListRequest:     ficf.whiteListHandler,
 			CurrentPeerId:        ficf.messenger.ID(),
 			PreferredPeersHolder: ficf.preferredPeersHolder,
+			MetricsRegistry:      ficf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -736,6 +746,7 @@ func (ficf *fullSyncInterceptorsContainerFactory) createOneTrieNodesInterceptor(
 			WhiteListRequest:     ficf.whiteListHandler,
 			CurrentPeerId:        ficf.messenger.ID(),
 			PreferredPeersHolder: ficf.preferredPeersHolder,
+			MetricsRegistry:      ficf.metricsRegistry,
 		},
 	)
 	if err != nil {
@@ -34,6 +34,7 @@ type resolversContainerFactory struct {
 	outputAntifloodHandler dataRetriever.P2PAntifloodHandler
 	throttler              dataRetriever.ResolverThrottler
 	peersRatingHandler     dataRetriever.PeersRatingHandler
+	metricsRegistry        common.MetricsRegistry
 }
 
 // ArgsNewResolversContainerFactory defines the arguments for the resolversContainerFactory constructor
@@ -46,6 +47,7 @@ type ArgsNewResolversContainerFactory struct {
 	InputAntifloodHandler      dataRetriever.P2PAntifloodHandler
 	OutputAntifloodHandler     dataRetriever.P2PAntifloodHandler
 	PeersRatingHandler         dataRetriever.PeersRatingHandler
+	MetricsRegistry            common.MetricsRegistry
 	NumConcurrentResolvingJobs int32
 }
 
@@ -69,6 +71,9 @@ func NewResolversContainerFactory(args ArgsNewResolversContainerFactory) (*resol
 	if check.IfNil(args.PeersRatingHandler) {
 		return nil, update.ErrNilPeersRatingHandler
 	}
+	if check.IfNil(args.MetricsRegistry) {
+		return nil, update.ErrNilMetricsRegistry
+	}
 
 	thr, err := throttler.NewNumGoRoutinesThrottler(args.NumConcurrentResolvingJobs)
 	if err != nil {
@@ -85,6 +90,7 @@ func NewResolversContainerFactory(args ArgsNewResolversContainerFactory) (*resol
 		outputAntifloodHandler: args.OutputAntifloodHandler,
 		throttler:              thr,
 		peersRatingHandler:     args.PeersRatingHandler,
+		metricsRegistry:        args.MetricsRegistry,
 	}, nil
 }
 
@@ -199,6 +205,7 @@ func (rcf *resolversContainerFactory) createTrieNodesResolver(baseTopic string,
 		Marshalizer:      rcf.marshalizer,
 		AntifloodHandler: rcf.inputAntifloodHandler,
 		Throttler:        rcf.throttler,
+		MetricsRegistry:  rcf.metricsRegistry,
 	}
 	resolver, err := resolvers.NewTrieNodeResolver(argTrieResolver)
 	if err != nil {
@@ -552,7 +552,7 @@ func saveDelegationManagementData(
 }
 
 func getDelegationContractList(
-	eei vm.SystemEI,
+	eei StorageFromAddressGetter,
 	marshalizer marshal.Marshalizer,
 	delegationMgrAddress []byte,
 ) (*DelegationContractList, error) {
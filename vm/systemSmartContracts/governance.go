@@ -1160,11 +1160,7 @@ func (g *governanceContract) proposalContainsVoter(proposal *GeneralProposal, vo
 // computeVotingPower returns the voting power for a value. The value can be either a balance or
 //  the staked value for a validator
 func (g *governanceContract) computeVotingPower(value *big.Int) (*big.Int, error) {
-	if value.Cmp(zero) < 0 {
-		return nil, fmt.Errorf("cannot compute voting power on a negative value")
-	}
-
-	return big.NewInt(0).Sqrt(value), nil
+	return ComputeVotingPower(value)
 }
 
 // computeAccountLeveledPower takes a value and some voter data and returns the voting power of that value in
@@ -1257,79 +1253,25 @@ func (g *governanceContract) computeValidatorVotingPower(validatorAddress []byte
 //TODO: benchmark this, the other solution is to receive in the arguments which delegation contracts should be checked
 // and consume gas for each delegation contract to be checked
 func (g *governanceContract) computeVotingPowerFromTotalStake(address []byte) (*big.Int, error) {
-	totalStake, err := g.getTotalStake(address)
-	if err != nil && err != vm.ErrEmptyStorage {
-		return nil, fmt.Errorf("could not return total stake for the provided address, thus cannot compute voting power")
-	}
-	if totalStake == nil {
-		totalStake = big.NewInt(0)
-	}
-
-	dContractList, err := getDelegationContractList(g.eei, g.marshalizer, g.delegationMgrSCAddress)
-	if err != nil {
-		return nil, err
-	}
-
-	var activeDelegated *big.Int
-	for _, contract := range dContractList.Addresses {
-		activeDelegated, err = g.getActiveFundForDelegator(contract, address)
-		if err != nil {
-			return nil, err
-		}
-
-		totalStake.Add(totalStake, activeDelegated)
-	}
-
-	votingPower, err := g.computeVotingPower(totalStake)
+	details, err := ComputeVotingPowerDetails(g.createArgsVotingPower(), address)
 	if err != nil {
 		return nil, err
 	}
 
-	return votingPower, nil
+	return details.VotingPower, nil
 }
 
-func (g *governanceContract) getActiveFundForDelegator(delegationAddress []byte, address []byte) (*big.Int, error) {
-	dData := &DelegatorData{
-		UnClaimedRewards:      big.NewInt(0),
-		TotalCumulatedRewards: big.NewInt(0),
-	}
-	marshaledData := g.eei.GetStorageFromAddress(delegationAddress, address)
-	if len(marshaledData) == 0 {
-		return big.NewInt(0), nil
-	}
-
-	err := g.marshalizer.Unmarshal(dData, marshaledData)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(dData.ActiveFund) == 0 {
-		return big.NewInt(0), nil
-	}
-
-	marshaledData = g.eei.GetStorageFromAddress(delegationAddress, dData.ActiveFund)
-	activeFund := &Fund{Value: big.NewInt(0)}
-	err = g.marshalizer.Unmarshal(activeFund, marshaledData)
-	if err != nil {
-		return nil, err
+func (g *governanceContract) createArgsVotingPower() ArgsVotingPower {
+	return ArgsVotingPower{
+		Storage:                g.eei,
+		Marshalizer:            g.marshalizer,
+		ValidatorSCAddress:     g.validatorSCAddress,
+		DelegationMgrSCAddress: g.delegationMgrSCAddress,
 	}
-
-	return activeFund.Value, nil
 }
 
 func (g *governanceContract) getTotalStake(validatorAddress []byte) (*big.Int, error) {
-	marshaledData := g.eei.GetStorageFromAddress(g.validatorSCAddress, validatorAddress)
-	if len(marshaledData) == 0 {
-		return nil, vm.ErrEmptyStorage
-	}
-
-	validatorData := &ValidatorDataV2{}
-	err := g.marshalizer.Unmarshal(validatorData, marshaledData)
-	if err != nil {
-		return nil, err
-	}
-
-	return validatorData.TotalStakeValue, nil
+	return getTotalStake(g.eei, g.marshalizer, g.validatorSCAddress, validatorAddress)
 }
 
 // validateInitialWhiteListedAddresses makes basic checks that the provided initial whitelisted
@@ -0,0 +1,133 @@
+package systemSmartContracts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/vm"
+)
+
+// StorageFromAddressGetter defines the read access to the storage of the system smart contracts
+type StorageFromAddressGetter interface {
+	GetStorageFromAddress(address []byte, key []byte) []byte
+}
+
+// ArgsVotingPower is the DTO used to compute the governance voting power of an address
+type ArgsVotingPower struct {
+	Storage                StorageFromAddressGetter
+	Marshalizer            marshal.Marshalizer
+	ValidatorSCAddress     []byte
+	DelegationMgrSCAddress []byte
+}
+
+// VotingPowerDetails holds the values that compose the governance voting power of an address
+type VotingPowerDetails struct {
+	StakedValue    *big.Int
+	DelegatedValue *big.Int
+	VotingPower    *big.Int
+}
+
+// ComputeVotingPower returns the voting power for a value. The value can be either a balance or
+// the staked value for a validator
+func ComputeVotingPower(value *big.Int) (*big.Int, error) {
+	if value.Cmp(zero) < 0 {
+		return nil, fmt.Errorf("cannot compute voting power on a negative value")
+	}
+
+	return big.NewInt(0).Sqrt(value), nil
+}
+
+// ComputeVotingPowerDetails returns the voting power of an address computed from its total staked value and
+// its active funds from all the delegation contracts
+func ComputeVotingPowerDetails(args ArgsVotingPower, address []byte) (*VotingPowerDetails, error) {
+	totalStake, err := getTotalStake(args.Storage, args.Marshalizer, args.ValidatorSCAddress, address)
+	if err != nil && err != vm.ErrEmptyStorage {
+		return nil, fmt.Errorf("could not return total stake for the provided address, thus cannot compute voting power")
+	}
+	stakedValue := big.NewInt(0)
+	if totalStake != nil {
+		stakedValue.Set(totalStake)
+	}
+
+	dContractList, err := getDelegationContractList(args.Storage, args.Marshalizer, args.DelegationMgrSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatedValue := big.NewInt(0)
+	var activeDelegated *big.Int
+	for _, contract := range dContractList.Addresses {
+		activeDelegated, err = getActiveFundForDelegator(args.Storage, args.Marshalizer, contract, address)
+		if err != nil {
+			return nil, err
+		}
+
+		delegatedValue.Add(delegatedValue, activeDelegated)
+	}
+
+	votingPower, err := ComputeVotingPower(big.NewInt(0).Add(stakedValue, delegatedValue))
+	if err != nil {
+		return nil, err
+	}
+
+	return &VotingPowerDetails{
+		StakedValue:    stakedValue,
+		DelegatedValue: delegatedValue,
+		VotingPower:    votingPower,
+	}, nil
+}
+
+func getActiveFundForDelegator(
+	storage StorageFromAddressGetter,
+	marshalizer marshal.Marshalizer,
+	delegationAddress []byte,
+	address []byte,
+) (*big.Int, error) {
+	dData := &DelegatorData{
+		UnClaimedRewards:      big.NewInt(0),
+		TotalCumulatedRewards: big.NewInt(0),
+	}
+	marshaledData := storage.GetStorageFromAddress(delegationAddress, address)
+	if len(marshaledData) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	err := marshalizer.Unmarshal(dData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(dData.ActiveFund) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	marshaledData = storage.GetStorageFromAddress(delegationAddress, dData.ActiveFund)
+	activeFund := &Fund{Value: big.NewInt(0)}
+	err = marshalizer.Unmarshal(activeFund, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return activeFund.Value, nil
+}
+
+func getTotalStake(
+	storage StorageFromAddressGetter,
+	marshalizer marshal.Marshalizer,
+	validatorSCAddress []byte,
+	validatorAddress []byte,
+) (*big.Int, error) {
+	marshaledData := storage.GetStorageFromAddress(validatorSCAddress, validatorAddress)
+	if len(marshaledData) == 0 {
+		return nil, vm.ErrEmptyStorage
+	}
+
+	validatorData := &ValidatorDataV2{}
+	err := marshalizer.Unmarshal(validatorData, marshaledData)
+	if err != nil {
+		return nil, err
+	}
+
+	return validatorData.TotalStakeValue, nil
+}